@@ -0,0 +1,499 @@
+// cmd/fakenews/main.go
+// Fake news provider server - emulates GDELT, NewsData.io, GNews and Mediastack
+// so the full ingestion path can run offline.
+//
+// Point the backend at it with:
+//
+//	GDELT_BASE_URL=http://localhost:8099/gdelt/api/v2/doc/doc
+//	NEWSDATA_BASE_URL=http://localhost:8099/newsdata/api/1/news
+//	GNEWS_BASE_URL=http://localhost:8099/gnews/api/v4/search
+//	MEDIASTACK_BASE_URL=http://localhost:8099/mediastack/v1/news
+//
+// and any non-empty API keys. Failure scenarios are set per provider with
+// -scenario (e.g. -scenario gnews=quota,mediastack=slow) or at runtime via
+// POST /_scenario?provider=gnews&mode=malformed. Combine with
+// API_TRANSPORT_MODE=record to capture fixtures for replay.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/services"
+)
+
+// Scenario modes
+const (
+	modeOK        = "ok"        // Normal response
+	modeQuota     = "quota"     // Provider-specific daily quota error
+	modeRateLimit = "ratelimit" // 429 with Retry-After
+	modeMalformed = "malformed" // 200 with truncated JSON
+	modeSlow      = "slow"      // Normal response after -slow delay
+	modeError     = "error"     // 500
+	modeFlaky     = "flaky"     // Alternates between ok and error
+	modeEmpty     = "empty"     // Valid response with no articles
+	modeDirty     = "dirty"     // Normal response plus repeated, re-titled and invalid records
+)
+
+var validModes = map[string]bool{
+	modeOK: true, modeQuota: true, modeRateLimit: true, modeMalformed: true,
+	modeSlow: true, modeError: true, modeFlaky: true, modeEmpty: true, modeDirty: true,
+}
+
+var providers = []string{"gdelt", "newsdata", "gnews", "mediastack"}
+
+// fakeServer holds per-provider scenarios and request counters
+type fakeServer struct {
+	mutex     sync.Mutex
+	scenarios map[string]string
+	requests  map[string]int
+	quota     int
+	slow      time.Duration
+	seed      string
+}
+
+func main() {
+	addr := flag.String("addr", ":8099", "listen address")
+	scenario := flag.String("scenario", "", "comma-separated provider=mode pairs (modes: ok, quota, ratelimit, malformed, slow, error, flaky, empty, dirty)")
+	quota := flag.Int("quota", 0, "requests per provider before quota errors start; 0 disables")
+	slow := flag.Duration("slow", 35*time.Second, "delay used by the slow scenario")
+	seed := flag.String("seed", "gonews", "seed for deterministic article generation")
+	flag.Parse()
+
+	server := &fakeServer{
+		scenarios: make(map[string]string),
+		requests:  make(map[string]int),
+		quota:     *quota,
+		slow:      *slow,
+		seed:      *seed,
+	}
+
+	for _, pair := range strings.Split(*scenario, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		provider, mode, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		if err := server.setScenario(provider, mode); err != nil {
+			log.Fatalf("invalid -scenario: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gdelt/api/v2/doc/doc", server.provider("gdelt", server.gdelt))
+	mux.HandleFunc("/newsdata/api/1/news", server.provider("newsdata", server.newsData))
+	mux.HandleFunc("/gnews/api/v4/search", server.provider("gnews", server.gnews))
+	mux.HandleFunc("/mediastack/v1/news", server.provider("mediastack", server.mediastack))
+	mux.HandleFunc("/_scenario", server.handleScenario)
+	mux.HandleFunc("/_reset", server.handleReset)
+
+	log.Printf("fakenews listening on %s (quota=%d, slow=%s)", *addr, *quota, *slow)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ===============================
+// SCENARIO CONTROL
+// ===============================
+
+func (s *fakeServer) setScenario(provider, mode string) error {
+	if !isProvider(provider) {
+		return fmt.Errorf("unknown provider %q", provider)
+	}
+	if !validModes[mode] {
+		return fmt.Errorf("unknown mode %q", mode)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.scenarios[provider] = mode
+	return nil
+}
+
+// handleScenario lists scenarios on GET and sets one on POST
+func (s *fakeServer) handleScenario(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		if err := s.setScenario(r.URL.Query().Get("provider"), r.URL.Query().Get("mode")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	s.mutex.Lock()
+	state := make(map[string]interface{}, len(providers))
+	for _, provider := range providers {
+		mode := s.scenarios[provider]
+		if mode == "" {
+			mode = modeOK
+		}
+		state[provider] = map[string]interface{}{"mode": mode, "requests": s.requests[provider]}
+	}
+	s.mutex.Unlock()
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// handleReset clears scenarios and request counters
+func (s *fakeServer) handleReset(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	s.scenarios = make(map[string]string)
+	s.requests = make(map[string]int)
+	s.mutex.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// provider wraps a success handler with key checks, quota counting and failure scenarios
+func (s *fakeServer) provider(name string, ok func(w http.ResponseWriter, r *http.Request, mode string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.requests[name]++
+		count := s.requests[name]
+		mode := s.scenarios[name]
+		s.mutex.Unlock()
+
+		if mode == "" {
+			mode = modeOK
+		}
+		if s.quota > 0 && count > s.quota {
+			mode = modeQuota
+		}
+		if mode == modeFlaky {
+			mode = modeOK
+			if count%2 == 0 {
+				mode = modeError
+			}
+		}
+
+		log.Printf("%s %s -> %s (request %d)", name, r.URL.Path, mode, count)
+
+		if name != "gdelt" && providerKey(name, r) == "" {
+			writeMissingKey(w, name)
+			return
+		}
+
+		switch mode {
+		case modeQuota:
+			writeQuotaError(w, name)
+		case modeRateLimit:
+			w.Header().Set("Retry-After", "60")
+			http.Error(w, `{"message":"Too Many Requests"}`, http.StatusTooManyRequests)
+		case modeMalformed:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"status":"success","articles":[{"title":"Truncated`)
+		case modeError:
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+		case modeSlow:
+			select {
+			case <-time.After(s.slow):
+			case <-r.Context().Done():
+				return
+			}
+			ok(w, r, modeOK)
+		default:
+			ok(w, r, mode)
+		}
+	}
+}
+
+// ===============================
+// PROVIDER RESPONSES
+// ===============================
+
+func (s *fakeServer) gdelt(w http.ResponseWriter, r *http.Request, mode string) {
+	query := r.URL.Query()
+	items := s.generate("gdelt", query.Get("query"), intParam(query.Get("maxrecords"), 25), mode)
+
+	response := services.GDELTResponse{Articles: []services.GDELTArticle{}}
+	for _, item := range items {
+		response.Articles = append(response.Articles, services.GDELTArticle{
+			URL:            item.url,
+			Title:          item.title,
+			Domain:         item.domain,
+			Language:       "English",
+			SourceCountry:  "India",
+			PublishDate:    item.published.Format("20060102150405"),
+			Tone:           item.tone,
+			SocialImageURL: item.image,
+			Themes:         []string{"GENERAL_GOVERNMENT"},
+			Persons:        []string{},
+			Organizations:  []string{},
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *fakeServer) newsData(w http.ResponseWriter, r *http.Request, mode string) {
+	query := r.URL.Query()
+	items := s.generate("newsdata", query.Get("q")+query.Get("category"), intParam(query.Get("size"), 10), mode)
+
+	response := services.NewsDataResponse{Status: "success", Results: []services.NewsDataArticle{}}
+	for _, item := range items {
+		image := item.image
+		response.Results = append(response.Results, services.NewsDataArticle{
+			ArticleID:   item.id,
+			Title:       item.title,
+			Link:        item.url,
+			Keywords:    []string{item.category, item.city},
+			Creator:     []string{item.author},
+			Description: item.description,
+			Content:     item.content,
+			PubDate:     item.published.Format("2006-01-02 15:04:05"),
+			ImageURL:    &image,
+			SourceID:    item.domain,
+			Category:    []string{item.category},
+			Country:     []string{"india"},
+			Language:    "english",
+		})
+	}
+	response.TotalResults = len(response.Results)
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *fakeServer) gnews(w http.ResponseWriter, r *http.Request, mode string) {
+	query := r.URL.Query()
+	items := s.generate("gnews", query.Get("q"), intParam(query.Get("max"), 10), mode)
+
+	response := services.GNewsResponse{Articles: []services.GNewsArticle{}}
+	for _, item := range items {
+		article := services.GNewsArticle{
+			Title:       item.title,
+			Description: item.description,
+			Content:     item.content,
+			URL:         item.url,
+			Image:       item.image,
+			PublishedAt: item.published.Format(time.RFC3339),
+		}
+		article.Source.Name = item.source
+		article.Source.URL = "https://" + item.domain
+		response.Articles = append(response.Articles, article)
+	}
+	response.TotalArticles = len(response.Articles)
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *fakeServer) mediastack(w http.ResponseWriter, r *http.Request, mode string) {
+	query := r.URL.Query()
+	limit := intParam(query.Get("limit"), 25)
+	items := s.generate("mediastack", query.Get("keywords")+query.Get("categories"), limit, mode)
+
+	response := services.MediastackResponse{Data: []services.MediastackArticle{}}
+	for _, item := range items {
+		image := item.image
+		response.Data = append(response.Data, services.MediastackArticle{
+			Author:      item.author,
+			Title:       item.title,
+			Description: item.description,
+			URL:         item.url,
+			Source:      item.source,
+			Image:       &image,
+			Category:    item.category,
+			Language:    "en",
+			Country:     "in",
+			PublishedAt: item.published,
+		})
+	}
+	response.Pagination.Limit = limit
+	response.Pagination.Count = len(response.Data)
+	response.Pagination.Total = len(response.Data)
+	writeJSON(w, http.StatusOK, response)
+}
+
+// writeQuotaError mimics each provider's own quota-exhausted response
+func writeQuotaError(w http.ResponseWriter, provider string) {
+	switch provider {
+	case "gdelt":
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, "Please limit requests to one every 5 seconds.")
+	case "newsdata":
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+			"status":  "error",
+			"results": map[string]string{"message": "API Rate limit exceeded", "code": "RateLimitExceeded"},
+		})
+	case "gnews":
+		writeJSON(w, http.StatusForbidden, map[string]interface{}{
+			"errors": []string{"You have reached your request limit for today."},
+		})
+	case "mediastack":
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+			"error": map[string]string{"code": "usage_limit_reached", "message": "Your monthly usage limit has been reached."},
+		})
+	}
+}
+
+func writeMissingKey(w http.ResponseWriter, provider string) {
+	writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+		"status": "error",
+		"error":  provider + ": missing API key",
+	})
+}
+
+func providerKey(provider string, r *http.Request) string {
+	query := r.URL.Query()
+	switch provider {
+	case "newsdata":
+		return query.Get("apikey")
+	case "gnews":
+		return query.Get("token")
+	case "mediastack":
+		return query.Get("access_key")
+	}
+	return ""
+}
+
+// ===============================
+// ARTICLE GENERATION
+// ===============================
+
+type fakeArticle struct {
+	id          string
+	title       string
+	description string
+	content     string
+	url         string
+	image       string
+	domain      string
+	source      string
+	author      string
+	category    string
+	city        string
+	tone        float64
+	published   time.Time
+}
+
+var (
+	fakeSources = []struct{ name, domain string }{
+		{"The Daily Ledger", "dailyledger.example.in"},
+		{"Metro Express", "metroexpress.example.in"},
+		{"Deccan Wire", "deccanwire.example.in"},
+		{"Northern Chronicle", "northernchronicle.example.in"},
+	}
+	fakeCities     = []string{"Mumbai", "Delhi", "Bengaluru", "Chennai", "Hyderabad", "Kolkata", "Pune", "Ahmedabad"}
+	fakeCategories = []string{"politics", "business", "sports", "technology", "health", "entertainment"}
+	fakeHeadlines  = map[string][]string{
+		"politics":      {"%s civic body passes budget after long debate", "State assembly session in %s sees opposition walkout"},
+		"business":      {"Sensex gains as %s-based firms report strong quarter", "RBI policy decision weighs on %s traders"},
+		"sports":        {"Ranji Trophy: %s side clinches thriller", "IPL franchise unveils new training centre in %s"},
+		"technology":    {"%s startup raises funding for UPI payments tool", "ISRO outreach event draws crowds in %s"},
+		"health":        {"%s hospitals report rise in monsoon fever cases", "New AIIMS wing approved near %s"},
+		"entertainment": {"Bollywood film shoot halts traffic in %s", "Film festival opens in %s with regional premieres"},
+	}
+	fakeAuthors = []string{"Staff Reporter", "Priya Sharma", "Arjun Mehta", "News Desk"}
+)
+
+// generate returns count deterministic articles; the same provider, query and seed
+// always produce the same URLs so re-ingestion exercises dedup
+func (s *fakeServer) generate(provider, query string, count int, mode string) []fakeArticle {
+	if mode == modeEmpty || count <= 0 {
+		return nil
+	}
+	if count > 100 {
+		count = 100
+	}
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	items := make([]fakeArticle, 0, count)
+	for i := 0; i < count; i++ {
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%s|%s|%s|%d", s.seed, provider, query, i)
+		n := h.Sum64()
+
+		category := fakeCategories[n%uint64(len(fakeCategories))]
+		city := fakeCities[(n>>8)%uint64(len(fakeCities))]
+		source := fakeSources[(n>>16)%uint64(len(fakeSources))]
+		headlines := fakeHeadlines[category]
+		title := fmt.Sprintf(headlines[(n>>24)%uint64(len(headlines))], city)
+		id := strconv.FormatUint(n, 36)
+
+		items = append(items, fakeArticle{
+			id:          id,
+			title:       title,
+			description: fmt.Sprintf("%s. Officials in %s said more details would follow.", title, city),
+			content:     fmt.Sprintf("%s. Reporting from %s, India. Officials in %s said further updates are expected later in the day.", title, city, city),
+			url:         fmt.Sprintf("https://%s/%s/%s-%s", source.domain, category, strings.ToLower(city), id),
+			image:       fmt.Sprintf("https://%s/images/%s.jpg", source.domain, id),
+			domain:      source.domain,
+			source:      source.name,
+			author:      fakeAuthors[(n>>32)%uint64(len(fakeAuthors))],
+			category:    category,
+			city:        city,
+			tone:        float64(int64(n>>40)%200-100) / 20,
+			published:   now.Add(-time.Duration(i*17) * time.Minute),
+		})
+	}
+	if mode == modeDirty {
+		items = append(items, dirtyArticles(items)...)
+	}
+	return items
+}
+
+// dirtyArticles returns the records real feeds mix into a page: a repeat of the first item, the
+// second re-titled at the same URL, and items with no title, a relative link or a date years ahead
+func dirtyArticles(items []fakeArticle) []fakeArticle {
+	if len(items) < 2 {
+		return nil
+	}
+
+	repeated := items[0]
+
+	retitled := items[1]
+	retitled.id += "u"
+	retitled.title += " - updated"
+
+	untitled := items[0]
+	untitled.id += "t"
+	untitled.title = ""
+	untitled.url += "-untitled"
+
+	relative := items[1]
+	relative.id += "r"
+	relative.title += " (relative link)"
+	relative.url = strings.TrimPrefix(relative.url, "https://"+relative.domain)
+
+	future := items[0]
+	future.id += "f"
+	future.title += " (scheduled)"
+	future.url += "-scheduled"
+	future.published = time.Date(2099, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return []fakeArticle{repeated, retitled, untitled, relative, future}
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
+
+func intParam(value string, fallback int) int {
+	if n, err := strconv.Atoi(value); err == nil && n > 0 {
+		return n
+	}
+	return fallback
+}
+
+func isProvider(name string) bool {
+	for _, provider := range providers {
+		if provider == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+// cmd/reingest/main.go
+// Raw archive replay - pushes archived provider responses back through the current
+// conversion and enrichment pipeline, upserting articles by external_id.
+//
+// Usage (from backend/):
+//
+//	go run ./cmd/reingest -list                                   # show archived partitions
+//	go run ./cmd/reingest -source gdelt -from 2026-10-01 -dry-run # diff against stored articles
+//	go run ./cmd/reingest -source gdelt -from 2026-10-01T06 -to 2026-10-01T12
+//
+// -from and -to accept YYYY-MM-DD, YYYY-MM-DDTHH or RFC 3339 and are read as UTC;
+// -to is exclusive. Without -dry-run, converted articles are written to the database.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/database"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+	"backend/pkg/rawarchive"
+)
+
+func main() {
+	source := flag.String("source", "", "only replay this provider (e.g. gdelt, newsdata, gnews, mediastack)")
+	from := flag.String("from", "", "first partition hour to replay (inclusive)")
+	to := flag.String("to", "", "partition hour to stop at (exclusive)")
+	dir := flag.String("dir", "", "archive directory (default RAW_ARCHIVE_DIR)")
+	dryRun := flag.Bool("dry-run", false, "convert and diff against stored articles without writing")
+	list := flag.Bool("list", false, "list matching partitions and exit")
+	batchSize := flag.Int("batch", 500, "records per pipeline run")
+	maxDiffs := flag.Int("diffs", 50, "maximum article diffs to print in dry-run mode")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Parse()
+
+	if err := run(*source, *from, *to, *dir, *dryRun, *list, *batchSize, *maxDiffs, *asJSON); err != nil {
+		fmt.Fprintln(os.Stderr, "reingest:", err)
+		os.Exit(1)
+	}
+}
+
+func run(source, from, to, dir string, dryRun, list bool, batchSize, maxDiffs int, asJSON bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if dir == "" {
+		dir = cfg.RawArchiveDir
+	}
+
+	filter := rawarchive.Filter{Source: source}
+	if filter.From, err = parseHour(from); err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	if filter.To, err = parseHour(to); err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	store, err := rawarchive.NewLocalStore(dir)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if list {
+		return listPartitions(store, filter)
+	}
+
+	db, err := database.Connect(cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// Replays must not archive their own output
+	cfg.RawArchiveEnabled = false
+
+	log := logger.NewLogger()
+	apiClient := services.NewAPIClient(cfg, log)
+	newsService := services.NewNewsAggregatorService(db.DB, db, nil, cfg, log, apiClient, nil, repository.NewArticleRepository(db))
+	defer newsService.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	report, err := newsService.ReplayArchive(ctx, store, services.ArchiveReplayOptions{
+		Filter:    filter,
+		DryRun:    dryRun,
+		BatchSize: batchSize,
+		MaxDiffs:  maxDiffs,
+	})
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	printReport(report)
+	return nil
+}
+
+func listPartitions(store rawarchive.Store, filter rawarchive.Filter) error {
+	partitions, err := store.Partitions(filter)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, partition := range partitions {
+		fmt.Printf("%-12s %s  %8.1f KiB\n", partition.Source, partition.Hour.Format("2006-01-02T15Z"), float64(partition.SizeBytes)/1024)
+		total += partition.SizeBytes
+	}
+	fmt.Printf("\n%d partition(s), %.1f KiB compressed\n", len(partitions), float64(total)/1024)
+	return nil
+}
+
+func printReport(report *models.ArchiveReplayReport) {
+	mode := "replay"
+	if report.DryRun {
+		mode = "dry run"
+	}
+
+	fmt.Printf("%s: %d partition(s), %d response(s), %d record(s) in %s\n",
+		mode, report.Partitions, report.Responses, report.Records, report.Duration.Round(time.Millisecond))
+	fmt.Printf("  accepted %d, duplicates %d, rejected %d", report.Accepted, report.Duplicates, report.Rejected)
+	if !report.DryRun {
+		fmt.Printf(", upserted %d", report.Persisted)
+	}
+	fmt.Println()
+
+	for stage, count := range report.RejectedByStage {
+		fmt.Printf("  rejected at %s: %d\n", stage, count)
+	}
+
+	if !report.DryRun {
+		return
+	}
+
+	fmt.Printf("  new %d, changed %d, unchanged %d\n", report.New, report.Changed, report.Unchanged)
+	for _, diff := range report.Diffs {
+		fmt.Printf("\n%s %s  %s\n", strings.ToUpper(diff.Status), diff.ExternalID, diff.Title)
+		for _, change := range diff.Changes {
+			fmt.Printf("  %s\n    - %s\n    + %s\n", change.Field, clip(change.Old), clip(change.New))
+		}
+	}
+	if shown := len(report.Diffs); shown < report.New+report.Changed {
+		fmt.Printf("\n(%d more not shown; raise -diffs)\n", report.New+report.Changed-shown)
+	}
+}
+
+// parseHour accepts YYYY-MM-DD, YYYY-MM-DDTHH or RFC 3339, in UTC
+func parseHour(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15", "2006-01-02"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not YYYY-MM-DD, YYYY-MM-DDTHH or RFC 3339", value)
+}
+
+// clip keeps long field values readable in the terminal
+func clip(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if len(value) > 160 {
+		return value[:157] + "..."
+	}
+	return value
+}
@@ -226,7 +226,96 @@ func main() {
 		"quota_managed":        quotaManager != nil,
 	})
 
-	// 8. Advanced Performance Service (Optional - skip if causing issues)
+	// 8. Recommendation Service (offline item-item co-occurrence job)
+	recommendationRepo := repository.NewRecommendationRepository(db)
+	recommendationService := services.NewRecommendationService(cfg, logger, recommendationRepo, articleRepo)
+	recommendationService.Start()
+	logger.Info("Recommendation service initialized", map[string]interface{}{
+		"enabled":     cfg.RecommendationEnabled,
+		"window_days": cfg.RecommendationWindowDays,
+		"top_k":       cfg.RecommendationNeighborsPerArt,
+	})
+
+	// 9. Experiment Service (A/B bucketing + async exposure/outcome logging)
+	experimentRepo := repository.NewExperimentRepository(db)
+	experimentService := services.NewExperimentService(cfg, logger, experimentRepo)
+	experimentService.Start()
+	logger.Info("Experiment service initialized", map[string]interface{}{
+		"enabled":       cfg.ExperimentsEnabled,
+		"device_header": cfg.ExperimentDeviceHeader,
+	})
+
+	// 10. Learning-to-Rank Service (impression logging + scheduled pairwise training)
+	ltrRepo := repository.NewLTRRepository(db)
+	learningToRankService := services.NewLearningToRankService(cfg, logger, ltrRepo, articleRepo, newsAggregatorService.RankingPipeline())
+	learningToRankService.Start()
+	logger.Info("Learning-to-rank service initialized", map[string]interface{}{
+		"enabled":      cfg.LTREnabled,
+		"serving":      cfg.LTRServingEnabled,
+		"auto_publish": cfg.LTRAutoPublish,
+	})
+
+	// 11. Feed Service (RSS/Atom source registration + per-feed polling)
+	feedRepo := repository.NewFeedRepository(db)
+	feedService := services.NewFeedService(cfg, logger, feedRepo, articleRepo, newsAggregatorService)
+	feedService.Start()
+	logger.Info("Feed service initialized", map[string]interface{}{
+		"enabled":         cfg.FeedsEnabled,
+		"tick_seconds":    cfg.FeedPollTickSeconds,
+		"default_minutes": cfg.FeedDefaultPollMinutes,
+	})
+
+	// 12. Syndication Service (outbound RSS/Atom/JSON feeds + WebSub hub pings)
+	syndicationRepo := repository.NewSyndicationRepository(db)
+	syndicationService := services.NewSyndicationService(cfg, logger, syndicationRepo, articleRepo, repository.NewEngagementRepository(db), searchService)
+	newsAggregatorService.SetSyndicationService(syndicationService)
+	syndicationService.Start()
+	logger.Info("Syndication service initialized", map[string]interface{}{
+		"public_base_url": cfg.PublicBaseURL,
+		"websub_enabled":  cfg.WebSubHubURL != "",
+		"cache_seconds":   cfg.SyndicationCacheSeconds,
+	})
+
+	// 13. Extraction Service (full text from article pages, robots.txt-aware)
+	extractionService := services.NewExtractionService(cfg, logger, repository.NewExtractionRepository(db))
+	extractionService.Start()
+	logger.Info("Extraction service initialized", map[string]interface{}{
+		"enabled":     cfg.ExtractionEnabled,
+		"concurrency": cfg.ExtractionConcurrency,
+		"per_domain":  cfg.ExtractionPerDomainConcurrency,
+	})
+
+	// 14. Story Service (clusters articles about one event into stories)
+	storyService := services.NewStoryService(cfg, logger, repository.NewStoryRepository(db), articleRepo)
+	storyService.Start()
+	logger.Info("Story service initialized", map[string]interface{}{
+		"enabled":      cfg.StoryClusteringEnabled,
+		"window_hours": cfg.StoryWindowHours,
+		"threshold":    cfg.StorySimilarityThreshold,
+	})
+
+	// 15. Trending Service (read and mention velocity with burst detection)
+	trendingService := services.NewTrendingService(cfg, logger, repository.NewTrendingRepository(db), articleRepo, newsAggregatorService.EntityExtractor())
+	trendingService.Start()
+	logger.Info("Trending service initialized", map[string]interface{}{
+		"enabled":         cfg.TrendingEnabled,
+		"window_minutes":  cfg.TrendingWindowMinutes,
+		"baseline_hours":  cfg.TrendingBaselineHours,
+		"burst_threshold": cfg.TrendingBurstThreshold,
+	})
+
+	// 16. Breaking News Service (flags bursting stories and notifies opted-in readers)
+	notificationService := services.NewNotificationService(cfg, logger, repository.NewNotificationRepository(db))
+	breakingService := services.NewBreakingNewsService(cfg, logger, repository.NewStoryRepository(db), articleRepo, notificationService)
+	breakingService.Start()
+	logger.Info("Breaking news service initialized", map[string]interface{}{
+		"enabled":         cfg.BreakingNewsEnabled,
+		"window_minutes":  cfg.BreakingWindowMinutes,
+		"min_sources":     cfg.BreakingMinSources,
+		"trusted_sources": cfg.BreakingTrustedSources,
+	})
+
+	// 17. Advanced Performance Service (Optional - skip if causing issues)
 	logger.Info("Skipping performance service initialization to avoid compilation issues")
 
 	logger.Info("Service initialization completed with Dashboard + Search + OTP integration", map[string]interface{}{
@@ -298,7 +387,7 @@ func main() {
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:3000,https://yourdomain.com,http://localhost:8080",
 		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS,PATCH",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,X-Requested-With,X-API-Key",
+		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,X-Requested-With,X-API-Key,X-Device-ID",
 		AllowCredentials: true,
 		MaxAge:           86400, // 24 hours
 	}))
@@ -351,6 +440,15 @@ func main() {
 		cacheService,
 		searchService, // PostgreSQL Search Service
 		quotaManager,  // NEW: QuotaManager for Dashboard integration
+		recommendationService,
+		experimentService,
+		learningToRankService,
+		feedService,
+		syndicationService,
+		extractionService,
+		storyService,
+		trendingService,
+		breakingService,
 	)
 
 	logger.Info("Routes configured with Dashboard monitoring endpoints", map[string]interface{}{
@@ -377,6 +475,42 @@ func main() {
 			logger.Info("News aggregator service stopped")
 		}
 
+		if recommendationService != nil {
+			recommendationService.Close()
+		}
+
+		if experimentService != nil {
+			experimentService.Close()
+		}
+
+		if learningToRankService != nil {
+			learningToRankService.Close()
+		}
+
+		if feedService != nil {
+			feedService.Close()
+		}
+
+		if syndicationService != nil {
+			syndicationService.Close()
+		}
+
+		if extractionService != nil {
+			extractionService.Close()
+		}
+
+		if storyService != nil {
+			storyService.Close()
+		}
+
+		if trendingService != nil {
+			trendingService.Close()
+		}
+
+		if breakingService != nil {
+			breakingService.Close()
+		}
+
 		if searchService != nil {
 			// Clear search cache and stop background tasks
 			searchService.ClearCache()
@@ -0,0 +1,110 @@
+// cmd/summarize/main.go
+// Summary backfill - writes extractive summaries for articles stored before summaries existed,
+// from the extracted full text where it gives a usable one. With -quality it writes clickbait,
+// filler and explicit content scores instead, for articles stored before quality scoring. Text
+// passed as arguments is summarized and printed instead, to check the summarizer without a
+// database.
+//
+// Usage (from backend/):
+//
+//	go run ./cmd/summarize                            # backfill every article without a summary
+//	go run ./cmd/summarize -batch 200                 # smaller transactions
+//	go run ./cmd/summarize -quality                   # score every article without a quality score
+//	go run ./cmd/summarize -title "Headline" "Text…"  # summarize one text
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/database"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+	"backend/pkg/summarize"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 500, "articles summarized or scored per transaction")
+	quality := flag.Bool("quality", false, "backfill quality scores instead of summaries")
+	title := flag.String("title", "", "headline of the text given as arguments")
+	asJSON := flag.Bool("json", false, "print results as JSON")
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		printJSONOr(*asJSON, summarize.Summarize(*title, strings.Join(flag.Args(), " ")), func(value interface{}) {
+			summary := value.(summarize.Summary)
+			fmt.Println(summary.Text)
+			if summary.LowQuality {
+				fmt.Printf("\nlow quality: %s\n", summary.Reason)
+			}
+		})
+		return
+	}
+
+	if err := backfill(*batchSize, *asJSON, *quality); err != nil {
+		fmt.Fprintln(os.Stderr, "summarize:", err)
+		os.Exit(1)
+	}
+}
+
+func backfill(batchSize int, asJSON, quality bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	db, err := database.Connect(cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	log := logger.NewLogger()
+	apiClient := services.NewAPIClient(cfg, log)
+	newsService := services.NewNewsAggregatorService(db.DB, db, nil, cfg, log, apiClient, nil, repository.NewArticleRepository(db))
+	defer newsService.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if quality {
+		report, err := newsService.BackfillQuality(ctx, batchSize)
+		if report != nil {
+			printJSONOr(asJSON, *report, func(interface{}) {
+				fmt.Printf("scored %d article(s) in %s: %d low quality, %d explicit\n",
+					report.Articles, report.Duration.Round(time.Millisecond), report.LowQuality, report.Explicit)
+			})
+		}
+		return err
+	}
+
+	report, err := newsService.BackfillSummaries(ctx, batchSize)
+	if report != nil {
+		printJSONOr(asJSON, *report, func(interface{}) {
+			fmt.Printf("summarized %d article(s) in %s: %d from full text, %d low quality\n",
+				report.Articles, report.Duration.Round(time.Millisecond), report.FromFull, report.LowQuality)
+		})
+	}
+	return err
+}
+
+// printJSONOr prints a value as indented JSON, or with the given printer
+func printJSONOr(asJSON bool, value interface{}, print func(interface{})) {
+	if !asJSON {
+		print(value)
+		return
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(value)
+}
@@ -0,0 +1,147 @@
+// cmd/trainclassifier/main.go
+// Category classifier training - fits the Naive Bayes category model on articles whose
+// category came from a provider or an editor, reports held-out scores and saves the model.
+//
+// Usage (from backend/):
+//
+//	go run ./cmd/trainclassifier                    # last 180 days, 20% held out, save
+//	go run ./cmd/trainclassifier -dry-run -json     # evaluate only
+//	go run ./cmd/trainclassifier -days 365 -legacy  # also learn from keyword-era rows
+//
+// The saved model is picked up by running servers on their next reload check.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/database"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+func main() {
+	days := flag.Int("days", 180, "train on articles published in the last N days")
+	holdout := flag.Float64("holdout", 0.2, "share of articles held out to measure the model (0 to skip)")
+	alpha := flag.Float64("alpha", 0, "additive smoothing (default 1)")
+	minDocFreq := flag.Int("min-df", 0, "minimum articles a word must appear in (default 2)")
+	maxFeatures := flag.Int("max-features", 0, "vocabulary cap, most frequent words first (0 for no cap)")
+	legacy := flag.Bool("legacy", false, "also learn from articles categorized before category sources were recorded")
+	dryRun := flag.Bool("dry-run", false, "train and evaluate without saving the model")
+	out := flag.String("out", "", "model file (default CLASSIFIER_MODEL_PATH)")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Parse()
+
+	opts := services.ClassifierTrainOptions{
+		Since:         time.Now().AddDate(0, 0, -*days),
+		IncludeLegacy: *legacy,
+		Holdout:       *holdout,
+		Alpha:         *alpha,
+		MinDocFreq:    *minDocFreq,
+		MaxFeatures:   *maxFeatures,
+		DryRun:        *dryRun,
+	}
+
+	if err := run(opts, *out, *asJSON); err != nil {
+		fmt.Fprintln(os.Stderr, "trainclassifier:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts services.ClassifierTrainOptions, out string, asJSON bool) error {
+	if opts.Holdout < 0 || opts.Holdout >= 1 {
+		return fmt.Errorf("-holdout must be at least 0 and below 1")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if out != "" {
+		cfg.ClassifierModelPath = out
+	}
+
+	db, err := database.Connect(cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	log := logger.NewLogger()
+	classifier := services.NewCategoryClassifier(cfg, log, repository.NewClassifierRepository(db))
+
+	report, err := classifier.Train(opts)
+	if err != nil {
+		if report != nil && len(report.LabelCounts) > 0 && !asJSON {
+			printLabelCounts(report)
+		}
+		return err
+	}
+
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	printReport(report)
+	return nil
+}
+
+func printReport(report *models.ClassifierTrainingReport) {
+	fmt.Printf("model %s: %d article(s), %d trained, %d held out, %d feature(s) in %s\n",
+		report.Version, report.Examples, report.TrainExamples, report.HoldoutExamples,
+		report.Features, report.Duration.Round(time.Millisecond))
+	printLabelCounts(report)
+
+	if evaluation := report.Evaluation; evaluation != nil {
+		fmt.Printf("\nheld out: %d article(s), accuracy %.3f, macro F1 %.3f\n",
+			evaluation.Examples, evaluation.Accuracy, evaluation.MacroF1)
+		fmt.Printf("  %-20s %8s %9s %7s %7s\n", "category", "support", "precision", "recall", "f1")
+		for _, label := range sortedLabels(evaluation.Labels) {
+			metrics := evaluation.Labels[label]
+			fmt.Printf("  %-20s %8d %9.3f %7.3f %7.3f\n", label, metrics.Support, metrics.Precision, metrics.Recall, metrics.F1)
+		}
+	}
+
+	if report.Saved {
+		fmt.Printf("\nsaved to %s\n", report.ModelPath)
+	} else {
+		fmt.Println("\ndry run: model not saved")
+	}
+}
+
+func printLabelCounts(report *models.ClassifierTrainingReport) {
+	labels := make([]string, 0, len(report.LabelCounts))
+	for label := range report.LabelCounts {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if report.LabelCounts[labels[i]] != report.LabelCounts[labels[j]] {
+			return report.LabelCounts[labels[i]] > report.LabelCounts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+
+	fmt.Println("\ntraining articles per category:")
+	for _, label := range labels {
+		fmt.Printf("  %-20s %6d\n", label, report.LabelCounts[label])
+	}
+}
+
+func sortedLabels(metrics map[string]models.ClassifierLabelMetrics) []string {
+	labels := make([]string, 0, len(metrics))
+	for label := range metrics {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
@@ -63,14 +63,17 @@ type Config struct {
 	// NewsData.io (SECONDARY - 150/day)
 	NewsDataAPIKey     string
 	NewsDataDailyLimit int
+	NewsDataBaseURL    string
 
 	// GNews (TERTIARY - 75/day)
 	GNewsAPIKey     string
 	GNewsDailyLimit int
+	GNewsBaseURL    string
 
 	// Mediastack (EMERGENCY - 12/day)
 	MediastackAPIKey     string
 	MediastackDailyLimit int
+	MediastackBaseURL    string
 
 	// Simple API Configuration (matches .env file)
 	NewsDataQuota   int
@@ -139,6 +142,8 @@ type Config struct {
 	// Deduplication
 	TitleSimilarityThreshold float64
 	TimeWindowHours          int
+	DedupLookbackDays        int // Stored articles incoming ones are checked against
+	SimHashMaxDistance       int // Differing bits at which two SimHash fingerprints are the same story
 
 	// Rate Limiting - Enhanced
 	APIRateLimit     int
@@ -160,6 +165,148 @@ type Config struct {
 	QuotaWarningThreshold  int
 	QuotaCriticalThreshold int
 	QuotaResetHour         int
+
+	// Recommendations (item-item collaborative filtering)
+	RecommendationEnabled         bool
+	RecommendationWindowDays      int     // Sliding window of reads/bookmarks used for co-occurrence
+	RecommendationNeighborsPerArt int     // Top-K neighbours stored per article
+	RecommendationMinCoOccurrence int     // Minimum users in common before a pair is kept
+	RecommendationMinSimilarity   float64 // Minimum cosine similarity for a stored neighbour
+	RecommendationJobIntervalMins int     // How often the offline job recomputes neighbours
+	RecommendationSeedArticles    int     // Recent user interactions used as recommendation seeds
+
+	// Feed diversity (maximal marginal relevance re-ranking)
+	DiversityEnabled            bool
+	DiversityLambdaNewsFeed     float64 // Relevance vs. novelty trade-off for the main feed (1.0 = no diversity)
+	DiversityLambdaPersonalized float64 // Relevance vs. novelty trade-off for the personalized feed
+	DiversitySameSourcePenalty  float64 // Penalty per consecutive article from the same source
+	DiversityCandidatePoolSize  int     // Candidates fetched per requested article before re-ranking
+
+	// Ranking pipeline (feature weights are normalised over the features that apply to an article)
+	RankingWeightFreshness        float64
+	RankingWeightIndiaRelevance   float64
+	RankingWeightSourceTrust      float64
+	RankingWeightEngagement       float64
+	RankingWeightPersonalization  float64
+	RankingWeightTopicMatch       float64
+	RankingFreshnessHalfLifeHours float64 // Hours until the freshness feature halves
+	RankingDefaultSourceTrust     float64 // Trust assigned to sources without an explicit rating
+	RankingEngagementViewsCap     int     // View count at which the engagement feature saturates
+
+	// Experiments (A/B testing of ranking and feed variants)
+	ExperimentsEnabled          bool
+	ExperimentDeviceHeader      string  // Header carrying the anonymous device ID used for bucketing
+	ExperimentRefreshSeconds    int     // How often running experiment definitions are reloaded
+	ExperimentSignificanceAlpha float64 // Two-sided significance level for results
+
+	// Learning-to-rank (pairwise model trained on impressions joined with reads)
+	LTREnabled               bool
+	LTRServingEnabled        bool    // Rollback switch: false serves configured weights and ignores learned models
+	LTRJobIntervalHours      int     // How often the training job runs
+	LTRWindowDays            int     // Impressions used for training
+	LTRRetentionDays         int     // Impressions older than this are deleted by the job
+	LTRMinPairs              int     // Minimum training pairs before a model is considered
+	LTRMinDwellSeconds       int     // Reads shorter than this are not treated as positive clicks
+	LTRPositionBiasExponent  float64 // Examination propensity is (1/position)^exponent
+	LTRMaxPropensityWeight   float64 // Cap on inverse-propensity weights
+	LTRLearningRate          float64
+	LTRRegularization        float64 // L2 penalty on weights
+	LTREpochs                int
+	LTRHoldoutPercent        int     // Share of impression requests held out for evaluation
+	LTRMinImprovement        float64 // Required holdout pairwise-accuracy gain over current weights
+	LTRMaxWeightChange       float64 // Largest relative change per feature weight in one publish
+	LTRAutoPublish           bool    // Publish models that pass the guards without admin action
+	LTRInitialRolloutPercent int     // Share of subjects served a newly published model
+
+	// RSS/Atom feed ingestion
+	FeedsEnabled           bool
+	FeedPollTickSeconds    int    // How often the poller looks for due feeds
+	FeedDefaultPollMinutes int    // Poll interval for feeds created without one
+	FeedMinPollMinutes     int    // Floor on per-feed poll intervals
+	FeedMaxItemsPerPoll    int    // Items taken from one feed document
+	FeedMaxConcurrentPolls int    // Feeds fetched in parallel per tick
+	FeedUserAgent          string // Sent with every feed request
+
+	// Outbound RSS/Atom/JSON feeds
+	PublicBaseURL             string // Absolute base for feed self links
+	SyndicationItemLimit      int    // Items per published feed
+	SyndicationCacheSeconds   int    // Cache-Control max-age and rendered feed cache TTL
+	SyndicationCacheEntries   int    // Rendered feeds held in memory; least recently used are evicted
+	FeedTokensPerUser         int    // Active private feed tokens allowed per user
+	WebSubHubURL              string // Hub pinged when feeds change; empty disables WebSub
+	WebSubPingIntervalSeconds int    // Hub pings are batched over this interval
+
+	// Outbound provider HTTP transport
+	APITransportMode string // "live", "record" (live + save fixtures) or "replay" (fixtures only)
+	APIFixturesDir   string // Where recorded provider responses are stored
+
+	// Full-text extraction from article pages
+	ExtractionEnabled              bool
+	ExtractionIntervalSeconds      int    // How often the worker looks for pending articles
+	ExtractionBatchSize            int    // Articles claimed per tick
+	ExtractionConcurrency          int    // Pages fetched in parallel overall
+	ExtractionPerDomainConcurrency int    // Pages fetched in parallel from one host
+	ExtractionTimeoutSeconds       int    // Per-page fetch timeout
+	ExtractionMaxBytes             int64  // Pages larger than this are truncated
+	ExtractionMaxAttempts          int    // Failed extractions are retried up to this many times
+	ExtractionUserAgent            string // Sent with page and robots.txt requests; its first token is matched against robots.txt
+
+	// Staged ingestion pipeline
+	IngestionStageBuffer  int // Capacity of the channel between two stages
+	IngestionStageWorkers int // Workers for each of the normalize, enrich and classify stages
+	IngestionPersistBatch int // Articles written per database upsert
+
+	// Raw provider response archive (compressed JSON lines per source and hour)
+	RawArchiveEnabled bool
+	RawArchiveDir     string
+
+	// Story clustering (one story per event, with a timeline of its articles)
+	StoryClusteringEnabled      bool
+	StoryClusterIntervalSeconds int     // How often unclustered articles are assigned to stories
+	StoryClusterBatchSize       int     // Unclustered articles handled per pass
+	StoryWindowHours            int     // Stories stop growing once their newest article is older than this
+	StorySimilarityThreshold    float64 // Minimum combined similarity for an article to join a story
+
+	// Category classifier (Naive Bayes model trained by cmd/trainclassifier)
+	ClassifierModelPath           string  // Model file; keyword rules are used until it exists
+	ClassifierMinConfidence       float64 // Less confident predictions go to the review queue uncategorized
+	ClassifierSecondaryConfidence float64 // Further categories at or above this are kept as secondary labels
+
+	// Regional language ingestion
+	RegionalLanguages  []string // ISO 639-1 codes fetched from providers besides English, one per refresh
+	RegionalFetchLimit int      // Articles requested per source for one regional language
+
+	// Keyword extraction and tags
+	KeywordCorpusSize int // Recent articles the keyword IDF is computed over
+	MaxArticleTags    int // Tags kept per article, provider tags and extracted keywords together
+
+	// Velocity trending (reads and new articles per time bucket, compared with each subject's baseline)
+	TrendingEnabled        bool
+	TrendingBucketMinutes  int     // Width of the buckets reads and mentions are counted in
+	TrendingWindowMinutes  int     // Current window whose velocity is compared with the baseline
+	TrendingBaselineHours  int     // History before the window that makes up each subject's baseline
+	TrendingMentionWeight  float64 // Reads one new article (or merged duplicate) counts as
+	TrendingMinActivity    float64 // Weighted activity a subject needs in the window to be considered
+	TrendingBurstThreshold float64 // Standard deviations above the baseline that count as a burst
+	TrendingRefreshSeconds int     // How often trends are recomputed
+
+	// Breaking news (stories flagged by a burst of sources, trusted sources or an editor, with notifications)
+	BreakingNewsEnabled     bool
+	BreakingCheckSeconds    int     // How often recent stories are checked for a burst
+	BreakingWindowMinutes   int     // Span the sources of a burst must publish within
+	BreakingLookbackMinutes int     // Only articles published this recently can start a burst
+	BreakingMinSources      int     // Distinct sources within the window that make a story breaking
+	BreakingTrustedSources  int     // Distinct trusted sources within the window that make a story breaking
+	BreakingTrustThreshold  float64 // Editorial trust rating a source needs to count as trusted
+	BreakingBadgeHours      int     // How long a flagged story keeps its breaking badge
+	BreakingEventHours      int     // How long a flagged story absorbs later stories about the same event
+	BreakingMaxPerHour      int     // Stories the detector may flag per hour (editor triggers are exempt)
+	BreakingUserMaxPerHour  int     // Breaking news notifications one user can receive per hour
+	BreakingUserMaxPerDay   int     // Breaking news notifications one user can receive per day
+
+	// Content quality (clickbait and filler scores applied by readers' content filter level)
+	ContentQualityHideBelow float64 // Score below which the moderate level hides articles
+	ContentQualityLowBelow  float64 // Score below which moderate demotes and strict hides articles
 }
 
 // AdminCredentials holds admin user configuration from environment
@@ -250,14 +397,17 @@ func Load() (*Config, error) {
 		// NewsData.io (Existing)
 		NewsDataAPIKey:     getEnv("NEWSDATA_API_KEY", ""),
 		NewsDataDailyLimit: getEnvAsInt("NEWSDATA_DAILY_LIMIT", 150),
+		NewsDataBaseURL:    getEnv("NEWSDATA_BASE_URL", "https://newsdata.io/api/1/news"),
 
 		// GNews (Existing)
 		GNewsAPIKey:     getEnv("GNEWS_API_KEY", ""),
 		GNewsDailyLimit: getEnvAsInt("GNEWS_DAILY_LIMIT", 75),
+		GNewsBaseURL:    getEnv("GNEWS_BASE_URL", "https://gnews.io/api/v4/search"),
 
 		// Mediastack (Existing)
 		MediastackAPIKey:     getEnv("MEDIASTACK_API_KEY", ""),
 		MediastackDailyLimit: getEnvAsInt("MEDIASTACK_DAILY_LIMIT", 12),
+		MediastackBaseURL:    getEnv("MEDIASTACK_BASE_URL", "http://api.mediastack.com/v1/news"),
 
 		// Simple API Configuration - UPDATED with GDELT
 		NewsDataQuota:   getEnvAsInt("NEWSDATA_QUOTA", 150),
@@ -326,6 +476,8 @@ func Load() (*Config, error) {
 		// Deduplication settings
 		TitleSimilarityThreshold: getEnvAsFloat("TITLE_SIMILARITY_THRESHOLD", 0.8),
 		TimeWindowHours:          getEnvAsInt("TIME_WINDOW_HOURS", 1),
+		DedupLookbackDays:        getEnvAsInt("DEDUP_LOOKBACK_DAYS", 7),
+		SimHashMaxDistance:       getEnvAsInt("SIMHASH_MAX_DISTANCE", 8),
 
 		// Rate Limiting
 		APIRateLimit:     getEnvAsInt("API_RATE_LIMIT", 100),
@@ -347,6 +499,148 @@ func Load() (*Config, error) {
 		QuotaWarningThreshold:  getEnvAsInt("QUOTA_WARNING_THRESHOLD", 85),
 		QuotaCriticalThreshold: getEnvAsInt("QUOTA_CRITICAL_THRESHOLD", 95),
 		QuotaResetHour:         getEnvAsInt("QUOTA_RESET_HOUR", 0),
+
+		// Recommendations
+		RecommendationEnabled:         getEnvAsBool("RECOMMENDATION_ENABLED", true),
+		RecommendationWindowDays:      getEnvAsInt("RECOMMENDATION_WINDOW_DAYS", 30),
+		RecommendationNeighborsPerArt: getEnvAsInt("RECOMMENDATION_NEIGHBORS_PER_ARTICLE", 20),
+		RecommendationMinCoOccurrence: getEnvAsInt("RECOMMENDATION_MIN_CO_OCCURRENCE", 2),
+		RecommendationMinSimilarity:   getEnvAsFloat("RECOMMENDATION_MIN_SIMILARITY", 0.05),
+		RecommendationJobIntervalMins: getEnvAsInt("RECOMMENDATION_JOB_INTERVAL_MINUTES", 60),
+		RecommendationSeedArticles:    getEnvAsInt("RECOMMENDATION_SEED_ARTICLES", 25),
+
+		// Feed diversity
+		DiversityEnabled:            getEnvAsBool("DIVERSITY_ENABLED", true),
+		DiversityLambdaNewsFeed:     getEnvAsFloat("DIVERSITY_LAMBDA_NEWS_FEED", 0.7),
+		DiversityLambdaPersonalized: getEnvAsFloat("DIVERSITY_LAMBDA_PERSONALIZED", 0.6),
+		DiversitySameSourcePenalty:  getEnvAsFloat("DIVERSITY_SAME_SOURCE_PENALTY", 0.15),
+		DiversityCandidatePoolSize:  getEnvAsInt("DIVERSITY_CANDIDATE_POOL_SIZE", 2),
+
+		// Ranking pipeline
+		RankingWeightFreshness:        getEnvAsFloat("RANKING_WEIGHT_FRESHNESS", 0.30),
+		RankingWeightIndiaRelevance:   getEnvAsFloat("RANKING_WEIGHT_INDIA_RELEVANCE", 0.25),
+		RankingWeightSourceTrust:      getEnvAsFloat("RANKING_WEIGHT_SOURCE_TRUST", 0.15),
+		RankingWeightEngagement:       getEnvAsFloat("RANKING_WEIGHT_ENGAGEMENT", 0.10),
+		RankingWeightPersonalization:  getEnvAsFloat("RANKING_WEIGHT_PERSONALIZATION", 0.20),
+		RankingWeightTopicMatch:       getEnvAsFloat("RANKING_WEIGHT_TOPIC_MATCH", 0.10),
+		RankingFreshnessHalfLifeHours: getEnvAsFloat("RANKING_FRESHNESS_HALF_LIFE_HOURS", 12),
+		RankingDefaultSourceTrust:     getEnvAsFloat("RANKING_DEFAULT_SOURCE_TRUST", 0.5),
+		RankingEngagementViewsCap:     getEnvAsInt("RANKING_ENGAGEMENT_VIEWS_CAP", 1000),
+
+		// Experiments
+		ExperimentsEnabled:          getEnvAsBool("EXPERIMENTS_ENABLED", true),
+		ExperimentDeviceHeader:      getEnv("EXPERIMENT_DEVICE_HEADER", "X-Device-ID"),
+		ExperimentRefreshSeconds:    getEnvAsInt("EXPERIMENT_REFRESH_SECONDS", 60),
+		ExperimentSignificanceAlpha: getEnvAsFloat("EXPERIMENT_SIGNIFICANCE_ALPHA", 0.05),
+
+		// Learning-to-rank
+		LTREnabled:               getEnvAsBool("LTR_ENABLED", true),
+		LTRServingEnabled:        getEnvAsBool("LTR_SERVING_ENABLED", true),
+		LTRJobIntervalHours:      getEnvAsInt("LTR_JOB_INTERVAL_HOURS", 24),
+		LTRWindowDays:            getEnvAsInt("LTR_WINDOW_DAYS", 14),
+		LTRRetentionDays:         getEnvAsInt("LTR_RETENTION_DAYS", 60),
+		LTRMinPairs:              getEnvAsInt("LTR_MIN_PAIRS", 500),
+		LTRMinDwellSeconds:       getEnvAsInt("LTR_MIN_DWELL_SECONDS", 10),
+		LTRPositionBiasExponent:  getEnvAsFloat("LTR_POSITION_BIAS_EXPONENT", 1.0),
+		LTRMaxPropensityWeight:   getEnvAsFloat("LTR_MAX_PROPENSITY_WEIGHT", 20),
+		LTRLearningRate:          getEnvAsFloat("LTR_LEARNING_RATE", 0.05),
+		LTRRegularization:        getEnvAsFloat("LTR_REGULARIZATION", 0.001),
+		LTREpochs:                getEnvAsInt("LTR_EPOCHS", 20),
+		LTRHoldoutPercent:        getEnvAsInt("LTR_HOLDOUT_PERCENT", 20),
+		LTRMinImprovement:        getEnvAsFloat("LTR_MIN_IMPROVEMENT", 0.005),
+		LTRMaxWeightChange:       getEnvAsFloat("LTR_MAX_WEIGHT_CHANGE", 0.5),
+		LTRAutoPublish:           getEnvAsBool("LTR_AUTO_PUBLISH", true),
+		LTRInitialRolloutPercent: getEnvAsInt("LTR_INITIAL_ROLLOUT_PERCENT", 10),
+
+		// RSS/Atom feeds
+		FeedsEnabled:           getEnvAsBool("FEEDS_ENABLED", true),
+		FeedPollTickSeconds:    getEnvAsInt("FEED_POLL_TICK_SECONDS", 60),
+		FeedDefaultPollMinutes: getEnvAsInt("FEED_DEFAULT_POLL_MINUTES", 15),
+		FeedMinPollMinutes:     getEnvAsInt("FEED_MIN_POLL_MINUTES", 5),
+		FeedMaxItemsPerPoll:    getEnvAsInt("FEED_MAX_ITEMS_PER_POLL", 50),
+		FeedMaxConcurrentPolls: getEnvAsInt("FEED_MAX_CONCURRENT_POLLS", 4),
+		FeedUserAgent:          getEnv("FEED_USER_AGENT", "GoNews/1.0 (feed reader)"),
+
+		// Outbound feeds
+		PublicBaseURL:             strings.TrimRight(getEnv("PUBLIC_BASE_URL", "http://localhost:8080"), "/"),
+		SyndicationItemLimit:      getEnvAsInt("SYNDICATION_ITEM_LIMIT", 50),
+		SyndicationCacheSeconds:   getEnvAsInt("SYNDICATION_CACHE_SECONDS", 300),
+		SyndicationCacheEntries:   getEnvAsInt("SYNDICATION_CACHE_ENTRIES", 500),
+		FeedTokensPerUser:         getEnvAsInt("FEED_TOKENS_PER_USER", 10),
+		WebSubHubURL:              getEnv("WEBSUB_HUB_URL", ""),
+		WebSubPingIntervalSeconds: getEnvAsInt("WEBSUB_PING_INTERVAL_SECONDS", 60),
+
+		// Provider transport (record/replay for offline runs)
+		APITransportMode: strings.ToLower(getEnv("API_TRANSPORT_MODE", "live")),
+		APIFixturesDir:   getEnv("API_FIXTURES_DIR", "testdata/fixtures/api"),
+
+		// Full-text extraction
+		ExtractionEnabled:              getEnvAsBool("EXTRACTION_ENABLED", true),
+		ExtractionIntervalSeconds:      getEnvAsInt("EXTRACTION_INTERVAL_SECONDS", 60),
+		ExtractionBatchSize:            getEnvAsInt("EXTRACTION_BATCH_SIZE", 40),
+		ExtractionConcurrency:          getEnvAsInt("EXTRACTION_CONCURRENCY", 8),
+		ExtractionPerDomainConcurrency: getEnvAsInt("EXTRACTION_PER_DOMAIN_CONCURRENCY", 2),
+		ExtractionTimeoutSeconds:       getEnvAsInt("EXTRACTION_TIMEOUT_SECONDS", 15),
+		ExtractionMaxBytes:             int64(getEnvAsInt("EXTRACTION_MAX_BYTES", 2<<20)),
+		ExtractionMaxAttempts:          getEnvAsInt("EXTRACTION_MAX_ATTEMPTS", 3),
+		ExtractionUserAgent:            getEnv("EXTRACTION_USER_AGENT", "GoNewsBot/1.0"),
+
+		// Staged ingestion pipeline
+		IngestionStageBuffer:  getEnvAsInt("INGESTION_STAGE_BUFFER", 64),
+		IngestionStageWorkers: getEnvAsInt("INGESTION_STAGE_WORKERS", 4),
+		IngestionPersistBatch: getEnvAsInt("INGESTION_PERSIST_BATCH", 50),
+
+		// Raw provider response archive
+		RawArchiveEnabled: getEnvAsBool("RAW_ARCHIVE_ENABLED", true),
+		RawArchiveDir:     getEnv("RAW_ARCHIVE_DIR", "data/archive/raw"),
+
+		// Story clustering
+		StoryClusteringEnabled:      getEnvAsBool("STORY_CLUSTERING_ENABLED", true),
+		StoryClusterIntervalSeconds: getEnvAsInt("STORY_CLUSTER_INTERVAL_SECONDS", 120),
+		StoryClusterBatchSize:       getEnvAsInt("STORY_CLUSTER_BATCH_SIZE", 500),
+		StoryWindowHours:            getEnvAsInt("STORY_WINDOW_HOURS", 72),
+		StorySimilarityThreshold:    getEnvAsFloat("STORY_SIMILARITY_THRESHOLD", 0.32),
+
+		// Category classifier
+		ClassifierModelPath:           getEnv("CLASSIFIER_MODEL_PATH", "data/models/category_nb.json"),
+		ClassifierMinConfidence:       getEnvAsFloat("CLASSIFIER_MIN_CONFIDENCE", 0.55),
+		ClassifierSecondaryConfidence: getEnvAsFloat("CLASSIFIER_SECONDARY_CONFIDENCE", 0.25),
+
+		// Regional languages
+		RegionalLanguages:  getEnvAsList("REGIONAL_LANGUAGES", "hi,mr,bn,ta,te,kn,ml,gu"),
+		RegionalFetchLimit: getEnvAsInt("REGIONAL_FETCH_LIMIT", 10),
+
+		// Keyword extraction and tags
+		KeywordCorpusSize: getEnvAsInt("KEYWORD_CORPUS_SIZE", 5000),
+		MaxArticleTags:    getEnvAsInt("MAX_ARTICLE_TAGS", 8),
+
+		// Velocity trending
+		TrendingEnabled:        getEnvAsBool("TRENDING_ENABLED", true),
+		TrendingBucketMinutes:  getEnvAsInt("TRENDING_BUCKET_MINUTES", 15),
+		TrendingWindowMinutes:  getEnvAsInt("TRENDING_WINDOW_MINUTES", 60),
+		TrendingBaselineHours:  getEnvAsInt("TRENDING_BASELINE_HOURS", 72),
+		TrendingMentionWeight:  getEnvAsFloat("TRENDING_MENTION_WEIGHT", 5),
+		TrendingMinActivity:    getEnvAsFloat("TRENDING_MIN_ACTIVITY", 15),
+		TrendingBurstThreshold: getEnvAsFloat("TRENDING_BURST_THRESHOLD", 3),
+		TrendingRefreshSeconds: getEnvAsInt("TRENDING_REFRESH_SECONDS", 60),
+
+		// Breaking news
+		BreakingNewsEnabled:     getEnvAsBool("BREAKING_NEWS_ENABLED", true),
+		BreakingCheckSeconds:    getEnvAsInt("BREAKING_CHECK_SECONDS", 60),
+		BreakingWindowMinutes:   getEnvAsInt("BREAKING_WINDOW_MINUTES", 30),
+		BreakingLookbackMinutes: getEnvAsInt("BREAKING_LOOKBACK_MINUTES", 180),
+		BreakingMinSources:      getEnvAsInt("BREAKING_MIN_SOURCES", 5),
+		BreakingTrustedSources:  getEnvAsInt("BREAKING_TRUSTED_SOURCES", 3),
+		BreakingTrustThreshold:  getEnvAsFloat("BREAKING_TRUST_THRESHOLD", 0.85),
+		BreakingBadgeHours:      getEnvAsInt("BREAKING_BADGE_HOURS", 6),
+		BreakingEventHours:      getEnvAsInt("BREAKING_EVENT_HOURS", 12),
+		BreakingMaxPerHour:      getEnvAsInt("BREAKING_MAX_PER_HOUR", 4),
+		BreakingUserMaxPerHour:  getEnvAsInt("BREAKING_USER_MAX_PER_HOUR", 2),
+		BreakingUserMaxPerDay:   getEnvAsInt("BREAKING_USER_MAX_PER_DAY", 8),
+
+		// Content quality
+		ContentQualityHideBelow: getEnvAsFloat("CONTENT_QUALITY_HIDE_BELOW", 0.35),
+		ContentQualityLowBelow:  getEnvAsFloat("CONTENT_QUALITY_LOW_BELOW", 0.6),
 	}
 
 	// Validate critical API keys (GDELT doesn't need validation since it's free)
@@ -694,6 +988,17 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// getEnvAsList splits a comma-separated variable, dropping empty entries
+func getEnvAsList(key, defaultValue string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, defaultValue), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func parseRapidAPIEndpoints(endpointsStr string) []string {
 	if endpointsStr == "" {
 		return []string{
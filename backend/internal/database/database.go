@@ -743,6 +743,512 @@ func Migrate(db *sqlx.DB) error {
 		 BEFORE UPDATE ON category_request_distribution 
 		 FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
 
+		// ===============================
+		// RECOMMENDATIONS: ITEM-ITEM CO-OCCURRENCE NEIGHBOURS
+		// ===============================
+
+		// Top-K "people also read" neighbours per article (rebuilt by the offline recommendation job)
+		`CREATE TABLE IF NOT EXISTS article_neighbors (
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			neighbor_article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			similarity FLOAT NOT NULL DEFAULT 0.0,
+			co_occurrence_count INTEGER NOT NULL DEFAULT 0,
+			rank INTEGER NOT NULL DEFAULT 0,
+			computed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+			PRIMARY KEY (article_id, neighbor_article_id)
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_article_neighbors_rank ON article_neighbors(article_id, rank)`,
+		`CREATE INDEX IF NOT EXISTS idx_reading_history_read_at ON reading_history(read_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_bookmarks_bookmarked_at ON bookmarks(bookmarked_at DESC)`,
+
+		// ===============================
+		// EXPERIMENTS: A/B TESTING OF RANKING AND FEED VARIANTS
+		// ===============================
+
+		`CREATE TABLE IF NOT EXISTS experiments (
+			id SERIAL PRIMARY KEY,
+			key VARCHAR(100) UNIQUE NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			description TEXT,
+			feed_type VARCHAR(50) NOT NULL DEFAULT 'all',
+			status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'running', 'paused', 'completed')),
+			started_at TIMESTAMP WITH TIME ZONE,
+			ended_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		`CREATE TABLE IF NOT EXISTS experiment_variants (
+			id SERIAL PRIMARY KEY,
+			experiment_id INTEGER NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+			key VARCHAR(100) NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			traffic_percent INTEGER NOT NULL CHECK (traffic_percent >= 0 AND traffic_percent <= 100),
+			is_control BOOLEAN NOT NULL DEFAULT false,
+			params JSONB NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+			UNIQUE(experiment_id, key)
+		)`,
+
+		// One row per experiment subject (user:<uuid> or device:<id>); repeat exposures bump the counter
+		`CREATE TABLE IF NOT EXISTS experiment_exposures (
+			id BIGSERIAL PRIMARY KEY,
+			experiment_id INTEGER NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+			variant_key VARCHAR(100) NOT NULL,
+			subject_key VARCHAR(150) NOT NULL,
+			user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+			feed_type VARCHAR(50),
+			exposure_count INTEGER NOT NULL DEFAULT 1,
+			first_exposed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			last_exposed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+			UNIQUE(experiment_id, subject_key)
+		)`,
+
+		// Outcome events (reads with dwell time, bookmarks) attributed to the subject's variant
+		`CREATE TABLE IF NOT EXISTS experiment_events (
+			id BIGSERIAL PRIMARY KEY,
+			experiment_id INTEGER NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+			variant_key VARCHAR(100) NOT NULL,
+			subject_key VARCHAR(150) NOT NULL,
+			event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('read', 'bookmark')),
+			article_id INTEGER,
+			dwell_seconds INTEGER DEFAULT 0,
+			scroll_depth INTEGER DEFAULT 0,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_experiments_status ON experiments(status)`,
+		`CREATE INDEX IF NOT EXISTS idx_experiment_exposures_variant ON experiment_exposures(experiment_id, variant_key)`,
+		`CREATE INDEX IF NOT EXISTS idx_experiment_events_subject ON experiment_events(experiment_id, subject_key)`,
+
+		`DROP TRIGGER IF EXISTS update_experiments_updated_at ON experiments`,
+		`CREATE TRIGGER update_experiments_updated_at 
+		 BEFORE UPDATE ON experiments 
+		 FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
+
+		// ===============================
+		// LEARNING-TO-RANK: IMPRESSIONS AND MODEL VERSIONS
+		// ===============================
+
+		// Every article shown in a feed or search response, joined with the subject's later read
+		`CREATE TABLE IF NOT EXISTS ranking_impressions (
+			id BIGSERIAL PRIMARY KEY,
+			request_id VARCHAR(64) NOT NULL,
+			subject_key VARCHAR(150) NOT NULL,
+			user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+			surface VARCHAR(30) NOT NULL,
+			query TEXT,
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			position INTEGER NOT NULL CHECK (position > 0),
+			shown_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			clicked_at TIMESTAMP WITH TIME ZONE,
+			dwell_seconds INTEGER NOT NULL DEFAULT 0
+		)`,
+
+		// Trained weight sets; at most one is active at a time
+		`CREATE TABLE IF NOT EXISTS ranking_models (
+			id SERIAL PRIMARY KEY,
+			status VARCHAR(20) NOT NULL DEFAULT 'candidate' CHECK (status IN ('candidate', 'active', 'rejected', 'superseded', 'rolled_back')),
+			weights JSONB NOT NULL,
+			baseline_weights JSONB NOT NULL,
+			rollout_percent INTEGER NOT NULL DEFAULT 0 CHECK (rollout_percent >= 0 AND rollout_percent <= 100),
+			training_pairs INTEGER NOT NULL DEFAULT 0,
+			holdout_pairs INTEGER NOT NULL DEFAULT 0,
+			train_accuracy DECIMAL(6,4) DEFAULT 0,
+			holdout_accuracy DECIMAL(6,4) DEFAULT 0,
+			baseline_accuracy DECIMAL(6,4) DEFAULT 0,
+			notes TEXT,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			activated_at TIMESTAMP WITH TIME ZONE,
+			rolled_back_at TIMESTAMP WITH TIME ZONE
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_ranking_impressions_subject ON ranking_impressions(subject_key, article_id, shown_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_ranking_impressions_shown_at ON ranking_impressions(shown_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_ranking_impressions_request ON ranking_impressions(request_id)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_ranking_models_single_active ON ranking_models(status) WHERE status = 'active'`,
+
+		// ===============================
+		// RSS/ATOM FEED SOURCES
+		// ===============================
+
+		// Publisher feeds polled on their own intervals with conditional GET and health counters
+		`CREATE TABLE IF NOT EXISTS news_feeds (
+			id SERIAL PRIMARY KEY,
+			name VARCHAR(200) NOT NULL,
+			url TEXT NOT NULL UNIQUE,
+			site_url TEXT,
+			category_slug VARCHAR(100),
+			language VARCHAR(10) NOT NULL DEFAULT 'en',
+			is_indian BOOLEAN NOT NULL DEFAULT TRUE,
+			poll_interval_minutes INTEGER NOT NULL DEFAULT 15 CHECK (poll_interval_minutes > 0),
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+			-- Conditional GET validators from the last 200 response
+			etag TEXT,
+			last_modified TEXT,
+
+			-- Scheduling and health
+			next_poll_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			last_polled_at TIMESTAMP WITH TIME ZONE,
+			last_success_at TIMESTAMP WITH TIME ZONE,
+			last_status_code INTEGER,
+			last_error TEXT,
+			last_item_count INTEGER NOT NULL DEFAULT 0,
+			consecutive_failures INTEGER NOT NULL DEFAULT 0,
+			total_polls INTEGER NOT NULL DEFAULT 0,
+			total_failures INTEGER NOT NULL DEFAULT 0,
+			not_modified_count INTEGER NOT NULL DEFAULT 0,
+			items_fetched BIGINT NOT NULL DEFAULT 0,
+			avg_response_ms INTEGER NOT NULL DEFAULT 0,
+
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_news_feeds_due ON news_feeds(next_poll_at) WHERE is_active = TRUE`,
+
+		`DROP TRIGGER IF EXISTS update_news_feeds_updated_at ON news_feeds`,
+		`CREATE TRIGGER update_news_feeds_updated_at 
+		 BEFORE UPDATE ON news_feeds 
+		 FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
+
+		// Free Indian publisher feeds
+		`INSERT INTO news_feeds (name, url, site_url, category_slug, poll_interval_minutes) VALUES
+			('PIB - Press Releases', 'https://pib.gov.in/RssMain.aspx?ModId=6&Lang=1&Regid=3', 'https://pib.gov.in', 'politics', 30),
+			('The Hindu - National', 'https://www.thehindu.com/news/national/feeder/default.rss', 'https://www.thehindu.com', 'top-stories', 15),
+			('The Hindu - Business', 'https://www.thehindu.com/business/feeder/default.rss', 'https://www.thehindu.com', 'business', 20),
+			('Indian Express - India', 'https://indianexpress.com/section/india/feed/', 'https://indianexpress.com', 'top-stories', 15),
+			('LiveMint - News', 'https://www.livemint.com/rss/news', 'https://www.livemint.com', 'business', 20)
+		 ON CONFLICT (url) DO NOTHING`,
+
+		// ===============================
+		// OUTBOUND FEEDS (TOKENS + SAVED SEARCHES)
+		// ===============================
+
+		// Revocable tokens for private per-user feeds; only the SHA-256 of the secret is stored
+		`CREATE TABLE IF NOT EXISTS feed_tokens (
+			id SERIAL PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			name VARCHAR(100) NOT NULL,
+			token_hash CHAR(64) NOT NULL UNIQUE,
+			token_prefix VARCHAR(16) NOT NULL,
+			last_used_at TIMESTAMP WITH TIME ZONE,
+			revoked_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_feed_tokens_user ON feed_tokens(user_id) WHERE revoked_at IS NULL`,
+
+		// Saved searches published as private feeds
+		`CREATE TABLE IF NOT EXISTS saved_searches (
+			id SERIAL PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			name VARCHAR(100) NOT NULL,
+			query VARCHAR(500) NOT NULL,
+			category_slug VARCHAR(50),
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_saved_searches_user ON saved_searches(user_id, created_at DESC)`,
+
+		`DROP TRIGGER IF EXISTS update_saved_searches_updated_at ON saved_searches`,
+		`CREATE TRIGGER update_saved_searches_updated_at 
+		 BEFORE UPDATE ON saved_searches 
+		 FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
+
+		// ===============================
+		// FULL-TEXT EXTRACTION
+		// ===============================
+
+		// Readability-extracted body text kept apart from provider content so upserts don't clobber it
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS full_text TEXT`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS extraction_status VARCHAR(20) DEFAULT 'pending'`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS extraction_error VARCHAR(500)`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS extraction_attempts INTEGER DEFAULT 0`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS extracted_at TIMESTAMP WITH TIME ZONE`,
+
+		`CREATE INDEX IF NOT EXISTS idx_articles_extraction_pending ON articles(published_at DESC) 
+		 WHERE extraction_status IN ('pending', 'failed')`,
+
+		// ===============================
+		// INGESTION DEAD LETTERS
+		// ===============================
+
+		// Provider items that failed conversion, validation or persistence, kept for inspection and replay
+		`CREATE TABLE IF NOT EXISTS ingestion_dead_letters (
+			id SERIAL PRIMARY KEY,
+			source VARCHAR(50) NOT NULL,
+			stage VARCHAR(20) NOT NULL,
+			payload_kind VARCHAR(20) NOT NULL DEFAULT 'raw',
+			payload JSONB NOT NULL,
+			query JSONB,
+			error TEXT NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			attempts INTEGER DEFAULT 0,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			last_attempt_at TIMESTAMP WITH TIME ZONE,
+			resolved_at TIMESTAMP WITH TIME ZONE
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_ingestion_dead_letters_status ON ingestion_dead_letters(status, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_ingestion_dead_letters_source ON ingestion_dead_letters(source, stage)`,
+
+		// ===============================
+		// MERGED DUPLICATES
+		// ===============================
+
+		// GDELT entities, unioned across merged duplicates
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS gdelt_tone DOUBLE PRECISION`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS gdelt_themes TEXT[] DEFAULT '{}'`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS gdelt_organizations TEXT[] DEFAULT '{}'`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS gdelt_persons TEXT[] DEFAULT '{}'`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS gdelt_locations TEXT[] DEFAULT '{}'`,
+
+		// Other outlets' copies of a canonical article ("Also reported by")
+		`CREATE TABLE IF NOT EXISTS article_sources (
+			id SERIAL PRIMARY KEY,
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			source VARCHAR(100) NOT NULL,
+			url TEXT NOT NULL,
+			external_id VARCHAR(255),
+			title TEXT,
+			published_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			UNIQUE(article_id, url)
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_article_sources_article ON article_sources(article_id)`,
+
+		// ===============================
+		// CONTENT FINGERPRINTS
+		// ===============================
+
+		// Cross-batch deduplication against stored articles
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS normalized_url TEXT`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_hash VARCHAR(64)`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS simhash BIGINT`,
+
+		`CREATE INDEX IF NOT EXISTS idx_articles_normalized_url ON articles(normalized_url)`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_content_hash ON articles(content_hash)`,
+		`CREATE INDEX IF NOT EXISTS idx_deduplication_logs_created ON deduplication_logs(created_at DESC)`,
+
+		// ===============================
+		// STORY CLUSTERS
+		// ===============================
+
+		// Articles about the same event, grouped into one story with a timeline
+		`CREATE TABLE IF NOT EXISTS stories (
+			id SERIAL PRIMARY KEY,
+			headline TEXT NOT NULL,
+			lead_article_id INTEGER REFERENCES articles(id) ON DELETE SET NULL,
+			category_id INTEGER REFERENCES categories(id),
+			article_count INTEGER NOT NULL DEFAULT 0,
+			source_count INTEGER NOT NULL DEFAULT 0,
+			themes TEXT[] DEFAULT '{}',
+			entities TEXT[] DEFAULT '{}',
+			first_published_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			last_published_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+
+		`CREATE TABLE IF NOT EXISTS story_articles (
+			story_id INTEGER NOT NULL REFERENCES stories(id) ON DELETE CASCADE,
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			similarity DOUBLE PRECISION NOT NULL DEFAULT 1,
+			added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (story_id, article_id),
+			UNIQUE (article_id)
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_stories_last_published ON stories(last_published_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_stories_category ON stories(category_id, last_published_at DESC)`,
+
+		// ===============================
+		// CATEGORY CLASSIFIER
+		// ===============================
+
+		// How each article's category was assigned, and the classifier's label scores
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS category_source VARCHAR(20)`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS category_confidence DOUBLE PRECISION`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS category_labels JSONB DEFAULT '[]'`,
+
+		// Articles the classifier was not confident about, left uncategorized for an editor
+		`CREATE TABLE IF NOT EXISTS category_reviews (
+			id SERIAL PRIMARY KEY,
+			article_id INTEGER NOT NULL UNIQUE REFERENCES articles(id) ON DELETE CASCADE,
+			suggested_category VARCHAR(100),
+			confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+			predictions JSONB NOT NULL DEFAULT '[]',
+			model_version VARCHAR(50),
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			resolved_category_id INTEGER REFERENCES categories(id),
+			reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+			reviewed_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_category_reviews_status ON category_reviews(status, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_category_source ON articles(category_source)`,
+
+		// ===============================
+		// SENTIMENT SCALE
+		// ===============================
+
+		// GDELT articles stored their raw tone (about -10 to 10); sentiment_score is -1 to 1
+		`UPDATE articles
+		SET sentiment_score = GREATEST(-1, LEAST(1, gdelt_tone / 10))
+		WHERE gdelt_tone IS NOT NULL
+		AND sentiment_score IS DISTINCT FROM GREATEST(-1, LEAST(1, gdelt_tone / 10))`,
+
+		// ===============================
+		// ENTITY GAZETTEER
+		// ===============================
+
+		// Admin additions to the built-in gazetteer; a row with a built-in ID replaces it
+		`CREATE TABLE IF NOT EXISTS gazetteer_entries (
+			canonical_id VARCHAR(100) PRIMARY KEY,
+			name VARCHAR(200) NOT NULL,
+			entity_type VARCHAR(20) NOT NULL,
+			kind VARCHAR(30),
+			aliases TEXT[] DEFAULT '{}',
+			parent_id VARCHAR(100),
+			case_sensitive BOOLEAN NOT NULL DEFAULT false,
+			not_after TEXT[] DEFAULT '{}',
+			is_active BOOLEAN NOT NULL DEFAULT true,
+			updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		)`,
+
+		// ===============================
+		// ARTICLE LOCATIONS
+		// ===============================
+
+		// Indian states, districts and cities each article is about, from geo-tagging
+		`CREATE TABLE IF NOT EXISTS article_locations (
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			place_code VARCHAR(64) NOT NULL,
+			place_name VARCHAR(100) NOT NULL,
+			place_level VARCHAR(10) NOT NULL,
+			state_code VARCHAR(4) NOT NULL,
+			district_code VARCHAR(64),
+			city_code VARCHAR(64),
+			in_headline BOOLEAN NOT NULL DEFAULT false,
+			is_primary BOOLEAN NOT NULL DEFAULT false,
+			position INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (article_id, place_code)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_locations_state ON article_locations(state_code, article_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_locations_district ON article_locations(district_code) WHERE district_code IS NOT NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_article_locations_city ON article_locations(city_code) WHERE city_code IS NOT NULL`,
+
+		// ===============================
+		// ARTICLE LANGUAGES
+		// ===============================
+
+		// Language detected at ingest; everything stored before detection was English
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS language VARCHAR(8) NOT NULL DEFAULT 'en'`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_language ON articles(language, published_at DESC) WHERE is_active = true`,
+
+		// Words of non-English articles split in Go, since the Postgres parser breaks Indic words
+		// at vowel signs and viramas; searched as a 'simple' tsvector
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_tokens TEXT[]`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_search_tokens ON articles USING GIN (array_to_tsvector(search_tokens)) WHERE search_tokens IS NOT NULL`,
+
+		// ===============================
+		// ARTICLE SUMMARIES
+		// ===============================
+
+		// Extractive summary generated at ingest and again from extracted full text
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary TEXT`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS summary_low_quality BOOLEAN NOT NULL DEFAULT false`,
+
+		// ===============================
+		// TAGS
+		// ===============================
+
+		// Catalogue of normalized tags; usage_count is the number of articles carrying each
+		`CREATE TABLE IF NOT EXISTS tags (
+			id SERIAL PRIMARY KEY,
+			slug VARCHAR(120) UNIQUE NOT NULL,
+			name VARCHAR(120) NOT NULL,
+			usage_count INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+			last_used_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_tags_usage ON tags(usage_count DESC)`,
+
+		// An article's tags in the order they were picked; mirrors articles.tags
+		`CREATE TABLE IF NOT EXISTS article_tags (
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+			position SMALLINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (article_id, tag_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_tags_tag ON article_tags(tag_id, article_id)`,
+
+		// ===============================
+		// TRENDING
+		// ===============================
+
+		// Reads per article per time bucket; mentions are counted from the articles themselves
+		`CREATE TABLE IF NOT EXISTS article_reads (
+			article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+			bucket TIMESTAMP WITH TIME ZONE NOT NULL,
+			reads INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (article_id, bucket)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_reads_bucket ON article_reads(bucket)`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_created_at ON articles(created_at DESC)`,
+
+		// ===============================
+		// BREAKING NEWS & NOTIFICATIONS
+		// ===============================
+
+		// A story stays flagged once breaking_at is set, so the detector never flags it twice;
+		// breaking_event_id is the first flagged story about the same event
+		`ALTER TABLE stories ADD COLUMN IF NOT EXISTS is_breaking BOOLEAN NOT NULL DEFAULT false`,
+		`ALTER TABLE stories ADD COLUMN IF NOT EXISTS breaking_at TIMESTAMP WITH TIME ZONE`,
+		`ALTER TABLE stories ADD COLUMN IF NOT EXISTS breaking_reason VARCHAR(32)`,
+		`ALTER TABLE stories ADD COLUMN IF NOT EXISTS breaking_by UUID REFERENCES users(id) ON DELETE SET NULL`,
+		`ALTER TABLE stories ADD COLUMN IF NOT EXISTS breaking_event_id INTEGER REFERENCES stories(id) ON DELETE SET NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_stories_breaking ON stories(breaking_at DESC) WHERE breaking_at IS NOT NULL`,
+
+		// In-app notification inbox; dedupe_key keeps one notification per user per event
+		`CREATE TABLE IF NOT EXISTS notifications (
+			id BIGSERIAL PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			kind VARCHAR(32) NOT NULL,
+			story_id INTEGER REFERENCES stories(id) ON DELETE SET NULL,
+			article_id INTEGER REFERENCES articles(id) ON DELETE SET NULL,
+			title TEXT NOT NULL,
+			body TEXT,
+			dedupe_key VARCHAR(100) NOT NULL,
+			read_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (user_id, dedupe_key)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user_id, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL`,
+
+		// ===============================
+		// CONTENT QUALITY
+		// ===============================
+
+		// quality_score is NULL until an article is scored and counts as clean until then
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS quality_score DOUBLE PRECISION`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS quality_flags TEXT[]`,
+		`ALTER TABLE articles ADD COLUMN IF NOT EXISTS is_explicit BOOLEAN NOT NULL DEFAULT false`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_low_quality ON articles(quality_score) WHERE quality_score < 0.6`,
+
 		// ===============================
 		// VERIFICATION: Check Indian content fix results
 		// ===============================
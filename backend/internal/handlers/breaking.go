@@ -0,0 +1,148 @@
+// internal/handlers/breaking.go
+// GoNews Breaking News Handler - Stories flagged as breaking, and editor triggers to flag or clear them
+
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// BreakingNewsHandler serves breaking stories and editor triggers
+type BreakingNewsHandler struct {
+	breaking *services.BreakingNewsService
+	logger   *logger.Logger
+}
+
+// NewBreakingNewsHandler creates a new breaking news handler
+func NewBreakingNewsHandler(breaking *services.BreakingNewsService, logger *logger.Logger) *BreakingNewsHandler {
+	return &BreakingNewsHandler{
+		breaking: breaking,
+		logger:   logger,
+	}
+}
+
+// ===============================
+// PUBLIC ENDPOINTS
+// ===============================
+
+// GetBreaking lists the stories currently wearing the breaking badge, newest first
+// GET /api/v1/news/breaking
+func (h *BreakingNewsHandler) GetBreaking(c *fiber.Ctx) error {
+	stories, err := h.breaking.ListBreaking()
+	if err != nil {
+		h.logger.Error("Failed to list breaking stories", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load breaking news",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Breaking news retrieved successfully",
+		Data: fiber.Map{
+			"stories": stories,
+			"total":   len(stories),
+		},
+	})
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// MarkBreaking flags a story as breaking news and notifies readers who opted in
+// POST /api/v1/news/admin/stories/:id/breaking
+func (h *BreakingNewsHandler) MarkBreaking(c *fiber.Ctx) error {
+	storyID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || storyID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric story ID is required",
+		})
+	}
+	editorID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Message: "Authentication required",
+		})
+	}
+
+	result, err := h.breaking.MarkBreaking(storyID, editorID)
+	if err != nil {
+		return h.breakingError(c, storyID, err, "Failed to flag story as breaking")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Story flagged as breaking news",
+		Data:    result,
+	})
+}
+
+// ClearBreaking removes a story's breaking badge
+// DELETE /api/v1/news/admin/stories/:id/breaking
+func (h *BreakingNewsHandler) ClearBreaking(c *fiber.Ctx) error {
+	storyID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || storyID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric story ID is required",
+		})
+	}
+
+	if err := h.breaking.ClearBreaking(storyID); err != nil {
+		return h.breakingError(c, storyID, err, "Failed to clear breaking story")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Breaking badge cleared",
+	})
+}
+
+// DetectNow runs a breaking news detection pass immediately instead of waiting for the worker
+// POST /api/v1/news/admin/breaking/detect
+func (h *BreakingNewsHandler) DetectNow(c *fiber.Ctx) error {
+	run, err := h.breaking.Detect()
+	if err != nil {
+		h.logger.Error("Manual breaking news detection failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to detect breaking news",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Breaking news detection completed",
+		Data:    run,
+	})
+}
+
+// breakingError maps repository errors to responses
+func (h *BreakingNewsHandler) breakingError(c *fiber.Ctx, storyID int, err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrStoryNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Story not found",
+		})
+	case errors.Is(err, repository.ErrStoryAlreadyBreaking):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Message: "Story is already breaking news",
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"story_id": storyID,
+		"error":    err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
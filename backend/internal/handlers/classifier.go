@@ -0,0 +1,222 @@
+// internal/handlers/classifier.go
+// GoNews Classifier Handler - Admin view of the category model and its review queue
+
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// ClassifierHandler exposes the category classifier to admins
+type ClassifierHandler struct {
+	classifier *services.CategoryClassifier
+	logger     *logger.Logger
+}
+
+// NewClassifierHandler creates a new classifier handler
+func NewClassifierHandler(classifier *services.CategoryClassifier, logger *logger.Logger) *ClassifierHandler {
+	return &ClassifierHandler{
+		classifier: classifier,
+		logger:     logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// GetStatus describes the loaded model, its held-out scores and the review backlog
+// GET /api/v1/news/admin/classifier/status
+func (h *ClassifierHandler) GetStatus(c *fiber.Ctx) error {
+	status, err := h.classifier.Status()
+	if err != nil {
+		h.logger.Error("Failed to get classifier status", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to get classifier status",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Classifier status retrieved successfully",
+		Data:    status,
+	})
+}
+
+// Predict classifies a title and description without storing anything
+// POST /api/v1/news/admin/classifier/predict
+func (h *ClassifierHandler) Predict(c *fiber.Ctx) error {
+	var req struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.Description) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A title or description is required",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Category predicted successfully",
+		Data:    h.classifier.Classify(req.Title, req.Description),
+	})
+}
+
+// ListReviews returns articles waiting for an editor to choose a category, oldest first
+// GET /api/v1/news/admin/classifier/reviews?status=pending&limit=50&offset=0
+func (h *ClassifierHandler) ListReviews(c *fiber.Ctx) error {
+	status := c.Query("status", models.CategoryReviewPending)
+	switch status {
+	case models.CategoryReviewPending, models.CategoryReviewResolved, models.CategoryReviewDismissed:
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Status must be pending, resolved or dismissed",
+		})
+	}
+
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+	offset := c.QueryInt("offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	reviews, total, err := h.classifier.ListReviews(status, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list category reviews", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to list category reviews",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Category reviews retrieved successfully",
+		Data: map[string]interface{}{
+			"reviews": reviews,
+			"total":   total,
+			"limit":   limit,
+			"offset":  offset,
+		},
+	})
+}
+
+// ResolveReview sets the article's category to the editor's choice
+// POST /api/v1/news/admin/classifier/reviews/:id/resolve
+func (h *ClassifierHandler) ResolveReview(c *fiber.Ctx) error {
+	id, ok := categoryReviewID(c)
+	if !ok {
+		return categoryReviewIDError(c)
+	}
+
+	var req models.CategoryReviewResolution
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+	if req.Category == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A category slug is required",
+		})
+	}
+
+	review, err := h.classifier.ResolveReview(id, req, reviewerID(c))
+	if err != nil {
+		return h.reviewError(c, id, err, "Failed to resolve category review")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Category review resolved",
+		Data:    review,
+	})
+}
+
+// DismissReview closes a review and leaves the article uncategorized
+// POST /api/v1/news/admin/classifier/reviews/:id/dismiss
+func (h *ClassifierHandler) DismissReview(c *fiber.Ctx) error {
+	id, ok := categoryReviewID(c)
+	if !ok {
+		return categoryReviewIDError(c)
+	}
+
+	review, err := h.classifier.DismissReview(id, reviewerID(c))
+	if err != nil {
+		return h.reviewError(c, id, err, "Failed to dismiss category review")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Category review dismissed",
+		Data:    review,
+	})
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+func categoryReviewID(c *fiber.Ctx) (int, bool) {
+	id, err := strconv.Atoi(c.Params("id"))
+	return id, err == nil && id > 0
+}
+
+func categoryReviewIDError(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+		Message: "A valid numeric review ID is required",
+	})
+}
+
+// reviewerID returns the admin making the request, if known
+func reviewerID(c *fiber.Ctx) *uuid.UUID {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return nil
+	}
+	return &userID
+}
+
+// reviewError maps repository errors to responses
+func (h *ClassifierHandler) reviewError(c *fiber.Ctx, id int, err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrCategoryReviewNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Category review not found",
+		})
+	case errors.Is(err, repository.ErrCategoryReviewClosed):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Message: "Category review was already resolved or dismissed",
+		})
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Unknown category slug",
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"review_id": id,
+		"error":     err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
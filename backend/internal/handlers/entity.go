@@ -0,0 +1,134 @@
+// internal/handlers/entity.go
+// GoNews Entity Handler - Admin view and extension of the entity extraction gazetteer
+
+package handlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// EntityHandler exposes the entity extractor to admins
+type EntityHandler struct {
+	extractor *services.EntityExtractor
+	logger    *logger.Logger
+}
+
+// NewEntityHandler creates a new entity handler
+func NewEntityHandler(extractor *services.EntityExtractor, logger *logger.Logger) *EntityHandler {
+	return &EntityHandler{
+		extractor: extractor,
+		logger:    logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// ListGazetteer returns gazetteer entities, optionally of one type or matching a search
+// GET /api/v1/news/admin/entities/gazetteer?type=person&q=modi
+func (h *EntityHandler) ListGazetteer(c *fiber.Ctx) error {
+	return c.JSON(models.SuccessResponse{
+		Message: "Gazetteer retrieved successfully",
+		Data:    h.extractor.ListEntities(c.Query("type"), c.Query("q")),
+	})
+}
+
+// SaveGazetteerEntity adds an entity, or replaces the built-in or custom one with its ID
+// POST /api/v1/news/admin/entities/gazetteer
+func (h *EntityHandler) SaveGazetteerEntity(c *fiber.Ctx) error {
+	var req models.GazetteerEntityRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	entry, err := h.extractor.SaveEntity(req, reviewerID(c))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidGazetteerEntity) {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: err.Error(),
+			})
+		}
+
+		h.logger.Error("Failed to save gazetteer entity", map[string]interface{}{
+			"name":  req.Name,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to save gazetteer entity",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Gazetteer entity saved",
+		Data:    entry,
+	})
+}
+
+// RemoveGazetteerEntity removes a custom entity or switches off a built-in one
+// DELETE /api/v1/news/admin/entities/gazetteer/:id
+func (h *EntityHandler) RemoveGazetteerEntity(c *fiber.Ctx) error {
+	id := strings.TrimSpace(c.Params("id"))
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "An entity ID is required",
+		})
+	}
+
+	if err := h.extractor.RemoveEntity(id, reviewerID(c)); err != nil {
+		if errors.Is(err, repository.ErrGazetteerEntryNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Gazetteer entity not found",
+			})
+		}
+
+		h.logger.Error("Failed to remove gazetteer entity", map[string]interface{}{
+			"id":    id,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to remove gazetteer entity",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Gazetteer entity removed",
+		Data: map[string]interface{}{
+			"id": id,
+		},
+	})
+}
+
+// Extract previews the entities found in a headline and description
+// POST /api/v1/news/admin/entities/extract
+func (h *EntityHandler) Extract(c *fiber.Ctx) error {
+	var req struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.Description) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A title or description is required",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Entities extracted successfully",
+		Data:    h.extractor.Extract(req.Title, req.Description),
+	})
+}
@@ -0,0 +1,161 @@
+// internal/handlers/experiment.go
+// GoNews Experiment Handler - Admin management of A/B experiments and per-variant results
+
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// ExperimentHandler exposes experiment management to admins
+type ExperimentHandler struct {
+	experiments *services.ExperimentService
+	logger      *logger.Logger
+}
+
+// NewExperimentHandler creates a new experiment handler
+func NewExperimentHandler(experiments *services.ExperimentService, logger *logger.Logger) *ExperimentHandler {
+	return &ExperimentHandler{
+		experiments: experiments,
+		logger:      logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// ListExperiments returns all experiments with their variants
+// GET /api/v1/news/admin/experiments
+func (h *ExperimentHandler) ListExperiments(c *fiber.Ctx) error {
+	experiments, err := h.experiments.ListExperiments()
+	if err != nil {
+		h.logger.Error("Failed to list experiments", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to list experiments",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Experiments retrieved successfully",
+		Data:    experiments,
+	})
+}
+
+// CreateExperiment defines a new experiment in draft state
+// POST /api/v1/news/admin/experiments
+func (h *ExperimentHandler) CreateExperiment(c *fiber.Ctx) error {
+	var req models.CreateExperimentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	experiment, err := h.experiments.CreateExperiment(&req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidExperiment):
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, repository.ErrExperimentExists):
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+				Message: "An experiment with this key already exists",
+			})
+		}
+
+		h.logger.Error("Failed to create experiment", map[string]interface{}{
+			"key":   req.Key,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to create experiment",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.SuccessResponse{
+		Message: "Experiment created successfully",
+		Data:    experiment,
+	})
+}
+
+// UpdateExperimentStatus starts, pauses or completes an experiment
+// PUT /api/v1/news/admin/experiments/:key/status
+func (h *ExperimentHandler) UpdateExperimentStatus(c *fiber.Ctx) error {
+	key := c.Params("key")
+
+	var req models.UpdateExperimentStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := h.experiments.UpdateStatus(key, req.Status); err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidExperiment):
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, repository.ErrExperimentNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Experiment not found",
+			})
+		}
+
+		h.logger.Error("Failed to update experiment status", map[string]interface{}{
+			"key":    key,
+			"status": req.Status,
+			"error":  err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to update experiment status",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Experiment status updated successfully",
+		Data: map[string]interface{}{
+			"key":    key,
+			"status": req.Status,
+		},
+	})
+}
+
+// GetExperimentResults returns per-variant metrics with significance tests against control
+// GET /api/v1/news/admin/experiments/:key/results
+func (h *ExperimentHandler) GetExperimentResults(c *fiber.Ctx) error {
+	key := c.Params("key")
+
+	results, err := h.experiments.GetResults(key)
+	if err != nil {
+		if errors.Is(err, repository.ErrExperimentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Experiment not found",
+			})
+		}
+
+		h.logger.Error("Failed to compute experiment results", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to compute experiment results",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Experiment results generated successfully",
+		Data:    results,
+	})
+}
@@ -0,0 +1,90 @@
+// internal/handlers/extraction.go
+// GoNews Extraction Handler - Admin view of full-text extraction coverage and on-demand re-extraction
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// ExtractionHandler exposes full-text extraction status to admins
+type ExtractionHandler struct {
+	extractionService *services.ExtractionService
+	logger            *logger.Logger
+}
+
+// NewExtractionHandler creates a new extraction handler
+func NewExtractionHandler(extractionService *services.ExtractionService, logger *logger.Logger) *ExtractionHandler {
+	return &ExtractionHandler{
+		extractionService: extractionService,
+		logger:            logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// GetStats returns article counts per extraction status
+// GET /api/v1/news/admin/extraction/stats
+func (h *ExtractionHandler) GetStats(c *fiber.Ctx) error {
+	stats, err := h.extractionService.GetStats()
+	if err != nil {
+		h.logger.Error("Failed to get extraction stats", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to get extraction stats",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Extraction stats retrieved successfully",
+		Data:    stats,
+	})
+}
+
+// ExtractArticle fetches and extracts one article now, regardless of its status
+// POST /api/v1/news/admin/extraction/articles/:id
+func (h *ExtractionHandler) ExtractArticle(c *fiber.Ctx) error {
+	articleID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || articleID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric article ID is required",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	result, err := h.extractionService.ExtractArticle(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrExtractionArticleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Article not found",
+			})
+		}
+		h.logger.Error("Failed to extract article", map[string]interface{}{
+			"article_id": articleID,
+			"error":      err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to extract article",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Article extraction " + result.Status,
+		Data:    result,
+	})
+}
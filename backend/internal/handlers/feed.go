@@ -0,0 +1,200 @@
+// internal/handlers/feed.go
+// GoNews Feed Handler - Admin management and health of polled RSS/Atom feeds
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// FeedHandler exposes feed list management to admins
+type FeedHandler struct {
+	feedService *services.FeedService
+	logger      *logger.Logger
+}
+
+// NewFeedHandler creates a new feed handler
+func NewFeedHandler(feedService *services.FeedService, logger *logger.Logger) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+		logger:      logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// ListFeeds returns all feeds with per-feed health and a health summary
+// GET /api/v1/news/admin/feeds
+func (h *FeedHandler) ListFeeds(c *fiber.Ctx) error {
+	feeds, err := h.feedService.ListFeeds()
+	if err != nil {
+		h.logger.Error("Failed to list feeds", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to list feeds",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feeds retrieved successfully",
+		Data:    feeds,
+	})
+}
+
+// GetFeed returns one feed with its health
+// GET /api/v1/news/admin/feeds/:id
+func (h *FeedHandler) GetFeed(c *fiber.Ctx) error {
+	feedID, ok := h.feedID(c)
+	if !ok {
+		return h.invalidID(c)
+	}
+
+	feed, err := h.feedService.GetFeed(feedID)
+	if err != nil {
+		return h.feedError(c, err, "Failed to get feed")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed retrieved successfully",
+		Data:    feed,
+	})
+}
+
+// CreateFeed adds a feed to the polling list
+// POST /api/v1/news/admin/feeds
+func (h *FeedHandler) CreateFeed(c *fiber.Ctx) error {
+	var req models.CreateFeedRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	feed, err := h.feedService.CreateFeed(&req)
+	if err != nil {
+		return h.feedError(c, err, "Failed to create feed")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.SuccessResponse{
+		Message: "Feed created successfully",
+		Data:    feed,
+	})
+}
+
+// UpdateFeed changes feed settings, including pausing it with is_active=false
+// PUT /api/v1/news/admin/feeds/:id
+func (h *FeedHandler) UpdateFeed(c *fiber.Ctx) error {
+	feedID, ok := h.feedID(c)
+	if !ok {
+		return h.invalidID(c)
+	}
+
+	var req models.UpdateFeedRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	feed, err := h.feedService.UpdateFeed(feedID, &req)
+	if err != nil {
+		return h.feedError(c, err, "Failed to update feed")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed updated successfully",
+		Data:    feed,
+	})
+}
+
+// DeleteFeed removes a feed from the polling list
+// DELETE /api/v1/news/admin/feeds/:id
+func (h *FeedHandler) DeleteFeed(c *fiber.Ctx) error {
+	feedID, ok := h.feedID(c)
+	if !ok {
+		return h.invalidID(c)
+	}
+
+	if err := h.feedService.DeleteFeed(feedID); err != nil {
+		return h.feedError(c, err, "Failed to delete feed")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed deleted successfully",
+	})
+}
+
+// PollFeed polls a feed now, ignoring its schedule
+// POST /api/v1/news/admin/feeds/:id/poll
+func (h *FeedHandler) PollFeed(c *fiber.Ctx) error {
+	feedID, ok := h.feedID(c)
+	if !ok {
+		return h.invalidID(c)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	result, err := h.feedService.PollNow(ctx, feedID)
+	if err != nil {
+		return h.feedError(c, err, "Failed to poll feed")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed polled",
+		Data:    result,
+	})
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+func (h *FeedHandler) feedID(c *fiber.Ctx) (int, bool) {
+	feedID, err := strconv.Atoi(c.Params("id"))
+	return feedID, err == nil && feedID > 0
+}
+
+func (h *FeedHandler) invalidID(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+		Message: "A valid numeric feed ID is required",
+	})
+}
+
+// feedError maps service and repository errors onto HTTP statuses
+func (h *FeedHandler) feedError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidFeed):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrFeedNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Feed not found",
+		})
+	case errors.Is(err, repository.ErrFeedExists):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"error": err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
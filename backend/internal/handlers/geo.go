@@ -0,0 +1,154 @@
+// internal/handlers/geo.go
+// GoNews Geo Handler - "News near me" and the states, districts and cities articles are tagged with
+
+package handlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"backend/internal/config"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// GeoHandler serves local news and the geo gazetteer
+type GeoHandler struct {
+	geo    *services.GeoService
+	logger *logger.Logger
+
+	// Optional readers' content filter levels
+	config   *config.Config
+	userRepo *repository.UserRepository
+}
+
+// NewGeoHandler creates a new geo handler
+func NewGeoHandler(geo *services.GeoService, logger *logger.Logger) *GeoHandler {
+	return &GeoHandler{
+		geo:    geo,
+		logger: logger,
+	}
+}
+
+// SetContentFilter applies readers' content filter levels to local news
+func (h *GeoHandler) SetContentFilter(cfg *config.Config, userRepo *repository.UserRepository) {
+	h.config = cfg
+	h.userRepo = userRepo
+}
+
+// ===============================
+// PUBLIC ENDPOINTS
+// ===============================
+
+// GetNearbyNews returns recent news about a place and the places around it. Without a state,
+// district or city, signed-in users get news for their profile location.
+// GET /api/v1/news/near-me?state=MH&city=pune&days=3&page=1&limit=20
+func (h *GeoHandler) GetNearbyNews(c *fiber.Ctx) error {
+	query := models.NearbyNewsQuery{
+		State:    c.Query("state"),
+		District: c.Query("district"),
+		City:     c.Query("city"),
+		Days:     c.QueryInt("days", 0),
+		Page:     c.QueryInt("page", 1),
+		Limit:    c.QueryInt("limit", 20),
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.Limit < 1 || query.Limit > 50 {
+		query.Limit = 20
+	}
+
+	var userID *uuid.UUID
+	if id, ok := middleware.GetUserIDFromContext(c); ok {
+		userID = &id
+	}
+
+	response, err := h.geo.NearbyNews(userID, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownPlace):
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: "Unknown state, district or city",
+			})
+		case errors.Is(err, services.ErrLocationRequired):
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: "Pass a state, district or city, or set a location in your profile",
+			})
+		}
+
+		h.logger.Error("Failed to get nearby news", map[string]interface{}{
+			"state": query.State,
+			"city":  query.City,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load nearby news",
+		})
+	}
+
+	// Pages come from SQL, so the filter applies within the page and keeps has_more intact
+	response.Articles = filterFeed(c, h.config, h.userRepo, h.logger, response.Articles, len(response.Articles))
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Nearby news retrieved successfully",
+		Data:    response,
+	})
+}
+
+// GetPlaces lists states and union territories, or the districts and cities of one state
+// GET /api/v1/news/places?state=MH
+func (h *GeoHandler) GetPlaces(c *fiber.Ctx) error {
+	state := strings.TrimSpace(c.Query("state"))
+
+	places, err := h.geo.ListPlaces(state)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Unknown state code",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Places retrieved successfully",
+		Data: fiber.Map{
+			"places": places,
+			"total":  len(places),
+		},
+	})
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// PreviewTags shows the places a headline and description would be tagged with
+// POST /api/v1/news/admin/geo/tag
+func (h *GeoHandler) PreviewTags(c *fiber.Ctx) error {
+	var req struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.Description) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A title or description is required",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Places tagged successfully",
+		Data: fiber.Map{
+			"locations": h.geo.PreviewTags(req.Title, req.Description),
+		},
+	})
+}
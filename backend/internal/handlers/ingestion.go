@@ -0,0 +1,226 @@
+// internal/handlers/ingestion.go
+// GoNews Ingestion Handler - Admin view of pipeline stage metrics and dead-lettered items
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// IngestionHandler exposes the ingestion pipeline to admins
+type IngestionHandler struct {
+	pipeline *services.IngestionPipeline
+	logger   *logger.Logger
+}
+
+// NewIngestionHandler creates a new ingestion handler
+func NewIngestionHandler(pipeline *services.IngestionPipeline, logger *logger.Logger) *IngestionHandler {
+	return &IngestionHandler{
+		pipeline: pipeline,
+		logger:   logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// GetMetrics returns per-stage counters and latencies plus dead letter counts
+// GET /api/v1/news/admin/ingestion/metrics
+func (h *IngestionHandler) GetMetrics(c *fiber.Ctx) error {
+	metrics, err := h.pipeline.Metrics()
+	if err != nil {
+		h.logger.Error("Failed to get ingestion metrics", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to get ingestion metrics",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Ingestion metrics retrieved successfully",
+		Data:    metrics,
+	})
+}
+
+// ListDeadLetters returns dead letters, newest first
+// GET /api/v1/news/admin/ingestion/dead-letters?status=pending&source=gnews&stage=normalize&limit=50&offset=0
+func (h *IngestionHandler) ListDeadLetters(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+	offset := c.QueryInt("offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	response, err := h.pipeline.ListDeadLetters(models.DeadLetterFilter{
+		Status: c.Query("status"),
+		Source: c.Query("source"),
+		Stage:  c.Query("stage"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.logger.Error("Failed to list dead letters", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to list dead letters",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Dead letters retrieved successfully",
+		Data:    response,
+	})
+}
+
+// GetDeadLetter returns one dead letter with its raw payload
+// GET /api/v1/news/admin/ingestion/dead-letters/:id
+func (h *IngestionHandler) GetDeadLetter(c *fiber.Ctx) error {
+	id, ok := deadLetterID(c)
+	if !ok {
+		return deadLetterIDError(c)
+	}
+
+	letter, err := h.pipeline.GetDeadLetter(id)
+	if err != nil {
+		return h.deadLetterError(c, id, err, "Failed to get dead letter")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Dead letter retrieved successfully",
+		Data:    letter,
+	})
+}
+
+// ReplayDeadLetter runs one pending dead letter through the pipeline again
+// POST /api/v1/news/admin/ingestion/dead-letters/:id/replay
+func (h *IngestionHandler) ReplayDeadLetter(c *fiber.Ctx) error {
+	id, ok := deadLetterID(c)
+	if !ok {
+		return deadLetterIDError(c)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	result, err := h.pipeline.ReplayDeadLetter(ctx, id)
+	if err != nil {
+		return h.deadLetterError(c, id, err, "Failed to replay dead letter")
+	}
+
+	message := "Dead letter replayed successfully"
+	if result.Status != models.DeadLetterReplayed {
+		message = "Dead letter failed again at " + result.Stage
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: message,
+		Data:    result,
+	})
+}
+
+// ReplayDeadLetters replays the oldest pending dead letters, optionally for one source or stage
+// POST /api/v1/news/admin/ingestion/dead-letters/replay?source=gnews&stage=normalize&limit=100
+func (h *IngestionHandler) ReplayDeadLetters(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 100)
+	if limit < 1 || limit > 500 {
+		limit = 100
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	result, err := h.pipeline.ReplayDeadLetters(ctx, c.Query("source"), c.Query("stage"), limit)
+	if err != nil {
+		h.logger.Error("Failed to replay dead letters", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to replay dead letters",
+		})
+	}
+
+	h.logger.Info("Dead letters replayed", map[string]interface{}{
+		"requested": result.Requested,
+		"replayed":  result.Replayed,
+		"failed":    result.Failed,
+	})
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Dead letter replay completed",
+		Data:    result,
+	})
+}
+
+// DiscardDeadLetter marks a pending dead letter as not worth replaying
+// POST /api/v1/news/admin/ingestion/dead-letters/:id/discard
+func (h *IngestionHandler) DiscardDeadLetter(c *fiber.Ctx) error {
+	id, ok := deadLetterID(c)
+	if !ok {
+		return deadLetterIDError(c)
+	}
+
+	if err := h.pipeline.DiscardDeadLetter(id); err != nil {
+		return h.deadLetterError(c, id, err, "Failed to discard dead letter")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Dead letter discarded",
+		Data: map[string]interface{}{
+			"id":     id,
+			"status": models.DeadLetterDiscarded,
+		},
+	})
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+func deadLetterID(c *fiber.Ctx) (int, bool) {
+	id, err := strconv.Atoi(c.Params("id"))
+	return id, err == nil && id > 0
+}
+
+func deadLetterIDError(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+		Message: "A valid numeric dead letter ID is required",
+	})
+}
+
+// deadLetterError maps repository errors to responses
+func (h *IngestionHandler) deadLetterError(c *fiber.Ctx, id int, err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrDeadLetterNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Dead letter not found",
+		})
+	case errors.Is(err, repository.ErrDeadLetterResolved):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Message: "Dead letter was already replayed or discarded",
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"dead_letter_id": id,
+		"error":          err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
@@ -0,0 +1,171 @@
+// internal/handlers/learning_to_rank.go
+// GoNews Learning-to-Rank Handler - Admin training, rollout and rollback of learned ranking weights
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// LearningToRankHandler exposes learning-to-rank operations to admins
+type LearningToRankHandler struct {
+	learningToRank *services.LearningToRankService
+	logger         *logger.Logger
+}
+
+// NewLearningToRankHandler creates a new learning-to-rank handler
+func NewLearningToRankHandler(learningToRank *services.LearningToRankService, logger *logger.Logger) *LearningToRankHandler {
+	return &LearningToRankHandler{
+		learningToRank: learningToRank,
+		logger:         logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// GetStatus returns the serving model, current weights, impression volume and last training run
+// GET /api/v1/news/admin/ltr/status
+func (h *LearningToRankHandler) GetStatus(c *fiber.Ctx) error {
+	return c.JSON(models.SuccessResponse{
+		Message: "Learning-to-rank status retrieved successfully",
+		Data:    h.learningToRank.Status(),
+	})
+}
+
+// ListModels returns recent trained model versions
+// GET /api/v1/news/admin/ltr/models?limit=20
+func (h *LearningToRankHandler) ListModels(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	rankingModels, err := h.learningToRank.ListModels(limit)
+	if err != nil {
+		h.logger.Error("Failed to list ranking models", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to list ranking models",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Ranking models retrieved successfully",
+		Data:    rankingModels,
+	})
+}
+
+// TrainModel runs the training job now; publish=false stores the model without serving it
+// POST /api/v1/news/admin/ltr/train?publish=false
+func (h *LearningToRankHandler) TrainModel(c *fiber.Ctx) error {
+	publish := c.QueryBool("publish", false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	result, err := h.learningToRank.TrainModel(ctx, publish)
+	if err != nil {
+		h.logger.Error("Manual learning-to-rank training failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Training failed: " + err.Error(),
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Learning-to-rank training completed",
+		Data:    result,
+	})
+}
+
+// UpdateRollout serves a model to a share of subjects; 100 makes it the pipeline default
+// PUT /api/v1/news/admin/ltr/models/:id/rollout
+func (h *LearningToRankHandler) UpdateRollout(c *fiber.Ctx) error {
+	modelID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || modelID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric model ID is required",
+		})
+	}
+
+	var req models.UpdateRolloutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := h.learningToRank.SetRollout(modelID, req.RolloutPercent); err != nil {
+		switch {
+		case errors.Is(err, services.ErrRolloutRejected):
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, repository.ErrRankingModelNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Ranking model not found",
+			})
+		}
+
+		h.logger.Error("Failed to update ranking model rollout", map[string]interface{}{
+			"model_id": modelID,
+			"error":    err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to update rollout",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Ranking model rollout updated successfully",
+		Data: map[string]interface{}{
+			"model_id":        modelID,
+			"rollout_percent": req.RolloutPercent,
+		},
+	})
+}
+
+// Rollback withdraws the active model and restores the weights in place before it
+// POST /api/v1/news/admin/ltr/rollback
+func (h *LearningToRankHandler) Rollback(c *fiber.Ctx) error {
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	// The body is optional
+	_ = c.BodyParser(&req)
+
+	model, err := h.learningToRank.Rollback(req.Reason)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoActiveRankingModel) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "No active ranking model to roll back",
+			})
+		}
+
+		h.logger.Error("Failed to roll back ranking model", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to roll back ranking model",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Ranking model rolled back successfully",
+		Data:    model,
+	})
+}
@@ -5,16 +5,22 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"backend/internal/config"
 	"backend/internal/middleware"
 	"backend/internal/models"
+	"backend/internal/repository"
 	"backend/internal/services"
+	"backend/pkg/lang"
 	"backend/pkg/logger"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 // NewsHandler handles all news-related HTTP endpoints
@@ -23,6 +29,34 @@ type NewsHandler struct {
 	cacheService *services.CacheService
 	config       *config.Config
 	logger       *logger.Logger
+
+	// Engagement persistence (bookmarks + reading history)
+	engagementRepo *repository.EngagementRepository
+	articleRepo    *repository.ArticleRepository
+
+	// Optional MMR diversity re-ranking for the main and personalized feeds
+	diversityRanker *services.DiversityRanker
+
+	// Optional A/B experiments over feed ranking parameters
+	experimentService *services.ExperimentService
+
+	// Optional impression logging and learned ranking weights
+	learningToRank *services.LearningToRankService
+
+	// Optional story clusters, for feeds that show one card per story
+	storyService *services.StoryService
+
+	// Optional velocity trending, fed by tracked reads
+	trendingService *services.TrendingService
+
+	// Optional breaking news badges on feed articles
+	breakingService *services.BreakingNewsService
+
+	// Optional profile lookup, so signed-in readers get news in their languages
+	userRepo *repository.UserRepository
+
+	// Optional place filters over the feeds, matched on articles' tagged locations
+	filterService *services.FilterService
 }
 
 // NewNewsHandler creates a new news handler (matches routes expectation)
@@ -35,6 +69,245 @@ func NewNewsHandler(newsService *services.NewsAggregatorService, cacheService *s
 	}
 }
 
+// SetEngagementRepositories enables database-backed bookmarks and reading history
+func (h *NewsHandler) SetEngagementRepositories(engagementRepo *repository.EngagementRepository, articleRepo *repository.ArticleRepository) {
+	h.engagementRepo = engagementRepo
+	h.articleRepo = articleRepo
+}
+
+// SetDiversityRanker enables diversity re-ranking of the main and personalized feeds
+func (h *NewsHandler) SetDiversityRanker(diversityRanker *services.DiversityRanker) {
+	h.diversityRanker = diversityRanker
+}
+
+// SetExperimentService enables A/B experiment variants on the feeds and outcome logging
+func (h *NewsHandler) SetExperimentService(experimentService *services.ExperimentService) {
+	h.experimentService = experimentService
+}
+
+// SetLearningToRank enables impression logging and serving of learned ranking weights
+func (h *NewsHandler) SetLearningToRank(learningToRank *services.LearningToRankService) {
+	h.learningToRank = learningToRank
+}
+
+// SetStoryService enables group=story on the main feed
+func (h *NewsHandler) SetStoryService(storyService *services.StoryService) {
+	h.storyService = storyService
+}
+
+// SetTrendingService counts tracked reads towards trending
+func (h *NewsHandler) SetTrendingService(trendingService *services.TrendingService) {
+	h.trendingService = trendingService
+}
+
+// SetBreakingNewsService badges feed articles whose story is breaking news
+func (h *NewsHandler) SetBreakingNewsService(breakingService *services.BreakingNewsService) {
+	h.breakingService = breakingService
+}
+
+// SetUserRepository serves feeds and search in signed-in readers' preferred languages
+func (h *NewsHandler) SetUserRepository(userRepo *repository.UserRepository) {
+	h.userRepo = userRepo
+}
+
+// SetFilterService lets feeds be narrowed to states, cities, metros or local news
+func (h *NewsHandler) SetFilterService(filterService *services.FilterService) {
+	h.filterService = filterService
+}
+
+// markBreaking badges the articles whose story is breaking news
+func (h *NewsHandler) markBreaking(articles []*models.Article) []*models.Article {
+	if h.breakingService == nil {
+		return articles
+	}
+	return h.breakingService.Annotate(articles)
+}
+
+// groupsByStory reports whether the feed should collapse articles into one card per story
+func (h *NewsHandler) groupsByStory(group string) bool {
+	return h.storyService != nil && strings.EqualFold(group, models.FeedGroupStory)
+}
+
+// experimentAssignment buckets the caller into running experiments for a feed (nil without experiments)
+func (h *NewsHandler) experimentAssignment(c *fiber.Ctx, feedType string) *services.ExperimentAssignment {
+	if h.experimentService == nil {
+		return nil
+	}
+	return h.experimentService.Assign(feedType, requestSubject(c, h.config.ExperimentDeviceHeader))
+}
+
+// recordExperimentOutcome attributes a read or bookmark to the caller's experiment variants
+func (h *NewsHandler) recordExperimentOutcome(c *fiber.Ctx, eventType, rawArticleID string, dwellSeconds, scrollDepth int) {
+	if h.experimentService == nil {
+		return
+	}
+
+	var articleID *int
+	if id, err := strconv.Atoi(rawArticleID); err == nil {
+		articleID = &id
+	}
+
+	subject := requestSubject(c, h.config.ExperimentDeviceHeader)
+	h.experimentService.RecordOutcome(subject, eventType, articleID, dwellSeconds, scrollDepth)
+}
+
+// candidateLimit returns how many articles to fetch so re-ranking has room to diversify
+func (h *NewsHandler) candidateLimit(limit int, assignment *services.ExperimentAssignment) int {
+	if h.diversityRanker == nil {
+		return limit
+	}
+	if size, ok := assignment.FloatParam(models.ExperimentParamCandidatePoolSize); ok {
+		return h.diversityRanker.CandidatePoolSizeWith(limit, int(size))
+	}
+	return h.diversityRanker.CandidatePoolSize(limit)
+}
+
+// rerankForDiversity applies the reader's content filter and MMR re-ranking, and returns placement
+// explanations when debug=rank is set. Articles the filter demotes only fill the page after the
+// re-ranked ones, so re-ranking never lifts them.
+func (h *NewsHandler) rerankForDiversity(c *fiber.Ctx, articles []*models.Article, feedType string, limit int, assignment *services.ExperimentAssignment) ([]*models.Article, []models.RankExplanation) {
+	articles, demoted := services.SplitByContentFilter(articles, readerContentFilter(c, h.config, h.userRepo, h.logger))
+
+	if h.diversityRanker == nil || !h.diversityRanker.Enabled() || assignment.BoolParam(models.ExperimentParamDiversityDisabled) {
+		return fillFeed(articles, demoted, limit), nil
+	}
+
+	opts := services.RerankOptions{
+		FeedType: feedType,
+		Explain:  c.Query("debug") == models.RankDebugMode,
+		Weights:  assignment.WeightsParam(models.ExperimentParamRankingWeights),
+	}
+	// Experiments take precedence over a partially rolled out learned model
+	if opts.Weights == nil && h.learningToRank != nil {
+		opts.Weights = h.learningToRank.WeightsFor(requestSubject(c, h.config.ExperimentDeviceHeader))
+	}
+	if lambda, ok := assignment.FloatParam(models.ExperimentParamDiversityLambda); ok {
+		opts.Lambda = &lambda
+	}
+
+	result := h.diversityRanker.Rerank(articles, limit, opts)
+	return fillFeed(result.Articles, demoted, limit), result.Explanations
+}
+
+// filterContent applies the reader's content filter to a feed that is not re-ranked, trimmed to limit
+func (h *NewsHandler) filterContent(c *fiber.Ctx, articles []*models.Article, limit int) []*models.Article {
+	return filterFeed(c, h.config, h.userRepo, h.logger, articles, limit)
+}
+
+// placeFilterOverfetch widens the candidate pool of feeds narrowed to places, since most
+// articles are about somewhere else
+const placeFilterOverfetch = 5
+
+// placeFilters reads the state, city, metro and local parameters. Nil means none is set or the
+// handler has no filter service.
+func (h *NewsHandler) placeFilters(c *fiber.Ctx) *services.GeographicFilters {
+	if h.filterService == nil {
+		return nil
+	}
+
+	filters := &services.GeographicFilters{
+		States: splitParam(c.Query("state")),
+		Cities: splitParam(c.Query("city")),
+	}
+	if c.QueryBool("metro") {
+		metro := true
+		filters.MetropolitanOnly = &metro
+	}
+	if c.QueryBool("local") {
+		local := true
+		filters.LocalNewsOnly = &local
+	}
+
+	if len(filters.States) == 0 && len(filters.Cities) == 0 && filters.MetropolitanOnly == nil && filters.LocalNewsOnly == nil {
+		return nil
+	}
+	return filters
+}
+
+// filterByPlace keeps the articles tagged with the requested places. Articles are returned
+// unfiltered when filtering fails, so a feed never errors on its place filters.
+func (h *NewsHandler) filterByPlace(c *fiber.Ctx, articles []*models.Article, filters *services.GeographicFilters) []*models.Article {
+	if filters == nil || len(articles) == 0 {
+		return articles
+	}
+
+	response, err := h.filterService.FilterArticles(c.UserContext(), &services.FilterRequest{
+		Articles:          articles,
+		FilterTypes:       []services.FilterType{services.FilterTypeGeographic},
+		FilterCombination: services.FilterCombinationAND,
+		GeographicFilters: filters,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to filter feed by place", map[string]interface{}{
+			"states": filters.States,
+			"cities": filters.Cities,
+			"error":  err.Error(),
+		})
+		return articles
+	}
+	return response.FilteredArticles
+}
+
+// splitParam splits a comma-separated query parameter, dropping empty entries
+func splitParam(param string) []string {
+	var values []string
+	for _, value := range strings.Split(param, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// contentFilterOverfetch widens the candidate pool of feeds trimmed after the reader's content
+// filter, so hidden articles don't leave them short
+const contentFilterOverfetch = 2
+
+// filterFeed applies the reader's content filter to articles and trims them to limit, demoted
+// articles filling in after the rest. Handlers without a config only trim.
+func filterFeed(c *fiber.Ctx, cfg *config.Config, userRepo *repository.UserRepository, log *logger.Logger, articles []*models.Article, limit int) []*models.Article {
+	if cfg == nil {
+		return fillFeed(articles, nil, limit)
+	}
+	kept, demoted := services.SplitByContentFilter(articles, readerContentFilter(c, cfg, userRepo, log))
+	return fillFeed(kept, demoted, limit)
+}
+
+// fillFeed trims articles to limit, topping them up with fill articles when there are too few
+func fillFeed(articles, fill []*models.Article, limit int) []*models.Article {
+	if len(articles) >= limit {
+		return articles[:limit]
+	}
+	if len(fill) > limit-len(articles) {
+		fill = fill[:limit-len(articles)]
+	}
+	return append(articles, fill...)
+}
+
+// logImpressions records the articles a feed response shows, for learning-to-rank
+func (h *NewsHandler) logImpressions(c *fiber.Ctx, surface string, articles []models.Article) string {
+	if h.learningToRank == nil {
+		return ""
+	}
+
+	articleIDs := make([]int, len(articles))
+	for i, article := range articles {
+		articleIDs[i] = article.ID
+	}
+
+	// Feeds are not paged server-side, so positions always start at the top
+	subject := requestSubject(c, h.config.ExperimentDeviceHeader)
+	return h.learningToRank.LogImpressions(surface, subject, "", articleIDs, 0)
+}
+
+// experimentVariants returns the caller's experiment -> variant map for the response
+func experimentVariants(assignment *services.ExperimentAssignment) map[string]string {
+	if assignment == nil || len(assignment.Variants) == 0 {
+		return nil
+	}
+	return assignment.Variants
+}
+
 // ===============================
 // PHASE 1 FIX: Category ID Mapping
 // ===============================
@@ -89,8 +362,9 @@ func getCategoryIDFromName(categoryName string) int {
 // MAIN NEWS FEED ENDPOINTS (DATABASE-FIRST FIXED)
 // ===============================
 
-// GetNewsFeed returns the main news feed with database-first architecture
-// GET /api/v1/news
+// GetNewsFeed returns the main news feed with database-first architecture, in the reader's
+// languages and optionally narrowed to states, cities, metros or local news
+// GET /api/v1/news?lang=hi,en&state=MH&city=pune&metro=true&local=true
 func (h *NewsHandler) GetNewsFeed(c *fiber.Ctx) error {
 	startTime := time.Now()
 
@@ -122,8 +396,22 @@ func (h *NewsHandler) GetNewsFeed(c *fiber.Ctx) error {
 		req.Limit = 20
 	}
 
+	// Bucket the caller into running feed experiments (ranking weights, diversity, pool size)
+	assignment := h.experimentAssignment(c, models.FeedTypeNewsFeed)
+
+	// Grouping by story drops every article but one per story, so fetch a deeper pool
+	fetchLimit := h.candidateLimit(req.Limit, assignment)
+	groupByStory := h.groupsByStory(req.Group)
+	if groupByStory {
+		fetchLimit *= storyGroupOverfetch
+	}
+	places := h.placeFilters(c)
+	if places != nil {
+		fetchLimit *= placeFilterOverfetch
+	}
+
 	// Use the existing service method which implements database-first approach
-	articles, err := h.newsService.FetchLatestNews("top-stories", req.Limit)
+	articles, err := h.newsService.FetchLatestNewsInLanguages("top-stories", readerLanguages(c, h.userRepo, h.logger), fetchLimit)
 	if err != nil {
 		h.logger.Error("Failed to fetch news feed", map[string]interface{}{
 			"error": err.Error(),
@@ -139,6 +427,15 @@ func (h *NewsHandler) GetNewsFeed(c *fiber.Ctx) error {
 		})
 	}
 
+	articles = h.filterByPlace(c, articles, places)
+	if groupByStory {
+		articles = h.storyService.CollapseByStory(articles)
+	}
+
+	// Spread near-duplicate stories and single-source runs across the feed
+	articles, rankDebug := h.rerankForDiversity(c, articles, models.FeedTypeNewsFeed, req.Limit, assignment)
+	articles = h.markBreaking(articles)
+
 	// Convert []*models.Article to []models.Article for response
 	responseArticles := make([]models.Article, len(articles))
 	for i, article := range articles {
@@ -160,6 +457,9 @@ func (h *NewsHandler) GetNewsFeed(c *fiber.Ctx) error {
 			HasNext:    req.Page < (len(responseArticles)+req.Limit-1)/req.Limit,
 			HasPrev:    req.Page > 1,
 		},
+		RankDebug:    rankDebug,
+		Experiments:  experimentVariants(assignment),
+		ImpressionID: h.logImpressions(c, models.ImpressionSurfaceNewsFeed, responseArticles),
 	}
 
 	duration := time.Since(startTime)
@@ -173,8 +473,9 @@ func (h *NewsHandler) GetNewsFeed(c *fiber.Ctx) error {
 	return c.JSON(response)
 }
 
-// GetCategoryNews returns news for a specific category with database-first architecture
-// GET /api/v1/news/category/:category
+// GetCategoryNews returns news for a specific category with database-first architecture,
+// optionally narrowed to states, cities, metros or local news
+// GET /api/v1/news/category/:category?lang=hi,en&state=MH&city=pune&metro=true&local=true
 func (h *NewsHandler) GetCategoryNews(c *fiber.Ctx) error {
 	startTime := time.Now()
 	categoryID := c.Params("category")
@@ -206,8 +507,18 @@ func (h *NewsHandler) GetCategoryNews(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	fetchLimit := limit
+	groupByStory := h.groupsByStory(c.Query("group"))
+	if groupByStory {
+		fetchLimit *= storyGroupOverfetch
+	}
+	places := h.placeFilters(c)
+	if places != nil {
+		fetchLimit *= placeFilterOverfetch
+	}
+
 	// Use service method for category news with database-first approach
-	articles, err := h.newsService.FetchNewsByCategory(categoryName, limit)
+	articles, err := h.newsService.FetchLatestNewsInLanguages(categoryName, readerLanguages(c, h.userRepo, h.logger), fetchLimit)
 	if err != nil {
 		h.logger.Error("Failed to fetch category news", map[string]interface{}{
 			"category": categoryName,
@@ -224,6 +535,12 @@ func (h *NewsHandler) GetCategoryNews(c *fiber.Ctx) error {
 		})
 	}
 
+	articles = h.filterByPlace(c, articles, places)
+	if groupByStory {
+		articles = h.storyService.CollapseByStory(articles)
+	}
+	articles = h.markBreaking(h.filterContent(c, articles, limit))
+
 	// Convert []*models.Article to []models.Article
 	responseArticles := make([]models.Article, len(articles))
 	for i, article := range articles {
@@ -283,8 +600,8 @@ func (h *NewsHandler) buildCategoryResponse(c *fiber.Ctx, articles []models.Arti
 // NEWS SEARCH ENDPOINTS (SERVICE-BACKED IMPLEMENTATION)
 // ===============================
 
-// SearchNews searches for news articles with service-backed search
-// GET /api/v1/news/search
+// SearchNews searches for news articles in the reader's languages with service-backed search
+// GET /api/v1/news/search?q=...&lang=hi,en
 func (h *NewsHandler) SearchNews(c *fiber.Ctx) error {
 	startTime := time.Now()
 
@@ -316,7 +633,7 @@ func (h *NewsHandler) SearchNews(c *fiber.Ctx) error {
 	}
 
 	// Use service search method
-	articles, err := h.newsService.SearchNews(req.Query, "", req.Limit*2)
+	articles, err := h.newsService.SearchNews(req.Query, "", readerLanguages(c, h.userRepo, h.logger), req.Limit*2)
 	if err != nil {
 		h.logger.Error("Search failed", map[string]interface{}{
 			"query": req.Query,
@@ -335,6 +652,8 @@ func (h *NewsHandler) SearchNews(c *fiber.Ctx) error {
 		})
 	}
 
+	articles = h.filterContent(c, articles, len(articles))
+
 	// Convert []*models.Article to []models.Article
 	responseArticles := make([]models.Article, len(articles))
 	for i, article := range articles {
@@ -416,6 +735,7 @@ func (h *NewsHandler) GetTrendingNews(c *fiber.Ctx) error {
 			},
 		})
 	}
+	articles = h.markBreaking(h.filterContent(c, articles, limit))
 
 	// Convert []*models.Article to []models.Article
 	responseArticles := make([]models.Article, len(articles))
@@ -468,6 +788,97 @@ func (h *NewsHandler) filterIndianContent(articles []models.Article) []models.Ar
 	return indianArticles
 }
 
+// readerLanguages returns the languages to serve news in: the lang parameter ("hi,en"), else the
+// signed-in reader's preferred and regional languages. Nil leaves the choice to the caller; feeds
+// are served in English.
+func readerLanguages(c *fiber.Ctx, userRepo *repository.UserRepository, log *logger.Logger) []string {
+	if param := c.Query("lang"); param != "" {
+		if languages := lang.NormalizeAll(strings.Split(param, ",")); len(languages) > 0 {
+			return languages
+		}
+	}
+
+	preferences := readerPreferences(c, userRepo, log)
+	if preferences == nil {
+		return nil
+	}
+	return lang.NormalizeAll(preferences.Languages())
+}
+
+// readerContentFilter returns the content filter policy of the reader's filter level
+func readerContentFilter(c *fiber.Ctx, cfg *config.Config, userRepo *repository.UserRepository, log *logger.Logger) models.ContentFilterPolicy {
+	return services.ContentFilterPolicyFor(cfg, readerFilterLevel(c, userRepo, log))
+}
+
+// readerFilterLevel returns the signed-in reader's content filter level, else the moderate default
+func readerFilterLevel(c *fiber.Ctx, userRepo *repository.UserRepository, log *logger.Logger) string {
+	if preferences := readerPreferences(c, userRepo, log); preferences != nil && preferences.ContentFilterLevel != "" {
+		return preferences.ContentFilterLevel
+	}
+	return models.ContentFilterModerate
+}
+
+// readerPreferences loads the signed-in reader's preferences once per request. Nil means the
+// reader is anonymous or their profile could not be loaded.
+func readerPreferences(c *fiber.Ctx, userRepo *repository.UserRepository, log *logger.Logger) *models.UserPreferences {
+	if preferences, ok := c.Locals("reader_preferences").(*models.UserPreferences); ok {
+		return preferences
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok || userRepo == nil {
+		return nil
+	}
+	user, err := userRepo.GetUserByID(userID)
+	if err != nil {
+		log.Warn("Failed to load reader preferences", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return nil
+	}
+	c.Locals("reader_preferences", &user.Preferences)
+	return &user.Preferences
+}
+
+// GetArticle returns one article with the other outlets that reported it
+// GET /api/v1/news/article/:id
+func (h *NewsHandler) GetArticle(c *fiber.Ctx) error {
+	articleID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || articleID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric article ID is required",
+		})
+	}
+
+	if h.articleRepo == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+			Message: "Article storage is not available",
+		})
+	}
+
+	article, err := h.articleRepo.GetArticleByID(articleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrArticleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Article not found",
+			})
+		}
+		h.logger.Error("Failed to load article", map[string]interface{}{
+			"article_id": articleID,
+			"error":      err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load article",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Article retrieved successfully",
+		Data:    article,
+	})
+}
+
 // ===============================
 // ADMIN & MANAGEMENT ENDPOINTS (FULLY IMPLEMENTED)
 // ===============================
@@ -729,17 +1140,29 @@ func (h *NewsHandler) GetUserBookmarks(c *fiber.Ctx) error {
 		limit = 20
 	}
 
-	// For now, return empty bookmarks since database integration would be needed
 	bookmarks := []models.Article{}
+	total := 0
+	if h.engagementRepo != nil && h.articleRepo != nil {
+		ids, count, err := h.engagementRepo.GetBookmarkedArticleIDs(userID, limit, (page-1)*limit)
+		if err != nil {
+			h.logger.Error("Failed to load bookmarks", map[string]interface{}{
+				"user_id": userID.String(),
+				"error":   err.Error(),
+			})
+		} else {
+			bookmarks = h.loadArticlesInOrder(ids, true)
+			total = count
+		}
+	}
 
 	response := &models.NewsFeedResponse{
 		Articles: bookmarks,
 		Pagination: models.PaginationResponse{
 			Page:       page,
 			Limit:      limit,
-			Total:      len(bookmarks),
-			TotalPages: (len(bookmarks) + limit - 1) / limit,
-			HasNext:    false,
+			Total:      total,
+			TotalPages: (total + limit - 1) / limit,
+			HasNext:    page < (total+limit-1)/limit,
 			HasPrev:    page > 1,
 		},
 	}
@@ -842,6 +1265,36 @@ func (h *NewsHandler) AddBookmark(c *fiber.Ctx) error {
 		})
 	}
 
+	bookmarkedAt := time.Now()
+	if h.engagementRepo != nil {
+		articleID, err := strconv.Atoi(req.ArticleID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: "Article ID must be numeric",
+			})
+		}
+
+		var notes *string
+		if req.Notes != "" {
+			notes = &req.Notes
+		}
+
+		bookmark, err := h.engagementRepo.AddBookmark(userID, articleID, notes)
+		if err != nil {
+			h.logger.Error("Failed to add bookmark", map[string]interface{}{
+				"user_id":    userID.String(),
+				"article_id": req.ArticleID,
+				"error":      err.Error(),
+			})
+			return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+				Message: "Failed to bookmark article",
+			})
+		}
+		bookmarkedAt = bookmark.BookmarkedAt
+	}
+
+	h.recordExperimentOutcome(c, models.ExperimentEventBookmark, req.ArticleID, 0, 0)
+
 	duration := time.Since(startTime)
 	h.logger.Info("Bookmark added", map[string]interface{}{
 		"user_id":    userID.String(),
@@ -853,7 +1306,7 @@ func (h *NewsHandler) AddBookmark(c *fiber.Ctx) error {
 		Message: "Article bookmarked successfully",
 		Data: map[string]interface{}{
 			"article_id":    req.ArticleID,
-			"bookmarked_at": time.Now().Format(time.RFC3339),
+			"bookmarked_at": bookmarkedAt.Format(time.RFC3339),
 		},
 	})
 }
@@ -877,6 +1330,31 @@ func (h *NewsHandler) RemoveBookmark(c *fiber.Ctx) error {
 		})
 	}
 
+	if h.engagementRepo != nil {
+		id, err := strconv.Atoi(articleID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: "Article ID must be numeric",
+			})
+		}
+
+		if err := h.engagementRepo.RemoveBookmark(userID, id); err != nil {
+			if errors.Is(err, repository.ErrBookmarkNotFound) {
+				return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+					Message: "Bookmark not found",
+				})
+			}
+			h.logger.Error("Failed to remove bookmark", map[string]interface{}{
+				"user_id":    userID.String(),
+				"article_id": articleID,
+				"error":      err.Error(),
+			})
+			return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+				Message: "Failed to remove bookmark",
+			})
+		}
+	}
+
 	duration := time.Since(startTime)
 	h.logger.Info("Bookmark removed", map[string]interface{}{
 		"user_id":    userID.String(),
@@ -924,17 +1402,30 @@ func (h *NewsHandler) GetReadingHistory(c *fiber.Ctx) error {
 		days = 30
 	}
 
-	// For now, return empty history since database integration would be needed
 	history := []models.Article{}
+	total := 0
+	if h.engagementRepo != nil && h.articleRepo != nil {
+		since := time.Now().AddDate(0, 0, -days)
+		ids, count, err := h.engagementRepo.GetReadArticleIDs(userID, since, limit, (page-1)*limit)
+		if err != nil {
+			h.logger.Error("Failed to load reading history", map[string]interface{}{
+				"user_id": userID.String(),
+				"error":   err.Error(),
+			})
+		} else {
+			history = h.loadArticlesInOrder(ids, false)
+			total = count
+		}
+	}
 
 	response := &models.NewsFeedResponse{
 		Articles: history,
 		Pagination: models.PaginationResponse{
 			Page:       page,
 			Limit:      limit,
-			Total:      len(history),
-			TotalPages: (len(history) + limit - 1) / limit,
-			HasNext:    false,
+			Total:      total,
+			TotalPages: (total + limit - 1) / limit,
+			HasNext:    page < (total+limit-1)/limit,
 			HasPrev:    page > 1,
 		},
 	}
@@ -985,6 +1476,7 @@ func (h *NewsHandler) TrackArticleRead(c *fiber.Ctx) error {
 			"user_id":       userID.String(),
 			"authenticated": true,
 		}
+		h.recordRead(userID, req.ArticleID, req.ReadTime, req.ScrollDepth)
 	} else {
 		logMessage = "Article read tracked anonymously"
 		userData = map[string]interface{}{
@@ -993,6 +1485,21 @@ func (h *NewsHandler) TrackArticleRead(c *fiber.Ctx) error {
 		}
 	}
 
+	// Experiment outcome (CTR + dwell time) for both signed-in users and anonymous devices
+	h.recordExperimentOutcome(c, models.ExperimentEventRead, req.ArticleID, req.ReadTime, req.ScrollDepth)
+
+	if articleID, err := strconv.Atoi(req.ArticleID); err == nil {
+		// Join the read back onto the impression that led to it (learning-to-rank clicks)
+		if h.learningToRank != nil {
+			h.learningToRank.RecordRead(requestSubject(c, h.config.ExperimentDeviceHeader), articleID, req.ReadTime)
+		}
+
+		// Reads from signed-in and anonymous readers alike drive trending velocity
+		if h.trendingService != nil {
+			h.trendingService.RecordRead(articleID)
+		}
+	}
+
 	duration := time.Since(startTime)
 	h.logger.Info(logMessage, map[string]interface{}{
 		"article_id":   req.ArticleID,
@@ -1011,6 +1518,62 @@ func (h *NewsHandler) TrackArticleRead(c *fiber.Ctx) error {
 	})
 }
 
+// recordRead persists an authenticated read so it feeds history and recommendations
+func (h *NewsHandler) recordRead(userID uuid.UUID, rawArticleID string, readTime, scrollDepth int) {
+	if h.engagementRepo == nil {
+		return
+	}
+
+	articleID, err := strconv.Atoi(rawArticleID)
+	if err != nil {
+		h.logger.Warn("Skipping read persistence for non-numeric article ID", map[string]interface{}{
+			"article_id": rawArticleID,
+		})
+		return
+	}
+
+	history := &models.ReadingHistory{
+		UserID:                 userID,
+		ArticleID:              articleID,
+		ReadAt:                 time.Now(),
+		ReadingDurationSeconds: readTime,
+		ScrollPercentage:       float64(scrollDepth),
+		Completed:              scrollDepth >= 90,
+		ReadDuringMarketHours:  models.IsMarketHours(),
+		ReadDuringIPLTime:      models.IsIPLTime(),
+	}
+
+	if err := h.engagementRepo.RecordRead(history); err != nil {
+		h.logger.Error("Failed to persist article read", map[string]interface{}{
+			"user_id":    userID.String(),
+			"article_id": articleID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// loadArticlesInOrder loads articles for IDs, keeping the given order
+func (h *NewsHandler) loadArticlesInOrder(ids []int, bookmarked bool) []models.Article {
+	articles, err := h.articleRepo.GetArticlesByIDsInOrder(ids)
+	if err != nil {
+		h.logger.Error("Failed to load articles by IDs", map[string]interface{}{
+			"count": len(ids),
+			"error": err.Error(),
+		})
+		return []models.Article{}
+	}
+
+	responseArticles := make([]models.Article, len(articles))
+	for i, article := range articles {
+		responseArticles[i] = *article
+		if bookmarked {
+			isBookmarked := true
+			responseArticles[i].IsBookmarked = &isBookmarked
+		}
+	}
+	return responseArticles
+}
+
 // ===============================
 // PERSONALIZED FEED ENDPOINTS (SERVICE IMPLEMENTATION)
 // ===============================
@@ -1038,7 +1601,9 @@ func (h *NewsHandler) GetPersonalizedFeed(c *fiber.Ctx) error {
 	}
 
 	// For personalization, use service to get general articles and prioritize Indian content
-	articles, err := h.newsService.FetchLatestNews("general", limit)
+	assignment := h.experimentAssignment(c, models.FeedTypePersonalized)
+
+	articles, err := h.newsService.FetchLatestNewsInLanguages("general", readerLanguages(c, h.userRepo, h.logger), h.candidateLimit(limit, assignment))
 	if err != nil {
 		h.logger.Error("Failed to get personalized feed", map[string]interface{}{
 			"user_id": userID.String(),
@@ -1049,23 +1614,28 @@ func (h *NewsHandler) GetPersonalizedFeed(c *fiber.Ctx) error {
 		return h.GetIndiaFocusedFeed(c)
 	}
 
-	// Convert and apply basic personalization (prioritize Indian content)
-	responseArticles := make([]models.Article, 0, len(articles))
+	// Apply basic personalization (prioritize Indian content, then global articles)
+	prioritized := make([]*models.Article, 0, len(articles))
 	for _, article := range articles {
 		if article.IsIndianContent {
-			responseArticles = append(responseArticles, *article)
+			prioritized = append(prioritized, article)
 		}
 	}
-
-	// Add global articles if we need more content
-	if len(responseArticles) < limit {
-		for _, article := range articles {
-			if !article.IsIndianContent && len(responseArticles) < limit {
-				responseArticles = append(responseArticles, *article)
-			}
+	for _, article := range articles {
+		if !article.IsIndianContent {
+			prioritized = append(prioritized, article)
 		}
 	}
 
+	// Re-rank the prioritized candidates for diversity and trim to the page size
+	prioritized, rankDebug := h.rerankForDiversity(c, prioritized, models.FeedTypePersonalized, limit, assignment)
+	prioritized = h.markBreaking(prioritized)
+
+	responseArticles := make([]models.Article, len(prioritized))
+	for i, article := range prioritized {
+		responseArticles[i] = *article
+	}
+
 	response := &models.NewsFeedResponse{
 		Articles: responseArticles,
 		Pagination: models.PaginationResponse{
@@ -1076,6 +1646,9 @@ func (h *NewsHandler) GetPersonalizedFeed(c *fiber.Ctx) error {
 			HasNext:    false,
 			HasPrev:    page > 1,
 		},
+		RankDebug:    rankDebug,
+		Experiments:  experimentVariants(assignment),
+		ImpressionID: h.logImpressions(c, models.ImpressionSurfacePersonalized, responseArticles),
 	}
 
 	duration := time.Since(startTime)
@@ -1105,7 +1678,7 @@ func (h *NewsHandler) GetIndiaFocusedFeed(c *fiber.Ctx) error {
 	}
 
 	// Get general articles and filter for Indian content
-	articles, err := h.newsService.FetchLatestNews("general", limit*2)
+	articles, err := h.newsService.FetchLatestNewsInLanguages("general", readerLanguages(c, h.userRepo, h.logger), limit*2)
 	if err != nil {
 		return c.JSON(&models.NewsFeedResponse{
 			Articles: []models.Article{},
@@ -1118,7 +1691,7 @@ func (h *NewsHandler) GetIndiaFocusedFeed(c *fiber.Ctx) error {
 
 	// Filter for Indian content
 	responseArticles := make([]models.Article, 0, limit)
-	for _, article := range articles {
+	for _, article := range h.markBreaking(h.filterContent(c, articles, len(articles))) {
 		if article.IsIndianContent && len(responseArticles) < limit {
 			responseArticles = append(responseArticles, *article)
 		}
@@ -1589,3 +2162,17 @@ func strPtr(s string) *string {
 func intPtr(i int) *int {
 	return &i
 }
+
+// requestSubject identifies the caller for experiment bucketing and impression logging: the signed-in
+// user, else the anonymous device header; an empty subject opts out of both
+func requestSubject(c *fiber.Ctx, deviceHeader string) services.ExperimentSubject {
+	if userID, exists := middleware.GetUserIDFromContext(c); exists {
+		return services.NewUserSubject(userID)
+	}
+
+	deviceID := strings.TrimSpace(c.Get(deviceHeader))
+	if deviceID == "" || len(deviceID) > 128 {
+		return services.ExperimentSubject{}
+	}
+	return services.NewDeviceSubject(deviceID)
+}
@@ -0,0 +1,133 @@
+// internal/handlers/notification.go
+// GoNews Notification Handler - The signed-in reader's in-app notification inbox
+
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// NotificationHandler serves readers' notification inboxes
+type NotificationHandler struct {
+	notifications *services.NotificationService
+	logger        *logger.Logger
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notifications *services.NotificationService, logger *logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// GetNotifications lists the reader's notifications, newest first, with the unread count
+// GET /api/v1/notifications?page=1&limit=20&unread=true
+func (h *NotificationHandler) GetNotifications(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Message: "Authentication required",
+		})
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	inbox, err := h.notifications.Inbox(userID, c.QueryBool("unread", false), page, limit)
+	if err != nil {
+		h.logger.Error("Failed to load notifications", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load notifications",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Notifications retrieved successfully",
+		Data:    inbox,
+	})
+}
+
+// MarkRead marks one notification as read
+// POST /api/v1/notifications/:id/read
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Message: "Authentication required",
+		})
+	}
+
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric notification ID is required",
+		})
+	}
+
+	if err := h.notifications.MarkRead(userID, id); err != nil {
+		if errors.Is(err, repository.ErrNotificationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Notification not found",
+			})
+		}
+		h.logger.Error("Failed to mark notification as read", map[string]interface{}{
+			"notification_id": id,
+			"error":           err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to mark notification as read",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Notification marked as read",
+	})
+}
+
+// MarkAllRead marks all of the reader's notifications as read
+// POST /api/v1/notifications/read-all
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Message: "Authentication required",
+		})
+	}
+
+	updated, err := h.notifications.MarkAllRead(userID)
+	if err != nil {
+		h.logger.Error("Failed to mark notifications as read", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to mark notifications as read",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Notifications marked as read",
+		Data: fiber.Map{
+			"updated": updated,
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// internal/handlers/ranking.go
+// GoNews Ranking Handler - Admin views of the unified ranking pipeline and per-article score breakdowns
+
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// RankingHandler exposes the ranking pipeline to admins and editors
+type RankingHandler struct {
+	ranking     *services.RankingPipeline
+	articleRepo *repository.ArticleRepository
+	logger      *logger.Logger
+}
+
+// NewRankingHandler creates a new ranking handler
+func NewRankingHandler(ranking *services.RankingPipeline, articleRepo *repository.ArticleRepository, logger *logger.Logger) *RankingHandler {
+	return &RankingHandler{
+		ranking:     ranking,
+		articleRepo: articleRepo,
+		logger:      logger,
+	}
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// GetRankingFeatures returns the registered feature extractors and their weights
+// GET /api/v1/news/admin/ranking/features
+func (h *RankingHandler) GetRankingFeatures(c *fiber.Ctx) error {
+	return c.JSON(models.SuccessResponse{
+		Message: "Ranking features retrieved successfully",
+		Data:    h.ranking.Features(),
+	})
+}
+
+// ExplainRanking ranks recent articles and returns each article's score breakdown
+// GET /api/v1/news/admin/ranking/explain?category=politics&limit=20
+func (h *RankingHandler) ExplainRanking(c *fiber.Ctx) error {
+	category := c.Query("category", "")
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	articles, err := h.articleRepo.GetRecentArticles(category, limit)
+	if err != nil {
+		h.logger.Error("Failed to load articles for ranking explanation", map[string]interface{}{
+			"category": category,
+			"error":    err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load articles",
+		})
+	}
+
+	now := time.Now()
+	_, breakdowns := h.ranking.Rank(articles, &services.RankingContext{Now: now, Category: category})
+
+	response := &models.RankingExplainResponse{
+		Category:   category,
+		Features:   h.ranking.Features(),
+		Breakdowns: make([]models.ScoreBreakdown, len(breakdowns)),
+		ScoredAt:   now,
+	}
+	for i, breakdown := range breakdowns {
+		response.Breakdowns[i] = *breakdown
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Ranking explanation generated successfully",
+		Data:    response,
+	})
+}
+
+// ExplainArticle returns the score breakdown for a single article
+// GET /api/v1/news/admin/ranking/articles/:id
+func (h *RankingHandler) ExplainArticle(c *fiber.Ctx) error {
+	articleID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || articleID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric article ID is required",
+		})
+	}
+
+	article, err := h.articleRepo.GetArticleByID(articleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrArticleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Article not found",
+			})
+		}
+		h.logger.Error("Failed to load article for ranking explanation", map[string]interface{}{
+			"article_id": articleID,
+			"error":      err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load article",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Article score breakdown generated successfully",
+		Data:    h.ranking.Explain(article, &services.RankingContext{Category: c.Query("category", "")}),
+	})
+}
+
+// RescoreArticles recomputes and stores relevance scores for recent articles with the current weights
+// POST /api/v1/news/admin/ranking/rescore?category=&limit=500
+func (h *RankingHandler) RescoreArticles(c *fiber.Ctx) error {
+	startTime := time.Now()
+
+	category := c.Query("category", "")
+	limit := c.QueryInt("limit", 500)
+	if limit < 1 || limit > 5000 {
+		limit = 500
+	}
+
+	articles, err := h.articleRepo.GetRecentArticles(category, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load articles: " + err.Error(),
+		})
+	}
+
+	result := &models.RankingRescoreResult{
+		Category:      category,
+		ArticlesCount: len(articles),
+	}
+
+	rctx := &services.RankingContext{Now: startTime, Category: category}
+	for _, article := range articles {
+		result.AverageBefore += article.RelevanceScore
+		article.RelevanceScore = h.ranking.Score(article, rctx)
+		result.AverageAfter += article.RelevanceScore
+	}
+	if len(articles) > 0 {
+		result.AverageBefore /= float64(len(articles))
+		result.AverageAfter /= float64(len(articles))
+	}
+
+	if err := h.articleRepo.BulkUpdateRelevanceScores(articles); err != nil {
+		h.logger.Error("Failed to store rescored relevance", map[string]interface{}{
+			"count": len(articles),
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to store relevance scores: " + err.Error(),
+		})
+	}
+
+	result.DurationMs = time.Since(startTime).Milliseconds()
+
+	h.logger.Info("Relevance scores recomputed", map[string]interface{}{
+		"category":       category,
+		"articles_count": result.ArticlesCount,
+		"average_before": result.AverageBefore,
+		"average_after":  result.AverageAfter,
+	})
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Relevance scores recomputed successfully",
+		Data:    result,
+	})
+}
@@ -0,0 +1,196 @@
+// internal/handlers/recommendation.go
+// GoNews Recommendation Handler - "Recommended for you" and "People also read" endpoints
+
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/config"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// RecommendationHandler handles recommendation API endpoints
+type RecommendationHandler struct {
+	recommendationService *services.RecommendationService
+	logger                *logger.Logger
+
+	// Optional readers' content filter levels
+	config   *config.Config
+	userRepo *repository.UserRepository
+}
+
+// NewRecommendationHandler creates a new recommendation handler
+func NewRecommendationHandler(recommendationService *services.RecommendationService, logger *logger.Logger) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		logger:                logger,
+	}
+}
+
+// SetContentFilter applies readers' content filter levels to the recommended feed
+func (h *RecommendationHandler) SetContentFilter(cfg *config.Config, userRepo *repository.UserRepository) {
+	h.config = cfg
+	h.userRepo = userRepo
+}
+
+// ===============================
+// PUBLIC / OPTIONAL-AUTH ENDPOINTS
+// ===============================
+
+// GetRecommendedFeed returns the "Recommended for you" feed (trending fallback for anonymous/cold-start users)
+// GET /api/v1/news/recommended
+func (h *RecommendationHandler) GetRecommendedFeed(c *fiber.Ctx) error {
+	startTime := time.Now()
+
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+
+	fetchLimit := limit
+	if h.config != nil {
+		fetchLimit *= contentFilterOverfetch
+	}
+
+	userID, isAuth := middleware.GetUserIDFromContext(c)
+
+	var result *services.RecommendationResult
+	var err error
+	if isAuth {
+		result, err = h.recommendationService.GetRecommendationsForUser(userID, fetchLimit)
+	} else {
+		result, err = h.recommendationService.GetTrendingFallback(fetchLimit)
+	}
+	if err != nil {
+		h.logger.Error("Failed to build recommendations", map[string]interface{}{
+			"authenticated": isAuth,
+			"error":         err.Error(),
+		})
+
+		// Graceful fallback: Return empty results instead of 500
+		result = &services.RecommendationResult{
+			Articles: []*models.Article{},
+			Source:   models.RecommendationSourceTrending,
+		}
+	}
+
+	articles := filterFeed(c, h.config, h.userRepo, h.logger, result.Articles, limit)
+	responseArticles := make([]models.Article, len(articles))
+	for i, article := range articles {
+		responseArticles[i] = *article
+	}
+
+	response := &models.RecommendationResponse{
+		Articles: responseArticles,
+		Pagination: models.PaginationResponse{
+			Page:       1,
+			Limit:      limit,
+			Total:      len(responseArticles),
+			TotalPages: 1,
+			HasNext:    false,
+			HasPrev:    false,
+		},
+		Source:       result.Source,
+		SeedArticles: result.SeedArticles,
+		GeneratedAt:  time.Now(),
+	}
+
+	logData := map[string]interface{}{
+		"articles_count": len(responseArticles),
+		"source":         result.Source,
+		"seed_articles":  result.SeedArticles,
+		"duration":       time.Since(startTime).String(),
+	}
+	if isAuth {
+		logData["user_id"] = userID.String()
+	}
+	h.logger.Info("Recommended feed served", logData)
+
+	return c.JSON(response)
+}
+
+// GetAlsoRead returns articles that readers of the given article also read
+// GET /api/v1/news/also-read/:id
+func (h *RecommendationHandler) GetAlsoRead(c *fiber.Ctx) error {
+	articleID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || articleID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric article ID is required",
+		})
+	}
+
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 || limit > 20 {
+		limit = 10
+	}
+
+	articles, err := h.recommendationService.GetAlsoRead(articleID, limit)
+	if err != nil {
+		h.logger.Error("Failed to get also-read articles", map[string]interface{}{
+			"article_id": articleID,
+			"error":      err.Error(),
+		})
+		articles = []*models.Article{}
+	}
+
+	responseArticles := make([]models.Article, len(articles))
+	for i, article := range articles {
+		responseArticles[i] = *article
+	}
+
+	return c.JSON(&models.NewsFeedResponse{
+		Articles: responseArticles,
+		Pagination: models.PaginationResponse{
+			Page:       1,
+			Limit:      limit,
+			Total:      len(responseArticles),
+			TotalPages: 1,
+			HasNext:    false,
+			HasPrev:    false,
+		},
+	})
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// RecomputeRecommendations triggers the offline neighbour computation immediately
+// POST /api/v1/news/admin/recommendations/recompute
+func (h *RecommendationHandler) RecomputeRecommendations(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	result, err := h.recommendationService.RecomputeNeighbors(ctx)
+	if err != nil {
+		h.logger.Error("Manual recommendation recompute failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Recommendation recompute failed: " + err.Error(),
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Recommendation neighbours recomputed successfully",
+		Data:    result,
+	})
+}
+
+// GetRecommendationStats returns recommendation job and neighbour table statistics
+// GET /api/v1/news/admin/recommendations/stats
+func (h *RecommendationHandler) GetRecommendationStats(c *fiber.Ctx) error {
+	return c.JSON(models.SuccessResponse{
+		Message: "Recommendation statistics retrieved successfully",
+		Data:    h.recommendationService.GetStats(),
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"backend/internal/models"
 	"backend/internal/repository"
 	"backend/internal/services"
+	"backend/pkg/lang"
 	"backend/pkg/logger"
 )
 
@@ -22,6 +23,13 @@ import (
 type SearchHandler struct {
 	searchService *services.SearchService
 	logger        *logger.Logger
+
+	// Optional impression logging for learning-to-rank
+	learningToRank *services.LearningToRankService
+	deviceHeader   string
+
+	// Optional profile lookup, so signed-in readers search their languages by default
+	userRepo *repository.UserRepository
 }
 
 // NewSearchHandler creates a new search handler
@@ -32,6 +40,17 @@ func NewSearchHandler(searchService *services.SearchService, logger *logger.Logg
 	}
 }
 
+// SetLearningToRank enables impression logging; deviceHeader identifies anonymous callers
+func (h *SearchHandler) SetLearningToRank(learningToRank *services.LearningToRankService, deviceHeader string) {
+	h.learningToRank = learningToRank
+	h.deviceHeader = deviceHeader
+}
+
+// SetUserRepository limits signed-in readers' searches to their preferred languages
+func (h *SearchHandler) SetUserRepository(userRepo *repository.UserRepository) {
+	h.userRepo = userRepo
+}
+
 // ===============================
 // MAIN SEARCH ENDPOINTS
 // ===============================
@@ -69,6 +88,13 @@ func (h *SearchHandler) SearchArticles(c *fiber.Ctx) error {
 		searchReq.UserID = &userID
 	}
 
+	// Search the requested languages, else the reader's; nobody's means every language
+	if languages := lang.NormalizeAll(searchReq.Languages); len(languages) > 0 {
+		searchReq.Languages = languages
+	} else {
+		searchReq.Languages = readerLanguages(c, h.userRepo, h.logger)
+	}
+
 	// Generate session ID for tracking
 	sessionID := h.generateSessionID()
 	searchReq.SessionID = &sessionID
@@ -82,6 +108,7 @@ func (h *SearchHandler) SearchArticles(c *fiber.Ctx) error {
 
 	// Convert to service request
 	serviceReq := h.convertToServiceRequest(searchReq)
+	serviceReq.ContentFilterLevel = readerFilterLevel(c, h.userRepo, h.logger)
 
 	// Execute search
 	response, err := h.searchService.Search(c.Context(), serviceReq)
@@ -99,6 +126,7 @@ func (h *SearchHandler) SearchArticles(c *fiber.Ctx) error {
 
 	// Convert to API response
 	apiResponse := h.convertToAPIResponse(response, searchReq)
+	apiResponse.ImpressionID = h.logImpressions(c, searchReq, apiResponse.Results)
 
 	h.logger.Info("Search completed", map[string]interface{}{
 		"query":         searchReq.Query,
@@ -608,6 +636,7 @@ func (h *SearchHandler) convertToServiceRequest(apiReq *models.SearchRequest) *s
 			Sources:           apiReq.Sources,
 			Authors:           apiReq.Authors,
 			Tags:              apiReq.Tags,
+			Languages:         apiReq.Languages,
 			IsIndianContent:   apiReq.IsIndianContent,
 			IsFeatured:        apiReq.IsFeatured,
 			MinRelevanceScore: apiReq.MinRelevanceScore,
@@ -715,6 +744,24 @@ func (h *SearchHandler) convertSearchMetrics(metrics *repository.SearchMetrics)
 	}
 }
 
+// logImpressions records the result positions shown for a query, for learning-to-rank
+func (h *SearchHandler) logImpressions(c *fiber.Ctx, searchReq *models.SearchRequest, results []*models.SearchResultDTO) string {
+	if h.learningToRank == nil {
+		return ""
+	}
+
+	articleIDs := make([]int, 0, len(results))
+	for _, result := range results {
+		if result.Article != nil {
+			articleIDs = append(articleIDs, result.Article.ID)
+		}
+	}
+
+	offset := (searchReq.Page - 1) * searchReq.Limit
+	subject := requestSubject(c, h.deviceHeader)
+	return h.learningToRank.LogImpressions(models.ImpressionSurfaceSearch, subject, searchReq.Query, articleIDs, offset)
+}
+
 // extractUserID extracts user ID from JWT token
 func (h *SearchHandler) extractUserID(c *fiber.Ctx) string {
 	userID := c.Locals("user_id")
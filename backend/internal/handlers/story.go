@@ -0,0 +1,191 @@
+// internal/handlers/story.go
+// GoNews Story Handler - "Full coverage" story pages: every article about one event as a timeline
+
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// storyGroupOverfetch multiplies the candidate pool of feeds grouped by story, since
+// collapsing keeps one article per story
+const storyGroupOverfetch = 3
+
+// StoryHandler serves story clusters and their timelines
+type StoryHandler struct {
+	storyService *services.StoryService
+	logger       *logger.Logger
+
+	// Optional readers' content filter levels
+	config   *config.Config
+	userRepo *repository.UserRepository
+}
+
+// NewStoryHandler creates a new story handler
+func NewStoryHandler(storyService *services.StoryService, logger *logger.Logger) *StoryHandler {
+	return &StoryHandler{
+		storyService: storyService,
+		logger:       logger,
+	}
+}
+
+// SetContentFilter applies readers' content filter levels to story lists and timelines
+func (h *StoryHandler) SetContentFilter(cfg *config.Config, userRepo *repository.UserRepository) {
+	h.config = cfg
+	h.userRepo = userRepo
+}
+
+// ===============================
+// PUBLIC ENDPOINTS
+// ===============================
+
+// GetStories lists recently updated stories with their lead articles
+// GET /api/v1/news/stories?page=1&limit=20&category_id=3&min_articles=2
+func (h *StoryHandler) GetStories(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	minArticles := c.QueryInt("min_articles", 2)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	if minArticles < 1 {
+		minArticles = 1
+	}
+
+	var categoryID *int
+	if raw := c.Query("category_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Message: "category_id must be a positive number",
+			})
+		}
+		categoryID = &id
+	}
+
+	stories, err := h.storyService.ListStories(categoryID, minArticles, limit, (page-1)*limit)
+	if err != nil {
+		h.logger.Error("Failed to list stories", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load stories",
+		})
+	}
+
+	if h.config != nil {
+		stories = filterStories(stories, readerContentFilter(c, h.config, h.userRepo, h.logger))
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Stories retrieved successfully",
+		Data: fiber.Map{
+			"stories": stories,
+			"page":    page,
+			"limit":   limit,
+		},
+	})
+}
+
+// GetStory returns a story and its articles ordered as a timeline, oldest first
+// GET /api/v1/news/stories/:id
+func (h *StoryHandler) GetStory(c *fiber.Ctx) error {
+	storyID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || storyID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric story ID is required",
+		})
+	}
+
+	timeline, err := h.storyService.GetTimeline(storyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrStoryNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Story not found",
+			})
+		}
+		h.logger.Error("Failed to load story timeline", map[string]interface{}{
+			"story_id": storyID,
+			"error":    err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load story",
+		})
+	}
+
+	// The timeline keeps its chronological order, so demoted articles stay in place
+	if h.config != nil {
+		policy := readerContentFilter(c, h.config, h.userRepo, h.logger)
+		visible := make([]*models.Article, 0, len(timeline.Articles))
+		for _, article := range timeline.Articles {
+			if !policy.Hides(article) {
+				visible = append(visible, article)
+			}
+		}
+		timeline.Articles = visible
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Story retrieved successfully",
+		Data:    timeline,
+	})
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// ClusterNow runs a clustering pass immediately instead of waiting for the worker
+// POST /api/v1/news/admin/stories/cluster
+func (h *StoryHandler) ClusterNow(c *fiber.Ctx) error {
+	run, err := h.storyService.ClusterPending()
+	if err != nil {
+		h.logger.Error("Manual story clustering failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to cluster stories",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Story clustering completed",
+		Data:    run,
+	})
+}
+
+// filterStories drops the stories whose lead article the reader's content filter hides and moves
+// those with demoted leads after the rest. Stories come paginated from SQL, so this works
+// within the page.
+func filterStories(stories []*models.Story, policy models.ContentFilterPolicy) []*models.Story {
+	if !policy.Filters() {
+		return stories
+	}
+
+	kept := make([]*models.Story, 0, len(stories))
+	var demoted []*models.Story
+	for _, story := range stories {
+		switch {
+		case story.LeadArticle == nil:
+			kept = append(kept, story)
+		case policy.Hides(story.LeadArticle):
+		case policy.Demotes(story.LeadArticle):
+			demoted = append(demoted, story)
+		default:
+			kept = append(kept, story)
+		}
+	}
+	return append(kept, demoted...)
+}
@@ -0,0 +1,364 @@
+// internal/handlers/syndication.go
+// GoNews Syndication Handler - Outbound RSS/Atom/JSON feeds, private feed tokens and saved searches
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// SyndicationHandler serves published feeds and manages private feed access
+type SyndicationHandler struct {
+	syndication *services.SyndicationService
+	logger      *logger.Logger
+}
+
+// NewSyndicationHandler creates a new syndication handler
+func NewSyndicationHandler(syndication *services.SyndicationService, logger *logger.Logger) *SyndicationHandler {
+	return &SyndicationHandler{
+		syndication: syndication,
+		logger:      logger,
+	}
+}
+
+// ===============================
+// PUBLIC FEEDS
+// ===============================
+
+// CategoryFeed serves the latest articles in a category
+// GET /feeds/category/:slug.rss | .atom | .json
+func (h *SyndicationHandler) CategoryFeed(c *fiber.Ctx) error {
+	slug, format := splitFeedFile(c.Params("file"))
+	feed, err := h.syndication.CategoryFeed(slug, format)
+	return h.serveFeed(c, feed, err)
+}
+
+// TrendingFeed serves articles trending over the last day
+// GET /feeds/trending.rss | .atom | .json
+func (h *SyndicationHandler) TrendingFeed(c *fiber.Ctx) error {
+	_, format := splitFeedFile(c.Path())
+	feed, err := h.syndication.TrendingFeed(format)
+	return h.serveFeed(c, feed, err)
+}
+
+// SearchFeed serves the newest articles matching a query
+// GET /feeds/search.rss?q=monsoon&category=business
+func (h *SyndicationHandler) SearchFeed(c *fiber.Ctx) error {
+	_, format := splitFeedFile(c.Path())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	feed, err := h.syndication.SearchFeed(ctx, c.Query("q"), c.Query("category"), format)
+	return h.serveFeed(c, feed, err)
+}
+
+// ===============================
+// PRIVATE TOKEN FEEDS
+// ===============================
+
+// BookmarksFeed serves the token owner's bookmarks
+// GET /feeds/private/:token/bookmarks.rss | .atom | .json
+func (h *SyndicationHandler) BookmarksFeed(c *fiber.Ctx) error {
+	_, format := splitFeedFile(c.Path())
+	feed, err := h.syndication.BookmarksFeed(c.Params("token"), format)
+	return h.serveFeed(c, feed, err)
+}
+
+// SavedSearchFeed serves one of the token owner's saved searches
+// GET /feeds/private/:token/searches/:id.rss | .atom | .json
+func (h *SyndicationHandler) SavedSearchFeed(c *fiber.Ctx) error {
+	name, format := splitFeedFile(c.Params("file"))
+	searchID, err := strconv.Atoi(name)
+	if err != nil || searchID < 1 {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Feed not found",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	feed, err := h.syndication.SavedSearchFeed(ctx, c.Params("token"), searchID, format)
+	return h.serveFeed(c, feed, err)
+}
+
+// ===============================
+// TOKEN MANAGEMENT (AUTHENTICATED)
+// ===============================
+
+// ListFeedTokens returns the user's private feed tokens
+// GET /api/v1/feeds/tokens
+func (h *SyndicationHandler) ListFeedTokens(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	tokens, err := h.syndication.ListFeedTokens(userID)
+	if err != nil {
+		return h.managementError(c, err, "Failed to list feed tokens")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed tokens retrieved successfully",
+		Data:    tokens,
+	})
+}
+
+// CreateFeedToken issues a private feed token; the secret is only returned here
+// POST /api/v1/feeds/tokens
+func (h *SyndicationHandler) CreateFeedToken(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	var req models.CreateFeedTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	created, err := h.syndication.CreateFeedToken(userID, &req)
+	if err != nil {
+		return h.managementError(c, err, "Failed to create feed token")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.SuccessResponse{
+		Message: "Feed token created - store the secret now, it will not be shown again",
+		Data:    created,
+	})
+}
+
+// RevokeFeedToken revokes a private feed token
+// DELETE /api/v1/feeds/tokens/:id
+func (h *SyndicationHandler) RevokeFeedToken(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	tokenID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || tokenID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric token ID is required",
+		})
+	}
+
+	if err := h.syndication.RevokeFeedToken(userID, tokenID); err != nil {
+		return h.managementError(c, err, "Failed to revoke feed token")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Feed token revoked",
+	})
+}
+
+// ===============================
+// SAVED SEARCHES (AUTHENTICATED)
+// ===============================
+
+// ListSavedSearches returns the user's saved searches
+// GET /api/v1/feeds/saved-searches
+func (h *SyndicationHandler) ListSavedSearches(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	searches, err := h.syndication.ListSavedSearches(userID)
+	if err != nil {
+		return h.managementError(c, err, "Failed to list saved searches")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Saved searches retrieved successfully",
+		Data:    searches,
+	})
+}
+
+// CreateSavedSearch stores a search; its feed is served under any of the user's tokens
+// POST /api/v1/feeds/saved-searches
+func (h *SyndicationHandler) CreateSavedSearch(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	var req models.CreateSavedSearchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+
+	search, err := h.syndication.CreateSavedSearch(userID, &req)
+	if err != nil {
+		return h.managementError(c, err, "Failed to create saved search")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.SuccessResponse{
+		Message: "Saved search created",
+		Data:    search,
+	})
+}
+
+// DeleteSavedSearch removes a saved search and its feed
+// DELETE /api/v1/feeds/saved-searches/:id
+func (h *SyndicationHandler) DeleteSavedSearch(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		return h.unauthorized(c)
+	}
+
+	searchID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || searchID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A valid numeric saved search ID is required",
+		})
+	}
+
+	if err := h.syndication.DeleteSavedSearch(userID, searchID); err != nil {
+		return h.managementError(c, err, "Failed to delete saved search")
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Saved search deleted",
+	})
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+// serveFeed writes a rendered feed with caching headers, answering conditional requests with 304
+func (h *SyndicationHandler) serveFeed(c *fiber.Ctx, feed *models.RenderedFeed, err error) error {
+	if err != nil {
+		return h.feedError(c, err)
+	}
+
+	cacheScope := "public"
+	if feed.Private {
+		cacheScope = "private"
+		c.Set("X-Robots-Tag", "noindex")
+	}
+	c.Set(fiber.HeaderCacheControl, fmt.Sprintf("%s, max-age=%d", cacheScope, h.syndication.CacheMaxAge()))
+	c.Set(fiber.HeaderETag, feed.ETag)
+	c.Set(fiber.HeaderLastModified, feed.LastModified.Format(http.TimeFormat))
+
+	links := []string{fmt.Sprintf(`<%s>; rel="self"`, feed.SelfURL)}
+	if hub := h.syndication.HubURL(); hub != "" && !feed.Private {
+		links = append(links, fmt.Sprintf(`<%s>; rel="hub"`, hub))
+	}
+	c.Set(fiber.HeaderLink, strings.Join(links, ", "))
+
+	if feedNotModified(c, feed) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, feed.ContentType)
+	return c.Send(feed.Body)
+}
+
+// feedNotModified applies If-None-Match, falling back to If-Modified-Since
+func feedNotModified(c *fiber.Ctx, feed *models.RenderedFeed) bool {
+	if match := c.Get(fiber.HeaderIfNoneMatch); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == feed.ETag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := c.Get(fiber.HeaderIfModifiedSince); since != "" {
+		if t, err := http.ParseTime(since); err == nil {
+			return !feed.LastModified.After(t)
+		}
+	}
+	return false
+}
+
+// splitFeedFile splits "politics.rss" into its name and format
+func splitFeedFile(file string) (string, string) {
+	ext := path.Ext(file)
+	return strings.TrimSuffix(path.Base(file), ext), strings.TrimPrefix(ext, ".")
+}
+
+// feedError maps feed lookup failures onto HTTP statuses
+func (h *SyndicationHandler) feedError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, services.ErrUnknownFeed),
+		errors.Is(err, repository.ErrFeedTokenNotFound),
+		errors.Is(err, repository.ErrSavedSearchNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Feed not found",
+		})
+	case errors.Is(err, services.ErrInvalidFeedRequest):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	// The route pattern, not the path, so private feed tokens never reach the logs
+	h.logger.Error("Failed to build feed", map[string]interface{}{
+		"route": c.Route().Path,
+		"error": err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: "Failed to build feed",
+	})
+}
+
+// managementError maps token and saved search failures onto HTTP statuses
+func (h *SyndicationHandler) managementError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidFeedRequest):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrFeedTokenLimit):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrFeedTokenNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Feed token not found",
+		})
+	case errors.Is(err, repository.ErrSavedSearchNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Message: "Saved search not found",
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"error": err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
+
+func (h *SyndicationHandler) unauthorized(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+		Message: "Authentication required",
+	})
+}
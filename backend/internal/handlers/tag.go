@@ -0,0 +1,148 @@
+// internal/handlers/tag.go
+// GoNews Tag Handler - Tag pages listing the articles for a normalized tag, and keyword previews
+
+package handlers
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// TagHandler serves the tag catalogue and tag pages
+type TagHandler struct {
+	tags   *services.TagService
+	logger *logger.Logger
+
+	// Optional readers' content filter levels
+	config   *config.Config
+	userRepo *repository.UserRepository
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tags *services.TagService, logger *logger.Logger) *TagHandler {
+	return &TagHandler{
+		tags:   tags,
+		logger: logger,
+	}
+}
+
+// SetContentFilter applies readers' content filter levels to tag pages
+func (h *TagHandler) SetContentFilter(cfg *config.Config, userRepo *repository.UserRepository) {
+	h.config = cfg
+	h.userRepo = userRepo
+}
+
+// ===============================
+// PUBLIC ENDPOINTS
+// ===============================
+
+// GetTags lists the tags carried by the most articles
+// GET /api/v1/tags?limit=50
+func (h *TagHandler) GetTags(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	tags, err := h.tags.PopularTags(limit)
+	if err != nil {
+		h.logger.Error("Failed to get popular tags", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load tags",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Tags retrieved successfully",
+		Data: fiber.Map{
+			"tags":  tags,
+			"total": len(tags),
+		},
+	})
+}
+
+// GetTagArticles lists the articles carrying a tag, newest first. The tag may be given as its
+// slug or its URL-encoded name.
+// GET /api/v1/tags/:slug?page=1&limit=20
+func (h *TagHandler) GetTagArticles(c *fiber.Ctx) error {
+	slug, err := url.PathUnescape(c.Params("slug"))
+	if err != nil || strings.TrimSpace(slug) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid tag",
+		})
+	}
+
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		page = 1
+	}
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+
+	response, err := h.tags.TagArticles(slug, page, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrUnknownTag) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Message: "Tag not found",
+			})
+		}
+
+		h.logger.Error("Failed to get tag articles", map[string]interface{}{
+			"tag":   slug,
+			"error": err.Error(),
+		})
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Message: "Failed to load tag articles",
+		})
+	}
+
+	// Pages come from SQL, so the filter applies within the page and keeps has_more intact
+	response.Articles = filterFeed(c, h.config, h.userRepo, h.logger, response.Articles, len(response.Articles))
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Tag articles retrieved successfully",
+		Data:    response,
+	})
+}
+
+// ===============================
+// ADMIN ENDPOINTS
+// ===============================
+
+// PreviewTags shows the keywords and tags a headline, description and provider tags would get
+// POST /api/v1/news/admin/tags/extract
+func (h *TagHandler) PreviewTags(c *fiber.Ctx) error {
+	var req struct {
+		Title       string   `json:"title"`
+		Description string   `json:"description"`
+		Tags        []string `json:"tags"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "Invalid request body",
+		})
+	}
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.Description) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Message: "A title or description is required",
+		})
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Keywords extracted successfully",
+		Data:    h.tags.Preview(req.Title, req.Description, req.Tags),
+	})
+}
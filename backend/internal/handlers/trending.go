@@ -0,0 +1,122 @@
+// internal/handlers/trending.go
+// GoNews Trending Handler - Articles, entities and tags whose reads and mentions are bursting
+
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/internal/services"
+	"backend/pkg/logger"
+)
+
+// TrendingHandler serves velocity trends
+type TrendingHandler struct {
+	trending *services.TrendingService
+	logger   *logger.Logger
+
+	// Optional readers' content filter levels
+	config   *config.Config
+	userRepo *repository.UserRepository
+}
+
+// NewTrendingHandler creates a new trending handler
+func NewTrendingHandler(trending *services.TrendingService, logger *logger.Logger) *TrendingHandler {
+	return &TrendingHandler{
+		trending: trending,
+		logger:   logger,
+	}
+}
+
+// SetContentFilter applies readers' content filter levels to trending articles
+func (h *TrendingHandler) SetContentFilter(cfg *config.Config, userRepo *repository.UserRepository) {
+	h.config = cfg
+	h.userRepo = userRepo
+}
+
+// GetTrending lists the articles, entities and tags bursting now with their growth rates
+// GET /api/v1/trending?limit=10
+func (h *TrendingHandler) GetTrending(c *fiber.Ctx) error {
+	limit := trendingLimit(c)
+	response, err := h.trending.Trending(limit)
+	if err != nil {
+		return h.trendingError(c, "Failed to get trends", err)
+	}
+
+	if h.config != nil {
+		articles, err := h.trending.TrendingArticles(limit * contentFilterOverfetch)
+		if err != nil {
+			return h.trendingError(c, "Failed to get trends", err)
+		}
+		response.Articles = filterFeed(c, h.config, h.userRepo, h.logger, articles, limit)
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Trends retrieved successfully",
+		Data:    response,
+	})
+}
+
+// GetTrendingEntities lists the people, organizations and places bursting now
+// GET /api/v1/trending/entities?limit=10
+func (h *TrendingHandler) GetTrendingEntities(c *fiber.Ctx) error {
+	entities, err := h.trending.TrendingEntities(trendingLimit(c))
+	if err != nil {
+		return h.trendingError(c, "Failed to get trending entities", err)
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Trending entities retrieved successfully",
+		Data: fiber.Map{
+			"entities": entities,
+			"total":    len(entities),
+		},
+	})
+}
+
+// GetTrendingTags lists the tags bursting now
+// GET /api/v1/trending/tags?limit=10
+func (h *TrendingHandler) GetTrendingTags(c *fiber.Ctx) error {
+	tags, err := h.trending.TrendingTags(trendingLimit(c))
+	if err != nil {
+		return h.trendingError(c, "Failed to get trending tags", err)
+	}
+
+	return c.JSON(models.SuccessResponse{
+		Message: "Trending tags retrieved successfully",
+		Data: fiber.Map{
+			"tags":  tags,
+			"total": len(tags),
+		},
+	})
+}
+
+// trendingError answers 503 until trends are first computed, and 500 otherwise
+func (h *TrendingHandler) trendingError(c *fiber.Ctx, message string, err error) error {
+	if errors.Is(err, services.ErrTrendingUnavailable) {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+			Message: "Trends are still being computed",
+		})
+	}
+
+	h.logger.Error(message, map[string]interface{}{
+		"error": err.Error(),
+	})
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Message: message,
+	})
+}
+
+// trendingLimit reads the limit parameter, 10 by default and at most 50
+func trendingLimit(c *fiber.Ctx) int {
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+	return limit
+}
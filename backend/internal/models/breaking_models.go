@@ -0,0 +1,100 @@
+// internal/models/breaking_models.go
+// GoNews - Breaking News & Notification Models
+// Stories flagged as breaking and the in-app notifications sent to readers who opted in
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Why a story was flagged as breaking
+const (
+	BreakingReasonSources        = "sources"         // Many sources published on it within minutes
+	BreakingReasonTrustedSources = "trusted_sources" // Several high-trust sources published on it within minutes
+	BreakingReasonEditor         = "editor"          // An editor flagged it
+)
+
+// Notification kinds
+const (
+	NotificationKindBreakingNews = "breaking_news"
+)
+
+// BreakingBadge marks an article whose story is breaking news
+type BreakingBadge struct {
+	StoryID int       `json:"story_id"`
+	Reason  string    `json:"reason"`
+	Since   time.Time `json:"since"`
+}
+
+// BreakingStory is a flagged story. EventID is the first flagged story about the same event,
+// which notifications are deduplicated by.
+type BreakingStory struct {
+	ID            int            `json:"id" db:"id"`
+	Headline      string         `json:"headline" db:"headline"`
+	LeadArticleID *int           `json:"lead_article_id" db:"lead_article_id"`
+	ArticleCount  int            `json:"article_count" db:"article_count"`
+	SourceCount   int            `json:"source_count" db:"source_count"`
+	Entities      pq.StringArray `json:"entities" db:"entities"`
+	IsBreaking    bool           `json:"is_breaking" db:"is_breaking"`
+	Reason        string         `json:"reason" db:"breaking_reason"`
+	BreakingAt    time.Time      `json:"breaking_at" db:"breaking_at"`
+	EventID       int            `json:"event_id" db:"breaking_event_id"`
+
+	LeadArticle *Article `json:"lead_article,omitempty" db:"-"`
+}
+
+// BreakingStoryArticle is one recent article of a story the detector has not flagged yet
+type BreakingStoryArticle struct {
+	StoryID     int       `db:"story_id"`
+	ArticleID   int       `db:"article_id"`
+	Source      string    `db:"source"`
+	PublishedAt time.Time `db:"published_at"`
+}
+
+// BreakingArticle ties an article to the breaking story it belongs to
+type BreakingArticle struct {
+	ArticleID  int       `db:"article_id"`
+	StoryID    int       `db:"story_id"`
+	Reason     string    `db:"breaking_reason"`
+	BreakingAt time.Time `db:"breaking_at"`
+}
+
+// BreakingRun summarizes one detection pass
+type BreakingRun struct {
+	Stories    int   `json:"stories_checked"`
+	Flagged    int   `json:"flagged"`
+	Notified   int   `json:"notified"`
+	Deferred   int   `json:"deferred"` // Bursts left for a later pass by the hourly flag limit
+	DurationMs int64 `json:"duration_ms"`
+}
+
+// BreakingFlagResult is the outcome of flagging one story
+type BreakingFlagResult struct {
+	Story    *BreakingStory `json:"story"`
+	Notified int            `json:"notified"`
+}
+
+// Notification is one entry in a reader's in-app inbox
+type Notification struct {
+	ID        int64      `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"-" db:"user_id"`
+	Kind      string     `json:"kind" db:"kind"`
+	StoryID   *int       `json:"story_id,omitempty" db:"story_id"`
+	ArticleID *int       `json:"article_id,omitempty" db:"article_id"`
+	Title     string     `json:"title" db:"title"`
+	Body      *string    `json:"body,omitempty" db:"body"`
+	ReadAt    *time.Time `json:"read_at,omitempty" db:"read_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// NotificationInbox is a page of a reader's notifications, newest first
+type NotificationInbox struct {
+	Notifications []*Notification `json:"notifications"`
+	Unread        int             `json:"unread"`
+	Page          int             `json:"page"`
+	Limit         int             `json:"limit"`
+}
@@ -0,0 +1,158 @@
+// internal/models/classifier_models.go
+// GoNews - Category Classifier Models
+// Classifier predictions, the low-confidence review queue and training reports
+
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// How an article's category was assigned, stored in articles.category_source
+const (
+	CategorySourceProvider = "provider" // Supplied by the news API or feed
+	CategorySourceModel    = "model"    // Predicted by the trained classifier
+	CategorySourceKeyword  = "keyword"  // Keyword rules, used until a model has been trained
+	CategorySourceReview   = "review"   // Chosen by an editor from the review queue
+)
+
+// Review states stored in category_reviews.status
+const (
+	CategoryReviewPending   = "pending"
+	CategoryReviewResolved  = "resolved"  // An editor chose the category
+	CategoryReviewDismissed = "dismissed" // Left uncategorized
+)
+
+// CategoryScore is one category's confidence for an article
+type CategoryScore struct {
+	Category   string  `json:"category"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategoryScores is a list of category confidences stored as JSONB
+type CategoryScores []CategoryScore
+
+// Scan implements the Scanner interface for CategoryScores
+func (s *CategoryScores) Scan(value interface{}) error {
+	if value == nil {
+		*s = CategoryScores{}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		*s = CategoryScores{}
+		return nil
+	}
+}
+
+// Value implements the driver.Valuer interface for CategoryScores
+func (s CategoryScores) Value() (driver.Value, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s)
+}
+
+// CategoryPrediction is the classifier's verdict on one article
+type CategoryPrediction struct {
+	Category     string         `json:"category"` // Most likely category slug
+	Confidence   float64        `json:"confidence"`
+	Labels       CategoryScores `json:"labels"` // The top category plus secondary ones above the secondary threshold
+	Scores       CategoryScores `json:"scores"` // Every category, most likely first
+	Source       string         `json:"source"` // CategorySourceModel or CategorySourceKeyword
+	ModelVersion string         `json:"model_version,omitempty"`
+	NeedsReview  bool           `json:"needs_review"` // Below the confidence threshold; left uncategorized
+}
+
+// CategoryReview is an article waiting for an editor to choose its category
+type CategoryReview struct {
+	ID                 int            `json:"id" db:"id"`
+	ArticleID          int            `json:"article_id" db:"article_id"`
+	SuggestedCategory  *string        `json:"suggested_category" db:"suggested_category"`
+	Confidence         float64        `json:"confidence" db:"confidence"`
+	Predictions        CategoryScores `json:"predictions" db:"predictions"`
+	ModelVersion       *string        `json:"model_version" db:"model_version"`
+	Status             string         `json:"status" db:"status"`
+	ResolvedCategoryID *int           `json:"resolved_category_id" db:"resolved_category_id"`
+	ReviewedBy         *uuid.UUID     `json:"reviewed_by" db:"reviewed_by"`
+	ReviewedAt         *time.Time     `json:"reviewed_at" db:"reviewed_at"`
+	CreatedAt          time.Time      `json:"created_at" db:"created_at"`
+
+	// Article fields shown to the editor
+	Title       string    `json:"title" db:"title"`
+	Description *string   `json:"description" db:"description"`
+	URL         string    `json:"url" db:"url"`
+	Source      string    `json:"source" db:"source"`
+	PublishedAt time.Time `json:"published_at" db:"published_at"`
+
+	ExternalID string `json:"-" db:"-"` // Links a review queued during ingestion to its saved article
+}
+
+// CategoryReviewResolution is an editor's decision on a review
+type CategoryReviewResolution struct {
+	Category  string   `json:"category"`  // Category slug
+	Secondary []string `json:"secondary"` // Further category slugs the article also belongs to
+}
+
+// ClassifierExample is a categorized article used for training
+type ClassifierExample struct {
+	Title          string         `db:"title"`
+	Description    *string        `db:"description"`
+	CategorySlug   string         `db:"category_slug"`
+	CategorySource *string        `db:"category_source"`
+	CategoryLabels CategoryScores `db:"category_labels"`
+}
+
+// ClassifierLabelMetrics are one category's scores on held-out articles
+type ClassifierLabelMetrics struct {
+	Support   int     `json:"support"`
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+}
+
+// ClassifierEvaluation measures a model on articles held out from training
+type ClassifierEvaluation struct {
+	Examples int                               `json:"examples"`
+	Accuracy float64                           `json:"accuracy"`
+	MacroF1  float64                           `json:"macro_f1"`
+	Labels   map[string]ClassifierLabelMetrics `json:"labels"`
+}
+
+// ClassifierTrainingReport describes a training run
+type ClassifierTrainingReport struct {
+	ModelPath       string                `json:"model_path"`
+	Version         string                `json:"version"`
+	Examples        int                   `json:"examples"`
+	TrainExamples   int                   `json:"train_examples"`
+	HoldoutExamples int                   `json:"holdout_examples"`
+	LabelCounts     map[string]int        `json:"label_counts"`
+	Features        int                   `json:"features"`
+	Evaluation      *ClassifierEvaluation `json:"evaluation,omitempty"`
+	Saved           bool                  `json:"saved"`
+	Duration        time.Duration         `json:"duration"`
+}
+
+// ClassifierStatus describes the loaded model for the admin dashboard
+type ClassifierStatus struct {
+	ModelLoaded     bool                  `json:"model_loaded"`
+	ModelPath       string                `json:"model_path"`
+	Version         string                `json:"version,omitempty"`
+	TrainedAt       *time.Time            `json:"trained_at,omitempty"`
+	Labels          []string              `json:"labels,omitempty"`
+	Features        int                   `json:"features"`
+	Documents       int                   `json:"documents"`
+	Evaluation      *ClassifierEvaluation `json:"evaluation,omitempty"`
+	MinConfidence   float64               `json:"min_confidence"`
+	PendingReviews  int                   `json:"pending_reviews"`
+	FallbackKeyword bool                  `json:"fallback_keyword"` // No model; keyword rules in use
+}
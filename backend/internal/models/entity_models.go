@@ -0,0 +1,86 @@
+// internal/models/entity_models.go
+// GoNews - Entity Extraction Models
+// Gazetteer entities added by admins and the entities found in article text
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Where a gazetteer entity comes from
+const (
+	GazetteerSourceBuiltin = "builtin" // Shipped with the extractor
+	GazetteerSourceCustom  = "custom"  // Added or overridden by an admin
+)
+
+// GazetteerEntry is an admin's gazetteer entity. A row with a built-in entity's ID replaces
+// it; an inactive row removes it.
+type GazetteerEntry struct {
+	CanonicalID   string         `json:"id" db:"canonical_id"`
+	Name          string         `json:"name" db:"name"`
+	EntityType    string         `json:"type" db:"entity_type"`
+	Kind          *string        `json:"kind" db:"kind"`
+	Aliases       pq.StringArray `json:"aliases" db:"aliases"`
+	ParentID      *string        `json:"parent" db:"parent_id"`
+	CaseSensitive bool           `json:"case_sensitive" db:"case_sensitive"`
+	NotAfter      pq.StringArray `json:"not_after" db:"not_after"`
+	IsActive      bool           `json:"is_active" db:"is_active"`
+	UpdatedBy     *uuid.UUID     `json:"updated_by" db:"updated_by"`
+	CreatedAt     time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// GazetteerEntityRequest adds an entity or replaces one with the same ID
+type GazetteerEntityRequest struct {
+	ID            string   `json:"id"` // Defaults to a slug of the name
+	Name          string   `json:"name"`
+	Type          string   `json:"type"` // person, organization or location
+	Kind          string   `json:"kind"`
+	Aliases       []string `json:"aliases"`
+	Parent        string   `json:"parent"` // Containing location's ID, for locations
+	CaseSensitive bool     `json:"case_sensitive"`
+	NotAfter      []string `json:"not_after"` // Preceding words that rule a match out
+}
+
+// GazetteerEntity is an entity the extractor knows
+type GazetteerEntity struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Kind          string   `json:"kind,omitempty"`
+	Aliases       []string `json:"aliases"`
+	Parent        string   `json:"parent,omitempty"`
+	CaseSensitive bool     `json:"case_sensitive"`
+	NotAfter      []string `json:"not_after,omitempty"`
+	Source        string   `json:"source"`
+}
+
+// GazetteerListResponse is a filtered view of the gazetteer
+type GazetteerListResponse struct {
+	Entities []GazetteerEntity `json:"entities"`
+	Total    int               `json:"total"`
+	Custom   int               `json:"custom"`   // Admin-added or overridden entities in use
+	Disabled []string          `json:"disabled"` // Built-in IDs an admin has removed
+}
+
+// EntityMention is one entity found in article text
+type EntityMention struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Kind   string `json:"kind,omitempty"`
+	Text   string `json:"text"`
+	Source string `json:"source"` // gazetteer or rule
+}
+
+// EntityExtraction lists canonical entity IDs found in a headline and description
+type EntityExtraction struct {
+	Persons       []string        `json:"persons"`
+	Organizations []string        `json:"organizations"`
+	Locations     []string        `json:"locations"`
+	Mentions      []EntityMention `json:"mentions"`
+}
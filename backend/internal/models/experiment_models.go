@@ -0,0 +1,185 @@
+// internal/models/experiment_models.go
+// GoNews - Experiment Models
+// A/B experiment definitions, exposures, outcome events and per-variant results
+
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Experiment statuses
+const (
+	ExperimentStatusDraft     = "draft"
+	ExperimentStatusRunning   = "running"
+	ExperimentStatusPaused    = "paused"
+	ExperimentStatusCompleted = "completed"
+)
+
+// ExperimentFeedAll targets every feed that reads experiment parameters
+const ExperimentFeedAll = "all"
+
+// Experiment outcome event types
+const (
+	ExperimentEventRead     = "read"
+	ExperimentEventBookmark = "bookmark"
+)
+
+// Variant parameters understood by the feed handlers
+const (
+	ExperimentParamDiversityLambda   = "diversity_lambda"    // float: MMR relevance/novelty trade-off
+	ExperimentParamDiversityDisabled = "diversity_disabled"  // bool: skip MMR re-ranking
+	ExperimentParamRankingWeights    = "ranking_weights"     // object: feature name -> weight
+	ExperimentParamCandidatePoolSize = "candidate_pool_size" // int: candidates fetched per slot
+)
+
+// ExperimentParams holds free-form variant parameters stored as JSONB
+type ExperimentParams map[string]interface{}
+
+// Scan implements the Scanner interface for ExperimentParams
+func (p *ExperimentParams) Scan(value interface{}) error {
+	if value == nil {
+		*p = ExperimentParams{}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	default:
+		*p = ExperimentParams{}
+		return nil
+	}
+}
+
+// Value implements the driver.Valuer interface for ExperimentParams
+func (p ExperimentParams) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p)
+}
+
+// Experiment represents an A/B experiment definition
+type Experiment struct {
+	ID          int                 `json:"id" db:"id"`
+	Key         string              `json:"key" db:"key"`
+	Name        string              `json:"name" db:"name"`
+	Description *string             `json:"description,omitempty" db:"description"`
+	FeedType    string              `json:"feed_type" db:"feed_type"`
+	Status      string              `json:"status" db:"status"`
+	StartedAt   *time.Time          `json:"started_at,omitempty" db:"started_at"`
+	EndedAt     *time.Time          `json:"ended_at,omitempty" db:"ended_at"`
+	CreatedAt   time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at" db:"updated_at"`
+	Variants    []ExperimentVariant `json:"variants" db:"-"`
+}
+
+// ExperimentVariant represents one arm of an experiment with its traffic share
+type ExperimentVariant struct {
+	ID             int              `json:"id" db:"id"`
+	ExperimentID   int              `json:"experiment_id" db:"experiment_id"`
+	Key            string           `json:"key" db:"key"`
+	Name           string           `json:"name" db:"name"`
+	TrafficPercent int              `json:"traffic_percent" db:"traffic_percent"`
+	IsControl      bool             `json:"is_control" db:"is_control"`
+	Params         ExperimentParams `json:"params" db:"params"`
+	CreatedAt      time.Time        `json:"created_at" db:"created_at"`
+}
+
+// ExperimentExposure records that a subject was served a variant
+type ExperimentExposure struct {
+	ExperimentID int        `db:"experiment_id"`
+	VariantKey   string     `db:"variant_key"`
+	SubjectKey   string     `db:"subject_key"`
+	UserID       *uuid.UUID `db:"user_id"`
+	FeedType     string     `db:"feed_type"`
+}
+
+// ExperimentEvent records an outcome attributed to a subject's variant
+type ExperimentEvent struct {
+	ExperimentID int    `db:"experiment_id"`
+	VariantKey   string `db:"variant_key"`
+	SubjectKey   string `db:"subject_key"`
+	EventType    string `db:"event_type"`
+	ArticleID    *int   `db:"article_id"`
+	DwellSeconds int    `db:"dwell_seconds"`
+	ScrollDepth  int    `db:"scroll_depth"`
+}
+
+// ===============================
+// REQUEST / RESPONSE MODELS
+// ===============================
+
+// CreateExperimentRequest represents an admin request to define an experiment
+type CreateExperimentRequest struct {
+	Key         string                    `json:"key" validate:"required"`
+	Name        string                    `json:"name" validate:"required"`
+	Description *string                   `json:"description,omitempty"`
+	FeedType    string                    `json:"feed_type,omitempty"`
+	Variants    []CreateExperimentVariant `json:"variants" validate:"required,min=2"`
+}
+
+// CreateExperimentVariant represents one variant in a create request
+type CreateExperimentVariant struct {
+	Key            string           `json:"key" validate:"required"`
+	Name           string           `json:"name"`
+	TrafficPercent int              `json:"traffic_percent"`
+	IsControl      bool             `json:"is_control"`
+	Params         ExperimentParams `json:"params,omitempty"`
+}
+
+// UpdateExperimentStatusRequest changes an experiment's lifecycle state
+type UpdateExperimentStatusRequest struct {
+	Status string `json:"status" validate:"required"`
+}
+
+// VariantMetrics holds raw per-variant aggregates loaded from the database
+type VariantMetrics struct {
+	VariantKey          string  `json:"variant_key" db:"variant_key"`
+	Subjects            int     `json:"subjects" db:"subjects"`
+	Exposures           int     `json:"exposures" db:"exposures"`
+	ConvertedSubjects   int     `json:"converted_subjects" db:"converted_subjects"`
+	Reads               int     `json:"reads" db:"reads"`
+	BookmarkingSubjects int     `json:"bookmarking_subjects" db:"bookmarking_subjects"`
+	Bookmarks           int     `json:"bookmarks" db:"bookmarks"`
+	DwellSamples        int     `json:"dwell_samples" db:"dwell_samples"`
+	DwellMean           float64 `json:"dwell_mean_seconds" db:"dwell_mean"`
+	DwellStdDev         float64 `json:"dwell_stddev_seconds" db:"dwell_stddev"`
+}
+
+// SignificanceTest is the result of comparing a variant metric against control
+type SignificanceTest struct {
+	Metric      string  `json:"metric"`
+	Control     float64 `json:"control"`
+	Variant     float64 `json:"variant"`
+	Lift        float64 `json:"lift"` // Relative change vs control
+	ZScore      float64 `json:"z_score"`
+	PValue      float64 `json:"p_value"`
+	Significant bool    `json:"significant"`
+}
+
+// VariantResult is the admin view of one variant's outcomes
+type VariantResult struct {
+	VariantMetrics
+	IsControl      bool               `json:"is_control"`
+	CTR            float64            `json:"ctr"`             // Reads per feed exposure
+	ConversionRate float64            `json:"conversion_rate"` // Share of subjects with at least one read
+	BookmarkRate   float64            `json:"bookmark_rate"`   // Share of subjects with at least one bookmark
+	Tests          []SignificanceTest `json:"tests,omitempty"`
+}
+
+// ExperimentResults is the admin results view for an experiment
+type ExperimentResults struct {
+	Experiment  *Experiment     `json:"experiment"`
+	Variants    []VariantResult `json:"variants"`
+	Alpha       float64         `json:"alpha"`
+	Warnings    []string        `json:"warnings,omitempty"`
+	GeneratedAt time.Time       `json:"generated_at"`
+}
@@ -0,0 +1,49 @@
+// internal/models/extraction_models.go
+// GoNews - Full-Text Extraction Models
+// Per-article extraction state, results and admin reporting
+
+package models
+
+import "time"
+
+// Extraction states stored in articles.extraction_status
+const (
+	ExtractionPending   = "pending"   // Not attempted yet
+	ExtractionExtracted = "extracted" // Full text stored
+	ExtractionFailed    = "failed"    // Fetch or parse error, retried until attempts run out
+	ExtractionBlocked   = "blocked"   // Disallowed by robots.txt
+	ExtractionSkipped   = "skipped"   // Not an HTML page or too little readable text
+)
+
+// ExtractionCandidate is an article awaiting extraction
+type ExtractionCandidate struct {
+	ID       int     `json:"id" db:"id"`
+	Title    string  `json:"title" db:"title"`
+	URL      string  `json:"url" db:"url"`
+	ImageURL *string `json:"image_url,omitempty" db:"image_url"`
+	Attempts int     `json:"attempts" db:"extraction_attempts"`
+}
+
+// ArticleExtraction is the outcome of one extraction attempt
+type ArticleExtraction struct {
+	ArticleID          int       `json:"article_id"`
+	URL                string    `json:"url"`
+	Status             string    `json:"status"`
+	Error              string    `json:"error,omitempty"`
+	FullText           string    `json:"full_text,omitempty"`
+	LeadImageURL       string    `json:"lead_image_url,omitempty"`
+	WordCount          int       `json:"word_count"`
+	ReadingTimeMinutes int       `json:"reading_time_minutes"`
+	Summary            string    `json:"summary,omitempty"` // Replaces the ingest summary unless low quality
+	SummaryLowQuality  bool      `json:"summary_low_quality"`
+	DurationMs         int64     `json:"duration_ms"`
+	ExtractedAt        time.Time `json:"extracted_at"`
+}
+
+// ExtractionStats summarizes extraction coverage for the admin dashboard
+type ExtractionStats struct {
+	ByStatus         map[string]int `json:"by_status"`
+	AvgWordCount     float64        `json:"avg_word_count"`
+	ExtractedLast24h int            `json:"extracted_last_24h"`
+	FailedLast24h    int            `json:"failed_last_24h"`
+}
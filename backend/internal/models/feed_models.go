@@ -0,0 +1,147 @@
+// internal/models/feed_models.go
+// GoNews - RSS/Atom Feed Models
+// Publisher feeds, their polling health and admin management requests
+
+package models
+
+import "time"
+
+// Feed health states derived from polling history
+const (
+	FeedHealthHealthy  = "healthy"  // Last poll succeeded
+	FeedHealthDegraded = "degraded" // Recent failures, still retrying
+	FeedHealthFailing  = "failing"  // Repeated consecutive failures
+	FeedHealthPending  = "pending"  // Never polled
+	FeedHealthDisabled = "disabled" // Deactivated by an admin
+)
+
+// feedFailingThreshold is the consecutive failure count at which a feed is reported as failing
+const feedFailingThreshold = 3
+
+// Feed poll outcomes
+const (
+	FeedPollOK          = "ok"
+	FeedPollNotModified = "not_modified"
+	FeedPollError       = "error"
+	FeedPollSkipped     = "skipped"
+)
+
+// NewsFeed is one RSS/Atom feed and its polling state
+type NewsFeed struct {
+	ID                  int     `json:"id" db:"id"`
+	Name                string  `json:"name" db:"name"`
+	URL                 string  `json:"url" db:"url"`
+	SiteURL             *string `json:"site_url,omitempty" db:"site_url"`
+	CategorySlug        *string `json:"category_slug,omitempty" db:"category_slug"`
+	Language            string  `json:"language" db:"language"`
+	IsIndian            bool    `json:"is_indian" db:"is_indian"`
+	PollIntervalMinutes int     `json:"poll_interval_minutes" db:"poll_interval_minutes"`
+	IsActive            bool    `json:"is_active" db:"is_active"`
+
+	ETag         *string `json:"-" db:"etag"`
+	LastModified *string `json:"-" db:"last_modified"`
+
+	NextPollAt          *time.Time `json:"next_poll_at,omitempty" db:"next_poll_at"`
+	LastPolledAt        *time.Time `json:"last_polled_at,omitempty" db:"last_polled_at"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty" db:"last_success_at"`
+	LastStatusCode      *int       `json:"last_status_code,omitempty" db:"last_status_code"`
+	LastError           *string    `json:"last_error,omitempty" db:"last_error"`
+	LastItemCount       int        `json:"last_item_count" db:"last_item_count"`
+	ConsecutiveFailures int        `json:"consecutive_failures" db:"consecutive_failures"`
+	TotalPolls          int        `json:"total_polls" db:"total_polls"`
+	TotalFailures       int        `json:"total_failures" db:"total_failures"`
+	NotModifiedCount    int        `json:"not_modified_count" db:"not_modified_count"`
+	ItemsFetched        int64      `json:"items_fetched" db:"items_fetched"`
+	AvgResponseMs       int        `json:"avg_response_ms" db:"avg_response_ms"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// Computed for admin views
+	Health      string  `json:"health" db:"-"`
+	SuccessRate float64 `json:"success_rate" db:"-"`
+}
+
+// ComputeHealth fills the derived health fields from the polling counters
+func (f *NewsFeed) ComputeHealth() {
+	switch {
+	case !f.IsActive:
+		f.Health = FeedHealthDisabled
+	case f.TotalPolls == 0:
+		f.Health = FeedHealthPending
+	case f.ConsecutiveFailures >= feedFailingThreshold:
+		f.Health = FeedHealthFailing
+	case f.ConsecutiveFailures > 0:
+		f.Health = FeedHealthDegraded
+	default:
+		f.Health = FeedHealthHealthy
+	}
+
+	if f.TotalPolls > 0 {
+		f.SuccessRate = float64(f.TotalPolls-f.TotalFailures) / float64(f.TotalPolls)
+	}
+}
+
+// FeedPollOutcome is what one poll recorded against a feed
+type FeedPollOutcome struct {
+	Status       string
+	StatusCode   int
+	ETag         string
+	LastModified string
+	ItemCount    int
+	Duration     time.Duration
+	Err          error
+	NextPollAt   time.Time
+}
+
+// FeedPollResult summarizes one poll for admins and logs
+type FeedPollResult struct {
+	FeedID     int           `json:"feed_id"`
+	FeedName   string        `json:"feed_name"`
+	Status     string        `json:"status"`
+	StatusCode int           `json:"status_code,omitempty"`
+	Items      int           `json:"items"`
+	Stored     int           `json:"stored"`
+	Duration   time.Duration `json:"duration"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// FeedHealthSummary counts feeds by health state
+type FeedHealthSummary struct {
+	Total    int `json:"total"`
+	Active   int `json:"active"`
+	Healthy  int `json:"healthy"`
+	Degraded int `json:"degraded"`
+	Failing  int `json:"failing"`
+	Pending  int `json:"pending"`
+	Disabled int `json:"disabled"`
+}
+
+// FeedListResponse is the admin feed list with health summary
+type FeedListResponse struct {
+	Feeds   []*NewsFeed       `json:"feeds"`
+	Summary FeedHealthSummary `json:"summary"`
+}
+
+// CreateFeedRequest registers a new feed
+type CreateFeedRequest struct {
+	Name                string  `json:"name" validate:"required,min=2,max=200"`
+	URL                 string  `json:"url" validate:"required,url"`
+	SiteURL             *string `json:"site_url,omitempty" validate:"omitempty,url"`
+	CategorySlug        *string `json:"category_slug,omitempty"`
+	Language            string  `json:"language,omitempty"`
+	IsIndian            *bool   `json:"is_indian,omitempty"`
+	PollIntervalMinutes int     `json:"poll_interval_minutes,omitempty"`
+}
+
+// UpdateFeedRequest changes feed settings; nil fields are left unchanged
+type UpdateFeedRequest struct {
+	Name                *string `json:"name,omitempty"`
+	URL                 *string `json:"url,omitempty"`
+	SiteURL             *string `json:"site_url,omitempty"`
+	CategorySlug        *string `json:"category_slug,omitempty"`
+	Language            *string `json:"language,omitempty"`
+	IsIndian            *bool   `json:"is_indian,omitempty"`
+	PollIntervalMinutes *int    `json:"poll_interval_minutes,omitempty"`
+	IsActive            *bool   `json:"is_active,omitempty"`
+}
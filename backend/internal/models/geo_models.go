@@ -0,0 +1,73 @@
+// internal/models/geo_models.go
+// GoNews - Geo-Tagging Models
+// Indian states, districts and cities articles are tagged with, and local news for a reader
+
+package models
+
+import "time"
+
+// ArticleLocation is a place an article is about, with the codes of the places containing it,
+// so a Navi Mumbai row also carries Thane district and Maharashtra.
+type ArticleLocation struct {
+	ArticleID    int     `json:"-" db:"article_id"`
+	PlaceCode    string  `json:"code" db:"place_code"`
+	PlaceName    string  `json:"name" db:"place_name"`
+	Level        string  `json:"level" db:"place_level"` // state, district or city
+	StateCode    string  `json:"state_code" db:"state_code"`
+	DistrictCode *string `json:"district_code,omitempty" db:"district_code"`
+	CityCode     *string `json:"city_code,omitempty" db:"city_code"`
+	InHeadline   bool    `json:"in_headline" db:"in_headline"`
+	IsPrimary    bool    `json:"is_primary" db:"is_primary"`
+}
+
+// GeoPlace is a state, district or city from the bundled gazetteer
+type GeoPlace struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Level        string `json:"level"`
+	StateCode    string `json:"state_code"`
+	StateName    string `json:"state_name"`
+	DistrictCode string `json:"district_code,omitempty"`
+	DistrictName string `json:"district_name,omitempty"`
+	Region       string `json:"region"`
+	Metro        bool   `json:"metro"`
+}
+
+// Where the place for "news near me" came from
+const (
+	NearbySourceQuery   = "query"   // state, district or city parameters
+	NearbySourceProfile = "profile" // The signed-in user's location
+)
+
+// NearbyNewsQuery asks for recent news about a place. Without a place, the user's profile
+// location is used.
+type NearbyNewsQuery struct {
+	State    string
+	District string
+	City     string
+	Days     int
+	Page     int
+	Limit    int
+}
+
+// NearbyNewsResponse is recent news about a place and the places around it, nearest first
+type NearbyNewsResponse struct {
+	Location GeoPlace   `json:"location"`
+	Source   string     `json:"source"`
+	Articles []*Article `json:"articles"`
+	Page     int        `json:"page"`
+	Limit    int        `json:"limit"`
+	HasMore  bool       `json:"has_more"`
+}
+
+// NearbyArticleFilter selects stored articles in a state, ranking those in the district or
+// city first
+type NearbyArticleFilter struct {
+	StateCode    string
+	DistrictCode *string
+	CityCode     *string
+	Since        time.Time
+	BoostHours   float64 // How much fresher each level of closeness makes an article look
+	Limit        int
+	Offset       int
+}
@@ -0,0 +1,205 @@
+// internal/models/ingestion_models.go
+// GoNews - Ingestion Pipeline Models
+// Dead-lettered provider items and per-stage pipeline metrics
+
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// Ingestion pipeline stages, in order
+const (
+	IngestionStageFetch     = "fetch"
+	IngestionStageNormalize = "normalize"
+	IngestionStageEnrich    = "enrich"
+	IngestionStageClassify  = "classify"
+	IngestionStageDedupe    = "dedupe"
+	IngestionStagePersist   = "persist"
+)
+
+// IngestionStages lists the stages in pipeline order
+var IngestionStages = []string{
+	IngestionStageFetch,
+	IngestionStageNormalize,
+	IngestionStageEnrich,
+	IngestionStageClassify,
+	IngestionStageDedupe,
+	IngestionStagePersist,
+}
+
+// Dead letter payload kinds stored in ingestion_dead_letters.payload_kind
+const (
+	DeadLetterPayloadRaw     = "raw"     // Provider record as received; replay re-runs conversion
+	DeadLetterPayloadArticle = "article" // Already-converted article (feeds, sources without raw access)
+)
+
+// Dead letter states stored in ingestion_dead_letters.status
+const (
+	DeadLetterPending   = "pending"
+	DeadLetterReplayed  = "replayed"
+	DeadLetterDiscarded = "discarded"
+)
+
+// RawJSON holds a JSONB document exactly as it was received
+type RawJSON json.RawMessage
+
+// Scan implements the Scanner interface for RawJSON
+func (r *RawJSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		*r = append((*r)[:0], v...)
+	case string:
+		*r = RawJSON(v)
+	default:
+		*r = nil
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for RawJSON
+func (r RawJSON) Value() (driver.Value, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+	return []byte(r), nil
+}
+
+// MarshalJSON embeds the document as-is
+func (r RawJSON) MarshalJSON() ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+	return []byte(r), nil
+}
+
+// IngestionDeadLetter is one item that could not make it through the pipeline
+type IngestionDeadLetter struct {
+	ID            int        `json:"id" db:"id"`
+	Source        string     `json:"source" db:"source"`
+	Stage         string     `json:"stage" db:"stage"`
+	PayloadKind   string     `json:"payload_kind" db:"payload_kind"`
+	Payload       RawJSON    `json:"payload" db:"payload"`
+	Query         RawJSON    `json:"query,omitempty" db:"query"`
+	Error         string     `json:"error" db:"error"`
+	Status        string     `json:"status" db:"status"`
+	Attempts      int        `json:"attempts" db:"attempts"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty" db:"last_attempt_at"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+}
+
+// DeadLetterFilter narrows the admin dead letter listing
+type DeadLetterFilter struct {
+	Status string
+	Source string
+	Stage  string
+	Limit  int
+	Offset int
+}
+
+// DeadLetterListResponse is a page of dead letters with counts by status
+type DeadLetterListResponse struct {
+	DeadLetters []*IngestionDeadLetter `json:"dead_letters"`
+	Total       int                    `json:"total"`
+	ByStatus    map[string]int         `json:"by_status"`
+	Limit       int                    `json:"limit"`
+	Offset      int                    `json:"offset"`
+}
+
+// DeadLetterReplayResult reports what happened when one dead letter was replayed
+type DeadLetterReplayResult struct {
+	ID        int    `json:"id"`
+	Status    string `json:"status"`
+	Stage     string `json:"stage,omitempty"` // Stage that rejected the item again
+	Error     string `json:"error,omitempty"`
+	Persisted bool   `json:"persisted"`
+	Duplicate bool   `json:"duplicate"`
+}
+
+// DeadLetterBulkReplayResult summarizes a batch replay
+type DeadLetterBulkReplayResult struct {
+	Requested int                      `json:"requested"`
+	Replayed  int                      `json:"replayed"`
+	Failed    int                      `json:"failed"`
+	Results   []DeadLetterReplayResult `json:"results"`
+}
+
+// IngestionStageMetrics are cumulative counters and latencies for one stage
+type IngestionStageMetrics struct {
+	Stage         string  `json:"stage"`
+	In            int64   `json:"in"`
+	Out           int64   `json:"out"`
+	Dropped       int64   `json:"dropped"`       // Filtered out on purpose (duplicates)
+	DeadLettered  int64   `json:"dead_lettered"` // Sent to ingestion_dead_letters
+	Errors        int64   `json:"errors"`        // Failures not tied to one item (provider errors)
+	AvgLatencyMs  float64 `json:"avg_latency_ms"`
+	MaxLatencyMs  float64 `json:"max_latency_ms"`
+	LastLatencyMs float64 `json:"last_latency_ms"`
+}
+
+// IngestionRunStats summarizes one pipeline run
+type IngestionRunStats struct {
+	Fetched      int           `json:"fetched"`
+	Accepted     int           `json:"accepted"` // Passed validation and dedupe
+	Duplicates   int           `json:"duplicates"`
+	Merged       int           `json:"merged"` // Duplicates from other records folded into an earlier article
+	Persisted    int           `json:"persisted"`
+	DeadLettered int           `json:"dead_lettered"`
+	FetchErrors  int           `json:"fetch_errors"`
+	Duration     time.Duration `json:"duration"`
+}
+
+// IngestionMetrics is the admin view of the pipeline
+type IngestionMetrics struct {
+	Stages      []IngestionStageMetrics `json:"stages"`
+	Runs        int64                   `json:"runs"`
+	LastRun     *IngestionRunStats      `json:"last_run,omitempty"`
+	LastRunAt   *time.Time              `json:"last_run_at,omitempty"`
+	DeadLetters map[string]int          `json:"dead_letters"`
+	Config      map[string]int          `json:"config"`
+}
+
+// ArchiveReplayReport summarizes re-ingesting archived provider responses
+type ArchiveReplayReport struct {
+	DryRun          bool           `json:"dry_run"`
+	Partitions      int            `json:"partitions"`
+	Responses       int            `json:"responses"`
+	Records         int            `json:"records"`
+	Accepted        int            `json:"accepted"`
+	Duplicates      int            `json:"duplicates"`
+	Persisted       int            `json:"persisted"`
+	Rejected        int            `json:"rejected"`
+	RejectedByStage map[string]int `json:"rejected_by_stage"`
+
+	// Dry runs compare converted articles with what is stored
+	New       int           `json:"new"`
+	Changed   int           `json:"changed"`
+	Unchanged int           `json:"unchanged"`
+	Diffs     []ArticleDiff `json:"diffs,omitempty"`
+
+	Duration time.Duration `json:"duration"`
+}
+
+// Article diff states
+const (
+	ArticleDiffNew     = "new"
+	ArticleDiffChanged = "changed"
+)
+
+// ArticleDiff is how one re-ingested article differs from the stored row
+type ArticleDiff struct {
+	ExternalID string        `json:"external_id"`
+	Title      string        `json:"title"`
+	Status     string        `json:"status"`
+	Changes    []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange is one differing article field
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
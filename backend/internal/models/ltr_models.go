@@ -0,0 +1,147 @@
+// internal/models/ltr_models.go
+// GoNews - Learning-to-Rank Models
+// Feed/search impressions, trained ranking weight versions and training job results
+
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Surfaces that log ranking impressions
+const (
+	ImpressionSurfaceNewsFeed     = FeedTypeNewsFeed
+	ImpressionSurfacePersonalized = FeedTypePersonalized
+	ImpressionSurfaceSearch       = "search"
+)
+
+// Ranking model lifecycle states
+const (
+	RankingModelCandidate  = "candidate"   // Trained, not serving
+	RankingModelActive     = "active"      // Serving to rollout_percent of subjects
+	RankingModelRejected   = "rejected"    // Failed the publish guards
+	RankingModelSuperseded = "superseded"  // Replaced by a newer active model
+	RankingModelRolledBack = "rolled_back" // Withdrawn by an admin
+)
+
+// RankingWeights holds feature name -> weight stored as JSONB
+type RankingWeights map[string]float64
+
+// Scan implements the Scanner interface for RankingWeights
+func (w *RankingWeights) Scan(value interface{}) error {
+	if value == nil {
+		*w = RankingWeights{}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, w)
+	case string:
+		return json.Unmarshal([]byte(v), w)
+	default:
+		*w = RankingWeights{}
+		return nil
+	}
+}
+
+// Value implements the driver.Valuer interface for RankingWeights
+func (w RankingWeights) Value() (driver.Value, error) {
+	if w == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(w)
+}
+
+// RankingImpression records one article shown at a position in a feed or search response
+type RankingImpression struct {
+	RequestID  string     `db:"request_id"`
+	SubjectKey string     `db:"subject_key"`
+	UserID     *uuid.UUID `db:"user_id"`
+	Surface    string     `db:"surface"`
+	Query      *string    `db:"query"`
+	ArticleID  int        `db:"article_id"`
+	Position   int        `db:"position"`
+}
+
+// RankingClick joins a read back onto the subject's most recent impression of the article
+type RankingClick struct {
+	SubjectKey   string
+	ArticleID    int
+	DwellSeconds int
+}
+
+// ImpressionRecord is an impression loaded for training, with its read outcome
+type ImpressionRecord struct {
+	RequestID    string     `db:"request_id"`
+	Surface      string     `db:"surface"`
+	ArticleID    int        `db:"article_id"`
+	Position     int        `db:"position"`
+	ShownAt      time.Time  `db:"shown_at"`
+	ClickedAt    *time.Time `db:"clicked_at"`
+	DwellSeconds int        `db:"dwell_seconds"`
+}
+
+// RankingModel is one trained set of ranking weights
+type RankingModel struct {
+	ID               int            `json:"id" db:"id"`
+	Status           string         `json:"status" db:"status"`
+	Weights          RankingWeights `json:"weights" db:"weights"`
+	BaselineWeights  RankingWeights `json:"baseline_weights" db:"baseline_weights"`
+	RolloutPercent   int            `json:"rollout_percent" db:"rollout_percent"`
+	TrainingPairs    int            `json:"training_pairs" db:"training_pairs"`
+	HoldoutPairs     int            `json:"holdout_pairs" db:"holdout_pairs"`
+	TrainAccuracy    float64        `json:"train_accuracy" db:"train_accuracy"`
+	HoldoutAccuracy  float64        `json:"holdout_accuracy" db:"holdout_accuracy"`
+	BaselineAccuracy float64        `json:"baseline_accuracy" db:"baseline_accuracy"`
+	Notes            *string        `json:"notes,omitempty" db:"notes"`
+	CreatedAt        time.Time      `json:"created_at" db:"created_at"`
+	ActivatedAt      *time.Time     `json:"activated_at,omitempty" db:"activated_at"`
+	RolledBackAt     *time.Time     `json:"rolled_back_at,omitempty" db:"rolled_back_at"`
+}
+
+// ImpressionStats summarizes logged impressions over a window
+type ImpressionStats struct {
+	Impressions int     `json:"impressions" db:"impressions"`
+	Requests    int     `json:"requests" db:"requests"`
+	Clicks      int     `json:"clicks" db:"clicks"`
+	CTR         float64 `json:"ctr" db:"-"`
+}
+
+// LTRJobResult summarizes one run of the training job
+type LTRJobResult struct {
+	ModelID          int                `json:"model_id,omitempty"`
+	Status           string             `json:"status"`
+	Impressions      int                `json:"impressions"`
+	Requests         int                `json:"requests"`
+	TrainingPairs    int                `json:"training_pairs"`
+	HoldoutPairs     int                `json:"holdout_pairs"`
+	TrainAccuracy    float64            `json:"train_accuracy"`
+	HoldoutAccuracy  float64            `json:"holdout_accuracy"`
+	BaselineAccuracy float64            `json:"baseline_accuracy"`
+	Weights          map[string]float64 `json:"weights,omitempty"`
+	Reason           string             `json:"reason,omitempty"`
+	WindowDays       int                `json:"window_days"`
+	Duration         time.Duration      `json:"duration"`
+	CompletedAt      time.Time          `json:"completed_at"`
+}
+
+// LTRStatusResponse is the admin overview of learning-to-rank
+type LTRStatusResponse struct {
+	Enabled        bool               `json:"enabled"`
+	ServingEnabled bool               `json:"serving_enabled"`
+	ActiveModel    *RankingModel      `json:"active_model,omitempty"`
+	CurrentWeights map[string]float64 `json:"current_weights"`
+	Impressions    *ImpressionStats   `json:"impressions,omitempty"`
+	LastJob        *LTRJobResult      `json:"last_job,omitempty"`
+	LastJobError   string             `json:"last_job_error,omitempty"`
+}
+
+// UpdateRolloutRequest changes the share of subjects served a model
+type UpdateRolloutRequest struct {
+	RolloutPercent int `json:"rollout_percent"`
+}
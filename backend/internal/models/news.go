@@ -54,6 +54,22 @@ type Article struct {
 	ReadingTimeMinutes int            `json:"reading_time_minutes" db:"reading_time_minutes"`
 	Tags               pq.StringArray `json:"tags" db:"tags"` // Enhanced: GDELT provides themes/organizations
 
+	// Language detected at ingest (ISO 639-1); non-English articles also keep their words,
+	// split by script, for full-text search
+	Language     string         `json:"language" db:"language"`
+	SearchTokens pq.StringArray `json:"-" db:"search_tokens"`
+
+	// Extractive summary of the text (TL;DR); summaries likely to be useless are flagged so
+	// clients can fall back to the description
+	Summary           *string `json:"summary,omitempty" db:"summary"`
+	SummaryLowQuality bool    `json:"summary_low_quality" db:"summary_low_quality"`
+
+	// Clickbait and filler score (1 is clean, NULL until scored) with the signals that lowered
+	// it, and whether the article is explicit or graphic; applied by readers' content filter level
+	QualityScore *float64       `json:"quality_score,omitempty" db:"quality_score"`
+	QualityFlags pq.StringArray `json:"quality_flags,omitempty" db:"quality_flags"`
+	IsExplicit   bool           `json:"is_explicit" db:"is_explicit"`
+
 	// NEW: GDELT-specific fields (stored as metadata)
 	GDELTTone          *float64       `json:"gdelt_tone,omitempty" db:"gdelt_tone"`                   // GDELT tone score
 	GDELTThemes        pq.StringArray `json:"gdelt_themes,omitempty" db:"gdelt_themes"`               // GDELT themes
@@ -61,6 +77,16 @@ type Article struct {
 	GDELTPersons       pq.StringArray `json:"gdelt_persons,omitempty" db:"gdelt_persons"`             // GDELT persons
 	GDELTLocations     pq.StringArray `json:"gdelt_locations,omitempty" db:"gdelt_locations"`         // GDELT locations
 
+	// How the category was assigned and the classifier's confidence in it
+	CategorySource     *string        `json:"category_source,omitempty" db:"category_source"`
+	CategoryConfidence *float64       `json:"category_confidence,omitempty" db:"category_confidence"`
+	CategoryLabels     CategoryScores `json:"category_labels,omitempty" db:"category_labels"` // Top and secondary categories
+
+	// Content fingerprints for cross-batch deduplication
+	NormalizedURL *string `json:"-" db:"normalized_url"`
+	ContentHash   *string `json:"-" db:"content_hash"`
+	SimHash       *int64  `json:"-" db:"simhash"` // 64-bit SimHash stored as a signed BIGINT
+
 	// SEO and metadata
 	MetaTitle       *string `json:"meta_title" db:"meta_title"`
 	MetaDescription *string `json:"meta_description" db:"meta_description"`
@@ -76,6 +102,32 @@ type Article struct {
 	// Related data (loaded via joins)
 	Category     *Category `json:"category,omitempty"`
 	IsBookmarked *bool     `json:"is_bookmarked,omitempty"`
+
+	// Other outlets whose copies were merged into this article
+	AlsoReportedBy []ArticleSource `json:"also_reported_by,omitempty" db:"-"`
+
+	// Story this article stands in for when a feed shows one card per story
+	Story *StoryCard `json:"story,omitempty" db:"-"`
+
+	// Indian states, districts and cities the article is about, most relevant first
+	Locations []ArticleLocation `json:"locations,omitempty" db:"-"`
+
+	// Current read and mention velocity, when the article is active enough to be tracked
+	Trend *Trend `json:"trend,omitempty" db:"-"`
+
+	// Set while the article's story is breaking news
+	Breaking *BreakingBadge `json:"breaking,omitempty" db:"-"`
+}
+
+// ArticleSource is a duplicate from another outlet, kept when it was merged into a canonical article
+type ArticleSource struct {
+	ID          int       `json:"-" db:"id"`
+	ArticleID   int       `json:"-" db:"article_id"`
+	Source      string    `json:"source" db:"source"`
+	URL         string    `json:"url" db:"url"`
+	ExternalID  *string   `json:"external_id,omitempty" db:"external_id"`
+	Title       *string   `json:"title,omitempty" db:"title"`
+	PublishedAt time.Time `json:"published_at" db:"published_at"`
 }
 
 // Bookmark represents a user's bookmarked article
@@ -150,6 +202,34 @@ type CacheMetadata struct {
 	AccessCount  int       `json:"access_count" db:"access_count"`
 }
 
+// ArticleFingerprint is the stored fingerprint of one article, loaded to warm the dedup index
+type ArticleFingerprint struct {
+	ID            int       `db:"id"`
+	ExternalID    *string   `db:"external_id"`
+	Title         string    `db:"title"`
+	NormalizedURL *string   `db:"normalized_url"`
+	ContentHash   *string   `db:"content_hash"`
+	SimHash       *int64    `db:"simhash"`
+	PublishedAt   time.Time `db:"published_at"`
+}
+
+// SummaryCandidate is the text of a stored article that has no summary yet
+type SummaryCandidate struct {
+	ID          int     `db:"id"`
+	Title       string  `db:"title"`
+	Description *string `db:"description"`
+	Content     *string `db:"content"`
+	FullText    *string `db:"full_text"`
+}
+
+// SummaryBackfillReport counts the summaries written for previously stored articles
+type SummaryBackfillReport struct {
+	Articles   int           `json:"articles"`
+	FromFull   int           `json:"from_full_text"` // Summarized from extracted full text
+	LowQuality int           `json:"low_quality"`
+	Duration   time.Duration `json:"duration"`
+}
+
 // DeduplicationLog tracks duplicate detection results
 type DeduplicationLog struct {
 	ID                   int    `json:"id" db:"id"`
@@ -182,6 +262,7 @@ type NewsFeedRequest struct {
 	OnlyIndian *bool    `json:"only_indian" query:"only_indian"`
 	Featured   *bool    `json:"featured" query:"featured"`
 	Tags       []string `json:"tags" query:"tags"`
+	Group      string   `json:"group" query:"group"` // "story" returns one card per story
 }
 
 // NewsSearchRequest represents a search request
@@ -229,6 +310,13 @@ type NewsFeedResponse struct {
 	Articles   []Article          `json:"articles"`
 	Pagination PaginationResponse `json:"pagination"`
 	Categories []Category         `json:"categories,omitempty"`
+	RankDebug  []RankExplanation  `json:"rank_debug,omitempty"` // Only populated with ?debug=rank
+
+	// Experiment key -> variant key the caller was bucketed into
+	Experiments map[string]string `json:"experiments,omitempty"`
+
+	// Identifies the logged impressions of this response (learning-to-rank)
+	ImpressionID string `json:"impression_id,omitempty"`
 }
 
 // NewsSearchResponse represents search results
@@ -617,18 +705,10 @@ func (a *Article) GetEstimatedReadingTime() int {
 	return 1
 }
 
-// IsTrending checks if article is trending (enhanced with GDELT data)
+// IsTrending reports whether the trending service found the article's reads and mentions
+// bursting above its baseline
 func (a *Article) IsTrending() bool {
-	// Enhanced trending logic with GDELT sentiment
-	dayAgo := time.Now().Add(-24 * time.Hour)
-	baseCondition := a.ViewCount > 100 && a.PublishedAt.After(dayAgo)
-
-	// Boost for positive GDELT sentiment
-	if a.GDELTTone != nil && *a.GDELTTone > 2.0 {
-		return baseCondition || (a.ViewCount > 50 && a.PublishedAt.After(dayAgo))
-	}
-
-	return baseCondition
+	return a.Trend != nil && a.Trend.Bursting
 }
 
 // HasGDELTData checks if article has GDELT-enhanced data
@@ -843,38 +923,6 @@ func IsIndianContentByKeywords(title, description, source string) bool {
 	return false
 }
 
-// CalculateGDELTEnhancedRelevance calculates relevance score enhanced with GDELT data
-func CalculateGDELTEnhancedRelevance(article *Article) float64 {
-	score := article.RelevanceScore
-
-	// Boost for GDELT data availability
-	if article.HasGDELTData() {
-		score += 0.1
-	}
-
-	// Boost for multiple GDELT themes
-	if len(article.GDELTThemes) > 3 {
-		score += 0.1
-	}
-
-	// Boost for organization mentions
-	if len(article.GDELTOrganizations) > 1 {
-		score += 0.05
-	}
-
-	// Boost for location specificity
-	if len(article.GDELTLocations) > 0 {
-		score += 0.05
-	}
-
-	// Cap at 1.0
-	if score > 1.0 {
-		score = 1.0
-	}
-
-	return score
-}
-
 // GetUpdatedCacheTTLConfigs returns updated cache TTL configurations with GDELT integration
 func GetUpdatedCacheTTLConfigs() map[string]CacheTTLConfig {
 	return map[string]CacheTTLConfig{
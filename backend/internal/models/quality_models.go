@@ -0,0 +1,61 @@
+// internal/models/quality_models.go
+// GoNews - Content Quality Models
+// Readers' content filter levels and the policies they apply to article quality scores
+
+package models
+
+import "time"
+
+// Content filter levels readers choose in their preferences
+const (
+	ContentFilterNone     = "none"     // Everything is shown
+	ContentFilterModerate = "moderate" // The worst clickbait is hidden; low quality and explicit articles sink
+	ContentFilterStrict   = "strict"   // Low quality and explicit articles are hidden
+)
+
+// ContentFilterPolicy is what a content filter level does to feeds and search results.
+// Articles without a quality score count as clean.
+type ContentFilterPolicy struct {
+	Level          string  `json:"level"`
+	HideBelow      float64 `json:"hide_below"`   // Quality score below which articles are left out; 0 keeps all
+	DemoteBelow    float64 `json:"demote_below"` // Quality score below which articles rank after the rest
+	HideExplicit   bool    `json:"hide_explicit"`
+	DemoteExplicit bool    `json:"demote_explicit"`
+}
+
+// Hides reports whether the policy leaves an article out
+func (p ContentFilterPolicy) Hides(article *Article) bool {
+	if p.HideExplicit && article.IsExplicit {
+		return true
+	}
+	return article.QualityScore != nil && *article.QualityScore < p.HideBelow
+}
+
+// Demotes reports whether the policy ranks an article after the others
+func (p ContentFilterPolicy) Demotes(article *Article) bool {
+	if p.DemoteExplicit && article.IsExplicit {
+		return true
+	}
+	return article.QualityScore != nil && *article.QualityScore < p.DemoteBelow
+}
+
+// Filters reports whether the policy changes results at all
+func (p ContentFilterPolicy) Filters() bool {
+	return p.HideBelow > 0 || p.DemoteBelow > 0 || p.HideExplicit || p.DemoteExplicit
+}
+
+// QualityCandidate is the text of a stored article that has no quality score yet
+type QualityCandidate struct {
+	ID          int     `db:"id"`
+	Title       string  `db:"title"`
+	Description *string `db:"description"`
+	Content     *string `db:"content"`
+}
+
+// QualityBackfillReport counts the quality scores written for previously stored articles
+type QualityBackfillReport struct {
+	Articles   int           `json:"articles"`
+	LowQuality int           `json:"low_quality"`
+	Explicit   int           `json:"explicit"`
+	Duration   time.Duration `json:"duration"`
+}
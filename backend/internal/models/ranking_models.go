@@ -0,0 +1,92 @@
+// internal/models/ranking_models.go
+// GoNews - Feed Ranking Models
+// Ranking pipeline score breakdowns and diversity re-ranking explanations
+
+package models
+
+import "time"
+
+// Feed types with their own diversity tuning
+const (
+	FeedTypeNewsFeed     = "news_feed"
+	FeedTypePersonalized = "personalized"
+)
+
+// RankDebugMode is the value of the debug query parameter that enables placement explanations
+const RankDebugMode = "rank"
+
+// RankExplanation explains why an article was placed at its position in a re-ranked feed
+type RankExplanation struct {
+	Position         int     `json:"position"`
+	OriginalPosition int     `json:"original_position"`
+	ArticleID        int     `json:"article_id"`
+	Title            string  `json:"title"`
+	Source           string  `json:"source"`
+	BaseScore        float64 `json:"base_score"`
+	MMRScore         float64 `json:"mmr_score"`
+	Redundancy       float64 `json:"redundancy"`
+	TitleSimilarity  float64 `json:"title_similarity"`
+	ThemeOverlap     float64 `json:"theme_overlap"`
+	SourceRun        int     `json:"source_run"`
+	SourcePenalty    float64 `json:"source_penalty"`
+	MostSimilarToID  *int    `json:"most_similar_to_id,omitempty"`
+	Lambda           float64 `json:"lambda"`
+	Reason           string  `json:"reason"`
+
+	// Ranking pipeline features behind BaseScore
+	Features []FeatureScore `json:"features,omitempty"`
+}
+
+// Ranking pipeline feature names
+const (
+	RankingFeatureFreshness       = "freshness"
+	RankingFeatureIndiaRelevance  = "india_relevance"
+	RankingFeatureSourceTrust     = "source_trust"
+	RankingFeatureEngagement      = "engagement"
+	RankingFeaturePersonalization = "personalization"
+	RankingFeatureTopicMatch      = "topic_match"
+)
+
+// FeatureScore is one feature's contribution to an article's ranking score
+type FeatureScore struct {
+	Name         string  `json:"name"`
+	Value        float64 `json:"value"`  // Raw feature value (0.0 to 1.0)
+	Weight       float64 `json:"weight"` // Configured weight
+	Contribution float64 `json:"contribution"`
+	Applicable   bool    `json:"applicable"` // Inapplicable features are excluded from normalisation
+	Detail       string  `json:"detail,omitempty"`
+}
+
+// ScoreBreakdown explains how an article's ranking score was assembled
+type ScoreBreakdown struct {
+	ArticleID int            `json:"article_id"`
+	Title     string         `json:"title"`
+	Source    string         `json:"source"`
+	Score     float64        `json:"score"`
+	Stored    float64        `json:"stored_relevance_score"`
+	Features  []FeatureScore `json:"features"`
+}
+
+// RankingFeatureInfo describes a feature extractor and its current weight
+type RankingFeatureInfo struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight"`
+}
+
+// RankingExplainResponse is the admin view of a ranked candidate list
+type RankingExplainResponse struct {
+	Category   string               `json:"category"`
+	Features   []RankingFeatureInfo `json:"features"`
+	Breakdowns []ScoreBreakdown     `json:"breakdowns"`
+	ScoredAt   time.Time            `json:"scored_at"`
+}
+
+// RankingRescoreResult summarises a bulk re-scoring of stored relevance scores
+type RankingRescoreResult struct {
+	Category      string  `json:"category"`
+	ArticlesCount int     `json:"articles_count"`
+	AverageBefore float64 `json:"average_before"`
+	AverageAfter  float64 `json:"average_after"`
+	DurationMs    int64   `json:"duration_ms"`
+}
@@ -0,0 +1,65 @@
+// internal/models/recommendation_models.go
+// GoNews Recommendation Models - Item-Item Collaborative Filtering ("People also read")
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===============================
+// ENTITY MODELS (Database)
+// ===============================
+
+// ArticleNeighbor represents a precomputed "people also read" neighbour of an article
+type ArticleNeighbor struct {
+	ArticleID         int       `json:"article_id" db:"article_id"`
+	NeighborArticleID int       `json:"neighbor_article_id" db:"neighbor_article_id"`
+	Similarity        float64   `json:"similarity" db:"similarity"`
+	CoOccurrenceCount int       `json:"co_occurrence_count" db:"co_occurrence_count"`
+	Rank              int       `json:"rank" db:"rank"`
+	ComputedAt        time.Time `json:"computed_at" db:"computed_at"`
+}
+
+// UserInteraction is a single weighted user-article signal (read or bookmark)
+type UserInteraction struct {
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	ArticleID    int       `json:"article_id" db:"article_id"`
+	Weight       float64   `json:"weight" db:"weight"`
+	InteractedAt time.Time `json:"interacted_at" db:"interacted_at"`
+}
+
+// ===============================
+// RESPONSE MODELS
+// ===============================
+
+// Recommendation sources reported in RecommendationResponse
+const (
+	RecommendationSourceCollaborative = "collaborative"
+	RecommendationSourceMixed         = "collaborative_with_trending"
+	RecommendationSourceTrending      = "trending_fallback"
+)
+
+// RecommendationResponse represents the "Recommended for you" feed response
+type RecommendationResponse struct {
+	Articles     []Article          `json:"articles"`
+	Pagination   PaginationResponse `json:"pagination"`
+	Source       string             `json:"source"`
+	SeedArticles int                `json:"seed_articles"`
+	GeneratedAt  time.Time          `json:"generated_at"`
+}
+
+// RecommendationJobResult summarizes one run of the offline neighbour computation
+type RecommendationJobResult struct {
+	Interactions     int           `json:"interactions"`
+	Users            int           `json:"users"`
+	Articles         int           `json:"articles"`
+	CandidatePairs   int           `json:"candidate_pairs"`
+	NeighborsStored  int           `json:"neighbors_stored"`
+	WindowDays       int           `json:"window_days"`
+	Duration         time.Duration `json:"duration"`
+	CompletedAt      time.Time     `json:"completed_at"`
+	SkippedNoSignals bool          `json:"skipped_no_signals"`
+}
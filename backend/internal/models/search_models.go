@@ -26,6 +26,7 @@ type SearchRequest struct {
 	Tags            []string `json:"tags,omitempty"`
 	IsIndianContent *bool    `json:"is_indian_content,omitempty"`
 	IsFeatured      *bool    `json:"is_featured,omitempty"`
+	Languages       []string `json:"languages,omitempty"` // ISO 639-1 codes; the reader's languages when empty
 
 	// Score filtering
 	MinRelevanceScore *float64 `json:"min_relevance_score,omitempty"`
@@ -105,6 +106,7 @@ type SearchResponse struct {
 	SearchID         string `json:"search_id"`
 	CacheHit         bool   `json:"cache_hit"`
 	ProcessingTimeMs int64  `json:"processing_time_ms"`
+	ImpressionID     string `json:"impression_id,omitempty"` // Logged result positions (learning-to-rank)
 
 	// Pagination
 	Pagination *PaginationDTO `json:"pagination"`
@@ -0,0 +1,77 @@
+// internal/models/story_models.go
+// GoNews - Story Cluster Models
+// Stories group every article about one event; their pages show the articles as a timeline
+
+package models
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// FeedGroupStory is the feed "group" parameter value that collapses articles into one card per story
+const FeedGroupStory = "story"
+
+// Story is a cluster of articles about the same event. The headline is the lead
+// (most relevant) article's title.
+type Story struct {
+	ID               int            `json:"id" db:"id"`
+	Headline         string         `json:"headline" db:"headline"`
+	LeadArticleID    *int           `json:"lead_article_id" db:"lead_article_id"`
+	CategoryID       *int           `json:"category_id" db:"category_id"`
+	ArticleCount     int            `json:"article_count" db:"article_count"`
+	SourceCount      int            `json:"source_count" db:"source_count"`
+	Themes           pq.StringArray `json:"themes" db:"themes"`
+	Entities         pq.StringArray `json:"entities" db:"entities"`
+	FirstPublishedAt time.Time      `json:"first_published_at" db:"first_published_at"`
+	LastPublishedAt  time.Time      `json:"last_published_at" db:"last_published_at"`
+	CreatedAt        time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at" db:"updated_at"`
+	IsBreaking       bool           `json:"is_breaking" db:"is_breaking"`
+	BreakingAt       *time.Time     `json:"breaking_at,omitempty" db:"breaking_at"`
+	BreakingReason   *string        `json:"breaking_reason,omitempty" db:"breaking_reason"`
+
+	LeadArticle *Article `json:"lead_article,omitempty" db:"-"`
+}
+
+// StoryCard is the story summary attached to an article that stands in for its story in a feed
+type StoryCard struct {
+	ID              int       `json:"id" db:"story_id"`
+	Headline        string    `json:"headline" db:"headline"`
+	ArticleCount    int       `json:"article_count" db:"article_count"`
+	SourceCount     int       `json:"source_count" db:"source_count"`
+	LastPublishedAt time.Time `json:"last_published_at" db:"last_published_at"`
+}
+
+// StoryTimeline is a story and its articles, oldest first
+type StoryTimeline struct {
+	Story    *Story     `json:"story"`
+	Articles []*Article `json:"articles"`
+}
+
+// StoryArticle is the part of an article the clustering job compares. StoryID is set for
+// articles already in a story.
+type StoryArticle struct {
+	ArticleID          int            `db:"id"`
+	StoryID            *int           `db:"story_id"`
+	Title              string         `db:"title"`
+	Source             string         `db:"source"`
+	CategoryID         *int           `db:"category_id"`
+	RelevanceScore     float64        `db:"relevance_score"`
+	SimHash            *int64         `db:"simhash"`
+	GDELTThemes        pq.StringArray `db:"gdelt_themes"`
+	GDELTPersons       pq.StringArray `db:"gdelt_persons"`
+	GDELTOrganizations pq.StringArray `db:"gdelt_organizations"`
+	GDELTLocations     pq.StringArray `db:"gdelt_locations"`
+	PublishedAt        time.Time      `db:"published_at"`
+}
+
+// StoryClusterRun summarizes one clustering pass
+type StoryClusterRun struct {
+	Articles   int   `json:"articles"`
+	Joined     int   `json:"joined"`
+	Created    int   `json:"created"`
+	Stories    int   `json:"active_stories"`
+	DurationMs int64 `json:"duration_ms"`
+}
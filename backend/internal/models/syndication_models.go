@@ -0,0 +1,83 @@
+// internal/models/syndication_models.go
+// GoNews - Outbound Feed Models
+// Published RSS/Atom/JSON feeds, private feed tokens and saved searches
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Outbound feed formats, named after their URL extension
+const (
+	FeedFormatRSS  = "rss"
+	FeedFormatAtom = "atom"
+	FeedFormatJSON = "json"
+)
+
+// FeedFormats lists the published formats
+var FeedFormats = []string{FeedFormatRSS, FeedFormatAtom, FeedFormatJSON}
+
+// SyndicationFeed is one published feed before rendering
+type SyndicationFeed struct {
+	Title       string
+	Description string
+	Path        string // Path without the format extension, e.g. /feeds/category/politics
+	Private     bool   // Token feeds are not cached publicly or announced to the hub
+	Articles    []*Article
+	UpdatedAt   time.Time
+}
+
+// RenderedFeed is a feed document with its HTTP caching validators
+type RenderedFeed struct {
+	Body         []byte
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+	SelfURL      string
+	Private      bool
+}
+
+// FeedToken grants access to a user's private feeds
+type FeedToken struct {
+	ID          int        `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Name        string     `json:"name" db:"name"`
+	TokenHash   string     `json:"-" db:"token_hash"`
+	TokenPrefix string     `json:"token_prefix" db:"token_prefix"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// CreateFeedTokenRequest names a new private feed token
+type CreateFeedTokenRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+// FeedTokenCreatedResponse carries the token secret, shown only once
+type FeedTokenCreatedResponse struct {
+	Token  *FeedToken        `json:"token"`
+	Secret string            `json:"secret"`
+	Feeds  map[string]string `json:"feeds"`
+}
+
+// SavedSearch is a stored query published as a private feed
+type SavedSearch struct {
+	ID           int       `json:"id" db:"id"`
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	Name         string    `json:"name" db:"name"`
+	Query        string    `json:"query" db:"query"`
+	CategorySlug *string   `json:"category_slug,omitempty" db:"category_slug"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateSavedSearchRequest stores a search for feed publishing
+type CreateSavedSearchRequest struct {
+	Name         string  `json:"name" validate:"required,min=1,max=100"`
+	Query        string  `json:"query" validate:"required,min=2,max=500"`
+	CategorySlug *string `json:"category_slug,omitempty"`
+}
@@ -0,0 +1,40 @@
+// internal/models/tag_models.go
+// GoNews - Tag Models
+// Normalized article tags, their catalogue and the articles listed under one tag
+
+package models
+
+import "time"
+
+// Tag is a catalogue entry for a normalized tag
+type Tag struct {
+	ID         int       `json:"id" db:"id"`
+	Slug       string    `json:"slug" db:"slug"`
+	Name       string    `json:"name" db:"name"`
+	UsageCount int       `json:"usage_count" db:"usage_count"` // Articles carrying the tag
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at" db:"last_used_at"`
+}
+
+// TagArticlesResponse is one page of the articles carrying a tag, newest first
+type TagArticlesResponse struct {
+	Tag      *Tag       `json:"tag"`
+	Articles []*Article `json:"articles"`
+	Page     int        `json:"page"`
+	Limit    int        `json:"limit"`
+	HasMore  bool       `json:"has_more"`
+}
+
+// ExtractedKeyword is a key phrase found in article text
+type ExtractedKeyword struct {
+	Phrase string  `json:"phrase"`
+	Score  float64 `json:"score"`
+}
+
+// TagPreview shows how an article would be tagged
+type TagPreview struct {
+	Keywords     []ExtractedKeyword `json:"keywords"`
+	ProviderTags []string           `json:"provider_tags"` // Normalized tags supplied with the article
+	Tags         []string           `json:"tags"`          // What would be stored
+	CorpusSize   int                `json:"corpus_size"`   // Articles the keyword IDF was computed over
+}
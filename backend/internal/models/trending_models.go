@@ -0,0 +1,57 @@
+// internal/models/trending_models.go
+// GoNews - Trending Models
+// Read and mention velocity of articles, entities and tags against their own baselines
+
+package models
+
+import "time"
+
+// Trend subject types
+const (
+	TrendSubjectArticle = "article"
+	TrendSubjectEntity  = "entity"
+	TrendSubjectTag     = "tag"
+)
+
+// Trend is how fast an article, entity or tag is being read and reported compared with its
+// usual rate. Activity is reads plus new articles weighted by TRENDING_MENTION_WEIGHT.
+type Trend struct {
+	Type             string  `json:"type"`
+	Key              string  `json:"key"` // Article ID, entity canonical ID or tag slug
+	Name             string  `json:"name"`
+	EntityType       string  `json:"entity_type,omitempty"`
+	Reads            int     `json:"reads"`             // Reads in the current window
+	Mentions         int     `json:"mentions"`          // New articles in the window; merged duplicates for an article
+	Velocity         float64 `json:"velocity"`          // Activity per hour in the current window
+	BaselineVelocity float64 `json:"baseline_velocity"` // Usual activity per hour
+	GrowthRate       float64 `json:"growth_rate"`       // Velocity over the baseline, less one: 2 is three times the usual rate
+	BurstScore       float64 `json:"burst_score"`       // Standard deviations above the baseline
+	Bursting         bool    `json:"bursting"`
+}
+
+// TrendingResponse lists the articles, entities and tags bursting now, strongest burst first
+type TrendingResponse struct {
+	Articles      []*Article `json:"articles"`
+	Entities      []Trend    `json:"entities"`
+	Tags          []Trend    `json:"tags"`
+	WindowMinutes int        `json:"window_minutes"`
+	BaselineHours int        `json:"baseline_hours"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// TrendCounts is a subject's activity in the current window and in its baseline
+type TrendCounts struct {
+	Key              string  `db:"subject_key"`
+	Name             string  `db:"subject_name"`
+	Reads            int     `db:"reads"`
+	Mentions         int     `db:"mentions"`
+	BaselineActivity float64 `db:"baseline_activity"` // Sum over the baseline buckets
+	BaselineSquares  float64 `db:"baseline_squares"`  // Sum of squared bucket activity, for the variance
+}
+
+// ArticleReadCount is the reads of an article in one time bucket
+type ArticleReadCount struct {
+	ArticleID int       `db:"article_id"`
+	Bucket    time.Time `db:"bucket"`
+	Reads     int       `db:"reads"`
+}
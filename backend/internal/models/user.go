@@ -34,6 +34,7 @@ type User struct {
 // UserPreferences represents user content preferences
 type UserPreferences struct {
 	PreferredLanguage      string   `json:"preferred_language"`
+	RegionalLanguages      []string `json:"regional_languages,omitempty"` // Further languages to read news in
 	DefaultCategory        string   `json:"default_category"`
 	ArticlesPerPage        int      `json:"articles_per_page"`
 	PreferredSources       []string `json:"preferred_sources"`
@@ -45,6 +46,15 @@ type UserPreferences struct {
 	RefreshIntervalMinutes int      `json:"refresh_interval_minutes"`
 }
 
+// Languages returns the preferred language followed by the regional ones
+func (up UserPreferences) Languages() []string {
+	languages := []string{}
+	if up.PreferredLanguage != "" {
+		languages = append(languages, up.PreferredLanguage)
+	}
+	return append(languages, up.RegionalLanguages...)
+}
+
 // NotificationSettings represents user notification preferences
 type NotificationSettings struct {
 	PushEnabled        bool     `json:"push_enabled"`
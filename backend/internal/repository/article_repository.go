@@ -86,28 +86,53 @@ func (r *ArticleRepository) SaveArticles(articles []*models.Article) error {
 	query := `
 		INSERT INTO articles (
 			external_id, title, description, content, url, image_url,
-			source, author, category_id, published_at, fetched_at,
+			source, author, category_id, category_source, category_confidence, category_labels,
+			published_at, fetched_at,
 			is_indian_content, relevance_score, sentiment_score,
-			word_count, reading_time_minutes, tags,
+			word_count, reading_time_minutes, tags, language, search_tokens, summary, summary_low_quality,
+			quality_score, quality_flags, is_explicit,
+			gdelt_tone, gdelt_themes, gdelt_organizations, gdelt_persons, gdelt_locations,
+			normalized_url, content_hash, simhash,
 			meta_title, meta_description, is_active, is_featured,
 			created_at, updated_at
 		) VALUES (
 			:external_id, :title, :description, :content, :url, :image_url,
-			:source, :author, :category_id, :published_at, :fetched_at,
+			:source, :author, :category_id, :category_source, :category_confidence, :category_labels,
+			:published_at, :fetched_at,
 			:is_indian_content, :relevance_score, :sentiment_score,
-			:word_count, :reading_time_minutes, :tags,
+			:word_count, :reading_time_minutes, :tags, :language, :search_tokens, :summary, :summary_low_quality,
+			:quality_score, :quality_flags, :is_explicit,
+			:gdelt_tone, :gdelt_themes, :gdelt_organizations, :gdelt_persons, :gdelt_locations,
+			:normalized_url, :content_hash, :simhash,
 			:meta_title, :meta_description, :is_active, :is_featured,
 			:created_at, :updated_at
 		) ON CONFLICT (external_id) DO UPDATE SET
 			title = EXCLUDED.title,
 			description = EXCLUDED.description,
 			content = EXCLUDED.content,
-			image_url = EXCLUDED.image_url,
+			image_url = COALESCE(EXCLUDED.image_url, articles.image_url),
 			relevance_score = EXCLUDED.relevance_score,
 			sentiment_score = EXCLUDED.sentiment_score,
-			word_count = EXCLUDED.word_count,
-			reading_time_minutes = EXCLUDED.reading_time_minutes,
+			-- Extracted full text gives better counts than truncated provider content
+			word_count = CASE WHEN articles.full_text IS NOT NULL THEN articles.word_count ELSE EXCLUDED.word_count END,
+			reading_time_minutes = CASE WHEN articles.full_text IS NOT NULL THEN articles.reading_time_minutes ELSE EXCLUDED.reading_time_minutes END,
 			tags = EXCLUDED.tags,
+			language = EXCLUDED.language,
+			search_tokens = EXCLUDED.search_tokens,
+			-- A summary of extracted full text beats one of provider snippets
+			summary = CASE WHEN articles.full_text IS NOT NULL AND articles.summary IS NOT NULL THEN articles.summary ELSE EXCLUDED.summary END,
+			summary_low_quality = CASE WHEN articles.full_text IS NOT NULL AND articles.summary IS NOT NULL THEN articles.summary_low_quality ELSE EXCLUDED.summary_low_quality END,
+			quality_score = EXCLUDED.quality_score,
+			quality_flags = EXCLUDED.quality_flags,
+			is_explicit = EXCLUDED.is_explicit,
+			gdelt_tone = COALESCE(EXCLUDED.gdelt_tone, articles.gdelt_tone),
+			gdelt_themes = EXCLUDED.gdelt_themes,
+			gdelt_organizations = EXCLUDED.gdelt_organizations,
+			gdelt_persons = EXCLUDED.gdelt_persons,
+			gdelt_locations = EXCLUDED.gdelt_locations,
+			normalized_url = COALESCE(EXCLUDED.normalized_url, articles.normalized_url),
+			content_hash = COALESCE(EXCLUDED.content_hash, articles.content_hash),
+			simhash = COALESCE(EXCLUDED.simhash, articles.simhash),
 			updated_at = EXCLUDED.updated_at`
 
 	// Set timestamps and defaults for all articles
@@ -128,6 +153,14 @@ func (r *ArticleRepository) SaveArticles(articles []*models.Article) error {
 		if article.Tags == nil {
 			article.Tags = []string{}
 		}
+		if article.Language == "" {
+			article.Language = "en"
+		}
+		for _, entities := range []*pq.StringArray{&article.GDELTThemes, &article.GDELTOrganizations, &article.GDELTPersons, &article.GDELTLocations} {
+			if *entities == nil {
+				*entities = pq.StringArray{}
+			}
+		}
 	}
 
 	// Execute batch insert
@@ -136,6 +169,21 @@ func (r *ArticleRepository) SaveArticles(articles []*models.Article) error {
 		return fmt.Errorf("failed to insert articles: %w", err)
 	}
 
+	// Link merged duplicates to their canonical rows
+	if err := saveArticleSources(tx, articles); err != nil {
+		return err
+	}
+
+	// Store the places geo-tagging found
+	if err := saveArticleLocations(tx, articles); err != nil {
+		return err
+	}
+
+	// Link normalized tags to the tag catalogue
+	if err := saveArticleTags(tx, articles); err != nil {
+		return err
+	}
+
 	// Commit transaction
 	err = tx.Commit()
 	if err != nil {
@@ -147,44 +195,40 @@ func (r *ArticleRepository) SaveArticles(articles []*models.Article) error {
 
 // GetRecentArticles retrieves recent articles by category (database-first approach)
 func (r *ArticleRepository) GetRecentArticles(categorySlug string, limit int) ([]*models.Article, error) {
-	var query string
-	var args []interface{}
+	return r.GetRecentArticlesInLanguages(categorySlug, nil, limit)
+}
 
-	if categorySlug == "" || categorySlug == "all" {
-		// Get articles from all categories
-		query = `
-			SELECT 
-				a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
-				a.source, a.author, a.category_id, a.published_at, a.fetched_at,
-				a.is_indian_content, a.relevance_score, a.sentiment_score,
-				a.word_count, a.reading_time_minutes, a.tags,
-				a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
-				a.created_at, a.updated_at,
-				c.name as category_name, c.slug as category_slug
-			FROM articles a
-			LEFT JOIN categories c ON a.category_id = c.id
-			WHERE a.is_active = true
-			ORDER BY a.published_at DESC, a.relevance_score DESC
-			LIMIT $1`
-		args = []interface{}{limit}
-	} else {
-		// Get articles for specific category
-		query = `
-			SELECT 
-				a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
-				a.source, a.author, a.category_id, a.published_at, a.fetched_at,
-				a.is_indian_content, a.relevance_score, a.sentiment_score,
-				a.word_count, a.reading_time_minutes, a.tags,
-				a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
-				a.created_at, a.updated_at,
-				c.name as category_name, c.slug as category_slug
-			FROM articles a
-			LEFT JOIN categories c ON a.category_id = c.id
-			WHERE a.is_active = true AND c.slug = $1
-			ORDER BY a.published_at DESC, a.relevance_score DESC
-			LIMIT $2`
-		args = []interface{}{categorySlug, limit}
+// GetRecentArticlesInLanguages retrieves recent articles by category written in one of the
+// languages (ISO 639-1 codes); no languages means any
+func (r *ArticleRepository) GetRecentArticlesInLanguages(categorySlug string, languages []string, limit int) ([]*models.Article, error) {
+	conditions := []string{"a.is_active = true"}
+	args := []interface{}{}
+
+	if categorySlug != "" && categorySlug != "all" {
+		args = append(args, categorySlug)
+		conditions = append(conditions, fmt.Sprintf("c.slug = $%d", len(args)))
 	}
+	if len(languages) > 0 {
+		args = append(args, pq.Array(languages))
+		conditions = append(conditions, fmt.Sprintf("a.language = ANY($%d)", len(args)))
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT 
+			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
+			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
+			a.is_indian_content, a.relevance_score, a.sentiment_score,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
+			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
+			a.created_at, a.updated_at,
+			c.name as category_name, c.slug as category_slug
+		FROM articles a
+		LEFT JOIN categories c ON a.category_id = c.id
+		WHERE %s
+		ORDER BY a.published_at DESC, a.relevance_score DESC
+		LIMIT $%d`, strings.Join(conditions, " AND "), len(args))
 
 	return r.executeArticleQuery(query, args...)
 }
@@ -196,7 +240,8 @@ func (r *ArticleRepository) GetArticlesByCategory(categorySlug string, limit, of
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -232,7 +277,8 @@ func (r *ArticleRepository) GetArticlesByIDs(ids []int) ([]*models.Article, erro
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -244,6 +290,29 @@ func (r *ArticleRepository) GetArticlesByIDs(ids []int) ([]*models.Article, erro
 	return r.executeArticleQuery(query, pq.Array(ids))
 }
 
+// GetArticlesByIDsInOrder retrieves multiple articles preserving the order of the given IDs
+func (r *ArticleRepository) GetArticlesByIDsInOrder(ids []int) ([]*models.Article, error) {
+	articles, err := r.GetArticlesByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*models.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+
+	ordered := make([]*models.Article, 0, len(articles))
+	for _, id := range ids {
+		if article, exists := byID[id]; exists {
+			ordered = append(ordered, article)
+			delete(byID, id)
+		}
+	}
+
+	return ordered, nil
+}
+
 // ===============================
 // CATEGORY MAPPING (FIXES FRONTEND CATEGORY=3 ISSUE)
 // ===============================
@@ -415,7 +484,8 @@ func (r *ArticleRepository) GetTrendingArticles(hours int, limit int) ([]*models
 			id, external_id, title, description, content, url, image_url,
 			source, author, category_id, published_at, fetched_at,
 			is_indian_content, relevance_score, sentiment_score,
-			word_count, reading_time_minutes, tags,
+			word_count, reading_time_minutes, tags, language,
+			summary, summary_low_quality, quality_score, quality_flags, is_explicit,
 			meta_title, meta_description, is_active, is_featured, view_count,
 			created_at, updated_at, category_name, category_slug
 		FROM trending_articles
@@ -434,7 +504,8 @@ func (r *ArticleRepository) GetPopularArticles(days int, limit int) ([]*models.A
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -460,7 +531,8 @@ func (r *ArticleRepository) GetArticlesBySource(sources []string, limit int) ([]
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -480,7 +552,8 @@ func (r *ArticleRepository) GetIndianArticles(limit int, offset int) ([]*models.
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -664,7 +737,8 @@ func (r *ArticleRepository) executeArticleQuery(query string, args ...interface{
 			&article.ImageURL, &article.Source, &article.Author,
 			&article.CategoryID, &article.PublishedAt, &article.FetchedAt,
 			&article.IsIndianContent, &article.RelevanceScore, &article.SentimentScore,
-			&article.WordCount, &article.ReadingTimeMinutes, pq.Array(&tags),
+			&article.WordCount, &article.ReadingTimeMinutes, pq.Array(&tags), &article.Language,
+			&article.Summary, &article.SummaryLowQuality, &article.QualityScore, &article.QualityFlags, &article.IsExplicit,
 			&article.MetaTitle, &article.MetaDescription,
 			&article.IsActive, &article.IsFeatured, &article.ViewCount,
 			&article.CreatedAt, &article.UpdatedAt,
@@ -696,6 +770,10 @@ func (r *ArticleRepository) executeArticleQuery(query string, args ...interface{
 		return nil, fmt.Errorf("row iteration error: %w", err)
 	}
 
+	if err := r.attachArticleSources(articles); err != nil {
+		return nil, err
+	}
+
 	return articles, nil
 }
 
@@ -706,7 +784,8 @@ func (r *ArticleRepository) GetLatestArticlesByCategory(categoryID int, limit in
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
@@ -719,6 +798,324 @@ func (r *ArticleRepository) GetLatestArticlesByCategory(categoryID int, limit in
 	return r.executeArticleQuery(query, categoryID, limit)
 }
 
+// GetArticlesByExternalIDs returns stored articles keyed by external_id, including inactive ones
+func (r *ArticleRepository) GetArticlesByExternalIDs(externalIDs []string) (map[string]*models.Article, error) {
+	byExternalID := make(map[string]*models.Article, len(externalIDs))
+	if len(externalIDs) == 0 {
+		return byExternalID, nil
+	}
+
+	query := `
+		SELECT 
+			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
+			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
+			a.is_indian_content, a.relevance_score, a.sentiment_score,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
+			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
+			a.created_at, a.updated_at,
+			c.name as category_name, c.slug as category_slug
+		FROM articles a
+		LEFT JOIN categories c ON a.category_id = c.id
+		WHERE a.external_id = ANY($1)`
+
+	articles, err := r.executeArticleQuery(query, pq.Array(externalIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, article := range articles {
+		if article.ExternalID != nil {
+			byExternalID[*article.ExternalID] = article
+		}
+	}
+	return byExternalID, nil
+}
+
+// ===============================
+// ARTICLE SOURCES (MERGED DUPLICATES)
+// ===============================
+
+// saveArticleSources stores each article's AlsoReportedBy entries against its row, found by external_id
+func saveArticleSources(tx *sqlx.Tx, articles []*models.Article) error {
+	query := `
+		INSERT INTO article_sources (article_id, source, url, external_id, title, published_at)
+		SELECT id, $2, $3, $4, $5, $6 FROM articles WHERE external_id = $1
+		ON CONFLICT (article_id, url) DO NOTHING`
+
+	for _, article := range articles {
+		if article.ExternalID == nil {
+			continue
+		}
+		for _, source := range article.AlsoReportedBy {
+			if _, err := tx.Exec(query, *article.ExternalID, source.Source, source.URL,
+				source.ExternalID, source.Title, source.PublishedAt); err != nil {
+				return fmt.Errorf("failed to save article source: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// MergeIntoArticle updates an already stored canonical article after a duplicate was merged into it.
+// Unlike the SaveArticles upsert, the published date only ever moves earlier.
+func (r *ArticleRepository) MergeIntoArticle(article *models.Article) error {
+	if article.ExternalID == nil {
+		return ErrInvalidArticleData
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE articles SET
+			description = :description,
+			content = :content,
+			image_url = COALESCE(:image_url, image_url),
+			published_at = LEAST(published_at, :published_at),
+			word_count = CASE WHEN full_text IS NOT NULL THEN word_count ELSE :word_count END,
+			reading_time_minutes = CASE WHEN full_text IS NOT NULL THEN reading_time_minutes ELSE :reading_time_minutes END,
+			tags = :tags,
+			gdelt_themes = :gdelt_themes,
+			gdelt_organizations = :gdelt_organizations,
+			gdelt_persons = :gdelt_persons,
+			gdelt_locations = :gdelt_locations,
+			updated_at = NOW()
+		WHERE external_id = :external_id`
+
+	result, err := tx.NamedExec(query, article)
+	if err != nil {
+		return fmt.Errorf("failed to merge article: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrArticleNotFound
+	}
+
+	if err := saveArticleSources(tx, []*models.Article{article}); err != nil {
+		return err
+	}
+	if err := saveArticleTags(tx, []*models.Article{article}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetArticleSources returns the other outlets reporting each article, earliest first
+func (r *ArticleRepository) GetArticleSources(articleIDs []int) (map[int][]models.ArticleSource, error) {
+	sources := make(map[int][]models.ArticleSource)
+	if len(articleIDs) == 0 {
+		return sources, nil
+	}
+
+	var rows []models.ArticleSource
+	query := `
+		SELECT id, article_id, source, url, external_id, title, published_at
+		FROM article_sources
+		WHERE article_id = ANY($1)
+		ORDER BY published_at ASC, id ASC`
+
+	if err := r.db.Select(&rows, query, pq.Array(articleIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get article sources: %w", err)
+	}
+
+	for _, row := range rows {
+		sources[row.ArticleID] = append(sources[row.ArticleID], row)
+	}
+	return sources, nil
+}
+
+// attachArticleSources fills AlsoReportedBy for loaded articles
+func (r *ArticleRepository) attachArticleSources(articles []*models.Article) error {
+	ids := make([]int, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+
+	sources, err := r.GetArticleSources(ids)
+	if err != nil {
+		return err
+	}
+	for _, article := range articles {
+		article.AlsoReportedBy = sources[article.ID]
+	}
+	return nil
+}
+
+// ===============================
+// CONTENT FINGERPRINTS & DEDUPLICATION LOGS
+// ===============================
+
+// GetArticleFingerprints returns stored fingerprints of articles published since the given time
+func (r *ArticleRepository) GetArticleFingerprints(since time.Time) ([]models.ArticleFingerprint, error) {
+	var fingerprints []models.ArticleFingerprint
+	query := `
+		SELECT id, external_id, title, normalized_url, content_hash, simhash, published_at
+		FROM articles
+		WHERE published_at >= $1 AND simhash IS NOT NULL
+		ORDER BY published_at ASC`
+
+	if err := r.db.Select(&fingerprints, query, since); err != nil {
+		return nil, fmt.Errorf("failed to get article fingerprints: %w", err)
+	}
+	return fingerprints, nil
+}
+
+// GetArticlesMissingFingerprints returns articles published since the given time that were
+// stored before fingerprints existed
+func (r *ArticleRepository) GetArticlesMissingFingerprints(since time.Time, limit int) ([]*models.Article, error) {
+	var articles []*models.Article
+	query := `
+		SELECT id, external_id, title, description, content, url, source, published_at
+		FROM articles
+		WHERE published_at >= $1 AND simhash IS NULL
+		ORDER BY published_at DESC
+		LIMIT $2`
+
+	if err := r.db.Select(&articles, query, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get articles missing fingerprints: %w", err)
+	}
+	return articles, nil
+}
+
+// UpdateArticleFingerprints stores computed fingerprints by article ID
+func (r *ArticleRepository) UpdateArticleFingerprints(articles []*models.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE articles SET normalized_url = :normalized_url, content_hash = :content_hash, simhash = :simhash WHERE id = :id`
+	for _, article := range articles {
+		if _, err := tx.NamedExec(query, article); err != nil {
+			return fmt.Errorf("failed to update article fingerprint: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetArticlesMissingSummary returns, by ascending ID, articles after the given ID that were
+// stored before summaries existed
+func (r *ArticleRepository) GetArticlesMissingSummary(afterID, limit int) ([]models.SummaryCandidate, error) {
+	var candidates []models.SummaryCandidate
+	query := `
+		SELECT id, title, description, content, full_text
+		FROM articles
+		WHERE id > $1 AND summary IS NULL AND summary_low_quality = false
+		ORDER BY id
+		LIMIT $2`
+
+	if err := r.db.Select(&candidates, query, afterID, limit); err != nil {
+		return nil, fmt.Errorf("failed to get articles missing summary: %w", err)
+	}
+	return candidates, nil
+}
+
+// UpdateArticleSummaries stores computed summaries by article ID
+func (r *ArticleRepository) UpdateArticleSummaries(articles []*models.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE articles SET summary = :summary, summary_low_quality = :summary_low_quality WHERE id = :id`
+	for _, article := range articles {
+		if _, err := tx.NamedExec(query, article); err != nil {
+			return fmt.Errorf("failed to update article summary: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetArticlesMissingQuality returns, by ascending ID, articles after the given ID that were
+// stored before quality scores existed
+func (r *ArticleRepository) GetArticlesMissingQuality(afterID, limit int) ([]models.QualityCandidate, error) {
+	var candidates []models.QualityCandidate
+	query := `
+		SELECT id, title, description, content
+		FROM articles
+		WHERE id > $1 AND quality_score IS NULL
+		ORDER BY id
+		LIMIT $2`
+
+	if err := r.db.Select(&candidates, query, afterID, limit); err != nil {
+		return nil, fmt.Errorf("failed to get articles missing quality score: %w", err)
+	}
+	return candidates, nil
+}
+
+// UpdateArticleQuality stores computed quality scores and explicit flags by article ID
+func (r *ArticleRepository) UpdateArticleQuality(articles []*models.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE articles SET quality_score = :quality_score, quality_flags = :quality_flags, is_explicit = :is_explicit WHERE id = :id`
+	for _, article := range articles {
+		if _, err := tx.NamedExec(query, article); err != nil {
+			return fmt.Errorf("failed to update article quality: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// CreateDeduplicationLogs stores deduplication decisions
+func (r *ArticleRepository) CreateDeduplicationLogs(logs []*models.DeduplicationLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO deduplication_logs (
+			original_article_id, duplicate_article_data,
+			title_similarity_score, url_match, content_hash_match, time_window_match,
+			is_duplicate, detection_method, created_at
+		) VALUES (
+			:original_article_id, :duplicate_article_data,
+			:title_similarity_score, :url_match, :content_hash_match, :time_window_match,
+			:is_duplicate, :detection_method, :created_at
+		)`
+
+	if _, err := r.db.NamedExec(query, logs); err != nil {
+		return fmt.Errorf("failed to create deduplication logs: %w", err)
+	}
+	return nil
+}
+
 // CheckArticleExists checks if an article exists by external_id
 func (r *ArticleRepository) CheckArticleExists(externalID string) (bool, error) {
 	var count int
@@ -979,7 +1376,8 @@ func (r *ArticleRepository) GetDuplicateArticles() ([][]*models.Article, error)
 			a.id, a.external_id, a.title, a.description, a.content, a.url, a.image_url,
 			a.source, a.author, a.category_id, a.published_at, a.fetched_at,
 			a.is_indian_content, a.relevance_score, a.sentiment_score,
-			a.word_count, a.reading_time_minutes, a.tags,
+			a.word_count, a.reading_time_minutes, a.tags, a.language,
+			a.summary, a.summary_low_quality, a.quality_score, a.quality_flags, a.is_explicit,
 			a.meta_title, a.meta_description, a.is_active, a.is_featured, a.view_count,
 			a.created_at, a.updated_at,
 			c.name as category_name, c.slug as category_slug
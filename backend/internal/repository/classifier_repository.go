@@ -0,0 +1,238 @@
+// internal/repository/classifier_repository.go
+// GoNews - Category Classifier Repository
+// Training data for the category classifier and the queue of articles it was unsure about
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrCategoryReviewNotFound = errors.New("category review not found")
+	ErrCategoryReviewClosed   = errors.New("category review already resolved or dismissed")
+)
+
+// categoryReviewColumns joins each review with the article fields an editor needs
+const categoryReviewColumns = `
+	r.id, r.article_id, r.suggested_category, r.confidence, r.predictions, r.model_version,
+	r.status, r.resolved_category_id, r.reviewed_by, r.reviewed_at, r.created_at,
+	a.title, a.description, a.url, a.source, a.published_at`
+
+// ClassifierRepository handles category classifier database operations
+type ClassifierRepository struct {
+	db *sqlx.DB
+}
+
+// NewClassifierRepository creates a new classifier repository
+func NewClassifierRepository(db *sqlx.DB) *ClassifierRepository {
+	return &ClassifierRepository{db: db}
+}
+
+// ===============================
+// TRAINING DATA
+// ===============================
+
+// GetTrainingExamples returns categorized articles published since the given time whose
+// category came from a provider or an editor. Legacy rows (assigned before category_source
+// existed, largely by keyword rules) are included only when asked for. Categories in
+// excluded, such as the catch-all top-stories, are left out.
+func (r *ClassifierRepository) GetTrainingExamples(since time.Time, includeLegacy bool, excluded []string) ([]*models.ClassifierExample, error) {
+	query := `
+		SELECT a.title, a.description, c.slug AS category_slug, a.category_source,
+			COALESCE(a.category_labels, '[]') AS category_labels
+		FROM articles a
+		JOIN categories c ON c.id = a.category_id
+		WHERE a.published_at >= $1
+		AND (a.category_source IN ($2, $3) OR ($4 AND a.category_source IS NULL))
+		AND NOT (c.slug = ANY($5))
+		ORDER BY a.published_at DESC`
+
+	var examples []*models.ClassifierExample
+	err := r.db.Select(&examples, query, since,
+		models.CategorySourceProvider, models.CategorySourceReview, includeLegacy, pq.Array(excluded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training examples: %w", err)
+	}
+	return examples, nil
+}
+
+// ===============================
+// REVIEW QUEUE
+// ===============================
+
+// QueueCategoryReviews adds reviews for articles saved by external ID. Articles that have
+// since been categorized, or already have a review, are skipped.
+func (r *ClassifierRepository) QueueCategoryReviews(reviews []*models.CategoryReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, review := range reviews {
+		_, err := tx.Exec(`
+			INSERT INTO category_reviews (article_id, suggested_category, confidence, predictions, model_version)
+			SELECT id, $2, $3, $4, $5 FROM articles
+			WHERE external_id = $1 AND category_id IS NULL
+			ON CONFLICT (article_id) DO NOTHING`,
+			review.ExternalID, review.SuggestedCategory, review.Confidence, review.Predictions, review.ModelVersion)
+		if err != nil {
+			return fmt.Errorf("failed to queue category review: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListCategoryReviews returns an oldest-first page of reviews in a status and the total in it
+func (r *ClassifierRepository) ListCategoryReviews(status string, limit, offset int) ([]*models.CategoryReview, int, error) {
+	var total int
+	if err := r.db.Get(&total, `SELECT COUNT(*) FROM category_reviews WHERE status = $1`, status); err != nil {
+		return nil, 0, fmt.Errorf("failed to count category reviews: %w", err)
+	}
+
+	reviews := []*models.CategoryReview{}
+	err := r.db.Select(&reviews, `
+		SELECT `+categoryReviewColumns+`
+		FROM category_reviews r
+		JOIN articles a ON a.id = r.article_id
+		WHERE r.status = $1
+		ORDER BY r.created_at ASC, r.id ASC
+		LIMIT $2 OFFSET $3`, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list category reviews: %w", err)
+	}
+	return reviews, total, nil
+}
+
+// CountPendingReviews returns how many reviews are waiting for an editor
+func (r *ClassifierRepository) CountPendingReviews() (int, error) {
+	var count int
+	err := r.db.Get(&count, `SELECT COUNT(*) FROM category_reviews WHERE status = $1`, models.CategoryReviewPending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending category reviews: %w", err)
+	}
+	return count, nil
+}
+
+// ResolveCategoryReview sets the article's category to an editor's choice and closes the
+// review. The article's category_source becomes "review", which makes it training data.
+func (r *ClassifierRepository) ResolveCategoryReview(id int, slug string, labels models.CategoryScores, reviewer *uuid.UUID) (*models.CategoryReview, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	articleID, err := lockPendingReview(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID int
+	if err := tx.Get(&categoryID, `SELECT id FROM categories WHERE slug = $1`, slug); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to look up category: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE articles
+		SET category_id = $2, category_source = $3, category_confidence = 1, category_labels = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, articleID, categoryID, models.CategorySourceReview, labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set article category: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE category_reviews
+		SET status = $2, resolved_category_id = $3, reviewed_by = $4, reviewed_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, models.CategoryReviewResolved, categoryID, reviewer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category review: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.getCategoryReview(id)
+}
+
+// DismissCategoryReview closes a review and leaves the article uncategorized
+func (r *ClassifierRepository) DismissCategoryReview(id int, reviewer *uuid.UUID) (*models.CategoryReview, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockPendingReview(tx, id); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE category_reviews
+		SET status = $2, reviewed_by = $3, reviewed_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, models.CategoryReviewDismissed, reviewer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dismiss category review: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.getCategoryReview(id)
+}
+
+// lockPendingReview locks a review row and returns its article, failing unless it is pending
+func lockPendingReview(tx *sqlx.Tx, id int) (int, error) {
+	var row struct {
+		ArticleID int    `db:"article_id"`
+		Status    string `db:"status"`
+	}
+	err := tx.Get(&row, `SELECT article_id, status FROM category_reviews WHERE id = $1 FOR UPDATE`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrCategoryReviewNotFound
+		}
+		return 0, fmt.Errorf("failed to get category review: %w", err)
+	}
+	if row.Status != models.CategoryReviewPending {
+		return 0, ErrCategoryReviewClosed
+	}
+	return row.ArticleID, nil
+}
+
+func (r *ClassifierRepository) getCategoryReview(id int) (*models.CategoryReview, error) {
+	review := &models.CategoryReview{}
+	err := r.db.Get(review, `
+		SELECT `+categoryReviewColumns+`
+		FROM category_reviews r
+		JOIN articles a ON a.id = r.article_id
+		WHERE r.id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCategoryReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get category review: %w", err)
+	}
+	return review, nil
+}
@@ -0,0 +1,151 @@
+// internal/repository/engagement_repository.go
+// GoNews - User Engagement Repository (Reading History + Bookmarks)
+// Persists the user signals that power recommendations and analytics
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrBookmarkNotFound = errors.New("bookmark not found")
+)
+
+// EngagementRepository handles reading history and bookmark database operations
+type EngagementRepository struct {
+	db *sqlx.DB
+}
+
+// NewEngagementRepository creates a new engagement repository
+func NewEngagementRepository(db *sqlx.DB) *EngagementRepository {
+	return &EngagementRepository{db: db}
+}
+
+// ===============================
+// READING HISTORY
+// ===============================
+
+// RecordRead stores a reading history entry for an authenticated user
+func (r *EngagementRepository) RecordRead(history *models.ReadingHistory) error {
+	if history.ReadAt.IsZero() {
+		history.ReadAt = time.Now()
+	}
+
+	query := `
+		INSERT INTO reading_history (
+			user_id, article_id, read_at, reading_duration_seconds,
+			scroll_percentage, completed, read_during_market_hours, read_during_ipl_time
+		) VALUES (
+			:user_id, :article_id, :read_at, :reading_duration_seconds,
+			:scroll_percentage, :completed, :read_during_market_hours, :read_during_ipl_time
+		) RETURNING id`
+
+	rows, err := r.db.NamedQuery(query, history)
+	if err != nil {
+		return fmt.Errorf("failed to record article read: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&history.ID); err != nil {
+			return fmt.Errorf("failed to scan reading history id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetReadArticleIDs returns the user's most recently read article IDs (newest first, distinct)
+func (r *EngagementRepository) GetReadArticleIDs(userID uuid.UUID, since time.Time, limit, offset int) ([]int, int, error) {
+	var total int
+	err := r.db.Get(&total, `
+		SELECT COUNT(DISTINCT article_id)
+		FROM reading_history
+		WHERE user_id = $1 AND read_at >= $2`, userID, since)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count reading history: %w", err)
+	}
+
+	var ids []int
+	err = r.db.Select(&ids, `
+		SELECT article_id
+		FROM reading_history
+		WHERE user_id = $1 AND read_at >= $2
+		GROUP BY article_id
+		ORDER BY MAX(read_at) DESC
+		LIMIT $3 OFFSET $4`, userID, since, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get reading history: %w", err)
+	}
+
+	return ids, total, nil
+}
+
+// ===============================
+// BOOKMARKS
+// ===============================
+
+// AddBookmark creates or updates a bookmark for a user
+func (r *EngagementRepository) AddBookmark(userID uuid.UUID, articleID int, notes *string) (*models.Bookmark, error) {
+	var bookmark models.Bookmark
+	query := `
+		INSERT INTO bookmarks (user_id, article_id, notes)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, article_id) DO UPDATE SET
+			notes = COALESCE(EXCLUDED.notes, bookmarks.notes)
+		RETURNING id, user_id, article_id, bookmarked_at, notes, is_read`
+
+	err := r.db.Get(&bookmark, query, userID, articleID, notes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add bookmark: %w", err)
+	}
+
+	return &bookmark, nil
+}
+
+// RemoveBookmark deletes a user's bookmark for an article
+func (r *EngagementRepository) RemoveBookmark(userID uuid.UUID, articleID int) error {
+	result, err := r.db.Exec(`DELETE FROM bookmarks WHERE user_id = $1 AND article_id = $2`, userID, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to remove bookmark: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check removed bookmark: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrBookmarkNotFound
+	}
+
+	return nil
+}
+
+// GetBookmarkedArticleIDs returns the user's bookmarked article IDs (newest first)
+func (r *EngagementRepository) GetBookmarkedArticleIDs(userID uuid.UUID, limit, offset int) ([]int, int, error) {
+	var total int
+	if err := r.db.Get(&total, `SELECT COUNT(*) FROM bookmarks WHERE user_id = $1`, userID); err != nil {
+		return nil, 0, fmt.Errorf("failed to count bookmarks: %w", err)
+	}
+
+	var ids []int
+	err := r.db.Select(&ids, `
+		SELECT article_id
+		FROM bookmarks
+		WHERE user_id = $1
+		ORDER BY bookmarked_at DESC
+		LIMIT $2 OFFSET $3`, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get bookmarks: %w", err)
+	}
+
+	return ids, total, nil
+}
@@ -0,0 +1,80 @@
+// internal/repository/entity_repository.go
+// GoNews - Entity Repository
+// Admin additions and overrides for the entity extraction gazetteer
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrGazetteerEntryNotFound = errors.New("gazetteer entry not found")
+
+// EntityRepository handles gazetteer database operations
+type EntityRepository struct {
+	db *sqlx.DB
+}
+
+// NewEntityRepository creates a new entity repository
+func NewEntityRepository(db *sqlx.DB) *EntityRepository {
+	return &EntityRepository{db: db}
+}
+
+// GetGazetteerEntries returns every admin gazetteer row, active or not
+func (r *EntityRepository) GetGazetteerEntries() ([]*models.GazetteerEntry, error) {
+	entries := []*models.GazetteerEntry{}
+	err := r.db.Select(&entries, `
+		SELECT canonical_id, name, entity_type, kind, COALESCE(aliases, '{}') AS aliases, parent_id,
+			case_sensitive, COALESCE(not_after, '{}') AS not_after, is_active, updated_by, created_at, updated_at
+		FROM gazetteer_entries
+		ORDER BY canonical_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gazetteer entries: %w", err)
+	}
+	return entries, nil
+}
+
+// UpsertGazetteerEntry stores an entry, replacing any row with the same ID
+func (r *EntityRepository) UpsertGazetteerEntry(entry *models.GazetteerEntry) (*models.GazetteerEntry, error) {
+	saved := &models.GazetteerEntry{}
+	err := r.db.Get(saved, `
+		INSERT INTO gazetteer_entries (
+			canonical_id, name, entity_type, kind, aliases, parent_id, case_sensitive, not_after, is_active, updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (canonical_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			entity_type = EXCLUDED.entity_type,
+			kind = EXCLUDED.kind,
+			aliases = EXCLUDED.aliases,
+			parent_id = EXCLUDED.parent_id,
+			case_sensitive = EXCLUDED.case_sensitive,
+			not_after = EXCLUDED.not_after,
+			is_active = EXCLUDED.is_active,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING canonical_id, name, entity_type, kind, aliases, parent_id, case_sensitive, not_after,
+			is_active, updated_by, created_at, updated_at`,
+		entry.CanonicalID, entry.Name, entry.EntityType, entry.Kind, entry.Aliases, entry.ParentID,
+		entry.CaseSensitive, entry.NotAfter, entry.IsActive, entry.UpdatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save gazetteer entry: %w", err)
+	}
+	return saved, nil
+}
+
+// DeleteGazetteerEntry removes an admin row
+func (r *EntityRepository) DeleteGazetteerEntry(id string) error {
+	result, err := r.db.Exec(`DELETE FROM gazetteer_entries WHERE canonical_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete gazetteer entry: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrGazetteerEntryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// internal/repository/experiment_repository.go
+// GoNews - Experiment Repository
+// A/B experiment definitions, exposure logging and per-variant outcome aggregation
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrExperimentNotFound = errors.New("experiment not found")
+	ErrExperimentExists   = errors.New("experiment key already exists")
+)
+
+// ExperimentRepository handles experiment database operations
+type ExperimentRepository struct {
+	db *sqlx.DB
+}
+
+// NewExperimentRepository creates a new experiment repository
+func NewExperimentRepository(db *sqlx.DB) *ExperimentRepository {
+	return &ExperimentRepository{db: db}
+}
+
+// ===============================
+// EXPERIMENT DEFINITIONS
+// ===============================
+
+// CreateExperiment inserts an experiment and its variants in one transaction
+func (r *ExperimentRepository) CreateExperiment(experiment *models.Experiment) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowx(`
+		INSERT INTO experiments (key, name, description, feed_type, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`,
+		experiment.Key, experiment.Name, experiment.Description, experiment.FeedType, experiment.Status,
+	).Scan(&experiment.ID, &experiment.CreatedAt, &experiment.UpdatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrExperimentExists
+		}
+		return fmt.Errorf("failed to create experiment: %w", err)
+	}
+
+	for i := range experiment.Variants {
+		variant := &experiment.Variants[i]
+		variant.ExperimentID = experiment.ID
+
+		err = tx.QueryRowx(`
+			INSERT INTO experiment_variants (experiment_id, key, name, traffic_percent, is_control, params)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, created_at`,
+			variant.ExperimentID, variant.Key, variant.Name, variant.TrafficPercent, variant.IsControl, variant.Params,
+		).Scan(&variant.ID, &variant.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create experiment variant %s: %w", variant.Key, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit experiment: %w", err)
+	}
+
+	return nil
+}
+
+// GetExperiments returns all experiments (newest first) with their variants
+func (r *ExperimentRepository) GetExperiments() ([]*models.Experiment, error) {
+	var experiments []*models.Experiment
+	if err := r.db.Select(&experiments, `SELECT * FROM experiments ORDER BY created_at DESC`); err != nil {
+		return nil, fmt.Errorf("failed to get experiments: %w", err)
+	}
+
+	if err := r.attachVariants(experiments); err != nil {
+		return nil, err
+	}
+
+	return experiments, nil
+}
+
+// GetRunningExperiments returns running experiments with their variants
+func (r *ExperimentRepository) GetRunningExperiments() ([]*models.Experiment, error) {
+	var experiments []*models.Experiment
+	err := r.db.Select(&experiments, `SELECT * FROM experiments WHERE status = $1 ORDER BY id`, models.ExperimentStatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running experiments: %w", err)
+	}
+
+	if err := r.attachVariants(experiments); err != nil {
+		return nil, err
+	}
+
+	return experiments, nil
+}
+
+// GetExperimentByKey returns an experiment with its variants
+func (r *ExperimentRepository) GetExperimentByKey(key string) (*models.Experiment, error) {
+	experiment := &models.Experiment{}
+	err := r.db.Get(experiment, `SELECT * FROM experiments WHERE key = $1`, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExperimentNotFound
+		}
+		return nil, fmt.Errorf("failed to get experiment: %w", err)
+	}
+
+	if err := r.attachVariants([]*models.Experiment{experiment}); err != nil {
+		return nil, err
+	}
+
+	return experiment, nil
+}
+
+// UpdateExperimentStatus moves an experiment through its lifecycle, stamping start/end times
+func (r *ExperimentRepository) UpdateExperimentStatus(key, status string) error {
+	result, err := r.db.Exec(`
+		UPDATE experiments SET
+			status = $2,
+			started_at = CASE WHEN $2 = 'running' AND started_at IS NULL THEN NOW() ELSE started_at END,
+			ended_at = CASE WHEN $2 = 'completed' THEN NOW() ELSE ended_at END
+		WHERE key = $1`, key, status)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment status: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check experiment status update: %w", err)
+	}
+	if rows == 0 {
+		return ErrExperimentNotFound
+	}
+
+	return nil
+}
+
+// attachVariants loads variants for a set of experiments
+func (r *ExperimentRepository) attachVariants(experiments []*models.Experiment) error {
+	if len(experiments) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(experiments))
+	byID := make(map[int]*models.Experiment, len(experiments))
+	for i, experiment := range experiments {
+		ids[i] = experiment.ID
+		byID[experiment.ID] = experiment
+		experiment.Variants = []models.ExperimentVariant{}
+	}
+
+	var variants []models.ExperimentVariant
+	err := r.db.Select(&variants, `
+		SELECT * FROM experiment_variants
+		WHERE experiment_id = ANY($1)
+		ORDER BY experiment_id, id`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get experiment variants: %w", err)
+	}
+
+	for _, variant := range variants {
+		if experiment, exists := byID[variant.ExperimentID]; exists {
+			experiment.Variants = append(experiment.Variants, variant)
+		}
+	}
+
+	return nil
+}
+
+// ===============================
+// EXPOSURES & OUTCOMES
+// ===============================
+
+// RecordExposures upserts subject exposures, bumping the counter on repeat views
+func (r *ExperimentRepository) RecordExposures(exposures []models.ExperimentExposure) error {
+	if len(exposures) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO experiment_exposures (experiment_id, variant_key, subject_key, user_id, feed_type)
+		VALUES (:experiment_id, :variant_key, :subject_key, :user_id, :feed_type)
+		ON CONFLICT (experiment_id, subject_key) DO UPDATE SET
+			exposure_count = experiment_exposures.exposure_count + 1,
+			last_exposed_at = NOW()`
+
+	// A batch may repeat a subject, which a single multi-row upsert statement cannot handle
+	for _, exposure := range exposures {
+		if _, err := r.db.NamedExec(query, exposure); err != nil {
+			return fmt.Errorf("failed to record experiment exposure: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RecordEvents stores outcome events for the subject's variants
+func (r *ExperimentRepository) RecordEvents(events []models.ExperimentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NamedExec(`
+		INSERT INTO experiment_events (experiment_id, variant_key, subject_key, event_type, article_id, dwell_seconds, scroll_depth)
+		VALUES (:experiment_id, :variant_key, :subject_key, :event_type, :article_id, :dwell_seconds, :scroll_depth)`, events)
+	if err != nil {
+		return fmt.Errorf("failed to record experiment events: %w", err)
+	}
+
+	return nil
+}
+
+// GetVariantMetrics aggregates exposures and post-exposure outcomes per variant
+func (r *ExperimentRepository) GetVariantMetrics(experimentID int) ([]models.VariantMetrics, error) {
+	var metrics []models.VariantMetrics
+	err := r.db.Select(&metrics, `
+		WITH subject_events AS (
+			SELECT ev.subject_key, ev.variant_key,
+				COUNT(*) FILTER (WHERE ev.event_type = 'read') AS reads,
+				COUNT(*) FILTER (WHERE ev.event_type = 'bookmark') AS bookmarks
+			FROM experiment_events ev
+			JOIN experiment_exposures x
+				ON x.experiment_id = ev.experiment_id
+				AND x.subject_key = ev.subject_key
+				AND x.variant_key = ev.variant_key
+			WHERE ev.experiment_id = $1 AND ev.created_at >= x.first_exposed_at
+			GROUP BY ev.subject_key, ev.variant_key
+		),
+		dwell AS (
+			SELECT ev.variant_key,
+				COUNT(*) AS dwell_samples,
+				AVG(ev.dwell_seconds) AS dwell_mean,
+				COALESCE(STDDEV_SAMP(ev.dwell_seconds), 0) AS dwell_stddev
+			FROM experiment_events ev
+			JOIN experiment_exposures x
+				ON x.experiment_id = ev.experiment_id
+				AND x.subject_key = ev.subject_key
+				AND x.variant_key = ev.variant_key
+			WHERE ev.experiment_id = $1 AND ev.event_type = 'read'
+				AND ev.dwell_seconds > 0 AND ev.created_at >= x.first_exposed_at
+			GROUP BY ev.variant_key
+		)
+		SELECT x.variant_key,
+			COUNT(*) AS subjects,
+			COALESCE(SUM(x.exposure_count), 0) AS exposures,
+			COUNT(*) FILTER (WHERE COALESCE(se.reads, 0) > 0) AS converted_subjects,
+			COALESCE(SUM(se.reads), 0) AS reads,
+			COUNT(*) FILTER (WHERE COALESCE(se.bookmarks, 0) > 0) AS bookmarking_subjects,
+			COALESCE(SUM(se.bookmarks), 0) AS bookmarks,
+			COALESCE(MAX(d.dwell_samples), 0) AS dwell_samples,
+			COALESCE(MAX(d.dwell_mean), 0) AS dwell_mean,
+			COALESCE(MAX(d.dwell_stddev), 0) AS dwell_stddev
+		FROM experiment_exposures x
+		LEFT JOIN subject_events se ON se.subject_key = x.subject_key AND se.variant_key = x.variant_key
+		LEFT JOIN dwell d ON d.variant_key = x.variant_key
+		WHERE x.experiment_id = $1
+		GROUP BY x.variant_key
+		ORDER BY x.variant_key`, experimentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate experiment metrics: %w", err)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,178 @@
+// internal/repository/extraction_repository.go
+// GoNews - Full-Text Extraction Repository
+// Claims articles awaiting extraction and stores readability results
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrExtractionArticleNotFound = errors.New("article not found")
+)
+
+// extractionRetryDelay keeps a failed article from being retried on every tick
+const extractionRetryDelay = time.Hour
+
+// maxExtractionErrorLength matches the extraction_error column width
+const maxExtractionErrorLength = 500
+
+// ExtractionRepository handles full-text extraction database operations
+type ExtractionRepository struct {
+	db *sqlx.DB
+}
+
+// NewExtractionRepository creates a new extraction repository
+func NewExtractionRepository(db *sqlx.DB) *ExtractionRepository {
+	return &ExtractionRepository{db: db}
+}
+
+// ===============================
+// EXTRACTION QUEUE
+// ===============================
+
+// GetPendingExtractions returns the newest articles never extracted, plus failed ones
+// due for another attempt
+func (r *ExtractionRepository) GetPendingExtractions(limit, maxAttempts int) ([]*models.ExtractionCandidate, error) {
+	query := `
+		SELECT id, title, url, image_url, COALESCE(extraction_attempts, 0) AS extraction_attempts
+		FROM articles
+		WHERE is_active = true
+		AND (
+			extraction_status = $1
+			OR extraction_status IS NULL
+			OR (extraction_status = $2 AND extraction_attempts < $3 AND extracted_at < $4)
+		)
+		ORDER BY published_at DESC
+		LIMIT $5`
+
+	var candidates []*models.ExtractionCandidate
+	retryBefore := time.Now().Add(-extractionRetryDelay)
+	err := r.db.Select(&candidates, query,
+		models.ExtractionPending, models.ExtractionFailed, maxAttempts, retryBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending extractions: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// GetExtractionCandidate returns one article for an on-demand extraction
+func (r *ExtractionRepository) GetExtractionCandidate(articleID int) (*models.ExtractionCandidate, error) {
+	candidate := &models.ExtractionCandidate{}
+	err := r.db.Get(candidate, `
+		SELECT id, title, url, image_url, COALESCE(extraction_attempts, 0) AS extraction_attempts
+		FROM articles WHERE id = $1`, articleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExtractionArticleNotFound
+		}
+		return nil, fmt.Errorf("failed to get article for extraction: %w", err)
+	}
+
+	return candidate, nil
+}
+
+// SaveExtraction records an extraction attempt. Successful attempts replace the word
+// count and reading time, fill in a missing image and replace the summary when the full text
+// gave a good one; others only update status.
+func (r *ExtractionRepository) SaveExtraction(result *models.ArticleExtraction) error {
+	var fullText, summary, extractionError, leadImage *string
+	if result.Status == models.ExtractionExtracted {
+		fullText = &result.FullText
+		if result.Summary != "" && !result.SummaryLowQuality {
+			summary = &result.Summary
+		}
+	}
+	if result.Error != "" {
+		message := result.Error
+		if len(message) > maxExtractionErrorLength {
+			message = message[:maxExtractionErrorLength]
+		}
+		extractionError = &message
+	}
+	if result.LeadImageURL != "" {
+		leadImage = &result.LeadImageURL
+	}
+
+	query := `
+		UPDATE articles SET
+			extraction_status = $2,
+			extraction_error = $3,
+			extraction_attempts = COALESCE(extraction_attempts, 0) + 1,
+			extracted_at = $4,
+			full_text = COALESCE($5, full_text),
+			word_count = CASE WHEN $5 IS NOT NULL THEN $6 ELSE word_count END,
+			reading_time_minutes = CASE WHEN $5 IS NOT NULL THEN $7 ELSE reading_time_minutes END,
+			image_url = COALESCE(NULLIF(image_url, ''), $8),
+			summary = COALESCE($9, summary),
+			summary_low_quality = CASE WHEN $9 IS NOT NULL THEN false ELSE summary_low_quality END
+		WHERE id = $1`
+
+	res, err := r.db.Exec(query,
+		result.ArticleID, result.Status, extractionError, result.ExtractedAt,
+		fullText, result.WordCount, result.ReadingTimeMinutes, leadImage, summary,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save extraction: %w", err)
+	}
+
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return ErrExtractionArticleNotFound
+	}
+
+	return nil
+}
+
+// ===============================
+// REPORTING
+// ===============================
+
+// GetExtractionStats returns counts per extraction status and recent throughput
+func (r *ExtractionRepository) GetExtractionStats() (*models.ExtractionStats, error) {
+	stats := &models.ExtractionStats{ByStatus: make(map[string]int)}
+
+	rows, err := r.db.Query(`
+		SELECT COALESCE(extraction_status, $1) AS status, COUNT(*)
+		FROM articles
+		WHERE is_active = true
+		GROUP BY 1`, models.ExtractionPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extraction counts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan extraction count: %w", err)
+		}
+		stats.ByStatus[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	err = r.db.QueryRow(`
+		SELECT
+			COALESCE(AVG(word_count) FILTER (WHERE extraction_status = $1), 0),
+			COUNT(*) FILTER (WHERE extraction_status = $1 AND extracted_at >= NOW() - INTERVAL '24 hours'),
+			COUNT(*) FILTER (WHERE extraction_status = $2 AND extracted_at >= NOW() - INTERVAL '24 hours')
+		FROM articles`,
+		models.ExtractionExtracted, models.ExtractionFailed,
+	).Scan(&stats.AvgWordCount, &stats.ExtractedLast24h, &stats.FailedLast24h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extraction throughput: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,202 @@
+// internal/repository/feed_repository.go
+// GoNews - RSS/Atom Feed Repository
+// Feed list management, due-feed scheduling and per-poll health accounting
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrFeedNotFound = errors.New("feed not found")
+	ErrFeedExists   = errors.New("feed with this URL already exists")
+)
+
+// FeedRepository handles news feed database operations
+type FeedRepository struct {
+	db *sqlx.DB
+}
+
+// NewFeedRepository creates a new feed repository
+func NewFeedRepository(db *sqlx.DB) *FeedRepository {
+	return &FeedRepository{db: db}
+}
+
+// ===============================
+// FEED MANAGEMENT
+// ===============================
+
+// GetFeeds returns all feeds, optionally only active ones
+func (r *FeedRepository) GetFeeds(activeOnly bool) ([]*models.NewsFeed, error) {
+	query := `SELECT * FROM news_feeds`
+	if activeOnly {
+		query += ` WHERE is_active = TRUE`
+	}
+	query += ` ORDER BY name`
+
+	var feeds []*models.NewsFeed
+	if err := r.db.Select(&feeds, query); err != nil {
+		return nil, fmt.Errorf("failed to get feeds: %w", err)
+	}
+
+	return feeds, nil
+}
+
+// GetFeed returns a feed by ID
+func (r *FeedRepository) GetFeed(id int) (*models.NewsFeed, error) {
+	feed := &models.NewsFeed{}
+	err := r.db.Get(feed, `SELECT * FROM news_feeds WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+// GetDueFeeds returns active feeds whose next poll time has passed, most overdue first
+func (r *FeedRepository) GetDueFeeds(limit int) ([]*models.NewsFeed, error) {
+	var feeds []*models.NewsFeed
+	err := r.db.Select(&feeds, `
+		SELECT * FROM news_feeds
+		WHERE is_active = TRUE AND (next_poll_at IS NULL OR next_poll_at <= NOW())
+		ORDER BY next_poll_at NULLS FIRST
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due feeds: %w", err)
+	}
+
+	return feeds, nil
+}
+
+// CreateFeed stores a new feed, due for polling immediately
+func (r *FeedRepository) CreateFeed(feed *models.NewsFeed) error {
+	err := r.db.QueryRowx(`
+		INSERT INTO news_feeds (name, url, site_url, category_slug, language, is_indian, poll_interval_minutes, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING *`,
+		feed.Name, feed.URL, feed.SiteURL, feed.CategorySlug, feed.Language, feed.IsIndian, feed.PollIntervalMinutes, feed.IsActive,
+	).StructScan(feed)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrFeedExists
+		}
+		return fmt.Errorf("failed to create feed: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFeed saves editable feed settings. A changed URL clears the conditional GET validators.
+func (r *FeedRepository) UpdateFeed(feed *models.NewsFeed) error {
+	err := r.db.QueryRowx(`
+		UPDATE news_feeds SET
+			name = $2,
+			url = $3,
+			site_url = $4,
+			category_slug = $5,
+			language = $6,
+			is_indian = $7,
+			poll_interval_minutes = $8,
+			is_active = $9,
+			etag = CASE WHEN url = $3 THEN etag END,
+			last_modified = CASE WHEN url = $3 THEN last_modified END
+		WHERE id = $1
+		RETURNING *`,
+		feed.ID, feed.Name, feed.URL, feed.SiteURL, feed.CategorySlug, feed.Language, feed.IsIndian, feed.PollIntervalMinutes, feed.IsActive,
+	).StructScan(feed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrFeedNotFound
+		}
+		if isUniqueViolation(err) {
+			return ErrFeedExists
+		}
+		return fmt.Errorf("failed to update feed: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteFeed removes a feed
+func (r *FeedRepository) DeleteFeed(id int) error {
+	result, err := r.db.Exec(`DELETE FROM news_feeds WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete feed: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check feed deletion: %w", err)
+	}
+	if rows == 0 {
+		return ErrFeedNotFound
+	}
+
+	return nil
+}
+
+// ===============================
+// POLL ACCOUNTING
+// ===============================
+
+// RecordPoll stores one poll outcome: validators, schedule and health counters
+func (r *FeedRepository) RecordPoll(feedID int, outcome models.FeedPollOutcome) error {
+	failed := outcome.Status == models.FeedPollError
+
+	var lastError *string
+	if outcome.Err != nil {
+		msg := outcome.Err.Error()
+		if len(msg) > 1000 {
+			msg = msg[:1000]
+		}
+		lastError = &msg
+	}
+
+	var statusCode *int
+	if outcome.StatusCode > 0 {
+		statusCode = &outcome.StatusCode
+	}
+
+	_, err := r.db.Exec(`
+		UPDATE news_feeds SET
+			etag = COALESCE(NULLIF($2, ''), etag),
+			last_modified = COALESCE(NULLIF($3, ''), last_modified),
+			next_poll_at = $4,
+			last_polled_at = NOW(),
+			last_success_at = CASE WHEN $5 THEN last_success_at ELSE NOW() END,
+			last_status_code = $6,
+			last_error = $7,
+			last_item_count = CASE WHEN $8 = 'ok' THEN $9 ELSE last_item_count END,
+			consecutive_failures = CASE WHEN $5 THEN consecutive_failures + 1 ELSE 0 END,
+			total_polls = total_polls + 1,
+			total_failures = total_failures + CASE WHEN $5 THEN 1 ELSE 0 END,
+			not_modified_count = not_modified_count + CASE WHEN $8 = 'not_modified' THEN 1 ELSE 0 END,
+			items_fetched = items_fetched + $9,
+			avg_response_ms = (avg_response_ms * total_polls + $10) / (total_polls + 1)
+		WHERE id = $1`,
+		feedID, outcome.ETag, outcome.LastModified, outcome.NextPollAt, failed, statusCode, lastError,
+		outcome.Status, outcome.ItemCount, int(outcome.Duration/time.Millisecond))
+	if err != nil {
+		return fmt.Errorf("failed to record feed poll: %w", err)
+	}
+
+	return nil
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
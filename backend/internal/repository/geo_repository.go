@@ -0,0 +1,125 @@
+// internal/repository/geo_repository.go
+// GoNews - Geo Repository
+// Article state, district and city tags and local news lookups
+
+package repository
+
+import (
+	"fmt"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// GeoRepository handles article location database operations
+type GeoRepository struct {
+	db *sqlx.DB
+}
+
+// NewGeoRepository creates a new geo repository
+func NewGeoRepository(db *sqlx.DB) *GeoRepository {
+	return &GeoRepository{db: db}
+}
+
+// GetArticleLocations returns each article's places, primary first
+func (r *GeoRepository) GetArticleLocations(articleIDs []int) (map[int][]models.ArticleLocation, error) {
+	locations := make(map[int][]models.ArticleLocation)
+	if len(articleIDs) == 0 {
+		return locations, nil
+	}
+
+	var rows []models.ArticleLocation
+	query := `
+		SELECT article_id, place_code, place_name, place_level, state_code, district_code, city_code,
+			in_headline, is_primary
+		FROM article_locations
+		WHERE article_id = ANY($1)
+		ORDER BY article_id, is_primary DESC, position ASC`
+
+	if err := r.db.Select(&rows, query, pq.Array(articleIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get article locations: %w", err)
+	}
+
+	for _, row := range rows {
+		locations[row.ArticleID] = append(locations[row.ArticleID], row)
+	}
+	return locations, nil
+}
+
+// AttachArticleLocations fills Locations for loaded articles
+func (r *GeoRepository) AttachArticleLocations(articles []*models.Article) error {
+	ids := make([]int, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+
+	locations, err := r.GetArticleLocations(ids)
+	if err != nil {
+		return err
+	}
+	for _, article := range articles {
+		article.Locations = locations[article.ID]
+	}
+	return nil
+}
+
+// GetNearbyArticleIDs returns recent active articles tagged with places in a state. Articles
+// in the same district or city rank as if they were published BoostHours later per level.
+func (r *GeoRepository) GetNearbyArticleIDs(filter models.NearbyArticleFilter) ([]int, error) {
+	ids := []int{}
+	query := `
+		SELECT al.article_id
+		FROM article_locations al
+		JOIN articles a ON a.id = al.article_id
+		WHERE al.state_code = $1 AND a.is_active = true AND a.published_at >= $4
+		GROUP BY al.article_id, a.published_at
+		ORDER BY a.published_at + MAX(
+			CASE
+				WHEN COALESCE(al.city_code = $3, false) THEN 2
+				WHEN COALESCE(al.district_code = $2, false) THEN 1
+				ELSE 0
+			END * $5::DOUBLE PRECISION) * INTERVAL '1 hour' DESC,
+			al.article_id DESC
+		LIMIT $6 OFFSET $7`
+
+	err := r.db.Select(&ids, query, filter.StateCode, filter.DistrictCode, filter.CityCode,
+		filter.Since, filter.BoostHours, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nearby articles: %w", err)
+	}
+	return ids, nil
+}
+
+// saveArticleLocations replaces the places of each geo-tagged article, found by external_id.
+// Articles saved without tags keep the places they have.
+func saveArticleLocations(tx *sqlx.Tx, articles []*models.Article) error {
+	deleteQuery := `
+		DELETE FROM article_locations
+		WHERE article_id = (SELECT id FROM articles WHERE external_id = $1)`
+	insertQuery := `
+		INSERT INTO article_locations (
+			article_id, place_code, place_name, place_level, state_code, district_code, city_code,
+			in_headline, is_primary, position
+		)
+		SELECT id, $2, $3, $4, $5, $6, $7, $8, $9, $10 FROM articles WHERE external_id = $1
+		ON CONFLICT (article_id, place_code) DO NOTHING`
+
+	for _, article := range articles {
+		if article.ExternalID == nil || len(article.Locations) == 0 {
+			continue
+		}
+		if _, err := tx.Exec(deleteQuery, *article.ExternalID); err != nil {
+			return fmt.Errorf("failed to clear article locations: %w", err)
+		}
+		for i, location := range article.Locations {
+			if _, err := tx.Exec(insertQuery, *article.ExternalID, location.PlaceCode, location.PlaceName,
+				location.Level, location.StateCode, location.DistrictCode, location.CityCode,
+				location.InHeadline, location.IsPrimary, i); err != nil {
+				return fmt.Errorf("failed to save article location: %w", err)
+			}
+		}
+	}
+	return nil
+}
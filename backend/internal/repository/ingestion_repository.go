@@ -0,0 +1,217 @@
+// internal/repository/ingestion_repository.go
+// GoNews - Ingestion Dead Letter Repository
+// Stores provider items the ingestion pipeline rejected so admins can inspect and replay them
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+	ErrDeadLetterResolved = errors.New("dead letter already replayed or discarded")
+)
+
+// IngestionRepository handles ingestion dead letter database operations
+type IngestionRepository struct {
+	db *sqlx.DB
+}
+
+// NewIngestionRepository creates a new ingestion repository
+func NewIngestionRepository(db *sqlx.DB) *IngestionRepository {
+	return &IngestionRepository{db: db}
+}
+
+// deadLetterColumns is the select list shared by every dead letter query
+const deadLetterColumns = `id, source, stage, payload_kind, payload, query, error, status,
+	COALESCE(attempts, 0) AS attempts, created_at, last_attempt_at, resolved_at`
+
+// ===============================
+// DEAD LETTER WRITES
+// ===============================
+
+// CreateDeadLetters stores rejected items in one transaction
+func (r *IngestionRepository) CreateDeadLetters(letters []*models.IngestionDeadLetter) error {
+	if len(letters) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin dead letter transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO ingestion_dead_letters (source, stage, payload_kind, payload, query, error, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare dead letter insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, letter := range letters {
+		_, err := stmt.Exec(letter.Source, letter.Stage, letter.PayloadKind, letter.Payload,
+			letter.Query, letter.Error, models.DeadLetterPending)
+		if err != nil {
+			return fmt.Errorf("failed to insert dead letter: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit dead letters: %w", err)
+	}
+	return nil
+}
+
+// MarkDeadLetterReplayed resolves a dead letter whose item made it through the pipeline
+func (r *IngestionRepository) MarkDeadLetterReplayed(id int) error {
+	return r.resolveDeadLetter(id, models.DeadLetterReplayed)
+}
+
+// DiscardDeadLetter resolves a dead letter without replaying it
+func (r *IngestionRepository) DiscardDeadLetter(id int) error {
+	return r.resolveDeadLetter(id, models.DeadLetterDiscarded)
+}
+
+func (r *IngestionRepository) resolveDeadLetter(id int, status string) error {
+	now := time.Now()
+	res, err := r.db.Exec(`
+		UPDATE ingestion_dead_letters
+		SET status = $2, resolved_at = $3, last_attempt_at = CASE WHEN $2 = $4 THEN $3 ELSE last_attempt_at END,
+			attempts = CASE WHEN $2 = $4 THEN COALESCE(attempts, 0) + 1 ELSE attempts END
+		WHERE id = $1 AND status = $5`,
+		id, status, now, models.DeadLetterReplayed, models.DeadLetterPending)
+	if err != nil {
+		return fmt.Errorf("failed to update dead letter: %w", err)
+	}
+
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		if _, err := r.GetDeadLetter(id); err != nil {
+			return err
+		}
+		return ErrDeadLetterResolved
+	}
+	return nil
+}
+
+// RecordDeadLetterFailure keeps a dead letter pending after a failed replay
+func (r *IngestionRepository) RecordDeadLetterFailure(id int, stage, message string) error {
+	_, err := r.db.Exec(`
+		UPDATE ingestion_dead_letters
+		SET stage = $2, error = $3, attempts = COALESCE(attempts, 0) + 1, last_attempt_at = $4
+		WHERE id = $1`,
+		id, stage, message, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to record dead letter failure: %w", err)
+	}
+	return nil
+}
+
+// ===============================
+// DEAD LETTER READS
+// ===============================
+
+// GetDeadLetter returns one dead letter with its payload
+func (r *IngestionRepository) GetDeadLetter(id int) (*models.IngestionDeadLetter, error) {
+	letter := &models.IngestionDeadLetter{}
+	err := r.db.Get(letter, `SELECT `+deadLetterColumns+` FROM ingestion_dead_letters WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("failed to get dead letter: %w", err)
+	}
+	return letter, nil
+}
+
+// ListDeadLetters returns a newest-first page of dead letters and the total matching the filter
+func (r *IngestionRepository) ListDeadLetters(filter models.DeadLetterFilter) ([]*models.IngestionDeadLetter, int, error) {
+	var conditions []string
+	var args []interface{}
+
+	addCondition := func(column, value string) {
+		if value == "" {
+			return
+		}
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	addCondition("status", filter.Status)
+	addCondition("source", filter.Source)
+	addCondition("stage", filter.Stage)
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.db.Get(&total, `SELECT COUNT(*) FROM ingestion_dead_letters `+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`SELECT %s FROM ingestion_dead_letters %s ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d`,
+		deadLetterColumns, where, len(args)-1, len(args))
+
+	letters := []*models.IngestionDeadLetter{}
+	if err := r.db.Select(&letters, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	return letters, total, nil
+}
+
+// GetPendingDeadLetterIDs returns the oldest pending dead letters matching source and stage
+func (r *IngestionRepository) GetPendingDeadLetterIDs(source, stage string, limit int) ([]int, error) {
+	var ids []int
+	err := r.db.Select(&ids, `
+		SELECT id FROM ingestion_dead_letters
+		WHERE status = $1 AND ($2 = '' OR source = $2) AND ($3 = '' OR stage = $3)
+		ORDER BY created_at ASC
+		LIMIT $4`,
+		models.DeadLetterPending, source, stage, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending dead letters: %w", err)
+	}
+	return ids, nil
+}
+
+// CountDeadLettersByStatus returns dead letter counts keyed by status
+func (r *IngestionRepository) CountDeadLettersByStatus() (map[string]int, error) {
+	counts := map[string]int{
+		models.DeadLetterPending:   0,
+		models.DeadLetterReplayed:  0,
+		models.DeadLetterDiscarded: 0,
+	}
+
+	rows, err := r.db.Query(`SELECT status, COUNT(*) FROM ingestion_dead_letters GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan dead letter count: %w", err)
+		}
+		counts[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,253 @@
+// internal/repository/ltr_repository.go
+// GoNews - Learning-to-Rank Repository
+// Ranking impressions joined with reads, and versioned ranking weight models
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrRankingModelNotFound = errors.New("ranking model not found")
+	ErrNoActiveRankingModel = errors.New("no active ranking model")
+)
+
+// maxTrainingImpressions bounds how many impressions one training run loads
+const maxTrainingImpressions = 250000
+
+// LTRRepository handles learning-to-rank database operations
+type LTRRepository struct {
+	db *sqlx.DB
+}
+
+// NewLTRRepository creates a new learning-to-rank repository
+func NewLTRRepository(db *sqlx.DB) *LTRRepository {
+	return &LTRRepository{db: db}
+}
+
+// ===============================
+// IMPRESSIONS & CLICKS
+// ===============================
+
+// RecordImpressions stores a batch of feed/search impressions
+func (r *LTRRepository) RecordImpressions(impressions []models.RankingImpression) error {
+	if len(impressions) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NamedExec(`
+		INSERT INTO ranking_impressions (request_id, subject_key, user_id, surface, query, article_id, position)
+		VALUES (:request_id, :subject_key, :user_id, :surface, :query, :article_id, :position)`, impressions)
+	if err != nil {
+		return fmt.Errorf("failed to record ranking impressions: %w", err)
+	}
+
+	return nil
+}
+
+// RecordClicks marks the subject's most recent impression of each read article as clicked.
+// Repeat reads keep the first click time and the longest dwell.
+func (r *LTRRepository) RecordClicks(clicks []models.RankingClick) error {
+	for _, click := range clicks {
+		_, err := r.db.Exec(`
+			UPDATE ranking_impressions SET
+				clicked_at = COALESCE(clicked_at, NOW()),
+				dwell_seconds = GREATEST(dwell_seconds, $3)
+			WHERE id = (
+				SELECT id FROM ranking_impressions
+				WHERE subject_key = $1 AND article_id = $2
+					AND shown_at >= NOW() - INTERVAL '24 hours'
+				ORDER BY shown_at DESC
+				LIMIT 1
+			)`, click.SubjectKey, click.ArticleID, click.DwellSeconds)
+		if err != nil {
+			return fmt.Errorf("failed to record ranking click: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetTrainingImpressions returns impressions since a time for requests that led to at least one read,
+// ordered by request and position
+func (r *LTRRepository) GetTrainingImpressions(since time.Time) ([]models.ImpressionRecord, error) {
+	var records []models.ImpressionRecord
+	err := r.db.Select(&records, `
+		SELECT i.request_id, i.surface, i.article_id, i.position, i.shown_at, i.clicked_at, i.dwell_seconds
+		FROM ranking_impressions i
+		WHERE i.shown_at >= $1
+			AND i.request_id IN (
+				SELECT DISTINCT request_id FROM ranking_impressions
+				WHERE shown_at >= $1 AND clicked_at IS NOT NULL
+			)
+		ORDER BY i.request_id, i.position
+		LIMIT $2`, since, maxTrainingImpressions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training impressions: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetImpressionStats counts impressions, requests and clicks since a time
+func (r *LTRRepository) GetImpressionStats(since time.Time) (*models.ImpressionStats, error) {
+	stats := &models.ImpressionStats{}
+	err := r.db.Get(stats, `
+		SELECT COUNT(*) AS impressions,
+			COUNT(DISTINCT request_id) AS requests,
+			COUNT(*) FILTER (WHERE clicked_at IS NOT NULL) AS clicks
+		FROM ranking_impressions
+		WHERE shown_at >= $1`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get impression stats: %w", err)
+	}
+
+	if stats.Impressions > 0 {
+		stats.CTR = float64(stats.Clicks) / float64(stats.Impressions)
+	}
+
+	return stats, nil
+}
+
+// DeleteImpressionsBefore removes impressions older than the retention cutoff
+func (r *LTRRepository) DeleteImpressionsBefore(cutoff time.Time) (int64, error) {
+	result, err := r.db.Exec(`DELETE FROM ranking_impressions WHERE shown_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old impressions: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted impressions: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// ===============================
+// RANKING MODELS
+// ===============================
+
+// CreateModel stores a trained model version
+func (r *LTRRepository) CreateModel(model *models.RankingModel) error {
+	err := r.db.QueryRowx(`
+		INSERT INTO ranking_models (
+			status, weights, baseline_weights, rollout_percent, training_pairs, holdout_pairs,
+			train_accuracy, holdout_accuracy, baseline_accuracy, notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at`,
+		model.Status, model.Weights, model.BaselineWeights, model.RolloutPercent, model.TrainingPairs, model.HoldoutPairs,
+		model.TrainAccuracy, model.HoldoutAccuracy, model.BaselineAccuracy, model.Notes,
+	).Scan(&model.ID, &model.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create ranking model: %w", err)
+	}
+
+	return nil
+}
+
+// GetModels returns the most recent model versions
+func (r *LTRRepository) GetModels(limit int) ([]*models.RankingModel, error) {
+	var rankingModels []*models.RankingModel
+	err := r.db.Select(&rankingModels, `SELECT * FROM ranking_models ORDER BY created_at DESC LIMIT $1`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranking models: %w", err)
+	}
+
+	return rankingModels, nil
+}
+
+// GetModel returns a model version by ID
+func (r *LTRRepository) GetModel(id int) (*models.RankingModel, error) {
+	model := &models.RankingModel{}
+	err := r.db.Get(model, `SELECT * FROM ranking_models WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRankingModelNotFound
+		}
+		return nil, fmt.Errorf("failed to get ranking model: %w", err)
+	}
+
+	return model, nil
+}
+
+// GetActiveModel returns the model currently serving, if any
+func (r *LTRRepository) GetActiveModel() (*models.RankingModel, error) {
+	model := &models.RankingModel{}
+	err := r.db.Get(model, `SELECT * FROM ranking_models WHERE status = $1`, models.RankingModelActive)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoActiveRankingModel
+		}
+		return nil, fmt.Errorf("failed to get active ranking model: %w", err)
+	}
+
+	return model, nil
+}
+
+// ActivateModel makes a model the active one at the given rollout, superseding the previous active model
+func (r *LTRRepository) ActivateModel(id, rolloutPercent int) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`UPDATE ranking_models SET status = $1 WHERE status = $2 AND id <> $3`,
+		models.RankingModelSuperseded, models.RankingModelActive, id)
+	if err != nil {
+		return fmt.Errorf("failed to supersede active ranking model: %w", err)
+	}
+
+	result, err := tx.Exec(`
+		UPDATE ranking_models SET
+			status = $2,
+			rollout_percent = $3,
+			activated_at = COALESCE(activated_at, NOW())
+		WHERE id = $1`, id, models.RankingModelActive, rolloutPercent)
+	if err != nil {
+		return fmt.Errorf("failed to activate ranking model: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check ranking model activation: %w", err)
+	}
+	if rows == 0 {
+		return ErrRankingModelNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit ranking model activation: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackActiveModel withdraws the active model and returns it
+func (r *LTRRepository) RollbackActiveModel(reason string) (*models.RankingModel, error) {
+	model := &models.RankingModel{}
+	err := r.db.Get(model, `
+		UPDATE ranking_models SET
+			status = $1,
+			rolled_back_at = NOW(),
+			notes = CONCAT_WS(E'\n', notes, $3::text)
+		WHERE status = $2
+		RETURNING *`, models.RankingModelRolledBack, models.RankingModelActive, reason)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoActiveRankingModel
+		}
+		return nil, fmt.Errorf("failed to roll back ranking model: %w", err)
+	}
+
+	return model, nil
+}
@@ -0,0 +1,119 @@
+// internal/repository/notification_repository.go
+// GoNews - Notification Repository
+// In-app notification inbox, with fan-out to opted-in readers under per-user rate limits
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+// NotificationRepository handles notification database operations
+type NotificationRepository struct {
+	db *sqlx.DB
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *sqlx.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// ===============================
+// FAN-OUT
+// ===============================
+
+// FanOut gives the notification to every active user whose notification_settings turn on the
+// given setting, and returns how many received it. Users who already have a notification with
+// the same dedupe key are skipped, as are users who got maxPerHour notifications of the same
+// kind in the last hour or maxPerDay in the last day.
+func (r *NotificationRepository) FanOut(notification *models.Notification, setting, dedupeKey string, maxPerHour, maxPerDay int) (int, error) {
+	now := time.Now()
+	result, err := r.db.Exec(`
+		INSERT INTO notifications (user_id, kind, story_id, article_id, title, body, dedupe_key)
+		SELECT u.id, $1::VARCHAR, $2::INTEGER, $3::INTEGER, $4::TEXT, $5::TEXT, $6::VARCHAR
+		FROM users u
+		WHERE u.is_active = true
+			AND COALESCE((u.notification_settings ->> $7::TEXT)::BOOLEAN, false)
+			AND (
+				SELECT COUNT(*) FROM notifications n
+				WHERE n.user_id = u.id AND n.kind = $1 AND n.created_at >= $8
+			) < $9
+			AND (
+				SELECT COUNT(*) FROM notifications n
+				WHERE n.user_id = u.id AND n.kind = $1 AND n.created_at >= $10
+			) < $11
+		ON CONFLICT (user_id, dedupe_key) DO NOTHING`,
+		notification.Kind, notification.StoryID, notification.ArticleID, notification.Title, notification.Body,
+		dedupeKey, setting, now.Add(-time.Hour), maxPerHour, now.Add(-24*time.Hour), maxPerDay)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fan out notification: %w", err)
+	}
+	sent, _ := result.RowsAffected()
+	return int(sent), nil
+}
+
+// ===============================
+// INBOX
+// ===============================
+
+// ListNotifications returns a user's notifications, newest first
+func (r *NotificationRepository) ListNotifications(userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, error) {
+	notifications := []*models.Notification{}
+	err := r.db.Select(&notifications, `
+		SELECT id, user_id, kind, story_id, article_id, title, body, read_at, created_at
+		FROM notifications
+		WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4`, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// CountUnread counts a user's unread notifications
+func (r *NotificationRepository) CountUnread(userID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.Get(&count, `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks one of a user's notifications as read
+func (r *NotificationRepository) MarkRead(userID uuid.UUID, id int64) error {
+	result, err := r.db.Exec(`
+		UPDATE notifications SET read_at = COALESCE(read_at, CURRENT_TIMESTAMP)
+		WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification as read: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+// MarkAllRead marks all of a user's notifications as read and returns how many were unread
+func (r *NotificationRepository) MarkAllRead(userID uuid.UUID) (int, error) {
+	result, err := r.db.Exec(`
+		UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND read_at IS NULL`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+	updated, _ := result.RowsAffected()
+	return int(updated), nil
+}
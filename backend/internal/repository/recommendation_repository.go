@@ -0,0 +1,158 @@
+// internal/repository/recommendation_repository.go
+// GoNews - Recommendation Repository
+// Item-item co-occurrence storage for "people also read" recommendations
+
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// neighborInsertBatchSize keeps batched inserts well below PostgreSQL's bind parameter limit
+const neighborInsertBatchSize = 1000
+
+// interactionSignalsQuery merges reads and bookmarks into one weighted signal per user/article.
+// Completed reads count more than skims, bookmarks count most; repeated reads are capped.
+const interactionSignalsQuery = `
+	SELECT user_id, article_id, LEAST(SUM(weight), 3.0) AS weight, MAX(interacted_at) AS interacted_at
+	FROM (
+		SELECT user_id, article_id,
+			CASE WHEN completed THEN 1.5 ELSE 1.0 END AS weight,
+			read_at AS interacted_at
+		FROM reading_history
+		WHERE read_at >= $1
+		UNION ALL
+		SELECT user_id, article_id, 2.0 AS weight, bookmarked_at AS interacted_at
+		FROM bookmarks
+		WHERE bookmarked_at >= $1
+	) signals
+	%s
+	GROUP BY user_id, article_id`
+
+// RecommendationRepository handles recommendation database operations
+type RecommendationRepository struct {
+	db *sqlx.DB
+}
+
+// NewRecommendationRepository creates a new recommendation repository
+func NewRecommendationRepository(db *sqlx.DB) *RecommendationRepository {
+	return &RecommendationRepository{db: db}
+}
+
+// ===============================
+// INTERACTION SIGNALS
+// ===============================
+
+// GetInteractionsSince returns weighted user-article interactions inside the sliding window
+func (r *RecommendationRepository) GetInteractionsSince(since time.Time) ([]models.UserInteraction, error) {
+	var interactions []models.UserInteraction
+	query := fmt.Sprintf(interactionSignalsQuery, "") + `
+	ORDER BY user_id, interacted_at DESC`
+
+	if err := r.db.Select(&interactions, query, since); err != nil {
+		return nil, fmt.Errorf("failed to load interactions: %w", err)
+	}
+
+	return interactions, nil
+}
+
+// GetUserInteractions returns a user's weighted interactions inside the window (newest first)
+func (r *RecommendationRepository) GetUserInteractions(userID uuid.UUID, since time.Time, limit int) ([]models.UserInteraction, error) {
+	var interactions []models.UserInteraction
+	query := fmt.Sprintf(interactionSignalsQuery, "WHERE user_id = $2") + `
+	ORDER BY interacted_at DESC
+	LIMIT $3`
+
+	if err := r.db.Select(&interactions, query, since, userID, limit); err != nil {
+		return nil, fmt.Errorf("failed to load user interactions: %w", err)
+	}
+
+	return interactions, nil
+}
+
+// ===============================
+// NEIGHBOUR STORAGE
+// ===============================
+
+// ReplaceNeighbors atomically swaps the neighbour table with a freshly computed set
+func (r *RecommendationRepository) ReplaceNeighbors(neighbors []models.ArticleNeighbor) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM article_neighbors`); err != nil {
+		return fmt.Errorf("failed to clear article neighbors: %w", err)
+	}
+
+	query := `
+		INSERT INTO article_neighbors (
+			article_id, neighbor_article_id, similarity, co_occurrence_count, rank, computed_at
+		) VALUES (
+			:article_id, :neighbor_article_id, :similarity, :co_occurrence_count, :rank, :computed_at
+		)`
+
+	for start := 0; start < len(neighbors); start += neighborInsertBatchSize {
+		end := min(start+neighborInsertBatchSize, len(neighbors))
+		if _, err := tx.NamedExec(query, neighbors[start:end]); err != nil {
+			return fmt.Errorf("failed to insert article neighbors: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit article neighbors: %w", err)
+	}
+
+	return nil
+}
+
+// GetNeighbors returns the top neighbours for each of the given articles
+func (r *RecommendationRepository) GetNeighbors(articleIDs []int, perArticle int) ([]models.ArticleNeighbor, error) {
+	if len(articleIDs) == 0 {
+		return []models.ArticleNeighbor{}, nil
+	}
+
+	var neighbors []models.ArticleNeighbor
+	query := `
+		SELECT n.article_id, n.neighbor_article_id, n.similarity, n.co_occurrence_count, n.rank, n.computed_at
+		FROM article_neighbors n
+		JOIN articles a ON a.id = n.neighbor_article_id
+		WHERE n.article_id = ANY($1) AND n.rank <= $2 AND a.is_active = true
+		ORDER BY n.article_id, n.rank`
+
+	if err := r.db.Select(&neighbors, query, pq.Array(articleIDs), perArticle); err != nil {
+		return nil, fmt.Errorf("failed to get article neighbors: %w", err)
+	}
+
+	return neighbors, nil
+}
+
+// GetNeighborStats returns summary statistics about the stored neighbour table
+func (r *RecommendationRepository) GetNeighborStats() (map[string]interface{}, error) {
+	var stats struct {
+		Articles   int        `db:"articles"`
+		Neighbors  int        `db:"neighbors"`
+		ComputedAt *time.Time `db:"computed_at"`
+	}
+
+	err := r.db.Get(&stats, `
+		SELECT COUNT(DISTINCT article_id) AS articles, COUNT(*) AS neighbors, MAX(computed_at) AS computed_at
+		FROM article_neighbors`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get neighbor stats: %w", err)
+	}
+
+	return map[string]interface{}{
+		"articles_with_neighbors": stats.Articles,
+		"total_neighbors":         stats.Neighbors,
+		"last_computed_at":        stats.ComputedAt,
+	}, nil
+}
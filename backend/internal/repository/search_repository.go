@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"backend/internal/models"
+	"backend/pkg/lang"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
@@ -44,6 +45,12 @@ type SearchResult struct {
 	IsIndian     bool           `db:"is_indian_content"`
 	WordCount    int            `db:"word_count"`
 	ReadingTime  int            `db:"reading_time_minutes"`
+	Language     string         `db:"language"`
+	Summary      *string        `db:"summary"`
+	LowSummary   bool           `db:"summary_low_quality"`
+	QualityScore *float64       `db:"quality_score"`
+	QualityFlags pq.StringArray `db:"quality_flags"`
+	IsExplicit   bool           `db:"is_explicit"`
 	Tags         pq.StringArray `db:"-"`
 }
 
@@ -57,10 +64,18 @@ func NewSearchRepository(db *sqlx.DB) *SearchRepository {
 	return &SearchRepository{db: db}
 }
 
-// SearchArticles performs PostgreSQL full-text search
+// SearchArticles performs PostgreSQL full-text search. English articles are matched with the
+// stemming 'english' configuration; others with 'simple' over the words split at ingest, since
+// the Postgres parser breaks Indic words at vowel signs and viramas.
 func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResult, *SearchMetrics, error) {
+	document := `CASE WHEN a.language = 'en'
+				THEN to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.full_text, a.content, ''))
+				ELSE array_to_tsvector(a.search_tokens) END`
+	tsQuery := `CASE WHEN a.language = 'en' THEN plainto_tsquery('english', $1) ELSE $2::tsquery END`
+	config := `CASE WHEN a.language = 'en' THEN 'english' ELSE 'simple' END::regconfig`
+
 	// Build the search query with proper column selection
-	query := `
+	query := fmt.Sprintf(`
 		SELECT 
 			a.id,
 			a.external_id,
@@ -78,34 +93,39 @@ func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResu
 			a.is_indian_content,
 			a.word_count,
 			a.reading_time_minutes,
+			a.language,
+			a.summary,
+			a.summary_low_quality,
+			a.quality_score,
+			a.quality_flags,
+			a.is_explicit,
 			-- Search ranking and highlighting
-			ts_rank_cd(
-				to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.content, '')),
-				plainto_tsquery('english', $1)
-			) as search_score,
-			ts_rank_cd(
-				to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.content, '')),
-				plainto_tsquery('english', $1)
-			) as relevance_rank,
-			ROW_NUMBER() OVER (ORDER BY ts_rank_cd(
-				to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.content, '')),
-				plainto_tsquery('english', $1)
-			) DESC) as search_rank,
+			ts_rank_cd(%[1]s, %[2]s) as search_score,
+			ts_rank_cd(%[1]s, %[2]s) as relevance_rank,
+			ROW_NUMBER() OVER (ORDER BY ts_rank_cd(%[1]s, %[2]s) DESC) as search_rank,
 			-- Highlighting
-			ts_headline('english', COALESCE(a.title, ''), plainto_tsquery('english', $1), 'MaxWords=10, MinWords=1') as highlight_title,
-			ts_headline('english', COALESCE(a.description, ''), plainto_tsquery('english', $1), 'MaxWords=20, MinWords=1') as highlight_desc
+			ts_headline(%[3]s, COALESCE(a.title, ''), %[2]s, 'MaxWords=10, MinWords=1') as highlight_title,
+			ts_headline(%[3]s, COALESCE(a.description, ''), %[2]s, 'MaxWords=20, MinWords=1') as highlight_desc
 		FROM articles a
 		LEFT JOIN categories c ON a.category_id = c.id
 		WHERE 
 			a.is_active = true
 			AND (
-				to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.content, ''))
-				@@ plainto_tsquery('english', $1)
+				(a.language = 'en' AND
+					to_tsvector('english', COALESCE(a.title, '') || ' ' || COALESCE(a.description, '') || ' ' || COALESCE(a.full_text, a.content, ''))
+					@@ plainto_tsquery('english', $1))
+				OR (a.search_tokens IS NOT NULL AND array_to_tsvector(a.search_tokens) @@ $2::tsquery)
 			)
-	`
+	`, document, tsQuery, config)
 
-	args := []interface{}{filters.Query}
-	argIndex := 2
+	args := []interface{}{filters.Query, simpleTSQuery(filters.Query)}
+	argIndex := 3
+
+	if len(filters.Languages) > 0 {
+		query += fmt.Sprintf(" AND a.language = ANY($%d)", argIndex)
+		args = append(args, pq.Array(filters.Languages))
+		argIndex++
+	}
 
 	// Add filters
 	if filters.IsIndianContent != nil {
@@ -134,6 +154,28 @@ func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResu
 		query += fmt.Sprintf(" AND a.source IN (%s)", strings.Join(placeholders, ","))
 	}
 
+	// Apply the reader's content filter: hidden articles are left out and demoted ones sort last.
+	// Articles without a quality score count as clean.
+	var demoted []string
+	if policy := filters.ContentFilter; policy != nil {
+		if policy.HideBelow > 0 {
+			query += fmt.Sprintf(" AND (a.quality_score IS NULL OR a.quality_score >= $%d::DOUBLE PRECISION)", argIndex)
+			args = append(args, policy.HideBelow)
+			argIndex++
+		}
+		if policy.HideExplicit {
+			query += " AND a.is_explicit = false"
+		}
+		if policy.DemoteBelow > 0 {
+			demoted = append(demoted, fmt.Sprintf("COALESCE(a.quality_score, 1) < $%d::DOUBLE PRECISION", argIndex))
+			args = append(args, policy.DemoteBelow)
+			argIndex++
+		}
+		if policy.DemoteExplicit {
+			demoted = append(demoted, "a.is_explicit")
+		}
+	}
+
 	// Add ordering
 	orderBy := "search_score DESC, a.published_at DESC"
 	if filters.SortBy != "" {
@@ -146,6 +188,9 @@ func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResu
 			orderBy = "a.view_count DESC, search_score DESC"
 		}
 	}
+	if len(demoted) > 0 {
+		orderBy = fmt.Sprintf("(%s) ASC, %s", strings.Join(demoted, " OR "), orderBy)
+	}
 
 	query += fmt.Sprintf(" ORDER BY %s", orderBy)
 
@@ -199,6 +244,12 @@ func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResu
 			IsIndianContent:    sr.IsIndian,
 			WordCount:          sr.WordCount,
 			ReadingTimeMinutes: sr.ReadingTime,
+			Language:           sr.Language,
+			Summary:            sr.Summary,
+			SummaryLowQuality:  sr.LowSummary,
+			QualityScore:       sr.QualityScore,
+			QualityFlags:       sr.QualityFlags,
+			IsExplicit:         sr.IsExplicit,
 			Category:           category,
 			IsActive:           true,
 			Tags:               pq.StringArray{},
@@ -241,6 +292,17 @@ func (r *SearchRepository) SearchArticles(filters *SearchFilters) ([]*SearchResu
 	return results, metrics, nil
 }
 
+// simpleTSQuery ANDs the script-aware words of a search as a tsquery literal; to_tsquery would
+// split Indic words with the Postgres parser
+func simpleTSQuery(query string) string {
+	tokens := lang.Tokens(query)
+	lexemes := make([]string, len(tokens))
+	for i, token := range tokens {
+		lexemes[i] = "'" + strings.ReplaceAll(token, "'", "''") + "'"
+	}
+	return strings.Join(lexemes, " & ")
+}
+
 // Helper methods
 func (r *SearchRepository) countFilters(filters *SearchFilters) int {
 	count := 0
@@ -253,6 +315,9 @@ func (r *SearchRepository) countFilters(filters *SearchFilters) int {
 	if len(filters.Sources) > 0 {
 		count++
 	}
+	if len(filters.Languages) > 0 {
+		count++
+	}
 	return count
 }
 
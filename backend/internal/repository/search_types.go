@@ -5,6 +5,8 @@ package repository
 
 import (
 	"time"
+
+	"backend/internal/models"
 )
 
 // SearchFilters represents search filter criteria
@@ -14,6 +16,7 @@ type SearchFilters struct {
 	Sources           []string   `json:"sources,omitempty"`
 	Authors           []string   `json:"authors,omitempty"`
 	Tags              []string   `json:"tags,omitempty"`
+	Languages         []string   `json:"languages,omitempty"` // ISO 639-1 codes; empty searches every language
 	IsIndianContent   *bool      `json:"is_indian_content,omitempty"`
 	IsFeatured        *bool      `json:"is_featured,omitempty"`
 	MinRelevanceScore *float64   `json:"min_relevance_score,omitempty"`
@@ -30,6 +33,9 @@ type SearchFilters struct {
 	SortOrder         string     `json:"sort_order"`
 	Page              int        `json:"page"`
 	Limit             int        `json:"limit"`
+
+	// Reader's content filter, set by the search service from their filter level
+	ContentFilter *models.ContentFilterPolicy `json:"content_filter,omitempty"`
 }
 
 // SearchMetrics represents search performance metrics
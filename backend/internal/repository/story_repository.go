@@ -0,0 +1,375 @@
+// internal/repository/story_repository.go
+// GoNews - Story Cluster Repository
+// Stores stories (articles about one event) and which articles belong to each
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrStoryNotFound        = errors.New("story not found")
+	ErrStoryAlreadyBreaking = errors.New("story is already breaking news")
+)
+
+// storyArticleColumns are the article fields the clustering job compares
+const storyArticleColumns = `
+	a.id, a.title, a.source, a.category_id, a.relevance_score, a.simhash,
+	COALESCE(a.gdelt_themes, '{}') AS gdelt_themes,
+	COALESCE(a.gdelt_persons, '{}') AS gdelt_persons,
+	COALESCE(a.gdelt_organizations, '{}') AS gdelt_organizations,
+	COALESCE(a.gdelt_locations, '{}') AS gdelt_locations,
+	a.published_at`
+
+// storyColumns lists the stories table columns in scan order
+const storyColumns = `
+	s.id, s.headline, s.lead_article_id, s.category_id, s.article_count, s.source_count,
+	COALESCE(s.themes, '{}') AS themes, COALESCE(s.entities, '{}') AS entities,
+	s.first_published_at, s.last_published_at, s.created_at, s.updated_at,
+	s.is_breaking, s.breaking_at, s.breaking_reason`
+
+// StoryRepository handles story cluster database operations
+type StoryRepository struct {
+	db *sqlx.DB
+}
+
+// NewStoryRepository creates a new story repository
+func NewStoryRepository(db *sqlx.DB) *StoryRepository {
+	return &StoryRepository{db: db}
+}
+
+// ===============================
+// CLUSTERING
+// ===============================
+
+// GetUnclusteredArticles returns articles published since the given time that are in no story, oldest first
+func (r *StoryRepository) GetUnclusteredArticles(since time.Time, limit int) ([]*models.StoryArticle, error) {
+	query := `
+		SELECT ` + storyArticleColumns + `
+		FROM articles a
+		LEFT JOIN story_articles sa ON sa.article_id = a.id
+		WHERE a.is_active = true AND a.published_at >= $1 AND sa.article_id IS NULL
+		ORDER BY a.published_at ASC, a.id ASC
+		LIMIT $2`
+
+	var articles []*models.StoryArticle
+	if err := r.db.Select(&articles, query, since, limit); err != nil {
+		return nil, fmt.Errorf("failed to get unclustered articles: %w", err)
+	}
+	return articles, nil
+}
+
+// GetActiveStoryArticles returns the articles of every story whose newest article was published
+// since the given time, so new articles can be compared with them
+func (r *StoryRepository) GetActiveStoryArticles(since time.Time) ([]*models.StoryArticle, error) {
+	query := `
+		SELECT ` + storyArticleColumns + `, sa.story_id
+		FROM stories s
+		JOIN story_articles sa ON sa.story_id = s.id
+		JOIN articles a ON a.id = sa.article_id
+		WHERE s.last_published_at >= $1
+		ORDER BY sa.story_id, a.published_at ASC`
+
+	var articles []*models.StoryArticle
+	if err := r.db.Select(&articles, query, since); err != nil {
+		return nil, fmt.Errorf("failed to get active story articles: %w", err)
+	}
+	return articles, nil
+}
+
+// CreateStory starts a story with one article as its lead
+func (r *StoryRepository) CreateStory(article *models.StoryArticle, themes, entities []string) (int, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var storyID int
+	err = tx.QueryRow(`
+		INSERT INTO stories (
+			headline, lead_article_id, category_id, article_count, source_count,
+			themes, entities, first_published_at, last_published_at
+		) VALUES ($1, $2, $3, 1, 1, $4, $5, $6, $6)
+		RETURNING id`,
+		article.Title, article.ArticleID, article.CategoryID,
+		pq.Array(themes), pq.Array(entities), article.PublishedAt,
+	).Scan(&storyID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create story: %w", err)
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO story_articles (story_id, article_id, similarity)
+		VALUES ($1, $2, 1)`, storyID, article.ArticleID); err != nil {
+		return 0, fmt.Errorf("failed to add article to new story: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return storyID, nil
+}
+
+// AddToStory adds an article to a story and recomputes the story's counts, time span and
+// lead article (the most relevant one, whose title becomes the headline)
+func (r *StoryRepository) AddToStory(storyID, articleID int, similarity float64, themes, entities []string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`
+		INSERT INTO story_articles (story_id, article_id, similarity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (article_id) DO NOTHING`, storyID, articleID, similarity); err != nil {
+		return fmt.Errorf("failed to add article to story: %w", err)
+	}
+
+	result, err := tx.Exec(`
+		UPDATE stories s SET
+			article_count = agg.article_count,
+			source_count = agg.source_count,
+			first_published_at = agg.first_published_at,
+			last_published_at = agg.last_published_at,
+			lead_article_id = lead.id,
+			headline = lead.title,
+			category_id = lead.category_id,
+			themes = $2,
+			entities = $3,
+			updated_at = CURRENT_TIMESTAMP
+		FROM (
+			SELECT COUNT(*) AS article_count,
+				COUNT(DISTINCT LOWER(a.source)) AS source_count,
+				MIN(a.published_at) AS first_published_at,
+				MAX(a.published_at) AS last_published_at
+			FROM story_articles sa
+			JOIN articles a ON a.id = sa.article_id
+			WHERE sa.story_id = $1
+		) agg, (
+			SELECT a.id, a.title, a.category_id
+			FROM story_articles sa
+			JOIN articles a ON a.id = sa.article_id
+			WHERE sa.story_id = $1
+			ORDER BY a.relevance_score DESC, a.published_at ASC
+			LIMIT 1
+		) lead
+		WHERE s.id = $1`, storyID, pq.Array(themes), pq.Array(entities))
+	if err != nil {
+		return fmt.Errorf("failed to update story: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrStoryNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ===============================
+// STORY PAGES
+// ===============================
+
+// GetStory returns one story
+func (r *StoryRepository) GetStory(id int) (*models.Story, error) {
+	story := &models.Story{}
+	err := r.db.Get(story, `SELECT `+storyColumns+` FROM stories s WHERE s.id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrStoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get story: %w", err)
+	}
+	return story, nil
+}
+
+// GetStoryArticleIDs returns a story's active articles in timeline order, oldest first
+func (r *StoryRepository) GetStoryArticleIDs(storyID int) ([]int, error) {
+	var ids []int
+	err := r.db.Select(&ids, `
+		SELECT a.id
+		FROM story_articles sa
+		JOIN articles a ON a.id = sa.article_id
+		WHERE sa.story_id = $1 AND a.is_active = true
+		ORDER BY a.published_at ASC, a.id ASC`, storyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get story articles: %w", err)
+	}
+	return ids, nil
+}
+
+// ListStories returns the most recently updated stories with at least minArticles articles
+func (r *StoryRepository) ListStories(categoryID *int, minArticles, limit, offset int) ([]*models.Story, error) {
+	query := `
+		SELECT ` + storyColumns + `
+		FROM stories s
+		WHERE s.article_count >= $1 AND ($2::INTEGER IS NULL OR s.category_id = $2)
+		ORDER BY s.last_published_at DESC, s.article_count DESC
+		LIMIT $3 OFFSET $4`
+
+	var stories []*models.Story
+	if err := r.db.Select(&stories, query, minArticles, categoryID, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list stories: %w", err)
+	}
+	return stories, nil
+}
+
+// GetStoryCards returns the story of each given article that has one
+func (r *StoryRepository) GetStoryCards(articleIDs []int) (map[int]*models.StoryCard, error) {
+	cards := make(map[int]*models.StoryCard)
+	if len(articleIDs) == 0 {
+		return cards, nil
+	}
+
+	rows, err := r.db.Queryx(`
+		SELECT sa.article_id, s.id AS story_id, s.headline, s.article_count, s.source_count, s.last_published_at
+		FROM story_articles sa
+		JOIN stories s ON s.id = sa.story_id
+		WHERE sa.article_id = ANY($1)`, pq.Array(articleIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get story cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row struct {
+			ArticleID int `db:"article_id"`
+			models.StoryCard
+		}
+		if err := rows.StructScan(&row); err != nil {
+			return nil, fmt.Errorf("failed to scan story card: %w", err)
+		}
+		card := row.StoryCard
+		cards[row.ArticleID] = &card
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return cards, nil
+}
+
+// ===============================
+// BREAKING NEWS
+// ===============================
+
+// breakingStoryColumns lists the fields of a flagged story in scan order
+const breakingStoryColumns = `
+	s.id, s.headline, s.lead_article_id, s.article_count, s.source_count,
+	COALESCE(s.entities, '{}') AS entities, s.is_breaking, s.breaking_reason, s.breaking_at,
+	COALESCE(s.breaking_event_id, s.id) AS breaking_event_id`
+
+// GetBreakingCandidateArticles returns the active articles published since the given time of
+// stories never flagged as breaking, grouped by story and oldest first
+func (r *StoryRepository) GetBreakingCandidateArticles(since time.Time) ([]*models.BreakingStoryArticle, error) {
+	var articles []*models.BreakingStoryArticle
+	err := r.db.Select(&articles, `
+		SELECT sa.story_id, a.id AS article_id, a.source, a.published_at
+		FROM stories s
+		JOIN story_articles sa ON sa.story_id = s.id
+		JOIN articles a ON a.id = sa.article_id
+		WHERE s.breaking_at IS NULL AND s.last_published_at >= $1
+			AND a.published_at >= $1 AND a.is_active = true
+		ORDER BY sa.story_id, a.published_at ASC, a.id ASC`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get breaking news candidates: %w", err)
+	}
+	return articles, nil
+}
+
+// MarkBreaking flags a story as breaking news and returns it. eventID is an earlier flagged
+// story about the same event, if any. The detector (no editor) only flags stories that were
+// never flagged; editors can also flag a story again after it was cleared.
+func (r *StoryRepository) MarkBreaking(storyID int, reason string, eventID *int, editorID *uuid.UUID) (*models.BreakingStory, error) {
+	story := &models.BreakingStory{}
+	err := r.db.Get(story, `
+		UPDATE stories s SET
+			is_breaking = true,
+			breaking_at = CURRENT_TIMESTAMP,
+			breaking_reason = $2,
+			breaking_by = $4,
+			breaking_event_id = COALESCE($3, s.breaking_event_id, s.id)
+		WHERE s.id = $1 AND s.is_breaking = false AND ($4::UUID IS NOT NULL OR s.breaking_at IS NULL)
+		RETURNING `+breakingStoryColumns, storyID, reason, eventID, editorID)
+	if err == nil {
+		return story, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to mark story as breaking: %w", err)
+	}
+
+	var exists bool
+	if err := r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM stories WHERE id = $1)`, storyID); err != nil {
+		return nil, fmt.Errorf("failed to check story: %w", err)
+	}
+	if !exists {
+		return nil, ErrStoryNotFound
+	}
+	return nil, ErrStoryAlreadyBreaking
+}
+
+// ClearBreaking removes a story's breaking badge. It keeps breaking_at, so the detector does
+// not flag the story again.
+func (r *StoryRepository) ClearBreaking(storyID int) error {
+	result, err := r.db.Exec(`UPDATE stories SET is_breaking = false WHERE id = $1`, storyID)
+	if err != nil {
+		return fmt.Errorf("failed to clear breaking story: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrStoryNotFound
+	}
+	return nil
+}
+
+// GetBreakingStories returns the stories flagged since the given time that are still breaking, newest first
+func (r *StoryRepository) GetBreakingStories(since time.Time) ([]*models.BreakingStory, error) {
+	var stories []*models.BreakingStory
+	err := r.db.Select(&stories, `
+		SELECT `+breakingStoryColumns+`
+		FROM stories s
+		WHERE s.is_breaking = true AND s.breaking_at >= $1
+		ORDER BY s.breaking_at DESC`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get breaking stories: %w", err)
+	}
+	return stories, nil
+}
+
+// GetBreakingArticles returns the articles of the stories flagged since the given time that are still breaking
+func (r *StoryRepository) GetBreakingArticles(since time.Time) ([]models.BreakingArticle, error) {
+	var articles []models.BreakingArticle
+	err := r.db.Select(&articles, `
+		SELECT sa.article_id, s.id AS story_id, s.breaking_reason, s.breaking_at
+		FROM stories s
+		JOIN story_articles sa ON sa.story_id = s.id
+		WHERE s.is_breaking = true AND s.breaking_at >= $1`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get breaking articles: %w", err)
+	}
+	return articles, nil
+}
+
+// CountDetectedBreakingSince counts the stories the detector (not an editor) flagged since the given time
+func (r *StoryRepository) CountDetectedBreakingSince(since time.Time) (int, error) {
+	var count int
+	err := r.db.Get(&count, `
+		SELECT COUNT(*) FROM stories
+		WHERE breaking_at >= $1 AND breaking_by IS NULL AND breaking_reason <> $2`,
+		since, models.BreakingReasonEditor)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count breaking stories: %w", err)
+	}
+	return count, nil
+}
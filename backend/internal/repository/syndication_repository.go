@@ -0,0 +1,177 @@
+// internal/repository/syndication_repository.go
+// GoNews - Outbound Feed Repository
+// Private feed tokens and saved searches backing per-user feeds
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrFeedTokenNotFound   = errors.New("feed token not found")
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+)
+
+// SyndicationRepository handles feed token and saved search database operations
+type SyndicationRepository struct {
+	db *sqlx.DB
+}
+
+// NewSyndicationRepository creates a new syndication repository
+func NewSyndicationRepository(db *sqlx.DB) *SyndicationRepository {
+	return &SyndicationRepository{db: db}
+}
+
+// ===============================
+// FEED TOKENS
+// ===============================
+
+// CreateFeedToken stores a new token by hash
+func (r *SyndicationRepository) CreateFeedToken(token *models.FeedToken) error {
+	err := r.db.QueryRowx(`
+		INSERT INTO feed_tokens (user_id, name, token_hash, token_prefix)
+		VALUES ($1, $2, $3, $4)
+		RETURNING *`,
+		token.UserID, token.Name, token.TokenHash, token.TokenPrefix,
+	).StructScan(token)
+	if err != nil {
+		return fmt.Errorf("failed to create feed token: %w", err)
+	}
+
+	return nil
+}
+
+// GetFeedTokens returns a user's tokens, newest first, including revoked ones
+func (r *SyndicationRepository) GetFeedTokens(userID uuid.UUID) ([]*models.FeedToken, error) {
+	var tokens []*models.FeedToken
+	err := r.db.Select(&tokens, `
+		SELECT * FROM feed_tokens
+		WHERE user_id = $1
+		ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feed tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// CountActiveFeedTokens counts a user's unrevoked tokens
+func (r *SyndicationRepository) CountActiveFeedTokens(userID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.Get(&count, `SELECT COUNT(*) FROM feed_tokens WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count feed tokens: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetActiveFeedTokenByHash resolves an unrevoked token and marks it used
+func (r *SyndicationRepository) GetActiveFeedTokenByHash(tokenHash string) (*models.FeedToken, error) {
+	token := &models.FeedToken{}
+	err := r.db.QueryRowx(`
+		UPDATE feed_tokens SET last_used_at = NOW()
+		WHERE token_hash = $1 AND revoked_at IS NULL
+		RETURNING *`, tokenHash).StructScan(token)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get feed token: %w", err)
+	}
+
+	return token, nil
+}
+
+// RevokeFeedToken revokes one of a user's tokens
+func (r *SyndicationRepository) RevokeFeedToken(userID uuid.UUID, id int) error {
+	result, err := r.db.Exec(`
+		UPDATE feed_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke feed token: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check feed token revocation: %w", err)
+	}
+	if rows == 0 {
+		return ErrFeedTokenNotFound
+	}
+
+	return nil
+}
+
+// ===============================
+// SAVED SEARCHES
+// ===============================
+
+// CreateSavedSearch stores a search for a user
+func (r *SyndicationRepository) CreateSavedSearch(search *models.SavedSearch) error {
+	err := r.db.QueryRowx(`
+		INSERT INTO saved_searches (user_id, name, query, category_slug)
+		VALUES ($1, $2, $3, $4)
+		RETURNING *`,
+		search.UserID, search.Name, search.Query, search.CategorySlug,
+	).StructScan(search)
+	if err != nil {
+		return fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	return nil
+}
+
+// GetSavedSearches returns a user's saved searches, newest first
+func (r *SyndicationRepository) GetSavedSearches(userID uuid.UUID) ([]*models.SavedSearch, error) {
+	var searches []*models.SavedSearch
+	err := r.db.Select(&searches, `
+		SELECT * FROM saved_searches
+		WHERE user_id = $1
+		ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved searches: %w", err)
+	}
+
+	return searches, nil
+}
+
+// GetSavedSearch returns one of a user's saved searches
+func (r *SyndicationRepository) GetSavedSearch(userID uuid.UUID, id int) (*models.SavedSearch, error) {
+	search := &models.SavedSearch{}
+	err := r.db.Get(search, `SELECT * FROM saved_searches WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSavedSearchNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved search: %w", err)
+	}
+
+	return search, nil
+}
+
+// DeleteSavedSearch removes one of a user's saved searches
+func (r *SyndicationRepository) DeleteSavedSearch(userID uuid.UUID, id int) error {
+	result, err := r.db.Exec(`DELETE FROM saved_searches WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check saved search deletion: %w", err)
+	}
+	if rows == 0 {
+		return ErrSavedSearchNotFound
+	}
+
+	return nil
+}
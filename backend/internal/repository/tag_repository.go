@@ -0,0 +1,156 @@
+// internal/repository/tag_repository.go
+// GoNews - Tag Repository
+// Tag catalogue with usage counts, article-to-tag links and tag article listings
+
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"backend/internal/models"
+	"backend/pkg/keywords"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrTagNotFound is returned when no tag has the slug
+var ErrTagNotFound = errors.New("tag not found")
+
+// TagRepository handles tag catalogue database operations
+type TagRepository struct {
+	db *sqlx.DB
+}
+
+// NewTagRepository creates a new tag repository
+func NewTagRepository(db *sqlx.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+// GetTagBySlug returns a catalogue tag
+func (r *TagRepository) GetTagBySlug(slug string) (*models.Tag, error) {
+	var tag models.Tag
+	query := `
+		SELECT id, slug, name, usage_count, created_at, last_used_at
+		FROM tags
+		WHERE slug = $1`
+
+	if err := r.db.Get(&tag, query, slug); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// GetPopularTags returns the tags carried by the most articles
+func (r *TagRepository) GetPopularTags(limit int) ([]models.Tag, error) {
+	tags := []models.Tag{}
+	query := `
+		SELECT id, slug, name, usage_count, created_at, last_used_at
+		FROM tags
+		WHERE usage_count > 0
+		ORDER BY usage_count DESC, last_used_at DESC
+		LIMIT $1`
+
+	if err := r.db.Select(&tags, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to get popular tags: %w", err)
+	}
+	return tags, nil
+}
+
+// GetArticleIDsForTag returns active articles carrying a tag, newest first
+func (r *TagRepository) GetArticleIDsForTag(tagID, limit, offset int) ([]int, error) {
+	ids := []int{}
+	query := `
+		SELECT at.article_id
+		FROM article_tags at
+		JOIN articles a ON a.id = at.article_id
+		WHERE at.tag_id = $1 AND a.is_active = true
+		ORDER BY a.published_at DESC, at.article_id DESC
+		LIMIT $2 OFFSET $3`
+
+	if err := r.db.Select(&ids, query, tagID, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to get tag articles: %w", err)
+	}
+	return ids, nil
+}
+
+// GetRecentArticleTexts returns the headline and description of recent articles, oldest first,
+// to seed the keyword corpus
+func (r *TagRepository) GetRecentArticleTexts(limit int) ([]string, error) {
+	texts := []string{}
+	query := `
+		SELECT title || E'\n' || COALESCE(description, '')
+		FROM articles
+		WHERE is_active = true
+		ORDER BY published_at DESC
+		LIMIT $1`
+
+	if err := r.db.Select(&texts, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to get recent article texts: %w", err)
+	}
+	for i, j := 0, len(texts)-1; i < j; i, j = i+1, j-1 {
+		texts[i], texts[j] = texts[j], texts[i]
+	}
+	return texts, nil
+}
+
+// saveArticleTags replaces the catalogue links of each article, found by external_id, with its
+// Tags, adding new tags to the catalogue, and recounts the usage of every tag gained or lost
+func saveArticleTags(tx *sqlx.Tx, articles []*models.Article) error {
+	deleteQuery := `
+		DELETE FROM article_tags
+		WHERE article_id = (SELECT id FROM articles WHERE external_id = $1)
+		RETURNING tag_id`
+	upsertQuery := `
+		INSERT INTO tags (slug, name) VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET last_used_at = NOW()
+		RETURNING id`
+	insertQuery := `
+		INSERT INTO article_tags (article_id, tag_id, position)
+		SELECT id, $2, $3 FROM articles WHERE external_id = $1
+		ON CONFLICT (article_id, tag_id) DO NOTHING`
+
+	var touched []int
+	for _, article := range articles {
+		if article.ExternalID == nil {
+			continue
+		}
+
+		var removed []int
+		if err := tx.Select(&removed, deleteQuery, *article.ExternalID); err != nil {
+			return fmt.Errorf("failed to clear article tags: %w", err)
+		}
+		touched = append(touched, removed...)
+
+		for i, name := range article.Tags {
+			slug := keywords.Slug(name)
+			if slug == "" {
+				continue
+			}
+			var tagID int
+			if err := tx.Get(&tagID, upsertQuery, slug, name); err != nil {
+				return fmt.Errorf("failed to save tag: %w", err)
+			}
+			if _, err := tx.Exec(insertQuery, *article.ExternalID, tagID, i); err != nil {
+				return fmt.Errorf("failed to save article tag: %w", err)
+			}
+			touched = append(touched, tagID)
+		}
+	}
+
+	if len(touched) == 0 {
+		return nil
+	}
+	recountQuery := `
+		UPDATE tags SET usage_count = (SELECT COUNT(*) FROM article_tags WHERE tag_id = tags.id)
+		WHERE id = ANY($1)`
+	if _, err := tx.Exec(recountQuery, pq.Array(touched)); err != nil {
+		return fmt.Errorf("failed to update tag usage counts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// internal/repository/trending_repository.go
+// GoNews - Trending Repository
+// Bucketed article reads, and the window and baseline activity of articles, entities and tags
+
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// trendSubjectQueries select, for each subject type, the (subject_key, subject_name,
+// article_id, created_at) rows tying subjects to articles created since $1, and the
+// (subject_key, subject_name, mentioned_at) mentions counted towards their velocity
+var trendSubjectQueries = map[string]struct{ subjects, mentions string }{
+	models.TrendSubjectArticle: {
+		subjects: `
+			SELECT a.id::text AS subject_key, a.title AS subject_name, a.id AS article_id, a.created_at
+			FROM articles a
+			WHERE a.created_at >= $1 AND a.is_active = true`,
+		// An article is mentioned again each time another outlet's copy is merged into it
+		mentions: `
+			SELECT s.subject_key, s.subject_name, m.created_at AS mentioned_at
+			FROM subjects s
+			JOIN article_sources m ON m.article_id = s.article_id AND m.created_at >= $1`,
+	},
+	models.TrendSubjectEntity: {
+		subjects: `
+			SELECT DISTINCT e.id AS subject_key, e.id AS subject_name, a.id AS article_id, a.created_at
+			FROM articles a
+			CROSS JOIN LATERAL unnest(a.gdelt_persons || a.gdelt_organizations || a.gdelt_locations) AS e(id)
+			WHERE a.created_at >= $1 AND a.is_active = true`,
+		mentions: `SELECT subject_key, subject_name, created_at AS mentioned_at FROM subjects`,
+	},
+	models.TrendSubjectTag: {
+		subjects: `
+			SELECT t.slug AS subject_key, t.name AS subject_name, a.id AS article_id, a.created_at
+			FROM article_tags link
+			JOIN tags t ON t.id = link.tag_id
+			JOIN articles a ON a.id = link.article_id
+			WHERE a.created_at >= $1 AND a.is_active = true`,
+		mentions: `SELECT subject_key, subject_name, created_at AS mentioned_at FROM subjects`,
+	},
+}
+
+// TrendingRepository handles read buckets and trend aggregation
+type TrendingRepository struct {
+	db *sqlx.DB
+}
+
+// NewTrendingRepository creates a new trending repository
+func NewTrendingRepository(db *sqlx.DB) *TrendingRepository {
+	return &TrendingRepository{db: db}
+}
+
+// RecordReads adds read counts to their article's buckets. Reads of articles that no longer
+// exist are dropped.
+func (r *TrendingRepository) RecordReads(counts []models.ArticleReadCount) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO article_reads (article_id, bucket, reads)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM articles WHERE id = $1)
+		ON CONFLICT (article_id, bucket) DO UPDATE SET reads = article_reads.reads + EXCLUDED.reads`
+
+	for _, count := range counts {
+		if _, err := tx.Exec(query, count.ArticleID, count.Bucket, count.Reads); err != nil {
+			return fmt.Errorf("failed to record article reads: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetTrendCounts returns the subjects of a type whose weighted activity since windowStart is at
+// least minActivity, most active first, with their activity in the buckets from since up to
+// windowStart as the baseline. Activity is reads plus mentionWeight per mention.
+func (r *TrendingRepository) GetTrendCounts(subjectType string, since, windowStart time.Time, bucket time.Duration, mentionWeight, minActivity float64, limit int) ([]models.TrendCounts, error) {
+	queries, ok := trendSubjectQueries[subjectType]
+	if !ok {
+		return nil, fmt.Errorf("unknown trend subject type %q", subjectType)
+	}
+
+	query := fmt.Sprintf(`
+		WITH subjects AS (%s),
+		mentions AS (%s),
+		events AS (
+			SELECT subject_key, subject_name,
+				to_timestamp(floor(extract(epoch FROM mentioned_at)::float8 / $3::float8) * $3::float8) AS bucket,
+				0 AS reads, 1 AS mentions
+			FROM mentions
+			UNION ALL
+			SELECT s.subject_key, s.subject_name, r.bucket, r.reads, 0
+			FROM subjects s
+			JOIN article_reads r ON r.article_id = s.article_id AND r.bucket >= $1
+		),
+		buckets AS (
+			SELECT subject_key, MAX(subject_name) AS subject_name, bucket,
+				SUM(reads) AS reads, SUM(mentions) AS mentions,
+				SUM(reads) + $4::float8 * SUM(mentions) AS activity
+			FROM events
+			GROUP BY subject_key, bucket
+		)
+		SELECT subject_key, MAX(subject_name) AS subject_name,
+			COALESCE(SUM(reads) FILTER (WHERE bucket >= $2), 0) AS reads,
+			COALESCE(SUM(mentions) FILTER (WHERE bucket >= $2), 0) AS mentions,
+			COALESCE(SUM(activity) FILTER (WHERE bucket < $2), 0) AS baseline_activity,
+			COALESCE(SUM(activity * activity) FILTER (WHERE bucket < $2), 0) AS baseline_squares
+		FROM buckets
+		GROUP BY subject_key
+		HAVING COALESCE(SUM(activity) FILTER (WHERE bucket >= $2), 0) >= $5::float8
+		ORDER BY SUM(activity) FILTER (WHERE bucket >= $2) DESC
+		LIMIT $6`, queries.subjects, queries.mentions)
+
+	var counts []models.TrendCounts
+	if err := r.db.Select(&counts, query, since, windowStart, bucket.Seconds(), mentionWeight, minActivity, limit); err != nil {
+		return nil, fmt.Errorf("failed to get %s trend counts: %w", subjectType, err)
+	}
+	return counts, nil
+}
+
+// GetFirstReadBucket returns the earliest read bucket stored, or nil before any read
+func (r *TrendingRepository) GetFirstReadBucket() (*time.Time, error) {
+	var first *time.Time
+	if err := r.db.Get(&first, `SELECT MIN(bucket) FROM article_reads`); err != nil {
+		return nil, fmt.Errorf("failed to get first read bucket: %w", err)
+	}
+	return first, nil
+}
+
+// DeleteReadsBefore removes read buckets older than the given time
+func (r *TrendingRepository) DeleteReadsBefore(before time.Time) (int, error) {
+	result, err := r.db.Exec(`DELETE FROM article_reads WHERE bucket < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old article reads: %w", err)
+	}
+	deleted, _ := result.RowsAffected()
+	return int(deleted), nil
+}
@@ -336,6 +336,7 @@ func (r *UserRepository) SearchUsers(query string, limit, offset int) ([]*models
 // isEmptyUserPreferences checks if UserPreferences is empty/zero value
 func isEmptyUserPreferences(up models.UserPreferences) bool {
 	return up.PreferredLanguage == "" &&
+		len(up.RegionalLanguages) == 0 &&
 		up.DefaultCategory == "" &&
 		up.ArticlesPerPage == 0 &&
 		len(up.PreferredSources) == 0 &&
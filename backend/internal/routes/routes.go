@@ -13,6 +13,7 @@ import (
 	"backend/internal/config"
 	"backend/internal/handlers"
 	"backend/internal/middleware"
+	"backend/internal/models"
 	"backend/internal/repository"
 	"backend/internal/services"
 	"backend/pkg/logger"
@@ -32,11 +33,21 @@ func SetupRoutes(
 	cacheService *services.CacheService,
 	searchService *services.SearchService,
 	quotaManager *services.QuotaManager,
+	recommendationService *services.RecommendationService,
+	experimentService *services.ExperimentService,
+	learningToRankService *services.LearningToRankService,
+	feedService *services.FeedService,
+	syndicationService *services.SyndicationService,
+	extractionService *services.ExtractionService,
+	storyService *services.StoryService,
+	trendingService *services.TrendingService,
+	breakingService *services.BreakingNewsService,
 ) {
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	articleRepo := repository.NewArticleRepository(db)
 	otpRepo := repository.NewOTPRepository(db)
+	engagementRepo := repository.NewEngagementRepository(db)
 
 	// Initialize services
 	authService := services.NewAuthService(userRepo, jwtManager)
@@ -59,6 +70,15 @@ func SetupRoutes(
 		finalPerformanceService *services.PerformanceService
 		finalSearchService      *services.SearchService
 		finalQuotaManager       *services.QuotaManager
+		finalRecommendation     *services.RecommendationService
+		finalExperiments        *services.ExperimentService
+		finalLearningToRank     *services.LearningToRankService
+		finalFeeds              *services.FeedService
+		finalSyndication        *services.SyndicationService
+		finalExtraction         *services.ExtractionService
+		finalStories            *services.StoryService
+		finalTrending           *services.TrendingService
+		finalBreaking           *services.BreakingNewsService
 	)
 
 	if newsService != nil {
@@ -86,6 +106,51 @@ func SetupRoutes(
 		log.Info("Using provided QuotaManager for dashboard integration")
 	}
 
+	if recommendationService != nil {
+		finalRecommendation = recommendationService
+		log.Info("Using provided RecommendationService")
+	}
+
+	if experimentService != nil {
+		finalExperiments = experimentService
+		log.Info("Using provided ExperimentService")
+	}
+
+	if learningToRankService != nil {
+		finalLearningToRank = learningToRankService
+		log.Info("Using provided LearningToRankService")
+	}
+
+	if feedService != nil {
+		finalFeeds = feedService
+		log.Info("Using provided FeedService")
+	}
+
+	if syndicationService != nil {
+		finalSyndication = syndicationService
+		log.Info("Using provided SyndicationService")
+	}
+
+	if extractionService != nil {
+		finalExtraction = extractionService
+		log.Info("Using provided ExtractionService")
+	}
+
+	if storyService != nil {
+		finalStories = storyService
+		log.Info("Using provided StoryService")
+	}
+
+	if trendingService != nil {
+		finalTrending = trendingService
+		log.Info("Using provided TrendingService")
+	}
+
+	if breakingService != nil {
+		finalBreaking = breakingService
+		log.Info("Using provided BreakingNewsService")
+	}
+
 	// Fallback initialization if services not provided
 	if finalCacheService == nil {
 		log.Info("Initializing fallback CacheService...")
@@ -125,6 +190,71 @@ func SetupRoutes(
 		finalQuotaManager = services.NewQuotaManager(cfg, db, rdb, log)
 	}
 
+	if finalRecommendation == nil {
+		// Serving only - the offline neighbour job is started by the owner of the service
+		log.Info("Initializing fallback RecommendationService (serving only)...")
+		finalRecommendation = services.NewRecommendationService(cfg, log, repository.NewRecommendationRepository(db), articleRepo)
+	}
+
+	if finalExperiments == nil {
+		// Exposure/outcome logging needs the background writer running
+		log.Info("Initializing fallback ExperimentService...")
+		finalExperiments = services.NewExperimentService(cfg, log, repository.NewExperimentRepository(db))
+		finalExperiments.Start()
+	}
+
+	if finalLearningToRank == nil {
+		// Publishes into the same pipeline the news service scores with
+		log.Info("Initializing fallback LearningToRankService...")
+		finalLearningToRank = services.NewLearningToRankService(cfg, log, repository.NewLTRRepository(db), articleRepo, finalNewsService.RankingPipeline())
+		finalLearningToRank.Start()
+	}
+
+	if finalFeeds == nil {
+		// Registers the RSS source with the news service and polls due feeds
+		log.Info("Initializing fallback FeedService...")
+		finalFeeds = services.NewFeedService(cfg, log, repository.NewFeedRepository(db), articleRepo, finalNewsService)
+		finalFeeds.Start()
+	}
+
+	if finalSyndication == nil {
+		// Notified by the news service so published feeds refresh and the WebSub hub is pinged
+		log.Info("Initializing fallback SyndicationService...")
+		finalSyndication = services.NewSyndicationService(cfg, log, repository.NewSyndicationRepository(db), articleRepo, engagementRepo, finalSearchService)
+		finalNewsService.SetSyndicationService(finalSyndication)
+		finalSyndication.Start()
+	}
+
+	if finalExtraction == nil {
+		// Works through articles left pending by ingestion
+		log.Info("Initializing fallback ExtractionService...")
+		finalExtraction = services.NewExtractionService(cfg, log, repository.NewExtractionRepository(db))
+		finalExtraction.Start()
+	}
+
+	if finalStories == nil {
+		// Clusters articles into stories for full coverage pages and grouped feeds
+		log.Info("Initializing fallback StoryService...")
+		finalStories = services.NewStoryService(cfg, log, repository.NewStoryRepository(db), articleRepo)
+		finalStories.Start()
+	}
+
+	if finalTrending == nil {
+		// Counts reads and recomputes trends in the background
+		log.Info("Initializing fallback TrendingService...")
+		finalTrending = services.NewTrendingService(cfg, log, repository.NewTrendingRepository(db), articleRepo, finalNewsService.EntityExtractor())
+		finalTrending.Start()
+	}
+	finalNewsService.SetTrendingService(finalTrending)
+
+	if finalBreaking == nil {
+		// Flags bursting stories and fans notifications out to opted-in readers
+		log.Info("Initializing fallback BreakingNewsService...")
+		notificationService := services.NewNotificationService(cfg, log, repository.NewNotificationRepository(db))
+		finalBreaking = services.NewBreakingNewsService(cfg, log, repository.NewStoryRepository(db), articleRepo, notificationService)
+		finalBreaking.Start()
+	}
+
 	// ===============================
 	// INITIALIZE HANDLERS WITH DASHBOARD + SEARCH + OTP + GOOGLE OAUTH SUPPORT
 	// ===============================
@@ -132,6 +262,56 @@ func SetupRoutes(
 	// Enhanced auth handler with OTP services and Google OAuth
 	authHandler := handlers.NewAuthHandler(authService, otpService, emailService, userRepo, googleOAuthService)
 	newsHandler := handlers.NewNewsHandler(finalNewsService, finalCacheService, cfg, log)
+	newsHandler.SetEngagementRepositories(engagementRepo, articleRepo)
+	newsHandler.SetDiversityRanker(services.NewDiversityRanker(cfg, log, finalNewsService.RankingPipeline()))
+	newsHandler.SetExperimentService(finalExperiments)
+	newsHandler.SetLearningToRank(finalLearningToRank)
+	newsHandler.SetStoryService(finalStories)
+	newsHandler.SetTrendingService(finalTrending)
+	newsHandler.SetBreakingNewsService(finalBreaking)
+	newsHandler.SetUserRepository(userRepo)
+
+	// Place filters over the feeds, scoring with the news service's shared ranking pipeline
+	newsHandler.SetFilterService(services.NewFilterService(cfg, log, db, rdb, finalNewsService.RankingPipeline()))
+
+	// Recommendation handler ("Recommended for you" + "People also read")
+	recommendationHandler := handlers.NewRecommendationHandler(finalRecommendation, log)
+	recommendationHandler.SetContentFilter(cfg, userRepo)
+
+	// Ranking handler (admin score breakdowns for the unified ranking pipeline)
+	rankingHandler := handlers.NewRankingHandler(finalNewsService.RankingPipeline(), articleRepo, log)
+
+	// Experiment handler (admin A/B experiment management and results)
+	experimentHandler := handlers.NewExperimentHandler(finalExperiments, log)
+
+	// Learning-to-rank handler (admin training, rollout and rollback)
+	learningToRankHandler := handlers.NewLearningToRankHandler(finalLearningToRank, log)
+
+	// Feed handler (admin RSS/Atom feed list and health)
+	feedHandler := handlers.NewFeedHandler(finalFeeds, log)
+
+	// Extraction handler (admin full-text extraction stats and re-extraction)
+	extractionHandler := handlers.NewExtractionHandler(finalExtraction, log)
+
+	// Story handler (story clusters and their timelines)
+	storyHandler := handlers.NewStoryHandler(finalStories, log)
+	storyHandler.SetContentFilter(cfg, userRepo)
+
+	// Ingestion handler (admin pipeline metrics and dead letter replay)
+	ingestionHandler := handlers.NewIngestionHandler(finalNewsService.IngestionPipeline(), log)
+	classifierHandler := handlers.NewClassifierHandler(finalNewsService.Classifier(), log)
+	entityHandler := handlers.NewEntityHandler(finalNewsService.EntityExtractor(), log)
+	geoHandler := handlers.NewGeoHandler(finalNewsService.Geo(), log)
+	tagHandler := handlers.NewTagHandler(finalNewsService.Tags(), log)
+	trendingHandler := handlers.NewTrendingHandler(finalTrending, log)
+	geoHandler.SetContentFilter(cfg, userRepo)
+	tagHandler.SetContentFilter(cfg, userRepo)
+	trendingHandler.SetContentFilter(cfg, userRepo)
+	breakingHandler := handlers.NewBreakingNewsHandler(finalBreaking, log)
+	notificationHandler := handlers.NewNotificationHandler(finalBreaking.Notifications(), log)
+
+	// Syndication handler (outbound RSS/Atom/JSON feeds + private feed tokens)
+	syndicationHandler := handlers.NewSyndicationHandler(finalSyndication, log)
 
 	// Dashboard handler for API monitoring
 	dashboardHandler := handlers.NewDashboardHandler(
@@ -149,6 +329,8 @@ func SetupRoutes(
 	var searchHandler *handlers.SearchHandler
 	if finalSearchService != nil {
 		searchHandler = handlers.NewSearchHandler(finalSearchService, log)
+		searchHandler.SetLearningToRank(finalLearningToRank, cfg.ExperimentDeviceHeader)
+		searchHandler.SetUserRepository(userRepo)
 		log.Info("Search handler initialized with PostgreSQL full-text search")
 	}
 
@@ -162,6 +344,7 @@ func SetupRoutes(
 	log.Info("All handlers initialized successfully", map[string]interface{}{
 		"auth_handler":         "✅ Enhanced with OTP verification + Google OAuth",
 		"news_handler":         "✅",
+		"recommendation":       "✅ Item-item collaborative filtering",
 		"dashboard_handler":    "✅ API monitoring and debugging",
 		"search_handler":       searchHandler != nil,
 		"performance_handler":  performanceHandler != nil,
@@ -220,7 +403,15 @@ func SetupRoutes(
 	setupAuthRoutesWithOTPAndGoogle(api, authHandler, jwtManager)
 
 	// News routes with database-first integration
-	setupNewsRoutes(api, newsHandler, jwtManager, finalNewsService, log)
+	setupNewsRoutes(api, newsHandler, recommendationHandler, rankingHandler, experimentHandler, learningToRankHandler, feedHandler, extractionHandler, storyHandler, ingestionHandler, classifierHandler, entityHandler, geoHandler, tagHandler, breakingHandler, jwtManager, finalNewsService, log)
+
+	// Tag pages (articles for a normalized tag)
+	setupTagRoutes(api, tagHandler)
+	setupTrendingRoutes(api, trendingHandler)
+	setupNotificationRoutes(api, notificationHandler, jwtManager)
+
+	// Outbound RSS/Atom/JSON feeds and private feed management
+	setupSyndicationRoutes(app, api, syndicationHandler, jwtManager)
 
 	// Search routes with PostgreSQL full-text search
 	if searchHandler != nil {
@@ -527,6 +718,39 @@ func setupPublicRoutes(api fiber.Router, newsHandler *handlers.NewsHandler) {
 	})
 }
 
+// setupSyndicationRoutes configures published feeds and private feed management
+func setupSyndicationRoutes(app *fiber.App, api fiber.Router, syndicationHandler *handlers.SyndicationHandler, jwtManager *auth.JWTManager) {
+	// ===============================
+	// PUBLISHED FEEDS (feed readers, no JWT)
+	// ===============================
+
+	feeds := app.Group("/feeds")
+
+	feeds.Get("/category/:file", syndicationHandler.CategoryFeed)
+	for _, format := range models.FeedFormats {
+		feeds.Get("/trending."+format, syndicationHandler.TrendingFeed)
+		feeds.Get("/search."+format, syndicationHandler.SearchFeed)
+
+		// Private feeds are authorized by the revocable token in the path
+		feeds.Get("/private/:token/bookmarks."+format, syndicationHandler.BookmarksFeed)
+	}
+	feeds.Get("/private/:token/searches/:file", syndicationHandler.SavedSearchFeed)
+
+	// ===============================
+	// FEED TOKENS & SAVED SEARCHES (authenticated)
+	// ===============================
+
+	manage := api.Group("/feeds", middleware.AuthMiddleware(jwtManager))
+
+	manage.Get("/tokens", syndicationHandler.ListFeedTokens)
+	manage.Post("/tokens", syndicationHandler.CreateFeedToken)
+	manage.Delete("/tokens/:id", syndicationHandler.RevokeFeedToken)
+
+	manage.Get("/saved-searches", syndicationHandler.ListSavedSearches)
+	manage.Post("/saved-searches", syndicationHandler.CreateSavedSearch)
+	manage.Delete("/saved-searches/:id", syndicationHandler.DeleteSavedSearch)
+}
+
 // setupSearchRoutes configures all search-related routes with PostgreSQL full-text search
 func setupSearchRoutes(api fiber.Router, searchHandler *handlers.SearchHandler, jwtManager *auth.JWTManager) {
 	// Create search API group
@@ -537,8 +761,10 @@ func setupSearchRoutes(api fiber.Router, searchHandler *handlers.SearchHandler,
 	// ===============================
 
 	// Main search endpoint - PostgreSQL full-text search with ranking
-	search.Get("/", searchHandler.SearchArticles)
-	search.Get("", searchHandler.SearchArticles) // Alternative path
+	// Optional auth so result impressions are attributed to signed-in users
+	optionalAuth := middleware.OptionalAuthMiddleware(jwtManager)
+	search.Get("/", optionalAuth, searchHandler.SearchArticles)
+	search.Get("", optionalAuth, searchHandler.SearchArticles) // Alternative path
 
 	// Content-based search with text ranking
 	search.Get("/content", searchHandler.SearchByContent)
@@ -662,7 +888,7 @@ func setupPerformanceRoutes(api fiber.Router, performanceHandler *handlers.Perfo
 }
 
 // setupNewsRoutes configures all news-related routes with database-first integration
-func setupNewsRoutes(api fiber.Router, newsHandler *handlers.NewsHandler, jwtManager *auth.JWTManager, newsService *services.NewsAggregatorService, log *logger.Logger) {
+func setupNewsRoutes(api fiber.Router, newsHandler *handlers.NewsHandler, recommendationHandler *handlers.RecommendationHandler, rankingHandler *handlers.RankingHandler, experimentHandler *handlers.ExperimentHandler, learningToRankHandler *handlers.LearningToRankHandler, feedHandler *handlers.FeedHandler, extractionHandler *handlers.ExtractionHandler, storyHandler *handlers.StoryHandler, ingestionHandler *handlers.IngestionHandler, classifierHandler *handlers.ClassifierHandler, entityHandler *handlers.EntityHandler, geoHandler *handlers.GeoHandler, tagHandler *handlers.TagHandler, breakingHandler *handlers.BreakingNewsHandler, jwtManager *auth.JWTManager, newsService *services.NewsAggregatorService, log *logger.Logger) {
 	// Create news API group
 	news := api.Group("/news")
 
@@ -732,16 +958,18 @@ func setupNewsRoutes(api fiber.Router, newsHandler *handlers.NewsHandler, jwtMan
 	// ===============================
 
 	// Main news feed (DATABASE-FIRST INTEGRATION!)
-	news.Get("", newsHandler.GetNewsFeed)      // This handles /api/v1/news (without trailing slash)
-	news.Get("/", newsHandler.GetNewsFeed)     // This handles /api/v1/news/ (with trailing slash)
-	news.Get("/feed", newsHandler.GetNewsFeed) // Alternative path
+	// Optional auth so signed-in users are bucketed into feed experiments by user ID
+	optionalAuth := middleware.OptionalAuthMiddleware(jwtManager)
+	news.Get("", optionalAuth, newsHandler.GetNewsFeed)      // This handles /api/v1/news (without trailing slash)
+	news.Get("/", optionalAuth, newsHandler.GetNewsFeed)     // This handles /api/v1/news/ (with trailing slash)
+	news.Get("/feed", optionalAuth, newsHandler.GetNewsFeed) // Alternative path
 
 	// Category-specific news (DATABASE-FIRST INTEGRATION!)
-	news.Get("/category/:category", newsHandler.GetCategoryNews)
+	news.Get("/category/:category", optionalAuth, newsHandler.GetCategoryNews)
 
 	// Search news articles (DATABASE-FIRST INTEGRATION!)
 	// NOTE: This is legacy - main search is now at /api/v1/search
-	news.Get("/search", newsHandler.SearchNews)
+	news.Get("/search", optionalAuth, newsHandler.SearchNews)
 
 	// Get trending news (DATABASE-FIRST INTEGRATION!)
 	news.Get("/trending", newsHandler.GetTrendingNews)
@@ -789,6 +1017,31 @@ func setupNewsRoutes(api fiber.Router, newsHandler *handlers.NewsHandler, jwtMan
 		return newsHandler.GetIndiaFocusedFeed(c)
 	})
 
+	// Recommended for you (collaborative filtering, trending fallback on cold start)
+	news.Get("/recommended", func(c *fiber.Ctx) error {
+		if err := middleware.OptionalAuthMiddleware(jwtManager)(c); err != nil {
+			return err
+		}
+		return recommendationHandler.GetRecommendedFeed(c)
+	})
+
+	// People also read (precomputed item-item neighbours)
+	news.Get("/also-read/:id", recommendationHandler.GetAlsoRead)
+
+	// Single article with "also reported by" outlets
+	news.Get("/article/:id", newsHandler.GetArticle)
+
+	// Story clusters ("full coverage" of one event)
+	news.Get("/stories", storyHandler.GetStories)
+	news.Get("/stories/:id", storyHandler.GetStory)
+
+	// Stories currently flagged as breaking news
+	news.Get("/breaking", breakingHandler.GetBreaking)
+
+	// Local news for a place or the signed-in user's profile location
+	news.Get("/near-me", optionalAuth, geoHandler.GetNearbyNews)
+	news.Get("/places", geoHandler.GetPlaces)
+
 	// ===============================
 	// AUTHENTICATED ENDPOINTS (JWT required)
 	// ===============================
@@ -822,6 +1075,102 @@ func setupNewsRoutes(api fiber.Router, newsHandler *handlers.NewsHandler, jwtMan
 
 	// Live API testing endpoints (admin only)
 	adminNews.Get("/admin/api-status", newsHandler.APISourcesHealthCheck)
+
+	// Recommendation job management (admin only)
+	adminNews.Post("/admin/recommendations/recompute", recommendationHandler.RecomputeRecommendations)
+	adminNews.Get("/admin/recommendations/stats", recommendationHandler.GetRecommendationStats)
+
+	// Ranking pipeline inspection (admin only)
+	adminNews.Get("/admin/ranking/features", rankingHandler.GetRankingFeatures)
+	adminNews.Get("/admin/ranking/explain", rankingHandler.ExplainRanking)
+	adminNews.Get("/admin/ranking/articles/:id", rankingHandler.ExplainArticle)
+	adminNews.Post("/admin/ranking/rescore", rankingHandler.RescoreArticles)
+
+	// A/B experiments (admin only)
+	adminNews.Get("/admin/experiments", experimentHandler.ListExperiments)
+	adminNews.Post("/admin/experiments", experimentHandler.CreateExperiment)
+	adminNews.Put("/admin/experiments/:key/status", experimentHandler.UpdateExperimentStatus)
+	adminNews.Get("/admin/experiments/:key/results", experimentHandler.GetExperimentResults)
+
+	// Learning-to-rank training, rollout and rollback (admin only)
+	adminNews.Get("/admin/ltr/status", learningToRankHandler.GetStatus)
+	adminNews.Get("/admin/ltr/models", learningToRankHandler.ListModels)
+	adminNews.Post("/admin/ltr/train", learningToRankHandler.TrainModel)
+	adminNews.Put("/admin/ltr/models/:id/rollout", learningToRankHandler.UpdateRollout)
+	adminNews.Post("/admin/ltr/rollback", learningToRankHandler.Rollback)
+
+	// RSS/Atom feed list and per-feed health (admin only)
+	adminNews.Get("/admin/feeds", feedHandler.ListFeeds)
+	adminNews.Post("/admin/feeds", feedHandler.CreateFeed)
+	adminNews.Get("/admin/feeds/:id", feedHandler.GetFeed)
+	adminNews.Put("/admin/feeds/:id", feedHandler.UpdateFeed)
+	adminNews.Delete("/admin/feeds/:id", feedHandler.DeleteFeed)
+	adminNews.Post("/admin/feeds/:id/poll", feedHandler.PollFeed)
+
+	// Full-text extraction (admin)
+	adminNews.Get("/admin/extraction/stats", extractionHandler.GetStats)
+	adminNews.Post("/admin/extraction/articles/:id", extractionHandler.ExtractArticle)
+
+	// Story clustering (admin)
+	adminNews.Post("/admin/stories/cluster", storyHandler.ClusterNow)
+
+	// Breaking news detection and editor triggers (admin)
+	adminNews.Post("/admin/breaking/detect", breakingHandler.DetectNow)
+	adminNews.Post("/admin/stories/:id/breaking", breakingHandler.MarkBreaking)
+	adminNews.Delete("/admin/stories/:id/breaking", breakingHandler.ClearBreaking)
+
+	// Ingestion pipeline metrics and dead letters (admin)
+	adminNews.Get("/admin/ingestion/metrics", ingestionHandler.GetMetrics)
+	adminNews.Get("/admin/ingestion/dead-letters", ingestionHandler.ListDeadLetters)
+	adminNews.Post("/admin/ingestion/dead-letters/replay", ingestionHandler.ReplayDeadLetters)
+	adminNews.Get("/admin/ingestion/dead-letters/:id", ingestionHandler.GetDeadLetter)
+	adminNews.Post("/admin/ingestion/dead-letters/:id/replay", ingestionHandler.ReplayDeadLetter)
+	adminNews.Post("/admin/ingestion/dead-letters/:id/discard", ingestionHandler.DiscardDeadLetter)
+
+	// Category classifier and review queue (admin)
+	adminNews.Get("/admin/classifier/status", classifierHandler.GetStatus)
+	adminNews.Post("/admin/classifier/predict", classifierHandler.Predict)
+	adminNews.Get("/admin/classifier/reviews", classifierHandler.ListReviews)
+	adminNews.Post("/admin/classifier/reviews/:id/resolve", classifierHandler.ResolveReview)
+	adminNews.Post("/admin/classifier/reviews/:id/dismiss", classifierHandler.DismissReview)
+
+	// Entity extraction gazetteer (admin)
+	adminNews.Get("/admin/entities/gazetteer", entityHandler.ListGazetteer)
+	adminNews.Post("/admin/entities/gazetteer", entityHandler.SaveGazetteerEntity)
+	adminNews.Delete("/admin/entities/gazetteer/:id", entityHandler.RemoveGazetteerEntity)
+	adminNews.Post("/admin/entities/extract", entityHandler.Extract)
+
+	// Geo-tagging (admin)
+	adminNews.Post("/admin/geo/tag", geoHandler.PreviewTags)
+
+	// Keyword extraction and tag normalization (admin)
+	adminNews.Post("/admin/tags/extract", tagHandler.PreviewTags)
+}
+
+// setupTagRoutes configures the public tag catalogue and tag pages
+func setupTagRoutes(api fiber.Router, tagHandler *handlers.TagHandler) {
+	tags := api.Group("/tags")
+
+	tags.Get("/", tagHandler.GetTags)
+	tags.Get("/:slug", tagHandler.GetTagArticles)
+}
+
+// setupTrendingRoutes configures the velocity trends of articles, entities and tags
+func setupTrendingRoutes(api fiber.Router, trendingHandler *handlers.TrendingHandler) {
+	trending := api.Group("/trending")
+
+	trending.Get("/", trendingHandler.GetTrending)
+	trending.Get("/entities", trendingHandler.GetTrendingEntities)
+	trending.Get("/tags", trendingHandler.GetTrendingTags)
+}
+
+// setupNotificationRoutes configures the signed-in reader's notification inbox
+func setupNotificationRoutes(api fiber.Router, notificationHandler *handlers.NotificationHandler, jwtManager *auth.JWTManager) {
+	notifications := api.Group("/notifications", middleware.AuthMiddleware(jwtManager))
+
+	notifications.Get("/", notificationHandler.GetNotifications)
+	notifications.Post("/read-all", notificationHandler.MarkAllRead)
+	notifications.Post("/:id/read", notificationHandler.MarkRead)
 }
 
 // ===============================
@@ -1140,28 +1489,90 @@ func GetAllRoutes() []RouteInfo {
 		{Method: "DELETE", Path: "/api/v1/auth/account", Description: "Deactivate user account", AuthLevel: "authenticated"},
 
 		// News Routes - Public
-		{Method: "GET", Path: "/api/v1/news", Description: "Get main news feed (database-first)", AuthLevel: "public"},
-		{Method: "GET", Path: "/api/v1/news/", Description: "Get main news feed (database-first)", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news", Description: "Get main news feed (database-first, diversity re-ranked; ?debug=rank explains placements; ?group=story returns one card per story; ?lang=hi,en overrides the reader's languages)", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/", Description: "Get main news feed (database-first, diversity re-ranked; ?debug=rank explains placements)", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/news/feed", Description: "Get main news feed (alternative path)", AuthLevel: "public"},
-		{Method: "GET", Path: "/api/v1/news/category/:category", Description: "Get category-specific news", AuthLevel: "public"},
-		{Method: "GET", Path: "/api/v1/news/search", Description: "Search news articles (legacy)", AuthLevel: "public"},
-		{Method: "GET", Path: "/api/v1/news/trending", Description: "Get trending news", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/category/:category", Description: "Get category-specific news in the reader's languages (?group=story returns one card per story; ?lang=hi,en)", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/search", Description: "Search news articles in the reader's languages (legacy; ?lang=hi,en)", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/trending", Description: "Get the articles whose reads and mentions are bursting", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/news/categories", Description: "Get available categories", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/news/stats", Description: "Get news statistics", AuthLevel: "public"},
 
 		// News Routes - Mixed Auth
 		{Method: "GET", Path: "/api/v1/news/bookmarks", Description: "Get bookmarks (demo for unauth, real for auth)", AuthLevel: "optional"},
 		{Method: "POST", Path: "/api/v1/news/read", Description: "Track article read", AuthLevel: "optional"},
-		{Method: "GET", Path: "/api/v1/news/personalized", Description: "Get personalized feed", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/personalized", Description: "Get personalized feed (diversity re-ranked; ?debug=rank explains placements)", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/recommended", Description: "Get recommended-for-you feed (trending fallback)", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/also-read/:id", Description: "Get articles people also read", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/article/:id", Description: "Get one article with the other outlets that reported it", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/stories", Description: "List recently updated stories (clusters of articles about one event)", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/stories/:id", Description: "Get a story with its articles as a timeline", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/breaking", Description: "List the stories currently flagged as breaking news", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/tags", Description: "List the most used tags", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/tags/:slug", Description: "List the articles carrying a tag, newest first", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/trending", Description: "Articles, entities and tags bursting now, with growth rates", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/trending/entities", Description: "People, organizations and places bursting now", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/trending/tags", Description: "Tags bursting now", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/news/near-me", Description: "Get news near a state, district or city, or the user's profile location", AuthLevel: "optional"},
+		{Method: "GET", Path: "/api/v1/news/places", Description: "List states, or the districts and cities of one (?state=MH)", AuthLevel: "public"},
 
 		// News Routes - Authenticated
 		{Method: "POST", Path: "/api/v1/news/refresh", Description: "Manual news refresh", AuthLevel: "authenticated"},
 		{Method: "POST", Path: "/api/v1/news/bookmarks", Description: "Add bookmark", AuthLevel: "authenticated"},
 		{Method: "DELETE", Path: "/api/v1/news/bookmarks/:id", Description: "Remove bookmark", AuthLevel: "authenticated"},
 		{Method: "GET", Path: "/api/v1/news/history", Description: "Get reading history", AuthLevel: "authenticated"},
+		{Method: "GET", Path: "/api/v1/notifications", Description: "List the reader's notifications with the unread count (?unread=true)", AuthLevel: "authenticated"},
+		{Method: "POST", Path: "/api/v1/notifications/:id/read", Description: "Mark a notification as read", AuthLevel: "authenticated"},
+		{Method: "POST", Path: "/api/v1/notifications/read-all", Description: "Mark all notifications as read", AuthLevel: "authenticated"},
+
+		// News Routes - Admin
+		{Method: "POST", Path: "/api/v1/news/admin/recommendations/recompute", Description: "Recompute recommendation neighbours", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/recommendations/stats", Description: "Get recommendation job statistics", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ranking/features", Description: "Get ranking features and weights", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ranking/explain", Description: "Rank recent articles with per-article score breakdowns", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ranking/articles/:id", Description: "Get an article's ranking score breakdown", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ranking/rescore", Description: "Recompute stored relevance scores with current weights", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/experiments", Description: "List A/B experiments with their variants", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/experiments", Description: "Create an A/B experiment (draft)", AuthLevel: "admin"},
+		{Method: "PUT", Path: "/api/v1/news/admin/experiments/:key/status", Description: "Start, pause or complete an experiment", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/experiments/:key/results", Description: "Per-variant CTR, dwell time and bookmarks with significance tests", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ltr/status", Description: "Learning-to-rank serving model, weights, impressions and last job", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ltr/models", Description: "List trained ranking model versions", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ltr/train", Description: "Train a ranking model from impressions now (?publish=true to roll out)", AuthLevel: "admin"},
+		{Method: "PUT", Path: "/api/v1/news/admin/ltr/models/:id/rollout", Description: "Serve a ranking model to a percentage of users (100 = default)", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ltr/rollback", Description: "Roll back the active ranking model to its baseline weights", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/feeds", Description: "List RSS/Atom feeds with per-feed health stats", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/feeds", Description: "Add an RSS/Atom feed to the polling list", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/feeds/:id", Description: "Get one feed with its health stats", AuthLevel: "admin"},
+		{Method: "PUT", Path: "/api/v1/news/admin/feeds/:id", Description: "Update feed settings, interval or active state", AuthLevel: "admin"},
+		{Method: "DELETE", Path: "/api/v1/news/admin/feeds/:id", Description: "Remove a feed from the polling list", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/feeds/:id/poll", Description: "Poll a feed now regardless of its schedule", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/extraction/stats", Description: "Full-text extraction coverage by status", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/extraction/articles/:id", Description: "Fetch and extract one article's full text now", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/stories/cluster", Description: "Assign unclustered articles to stories now", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/breaking/detect", Description: "Check recent stories for breaking news bursts now", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/stories/:id/breaking", Description: "Flag a story as breaking news and notify opted-in readers", AuthLevel: "admin"},
+		{Method: "DELETE", Path: "/api/v1/news/admin/stories/:id/breaking", Description: "Clear a story's breaking badge", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ingestion/metrics", Description: "Ingestion pipeline counters and latencies per stage", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ingestion/dead-letters", Description: "List items rejected by the ingestion pipeline", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ingestion/dead-letters/replay", Description: "Replay the oldest pending dead letters", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/ingestion/dead-letters/:id", Description: "Inspect one dead letter and its raw payload", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ingestion/dead-letters/:id/replay", Description: "Run one dead letter through the pipeline again", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/ingestion/dead-letters/:id/discard", Description: "Discard a dead letter without replaying it", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/classifier/status", Description: "Loaded category model, held-out scores and review backlog", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/classifier/predict", Description: "Predict categories for a title and description", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/classifier/reviews", Description: "Articles the classifier was unsure about", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/classifier/reviews/:id/resolve", Description: "Set a reviewed article's category", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/classifier/reviews/:id/dismiss", Description: "Dismiss a review, leaving the article uncategorized", AuthLevel: "admin"},
+		{Method: "GET", Path: "/api/v1/news/admin/entities/gazetteer", Description: "List known people, organizations and places", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/entities/gazetteer", Description: "Add or replace a gazetteer entity", AuthLevel: "admin"},
+		{Method: "DELETE", Path: "/api/v1/news/admin/entities/gazetteer/:id", Description: "Remove a custom entity or switch off a built-in one", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/entities/extract", Description: "Preview entities found in a headline and description", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/geo/tag", Description: "Preview the states, districts and cities a headline is tagged with", AuthLevel: "admin"},
+		{Method: "POST", Path: "/api/v1/news/admin/tags/extract", Description: "Preview the keywords and normalized tags an article would get", AuthLevel: "admin"},
 
 		// Search Routes - Public
-		{Method: "GET", Path: "/api/v1/search", Description: "PostgreSQL full-text search", AuthLevel: "public"},
+		{Method: "GET", Path: "/api/v1/search", Description: "PostgreSQL full-text search ('english' for English, 'simple' over script-aware words for Indian languages; ?lang=hi,en)", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/search/content", Description: "Content-based search", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/search/category", Description: "Category-specific search", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/search/suggestions", Description: "Get search suggestions", AuthLevel: "public"},
@@ -1175,6 +1586,21 @@ func GetAllRoutes() []RouteInfo {
 		{Method: "GET", Path: "/api/v1/search/analytics", Description: "Get search analytics", AuthLevel: "authenticated"},
 		{Method: "GET", Path: "/api/v1/search/performance", Description: "Get search performance stats", AuthLevel: "authenticated"},
 
+		// Feed Routes - Published
+		{Method: "GET", Path: "/feeds/category/:slug.{rss,atom,json}", Description: "Latest articles in a category as RSS, Atom or JSON Feed", AuthLevel: "public"},
+		{Method: "GET", Path: "/feeds/trending.{rss,atom,json}", Description: "Trending articles feed", AuthLevel: "public"},
+		{Method: "GET", Path: "/feeds/search.{rss,atom,json}", Description: "Feed of newest articles matching ?q= (optional &category=)", AuthLevel: "public"},
+		{Method: "GET", Path: "/feeds/private/:token/bookmarks.{rss,atom,json}", Description: "Private bookmarks feed authorized by a feed token", AuthLevel: "feed token"},
+		{Method: "GET", Path: "/feeds/private/:token/searches/:id.{rss,atom,json}", Description: "Private saved search feed authorized by a feed token", AuthLevel: "feed token"},
+
+		// Feed Routes - Authenticated
+		{Method: "GET", Path: "/api/v1/feeds/tokens", Description: "List private feed tokens", AuthLevel: "authenticated"},
+		{Method: "POST", Path: "/api/v1/feeds/tokens", Description: "Create a private feed token (secret shown once)", AuthLevel: "authenticated"},
+		{Method: "DELETE", Path: "/api/v1/feeds/tokens/:id", Description: "Revoke a private feed token", AuthLevel: "authenticated"},
+		{Method: "GET", Path: "/api/v1/feeds/saved-searches", Description: "List saved searches published as feeds", AuthLevel: "authenticated"},
+		{Method: "POST", Path: "/api/v1/feeds/saved-searches", Description: "Save a search for feed publishing", AuthLevel: "authenticated"},
+		{Method: "DELETE", Path: "/api/v1/feeds/saved-searches/:id", Description: "Delete a saved search", AuthLevel: "authenticated"},
+
 		// Performance Routes - Public
 		{Method: "GET", Path: "/api/v1/performance/status", Description: "Basic performance status", AuthLevel: "public"},
 		{Method: "GET", Path: "/api/v1/performance/system-metrics", Description: "System metrics", AuthLevel: "public"},
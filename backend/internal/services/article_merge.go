@@ -0,0 +1,132 @@
+// internal/services/article_merge.go
+// GoNews - Duplicate Article Merging
+// Folds duplicates from other outlets into one canonical article instead of discarding them
+
+package services
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"backend/internal/models"
+)
+
+var (
+	// mergeTruncationMarker matches the "[+1234 chars]" suffix providers append to cut-off content
+	mergeTruncationMarker = regexp.MustCompile(`\s*\[\+\d+ chars\]\s*$`)
+
+	// mergePlaceholderImage matches image URLs that are logos or stock fallbacks rather than photos
+	mergePlaceholderImage = regexp.MustCompile(`(?i)logo|placeholder|default|favicon|icon|blank|spacer|no[-_]?image`)
+)
+
+// mergeDuplicateArticle folds a duplicate into the canonical article: best image, longest clean
+// content and description, union of tags and GDELT entities, earliest published date. The
+// duplicate's outlet is remembered in AlsoReportedBy.
+func mergeDuplicateArticle(canonical, duplicate *models.Article) {
+	if canonical == duplicate {
+		return
+	}
+
+	if mergeImageScore(derefString(duplicate.ImageURL)) > mergeImageScore(derefString(canonical.ImageURL)) {
+		canonical.ImageURL = duplicate.ImageURL
+	}
+
+	if len(cleanMergeText(derefString(duplicate.Content))) > len(cleanMergeText(derefString(canonical.Content))) {
+		canonical.Content = duplicate.Content
+		canonical.WordCount = duplicate.WordCount
+		canonical.ReadingTimeMinutes = duplicate.ReadingTimeMinutes
+	}
+	if len(cleanMergeText(derefString(duplicate.Description))) > len(cleanMergeText(derefString(canonical.Description))) {
+		canonical.Description = duplicate.Description
+	}
+	if canonical.Author == nil || *canonical.Author == "" {
+		canonical.Author = duplicate.Author
+	}
+
+	if !duplicate.PublishedAt.IsZero() && (canonical.PublishedAt.IsZero() || duplicate.PublishedAt.Before(canonical.PublishedAt)) {
+		canonical.PublishedAt = duplicate.PublishedAt
+	}
+
+	canonical.Tags = unionStrings(canonical.Tags, duplicate.Tags)
+	canonical.GDELTThemes = unionStrings(canonical.GDELTThemes, duplicate.GDELTThemes)
+	canonical.GDELTOrganizations = unionStrings(canonical.GDELTOrganizations, duplicate.GDELTOrganizations)
+	canonical.GDELTPersons = unionStrings(canonical.GDELTPersons, duplicate.GDELTPersons)
+	canonical.GDELTLocations = unionStrings(canonical.GDELTLocations, duplicate.GDELTLocations)
+	if canonical.GDELTTone == nil {
+		canonical.GDELTTone = duplicate.GDELTTone
+	}
+	canonical.IsIndianContent = canonical.IsIndianContent || duplicate.IsIndianContent
+
+	var title *string
+	if duplicate.Title != "" {
+		title = &duplicate.Title
+	}
+	addAlsoReportedBy(canonical, models.ArticleSource{
+		Source:      duplicate.Source,
+		URL:         duplicate.URL,
+		ExternalID:  duplicate.ExternalID,
+		Title:       title,
+		PublishedAt: duplicate.PublishedAt,
+	})
+	for _, source := range duplicate.AlsoReportedBy {
+		addAlsoReportedBy(canonical, source)
+	}
+}
+
+// addAlsoReportedBy records another outlet once, skipping the canonical article's own outlet and URL
+func addAlsoReportedBy(canonical *models.Article, source models.ArticleSource) {
+	if source.URL == "" || source.URL == canonical.URL || strings.EqualFold(source.Source, canonical.Source) {
+		return
+	}
+	for _, existing := range canonical.AlsoReportedBy {
+		if existing.URL == source.URL || strings.EqualFold(existing.Source, source.Source) {
+			return
+		}
+	}
+	canonical.AlsoReportedBy = append(canonical.AlsoReportedBy, source)
+}
+
+// mergeImageScore prefers real photos over logos and HTTPS over plain HTTP
+func mergeImageScore(imageURL string) int {
+	if imageURL == "" {
+		return 0
+	}
+	score := 2
+	if !mergePlaceholderImage.MatchString(imageURL) {
+		score += 2
+	}
+	if strings.HasPrefix(imageURL, "https://") {
+		score++
+	}
+	return score
+}
+
+// cleanMergeText strips markup and truncation markers so lengths compare actual text
+func cleanMergeText(text string) string {
+	text = cleanFeedText(text)
+	text = mergeTruncationMarker.ReplaceAllString(text, "")
+	return strings.TrimSpace(strings.TrimRight(text, ".… "))
+}
+
+// unionStrings appends values not already present, ignoring case, keeping first-seen order
+func unionStrings(base, extra pq.StringArray) pq.StringArray {
+	if len(extra) == 0 {
+		return base
+	}
+
+	seen := make(map[string]bool, len(base)+len(extra))
+	merged := make(pq.StringArray, 0, len(base)+len(extra))
+	for _, values := range []pq.StringArray{base, extra} {
+		for _, value := range values {
+			key := strings.ToLower(strings.TrimSpace(value))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
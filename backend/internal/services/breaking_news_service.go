@@ -0,0 +1,475 @@
+// internal/services/breaking_news_service.go
+// GoNews - Breaking News Detection
+// Flags a story as breaking when many sources, or several high-trust ones, publish on it within
+// minutes, or when an editor says so; badges its articles in feeds and notifies opted-in readers
+
+package services
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// A story is about the same event as an earlier flagged one when they share at least
+	// breakingEventMinShared entities and breakingEventOverlap of their combined entities
+	breakingEventMinShared = 2
+	breakingEventOverlap   = 0.5
+)
+
+// breakingBurst is the strongest burst found in one story's recent articles
+type breakingBurst struct {
+	storyID int
+	sources int // Most distinct sources within one window
+	trusted int // Most distinct trusted sources within one window
+	reason  string
+}
+
+// BreakingNewsService detects breaking stories, badges their articles and sends notifications
+type BreakingNewsService struct {
+	config        *config.Config
+	logger        *logger.Logger
+	stories       *repository.StoryRepository
+	articleRepo   *repository.ArticleRepository
+	notifications *NotificationService
+
+	flagMutex sync.Mutex // One detection pass or editor change at a time
+
+	badges     map[int]*models.BreakingBadge // Article ID -> badge of its breaking story
+	badgeMutex sync.RWMutex
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewBreakingNewsService creates the breaking news service
+func NewBreakingNewsService(cfg *config.Config, log *logger.Logger, stories *repository.StoryRepository, articleRepo *repository.ArticleRepository, notifications *NotificationService) *BreakingNewsService {
+	return &BreakingNewsService{
+		config:        cfg,
+		logger:        log,
+		stories:       stories,
+		articleRepo:   articleRepo,
+		notifications: notifications,
+		badges:        make(map[int]*models.BreakingBadge),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Notifications returns the notification service breaking news is sent through
+func (s *BreakingNewsService) Notifications() *NotificationService {
+	return s.notifications
+}
+
+// Start loads the current badges and begins checking recent stories in the background
+func (s *BreakingNewsService) Start() {
+	s.loadBadges()
+
+	if !s.config.BreakingNewsEnabled {
+		s.logger.Info("Breaking news detection disabled by configuration")
+		return
+	}
+
+	interval := time.Duration(maxInt(10, s.config.BreakingCheckSeconds)) * time.Second
+
+	s.wg.Add(1)
+	go s.run(interval)
+
+	s.logger.Info("Breaking news detection started", map[string]interface{}{
+		"interval":         interval.String(),
+		"window_minutes":   s.config.BreakingWindowMinutes,
+		"min_sources":      s.config.BreakingMinSources,
+		"trusted_sources":  s.config.BreakingTrustedSources,
+		"trust_threshold":  s.config.BreakingTrustThreshold,
+		"max_flags_per_hr": s.config.BreakingMaxPerHour,
+	})
+}
+
+// run runs one detection pass per tick
+func (s *BreakingNewsService) run(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.Detect(); err != nil {
+				s.logger.Error("Breaking news detection pass failed", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+			// Also expires badges older than BREAKING_BADGE_HOURS
+			s.loadBadges()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// Close stops the background detector
+func (s *BreakingNewsService) Close() {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Breaking news service stopped")
+}
+
+// ===============================
+// DETECTION
+// ===============================
+
+// Detect flags the recent stories whose sources burst and notifies readers about them. At most
+// BREAKING_MAX_PER_HOUR stories are flagged per hour; stronger bursts go first and the rest are
+// left for a later pass.
+func (s *BreakingNewsService) Detect() (*models.BreakingRun, error) {
+	s.flagMutex.Lock()
+	defer s.flagMutex.Unlock()
+
+	startTime := time.Now()
+	lookback := time.Duration(maxInt(1, s.config.BreakingLookbackMinutes)) * time.Minute
+
+	articles, err := s.stories.GetBreakingCandidateArticles(startTime.Add(-lookback))
+	if err != nil {
+		return nil, err
+	}
+
+	byStory := make(map[int][]*models.BreakingStoryArticle)
+	for _, article := range articles {
+		byStory[article.StoryID] = append(byStory[article.StoryID], article)
+	}
+
+	bursts := make([]*breakingBurst, 0)
+	for storyID, storyArticles := range byStory {
+		if burst := s.burst(storyID, storyArticles); burst != nil {
+			bursts = append(bursts, burst)
+		}
+	}
+	sort.Slice(bursts, func(i, j int) bool {
+		if bursts[i].sources != bursts[j].sources {
+			return bursts[i].sources > bursts[j].sources
+		}
+		if bursts[i].trusted != bursts[j].trusted {
+			return bursts[i].trusted > bursts[j].trusted
+		}
+		return bursts[i].storyID < bursts[j].storyID
+	})
+
+	run := &models.BreakingRun{Stories: len(byStory)}
+	if len(bursts) > 0 {
+		flaggedLastHour, err := s.stories.CountDetectedBreakingSince(startTime.Add(-time.Hour))
+		if err != nil {
+			return nil, err
+		}
+		recent, err := s.recentBreaking()
+		if err != nil {
+			return nil, err
+		}
+
+		budget := s.config.BreakingMaxPerHour - flaggedLastHour
+		for _, burst := range bursts {
+			if budget <= 0 {
+				run.Deferred++
+				continue
+			}
+
+			story, err := s.flag(burst, recent)
+			if err != nil {
+				if !errors.Is(err, repository.ErrStoryAlreadyBreaking) {
+					s.logger.Warn("Failed to flag breaking story", map[string]interface{}{
+						"story_id": burst.storyID,
+						"error":    err.Error(),
+					})
+				}
+				continue
+			}
+			budget--
+			run.Flagged++
+			recent = append(recent, story)
+			run.Notified += s.notify(story)
+
+			s.logger.Info("Story flagged as breaking news", map[string]interface{}{
+				"story_id": story.ID,
+				"event_id": story.EventID,
+				"reason":   story.Reason,
+				"sources":  burst.sources,
+				"trusted":  burst.trusted,
+				"headline": story.Headline,
+			})
+		}
+	}
+
+	if run.Flagged > 0 {
+		s.loadBadges()
+	}
+	if run.Deferred > 0 {
+		s.logger.Warn("Breaking news flags deferred by the hourly limit", map[string]interface{}{
+			"deferred":     run.Deferred,
+			"max_per_hour": s.config.BreakingMaxPerHour,
+		})
+	}
+
+	run.DurationMs = time.Since(startTime).Milliseconds()
+	return run, nil
+}
+
+// burst finds the most distinct sources, and trusted sources, that published on a story within
+// one BREAKING_WINDOW_MINUTES span, and returns nil unless either is enough to flag it.
+// Articles come oldest first.
+func (s *BreakingNewsService) burst(storyID int, articles []*models.BreakingStoryArticle) *breakingBurst {
+	window := time.Duration(maxInt(1, s.config.BreakingWindowMinutes)) * time.Minute
+
+	best := &breakingBurst{storyID: storyID}
+	inWindow := make(map[string]int)
+	left := 0
+	for _, article := range articles {
+		inWindow[normalizeSourceName(article.Source)]++
+		for article.PublishedAt.Sub(articles[left].PublishedAt) > window {
+			source := normalizeSourceName(articles[left].Source)
+			inWindow[source]--
+			if inWindow[source] == 0 {
+				delete(inWindow, source)
+			}
+			left++
+		}
+
+		sources, trusted := 0, 0
+		for source := range inWindow {
+			if source == "" {
+				continue
+			}
+			sources++
+			if trust, _, rated := ratedSourceTrust(source); rated && trust >= s.config.BreakingTrustThreshold {
+				trusted++
+			}
+		}
+		best.sources = maxInt(best.sources, sources)
+		best.trusted = maxInt(best.trusted, trusted)
+	}
+
+	switch {
+	case best.sources >= maxInt(2, s.config.BreakingMinSources):
+		best.reason = models.BreakingReasonSources
+	case best.trusted >= maxInt(2, s.config.BreakingTrustedSources):
+		best.reason = models.BreakingReasonTrustedSources
+	default:
+		return nil
+	}
+	return best
+}
+
+// flag marks a burst's story as breaking, under the event of a recent breaking story about
+// the same thing if there is one
+func (s *BreakingNewsService) flag(burst *breakingBurst, recent []*models.BreakingStory) (*models.BreakingStory, error) {
+	current, err := s.stories.GetStory(burst.storyID)
+	if err != nil {
+		return nil, err
+	}
+	return s.stories.MarkBreaking(burst.storyID, burst.reason, sameEvent(burst.storyID, current.Entities, recent), nil)
+}
+
+// recentBreaking returns the stories flagged within BREAKING_EVENT_HOURS, which later stories
+// about the same event are deduplicated against
+func (s *BreakingNewsService) recentBreaking() ([]*models.BreakingStory, error) {
+	eventWindow := time.Duration(maxInt(1, s.config.BreakingEventHours)) * time.Hour
+	return s.stories.GetBreakingStories(time.Now().Add(-eventWindow))
+}
+
+// sameEvent returns the event of the recent breaking story that shares most of a story's
+// entities, or nil when the story is about a new event
+func sameEvent(storyID int, entities []string, recent []*models.BreakingStory) *int {
+	own := make(map[string]bool, len(entities))
+	for _, entity := range entities {
+		own[strings.ToLower(entity)] = true
+	}
+	if len(own) == 0 {
+		return nil
+	}
+
+	var event *int
+	bestOverlap := 0.0
+	for _, other := range recent {
+		if other.ID == storyID {
+			continue
+		}
+
+		union := make(map[string]bool, len(own)+len(other.Entities))
+		shared := 0
+		for _, entity := range other.Entities {
+			key := strings.ToLower(entity)
+			if own[key] && !union[key] {
+				shared++
+			}
+			union[key] = true
+		}
+		for key := range own {
+			union[key] = true
+		}
+		if shared < breakingEventMinShared {
+			continue
+		}
+		if overlap := float64(shared) / float64(len(union)); overlap >= breakingEventOverlap && overlap > bestOverlap {
+			eventID := other.EventID
+			event, bestOverlap = &eventID, overlap
+		}
+	}
+	return event
+}
+
+// notify sends a flagged story's notifications, logging failures so flagging still counts
+func (s *BreakingNewsService) notify(story *models.BreakingStory) int {
+	if s.notifications == nil {
+		return 0
+	}
+
+	sent, err := s.notifications.NotifyBreaking(story)
+	if err != nil {
+		s.logger.Warn("Failed to send breaking news notifications", map[string]interface{}{
+			"story_id": story.ID,
+			"error":    err.Error(),
+		})
+		return 0
+	}
+	return sent
+}
+
+// ===============================
+// EDITOR TRIGGERS
+// ===============================
+
+// MarkBreaking flags a story as breaking news on an editor's say-so and notifies readers. The
+// hourly flag limit does not apply, but readers' notification limits and event dedupe do.
+func (s *BreakingNewsService) MarkBreaking(storyID int, editorID uuid.UUID) (*models.BreakingFlagResult, error) {
+	s.flagMutex.Lock()
+	defer s.flagMutex.Unlock()
+
+	current, err := s.stories.GetStory(storyID)
+	if err != nil {
+		return nil, err
+	}
+	recent, err := s.recentBreaking()
+	if err != nil {
+		return nil, err
+	}
+
+	story, err := s.stories.MarkBreaking(storyID, models.BreakingReasonEditor, sameEvent(storyID, current.Entities, recent), &editorID)
+	if err != nil {
+		return nil, err
+	}
+	s.loadBadges()
+
+	s.logger.Info("Story flagged as breaking news by an editor", map[string]interface{}{
+		"story_id":  story.ID,
+		"event_id":  story.EventID,
+		"editor_id": editorID.String(),
+		"headline":  story.Headline,
+	})
+
+	return &models.BreakingFlagResult{Story: story, Notified: s.notify(story)}, nil
+}
+
+// ClearBreaking removes a story's breaking badge. The detector won't flag it again.
+func (s *BreakingNewsService) ClearBreaking(storyID int) error {
+	s.flagMutex.Lock()
+	defer s.flagMutex.Unlock()
+
+	if err := s.stories.ClearBreaking(storyID); err != nil {
+		return err
+	}
+	s.loadBadges()
+	return nil
+}
+
+// ===============================
+// BADGES & LISTING
+// ===============================
+
+// badgeSince is when the oldest story still wearing its breaking badge was flagged
+func (s *BreakingNewsService) badgeSince() time.Time {
+	return time.Now().Add(-time.Duration(maxInt(1, s.config.BreakingBadgeHours)) * time.Hour)
+}
+
+// loadBadges reloads which articles belong to breaking stories
+func (s *BreakingNewsService) loadBadges() {
+	articles, err := s.stories.GetBreakingArticles(s.badgeSince())
+	if err != nil {
+		s.logger.Warn("Failed to load breaking news badges", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	badges := make(map[int]*models.BreakingBadge, len(articles))
+	for _, article := range articles {
+		badges[article.ArticleID] = &models.BreakingBadge{
+			StoryID: article.StoryID,
+			Reason:  article.Reason,
+			Since:   article.BreakingAt,
+		}
+	}
+
+	s.badgeMutex.Lock()
+	s.badges = badges
+	s.badgeMutex.Unlock()
+}
+
+// Annotate sets the breaking badge on articles whose story is breaking. Feed articles may be
+// shared with the cache, so badges go on copies.
+func (s *BreakingNewsService) Annotate(articles []*models.Article) []*models.Article {
+	s.badgeMutex.RLock()
+	defer s.badgeMutex.RUnlock()
+
+	if len(s.badges) == 0 {
+		return articles
+	}
+
+	annotated := make([]*models.Article, len(articles))
+	for i, article := range articles {
+		annotated[i] = article
+		if badge := s.badges[article.ID]; badge != nil {
+			badged := *article
+			badged.Breaking = badge
+			annotated[i] = &badged
+		}
+	}
+	return annotated
+}
+
+// ListBreaking returns the stories currently wearing the breaking badge with their lead articles, newest first
+func (s *BreakingNewsService) ListBreaking() ([]*models.BreakingStory, error) {
+	stories, err := s.stories.GetBreakingStories(s.badgeSince())
+	if err != nil {
+		return nil, err
+	}
+
+	leadIDs := make([]int, 0, len(stories))
+	for _, story := range stories {
+		if story.LeadArticleID != nil {
+			leadIDs = append(leadIDs, *story.LeadArticleID)
+		}
+	}
+	leads, err := s.articleRepo.GetArticlesByIDs(leadIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*models.Article, len(leads))
+	for _, article := range leads {
+		byID[article.ID] = article
+	}
+	for _, story := range stories {
+		if story.LeadArticleID != nil {
+			story.LeadArticle = byID[*story.LeadArticleID]
+		}
+	}
+	return stories, nil
+}
@@ -0,0 +1,391 @@
+// internal/services/category_classifier.go
+// GoNews - Category Classifier
+// Assigns categories to new articles with a Naive Bayes model trained on categorized articles;
+// predictions below the confidence threshold are queued for an editor instead of guessed
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+	"backend/pkg/textclass"
+)
+
+const (
+	// classifierReloadInterval is how often the model file is checked for a retrained model
+	classifierReloadInterval = time.Minute
+
+	// classifierMinExamples is the smallest training set worth fitting a model to
+	classifierMinExamples = 50
+)
+
+// classifierExcludedLabels are catch-all categories that describe placement, not topic
+var classifierExcludedLabels = []string{"top-stories"}
+
+// ClassifierTrainOptions control a training run
+type ClassifierTrainOptions struct {
+	Since         time.Time // Only articles published after this are used
+	IncludeLegacy bool      // Also learn from articles categorized before category_source existed
+	Holdout       float64   // Share of examples held out to measure the model
+	Alpha         float64   // Smoothing; textclass default when zero
+	MinDocFreq    int       // Minimum documents per feature; textclass default when zero
+	MaxFeatures   int       // Vocabulary cap; unlimited when zero
+	DryRun        bool      // Train and evaluate without saving or loading the model
+}
+
+// CategoryClassifier predicts article categories. Until a model has been trained it falls
+// back to keyword rules, and it picks up a retrained model file without a restart.
+type CategoryClassifier struct {
+	config *config.Config
+	logger *logger.Logger
+	repo   *repository.ClassifierRepository
+
+	mutex       sync.RWMutex
+	model       *textclass.Model
+	modelTime   time.Time // Modification time of the loaded model file
+	lastChecked time.Time
+}
+
+// NewCategoryClassifier creates the classifier and loads the model file if there is one
+func NewCategoryClassifier(cfg *config.Config, log *logger.Logger, repo *repository.ClassifierRepository) *CategoryClassifier {
+	classifier := &CategoryClassifier{
+		config: cfg,
+		logger: log,
+		repo:   repo,
+	}
+	classifier.reload()
+	return classifier
+}
+
+// ===============================
+// PREDICTION
+// ===============================
+
+// Classify predicts the category of an article from its title and description
+func (c *CategoryClassifier) Classify(title, description string) *models.CategoryPrediction {
+	model := c.currentModel()
+	if model == nil {
+		return &models.CategoryPrediction{
+			Category: keywordCategory(title, description),
+			Source:   models.CategorySourceKeyword,
+		}
+	}
+
+	predictions := model.Predict(classifierText(title, description))
+	prediction := &models.CategoryPrediction{
+		Category:     predictions[0].Label,
+		Confidence:   roundScore(predictions[0].Confidence),
+		Source:       models.CategorySourceModel,
+		ModelVersion: model.Version,
+		NeedsReview:  predictions[0].Confidence < c.config.ClassifierMinConfidence,
+	}
+	for i, p := range predictions {
+		score := models.CategoryScore{Category: p.Label, Confidence: roundScore(p.Confidence)}
+		prediction.Scores = append(prediction.Scores, score)
+		if i == 0 || p.Confidence >= c.config.ClassifierSecondaryConfidence {
+			prediction.Labels = append(prediction.Labels, score)
+		}
+	}
+	return prediction
+}
+
+// classifierText is what the model sees; the headline is repeated because it says more
+// about the topic than the rest of the description
+func classifierText(title, description string) string {
+	return title + " " + title + " " + cleanFeedText(description)
+}
+
+// keywordCategory is the rule-based category used until a model has been trained
+func keywordCategory(title, description string) string {
+	content := strings.ToLower(title + " " + description)
+
+	if strings.Contains(content, "politics") || strings.Contains(content, "government") ||
+		strings.Contains(content, "election") || strings.Contains(content, "congress") ||
+		strings.Contains(content, "bjp") || strings.Contains(content, "modi") {
+		return "politics"
+	}
+	if strings.Contains(content, "business") || strings.Contains(content, "stock") ||
+		strings.Contains(content, "market") || strings.Contains(content, "economy") ||
+		strings.Contains(content, "ipo") || strings.Contains(content, "gst") {
+		return "business"
+	}
+	if strings.Contains(content, "cricket") || strings.Contains(content, "sports") ||
+		strings.Contains(content, "ipl") {
+		return "sports"
+	}
+	if strings.Contains(content, "technology") || strings.Contains(content, "tech") {
+		return "technology"
+	}
+
+	return "top-stories" // Default fallback
+}
+
+// ===============================
+// MODEL LOADING
+// ===============================
+
+// currentModel returns the loaded model, reloading it first if the file changed
+func (c *CategoryClassifier) currentModel() *textclass.Model {
+	c.mutex.RLock()
+	model, due := c.model, time.Since(c.lastChecked) >= classifierReloadInterval
+	c.mutex.RUnlock()
+
+	if due {
+		c.reload()
+		c.mutex.RLock()
+		model = c.model
+		c.mutex.RUnlock()
+	}
+	return model
+}
+
+// reload loads the model file when it is newer than the loaded model. A missing or broken
+// file leaves the current model in place.
+func (c *CategoryClassifier) reload() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lastChecked = time.Now()
+
+	info, err := os.Stat(c.config.ClassifierModelPath)
+	if err != nil {
+		if c.model == nil && !errors.Is(err, os.ErrNotExist) {
+			c.logger.Warn("Category model unavailable, using keyword rules", map[string]interface{}{
+				"path":  c.config.ClassifierModelPath,
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+	if c.model != nil && !info.ModTime().After(c.modelTime) {
+		return
+	}
+
+	model, err := textclass.Load(c.config.ClassifierModelPath)
+	if err != nil {
+		c.logger.Warn("Failed to load category model", map[string]interface{}{
+			"path":  c.config.ClassifierModelPath,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.model = model
+	c.modelTime = info.ModTime()
+	c.logger.Info("Category model loaded", map[string]interface{}{
+		"version":   model.Version,
+		"labels":    model.Labels,
+		"features":  len(model.Features),
+		"documents": model.Documents,
+	})
+}
+
+// ===============================
+// TRAINING
+// ===============================
+
+// Train fits a model on categorized articles. Held-out articles measure a model trained on
+// the rest; the saved model is then fitted on every example. Unless this is a dry run the
+// model file is replaced and the new model is used straight away.
+func (c *CategoryClassifier) Train(opts ClassifierTrainOptions) (*models.ClassifierTrainingReport, error) {
+	startTime := time.Now()
+
+	rows, err := c.repo.GetTrainingExamples(opts.Since, opts.IncludeLegacy, classifierExcludedLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ClassifierTrainingReport{
+		ModelPath:   c.config.ClassifierModelPath,
+		LabelCounts: make(map[string]int),
+	}
+	examples := make([]textclass.Example, 0, len(rows))
+	for _, row := range rows {
+		labels := []string{row.CategorySlug}
+		// Secondary categories count only when an editor chose them, not the model
+		if row.CategorySource != nil && *row.CategorySource == models.CategorySourceReview {
+			for _, label := range row.CategoryLabels {
+				if !isExcludedLabel(label.Category) {
+					labels = append(labels, label.Category)
+				}
+			}
+		}
+		for _, label := range labels {
+			report.LabelCounts[label]++
+		}
+		examples = append(examples, textclass.Example{
+			Text:   classifierText(row.Title, derefString(row.Description)),
+			Labels: labels,
+		})
+	}
+	report.Examples = len(examples)
+	if len(examples) < classifierMinExamples {
+		return report, fmt.Errorf("only %d categorized articles to train on, need at least %d", len(examples), classifierMinExamples)
+	}
+
+	trainOpts := textclass.Options{Alpha: opts.Alpha, MinDocFreq: opts.MinDocFreq, MaxFeatures: opts.MaxFeatures}
+
+	train, holdout := textclass.Split(examples, opts.Holdout)
+	report.TrainExamples = len(train)
+	report.HoldoutExamples = len(holdout)
+	var evaluation *textclass.Evaluation
+	if len(holdout) > 0 {
+		measured, err := textclass.Train(train, trainOpts)
+		if err != nil {
+			return report, fmt.Errorf("failed to train evaluation model: %w", err)
+		}
+		evaluation = measured.Evaluate(holdout)
+		report.Evaluation = classifierEvaluation(evaluation)
+	}
+
+	model, err := textclass.Train(examples, trainOpts)
+	if err != nil {
+		return report, fmt.Errorf("failed to train model: %w", err)
+	}
+	// Kept in the model file so status can report it after a restart
+	model.Evaluation = evaluation
+	report.Version = model.Version
+	report.Features = len(model.Features)
+
+	if !opts.DryRun {
+		if err := model.Save(c.config.ClassifierModelPath); err != nil {
+			return report, err
+		}
+		report.Saved = true
+
+		c.mutex.Lock()
+		c.model = model
+		c.modelTime = time.Now()
+		c.mutex.Unlock()
+	}
+
+	report.Duration = time.Since(startTime)
+	c.logger.Info("Category model trained", map[string]interface{}{
+		"version":  report.Version,
+		"examples": report.Examples,
+		"features": report.Features,
+		"saved":    report.Saved,
+		"duration": report.Duration.String(),
+	})
+	return report, nil
+}
+
+func isExcludedLabel(label string) bool {
+	for _, excluded := range classifierExcludedLabels {
+		if label == excluded {
+			return true
+		}
+	}
+	return false
+}
+
+// classifierEvaluation converts textclass metrics to the API model
+func classifierEvaluation(evaluation *textclass.Evaluation) *models.ClassifierEvaluation {
+	converted := &models.ClassifierEvaluation{
+		Examples: evaluation.Examples,
+		Accuracy: roundScore(evaluation.Accuracy),
+		MacroF1:  roundScore(evaluation.MacroF1),
+		Labels:   make(map[string]models.ClassifierLabelMetrics, len(evaluation.Labels)),
+	}
+	for label, metrics := range evaluation.Labels {
+		converted.Labels[label] = models.ClassifierLabelMetrics{
+			Support:   metrics.Support,
+			Precision: roundScore(metrics.Precision),
+			Recall:    roundScore(metrics.Recall),
+			F1:        roundScore(metrics.F1),
+		}
+	}
+	return converted
+}
+
+// ===============================
+// REVIEW QUEUE
+// ===============================
+
+// QueueReviews stores low-confidence predictions for saved articles, keyed by external ID
+func (c *CategoryClassifier) QueueReviews(reviews []*models.CategoryReview) {
+	if err := c.repo.QueueCategoryReviews(reviews); err != nil {
+		c.logger.Warn("Failed to queue category reviews", map[string]interface{}{
+			"count": len(reviews),
+			"error": err.Error(),
+		})
+	}
+}
+
+// NewReview builds a review queue entry for an article the classifier was unsure about
+func (c *CategoryClassifier) NewReview(externalID string, prediction *models.CategoryPrediction) *models.CategoryReview {
+	review := &models.CategoryReview{
+		ExternalID:  externalID,
+		Confidence:  prediction.Confidence,
+		Predictions: prediction.Scores,
+		Status:      models.CategoryReviewPending,
+	}
+	if prediction.Category != "" {
+		review.SuggestedCategory = &prediction.Category
+	}
+	if prediction.ModelVersion != "" {
+		review.ModelVersion = &prediction.ModelVersion
+	}
+	return review
+}
+
+// ListReviews returns a page of the review queue, oldest first
+func (c *CategoryClassifier) ListReviews(status string, limit, offset int) ([]*models.CategoryReview, int, error) {
+	return c.repo.ListCategoryReviews(status, limit, offset)
+}
+
+// ResolveReview applies an editor's category choice to the article
+func (c *CategoryClassifier) ResolveReview(id int, resolution models.CategoryReviewResolution, reviewer *uuid.UUID) (*models.CategoryReview, error) {
+	labels := models.CategoryScores{{Category: resolution.Category, Confidence: 1}}
+	for _, secondary := range resolution.Secondary {
+		if secondary != "" && secondary != resolution.Category {
+			labels = append(labels, models.CategoryScore{Category: secondary, Confidence: 1})
+		}
+	}
+	return c.repo.ResolveCategoryReview(id, resolution.Category, labels, reviewer)
+}
+
+// DismissReview closes a review without categorizing the article
+func (c *CategoryClassifier) DismissReview(id int, reviewer *uuid.UUID) (*models.CategoryReview, error) {
+	return c.repo.DismissCategoryReview(id, reviewer)
+}
+
+// Status describes the loaded model and the review backlog
+func (c *CategoryClassifier) Status() (*models.ClassifierStatus, error) {
+	status := &models.ClassifierStatus{
+		ModelPath:     c.config.ClassifierModelPath,
+		MinConfidence: c.config.ClassifierMinConfidence,
+	}
+
+	if model := c.currentModel(); model != nil {
+		trainedAt := model.TrainedAt
+		status.ModelLoaded = true
+		status.Version = model.Version
+		status.TrainedAt = &trainedAt
+		status.Labels = model.Labels
+		status.Features = len(model.Features)
+		status.Documents = model.Documents
+		if model.Evaluation != nil {
+			status.Evaluation = classifierEvaluation(model.Evaluation)
+		}
+	} else {
+		status.FallbackKeyword = true
+	}
+
+	pending, err := c.repo.CountPendingReviews()
+	if err != nil {
+		return nil, err
+	}
+	status.PendingReviews = pending
+	return status, nil
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"path/filepath"
+	"testing"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+	"backend/pkg/textclass"
+)
+
+func TestCategoryClassifierClassify(t *testing.T) {
+	examples := []textclass.Example{
+		{Text: "Congress leader attacks BJP at election rally", Labels: []string{"politics"}},
+		{Text: "BJP and Congress clash in Lok Sabha over election bill", Labels: []string{"politics"}},
+		{Text: "Congress leader joins BJP before assembly election", Labels: []string{"politics"}},
+		{Text: "US Congress passes Washington funding bill", Labels: []string{"international"}},
+		{Text: "Biden urges US Congress to approve Ukraine aid", Labels: []string{"international"}},
+		{Text: "US Congress hearing in Washington on Ukraine", Labels: []string{"international"}},
+		{Text: "Sensex rallies as stock market gains on bank shares", Labels: []string{"business"}},
+		{Text: "Stock market falls as bank shares drag Sensex", Labels: []string{"business"}},
+		{Text: "Bank shares lift Sensex to record in stock market rally", Labels: []string{"business"}},
+		{Text: "US tariffs hit stock market in Washington trade row", Labels: []string{"international", "business"}},
+	}
+	model, err := textclass.Train(examples, textclass.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "category_nb.json")
+	if err := model.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{ClassifierModelPath: path, ClassifierMinConfidence: 0.55, ClassifierSecondaryConfidence: 0.25}
+	classifier := NewCategoryClassifier(cfg, logger.NewLogger(), nil)
+
+	tests := []struct {
+		title        string
+		wantCategory string
+		wantReview   bool
+	}{
+		{"US Congress approves Ukraine aid bill", "international", false},
+		{"Congress leader slams BJP ahead of election", "politics", false},
+		{"Sensex ends higher as bank shares rally", "business", false},
+		// Nothing the model knows, so it is queued for an editor instead of guessed
+		{"Monsoon arrives early in Kerala", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			prediction := classifier.Classify(tt.title, "")
+			if prediction.Source != models.CategorySourceModel || prediction.ModelVersion != model.Version {
+				t.Fatalf("source = %s %s, want the model %s", prediction.Source, prediction.ModelVersion, model.Version)
+			}
+			if prediction.NeedsReview != tt.wantReview {
+				t.Errorf("needs review = %v at %.2f, want %v", prediction.NeedsReview, prediction.Confidence, tt.wantReview)
+			}
+			if !tt.wantReview && prediction.Category != tt.wantCategory {
+				t.Errorf("category = %s, want %s", prediction.Category, tt.wantCategory)
+			}
+			if len(prediction.Scores) != len(model.Labels) || prediction.Labels[0] != prediction.Scores[0] {
+				t.Errorf("labels %v and scores %v disagree", prediction.Labels, prediction.Scores)
+			}
+			for _, label := range prediction.Labels[1:] {
+				if label.Confidence < cfg.ClassifierSecondaryConfidence {
+					t.Errorf("secondary label %s at %.2f is below the threshold", label.Category, label.Confidence)
+				}
+			}
+
+			if !prediction.NeedsReview {
+				return
+			}
+			review := classifier.NewReview("ext-1", prediction)
+			if review.Status != models.CategoryReviewPending || review.ExternalID != "ext-1" {
+				t.Errorf("review = %s %s, want a pending review of ext-1", review.Status, review.ExternalID)
+			}
+			if review.SuggestedCategory == nil || *review.SuggestedCategory != prediction.Category {
+				t.Errorf("suggested category = %v, want %s", review.SuggestedCategory, prediction.Category)
+			}
+			if review.ModelVersion == nil || *review.ModelVersion != model.Version {
+				t.Errorf("model version = %v, want %s", review.ModelVersion, model.Version)
+			}
+		})
+	}
+}
+
+func TestCategoryClassifierKeywordFallback(t *testing.T) {
+	cfg := &config.Config{ClassifierModelPath: filepath.Join(t.TempDir(), "missing.json"), ClassifierMinConfidence: 0.55}
+	classifier := NewCategoryClassifier(cfg, logger.NewLogger(), nil)
+
+	prediction := classifier.Classify("Sensex rallies as stock market gains", "")
+	if prediction.Source != models.CategorySourceKeyword || prediction.Category != "business" || prediction.NeedsReview {
+		t.Errorf("prediction = %s from %s (review %v), want business from keyword rules", prediction.Category, prediction.Source, prediction.NeedsReview)
+	}
+}
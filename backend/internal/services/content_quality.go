@@ -0,0 +1,64 @@
+// internal/services/content_quality.go
+// GoNews - Content Quality
+// Scores articles for clickbait, filler and explicit content at ingest, and applies readers'
+// content filter levels to feeds
+
+package services
+
+import (
+	"strings"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/quality"
+)
+
+// scoreArticleQuality sets the article's quality score, flags and explicit flag from its
+// headline and a body text
+func scoreArticleQuality(article *models.Article, body string) {
+	result := quality.Score(article.Title, body)
+	article.QualityScore = &result.Score
+	article.QualityFlags = result.Flags
+	article.IsExplicit = result.Explicit
+}
+
+// ContentFilterPolicyFor returns the policy of a content filter level. Unknown or empty
+// levels get the moderate default, which anonymous readers get too.
+func ContentFilterPolicyFor(cfg *config.Config, level string) models.ContentFilterPolicy {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case models.ContentFilterNone:
+		return models.ContentFilterPolicy{Level: models.ContentFilterNone}
+	case models.ContentFilterStrict:
+		return models.ContentFilterPolicy{
+			Level:        models.ContentFilterStrict,
+			HideBelow:    cfg.ContentQualityLowBelow,
+			HideExplicit: true,
+		}
+	}
+	return models.ContentFilterPolicy{
+		Level:          models.ContentFilterModerate,
+		HideBelow:      cfg.ContentQualityHideBelow,
+		DemoteBelow:    cfg.ContentQualityLowBelow,
+		DemoteExplicit: true,
+	}
+}
+
+// SplitByContentFilter drops the articles a policy hides and returns the rest split into those
+// it keeps in place and those it demotes, keeping the order within each
+func SplitByContentFilter(articles []*models.Article, policy models.ContentFilterPolicy) (kept, demoted []*models.Article) {
+	if !policy.Filters() {
+		return articles, nil
+	}
+
+	kept = make([]*models.Article, 0, len(articles))
+	for _, article := range articles {
+		switch {
+		case policy.Hides(article):
+		case policy.Demotes(article):
+			demoted = append(demoted, article)
+		default:
+			kept = append(kept, article)
+		}
+	}
+	return kept, demoted
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"backend/internal/config"
+	"backend/internal/models"
+)
+
+func TestSplitByContentFilter(t *testing.T) {
+	cfg := &config.Config{ContentQualityHideBelow: 0.35, ContentQualityLowBelow: 0.6}
+
+	article := func(title string, score float64, explicit bool) *models.Article {
+		return &models.Article{Title: title, QualityScore: &score, IsExplicit: explicit}
+	}
+	articles := []*models.Article{
+		article("clean", 0.9, false),
+		article("low", 0.5, false),
+		{Title: "unscored"},
+		article("bait", 0.2, false),
+		article("explicit", 0.9, true),
+		article("borderline", 0.6, false),
+	}
+
+	tests := []struct {
+		level       string
+		wantKept    []string
+		wantDemoted []string
+	}{
+		{models.ContentFilterNone, []string{"clean", "low", "unscored", "bait", "explicit", "borderline"}, nil},
+		// Moderate hides the worst clickbait and sinks low quality and explicit articles
+		{models.ContentFilterModerate, []string{"clean", "unscored", "borderline"}, []string{"low", "explicit"}},
+		// Strict hides everything moderate demotes
+		{models.ContentFilterStrict, []string{"clean", "unscored", "borderline"}, nil},
+		// Unknown and empty levels fall back to moderate
+		{"", []string{"clean", "unscored", "borderline"}, []string{"low", "explicit"}},
+		{"paranoid", []string{"clean", "unscored", "borderline"}, []string{"low", "explicit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			kept, demoted := SplitByContentFilter(articles, ContentFilterPolicyFor(cfg, tt.level))
+			if got := articleTitles(kept); !reflect.DeepEqual(got, tt.wantKept) {
+				t.Errorf("kept = %v, want %v", got, tt.wantKept)
+			}
+			if got := articleTitles(demoted); !reflect.DeepEqual(got, tt.wantDemoted) {
+				t.Errorf("demoted = %v, want %v", got, tt.wantDemoted)
+			}
+		})
+	}
+}
+
+func TestContentFilterPolicyForLevelName(t *testing.T) {
+	cfg := &config.Config{ContentQualityHideBelow: 0.35, ContentQualityLowBelow: 0.6}
+	if level := ContentFilterPolicyFor(cfg, " Strict ").Level; level != models.ContentFilterStrict {
+		t.Errorf("level = %q, want %q", level, models.ContentFilterStrict)
+	}
+	if ContentFilterPolicyFor(cfg, models.ContentFilterNone).Filters() {
+		t.Error("the none level filters articles")
+	}
+}
+
+func articleTitles(articles []*models.Article) []string {
+	var titles []string
+	for _, article := range articles {
+		titles = append(titles, article.Title)
+	}
+	return titles
+}
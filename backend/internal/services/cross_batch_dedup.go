@@ -0,0 +1,321 @@
+// internal/services/cross_batch_dedup.go
+// GoNews - Cross-Batch Deduplication
+// Checks incoming articles against articles stored in the last N days by normalized URL, content
+// hash and SimHash, using an LSH banding index so each check touches only a few candidates
+
+package services
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/fingerprint"
+	"backend/pkg/logger"
+)
+
+const (
+	// crossDedupMinTokens is the shortest text whose SimHash is stable enough to match on
+	crossDedupMinTokens = 8
+
+	// crossDedupSimHashWindow limits SimHash matches to articles published close together, so
+	// recurring reports with similar wording (daily market closes) aren't merged across days
+	crossDedupSimHashWindow = 18 * time.Hour
+
+	// crossDedupBackfillLimit caps how many older articles get fingerprints when the index loads
+	crossDedupBackfillLimit = 5000
+
+	crossDedupRetryInterval = 5 * time.Minute
+	crossDedupPruneInterval = time.Hour
+)
+
+// CrossBatchDeduplicator remembers fingerprints of recently stored articles
+type CrossBatchDeduplicator struct {
+	articleRepo *repository.ArticleRepository
+	dedup       *DeduplicationService
+	logger      *logger.Logger
+
+	lookback    time.Duration
+	maxDistance int
+	timeWindow  time.Duration
+
+	index         *fingerprint.Index
+	entries       map[int]*storedFingerprint // Index IDs are local; several can point at one article
+	byURL         map[string]int
+	byContentHash map[string]int
+	byExternalID  map[string]int
+	nextID        int
+
+	loaded          bool
+	lastLoadAttempt time.Time
+	lastPrune       time.Time
+	mutex           sync.Mutex
+}
+
+// storedFingerprint is one indexed fingerprint and the stored article it belongs to
+type storedFingerprint struct {
+	externalID    string
+	title         string
+	normalizedURL string
+	contentHash   string
+	simHash       *uint64
+	publishedAt   time.Time
+}
+
+// crossBatchMatch is a stored article an incoming one duplicates
+type crossBatchMatch struct {
+	externalID string
+	pair       DuplicatePair
+}
+
+// NewCrossBatchDeduplicator creates the deduplicator; the index loads on first use
+func NewCrossBatchDeduplicator(cfg *config.Config, log *logger.Logger, articleRepo *repository.ArticleRepository) *CrossBatchDeduplicator {
+	return &CrossBatchDeduplicator{
+		articleRepo:   articleRepo,
+		dedup:         NewDeduplicationService(cfg, log),
+		logger:        log,
+		lookback:      time.Duration(maxInt(1, cfg.DedupLookbackDays)) * 24 * time.Hour,
+		maxDistance:   maxInt(0, cfg.SimHashMaxDistance),
+		timeWindow:    time.Duration(cfg.TimeWindowHours) * time.Hour,
+		index:         fingerprint.NewIndex(maxInt(0, cfg.SimHashMaxDistance)),
+		entries:       make(map[int]*storedFingerprint),
+		byURL:         make(map[string]int),
+		byContentHash: make(map[string]int),
+		byExternalID:  make(map[string]int),
+	}
+}
+
+// fingerprintArticle sets the article's normalized URL, content hash and (for long enough text) SimHash
+func fingerprintArticle(article *models.Article) {
+	text := article.Title + " " + cleanMergeText(derefString(article.Description)) + " " + cleanMergeText(derefString(article.Content))
+
+	normalizedURL := normalizeArticleURL(article.URL)
+	contentHash := fingerprint.ContentHash(text)
+	article.NormalizedURL = &normalizedURL
+	article.ContentHash = &contentHash
+
+	article.SimHash = nil
+	if len(fingerprint.Tokens(text)) >= crossDedupMinTokens {
+		simHash := int64(fingerprint.SimHash(text))
+		article.SimHash = &simHash
+	}
+}
+
+// Check returns the stored article the incoming one duplicates, or nil. An article whose
+// external_id is already stored is an update of that row, not a duplicate.
+func (d *CrossBatchDeduplicator) Check(article *models.Article) *crossBatchMatch {
+	if article.NormalizedURL == nil {
+		fingerprintArticle(article)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.ensureLoaded()
+	d.prune()
+
+	if _, stored := d.byExternalID[derefString(article.ExternalID)]; stored {
+		return nil
+	}
+
+	if id, ok := d.byURL[derefString(article.NormalizedURL)]; ok {
+		return d.match(article, d.entries[id], "url_match", "HIGH")
+	}
+	if id, ok := d.byContentHash[derefString(article.ContentHash)]; ok {
+		return d.match(article, d.entries[id], "content_hash", "HIGH")
+	}
+	if article.SimHash == nil {
+		return nil
+	}
+
+	for _, candidate := range d.index.Near(uint64(*article.SimHash), d.maxDistance) {
+		entry := d.entries[candidate.ID]
+		if math.Abs(article.PublishedAt.Sub(entry.publishedAt).Hours()) > crossDedupSimHashWindow.Hours() {
+			continue
+		}
+		confidence := "MEDIUM"
+		if candidate.Distance <= d.maxDistance/2 {
+			confidence = "HIGH"
+		}
+		return d.match(article, entry, "simhash", confidence)
+	}
+	return nil
+}
+
+// match describes why article duplicates a stored entry, in the terms deduplication_logs records
+func (d *CrossBatchDeduplicator) match(article *models.Article, entry *storedFingerprint, method, confidence string) *crossBatchMatch {
+	titleSimilarity := d.dedup.calculateTitleSimilarity(article.Title, entry.title)
+	timeDifference := math.Abs(article.PublishedAt.Sub(entry.publishedAt).Hours())
+	urlMatch := derefString(article.NormalizedURL) == entry.normalizedURL
+	contentHashMatch := derefString(article.ContentHash) == entry.contentHash
+	timeWindowMatch := timeDifference <= d.timeWindow.Hours()
+
+	return &crossBatchMatch{
+		externalID: entry.externalID,
+		pair: DuplicatePair{
+			DetectionMethod:     method,
+			SimilarityScore:     d.dedup.calculateOverallSimilarity(titleSimilarity, urlMatch, contentHashMatch, timeWindowMatch),
+			TitleSimilarity:     titleSimilarity,
+			URLMatch:            urlMatch,
+			ContentHashMatch:    contentHashMatch,
+			TimeWindowMatch:     timeWindowMatch,
+			ConfidenceLevel:     confidence,
+			TimeDifferenceHours: timeDifference,
+		},
+	}
+}
+
+// Remember indexes an article's fingerprint as belonging to the stored article with externalID:
+// its own for new articles, the canonical one for merged duplicates
+func (d *CrossBatchDeduplicator) Remember(article *models.Article, externalID string) {
+	if article.NormalizedURL == nil {
+		fingerprintArticle(article)
+	}
+
+	entry := &storedFingerprint{
+		externalID:    externalID,
+		title:         article.Title,
+		normalizedURL: derefString(article.NormalizedURL),
+		contentHash:   derefString(article.ContentHash),
+		publishedAt:   article.PublishedAt,
+	}
+	if article.SimHash != nil {
+		simHash := uint64(*article.SimHash)
+		entry.simHash = &simHash
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.add(entry)
+}
+
+// add indexes an entry; callers hold the mutex
+func (d *CrossBatchDeduplicator) add(entry *storedFingerprint) {
+	d.nextID++
+	id := d.nextID
+	d.entries[id] = entry
+
+	if entry.externalID != "" {
+		if _, exists := d.byExternalID[entry.externalID]; !exists {
+			d.byExternalID[entry.externalID] = id
+		}
+	}
+	if entry.normalizedURL != "" {
+		d.byURL[entry.normalizedURL] = id
+	}
+	if entry.contentHash != "" {
+		d.byContentHash[entry.contentHash] = id
+	}
+	if entry.simHash != nil {
+		d.index.Add(id, *entry.simHash)
+	}
+}
+
+// ensureLoaded fills the index from the database once, backfilling fingerprints for articles
+// stored before they existed. A failed load is retried after a pause; callers hold the mutex.
+func (d *CrossBatchDeduplicator) ensureLoaded() {
+	if d.loaded || d.articleRepo == nil || time.Since(d.lastLoadAttempt) < crossDedupRetryInterval {
+		return
+	}
+	d.lastLoadAttempt = time.Now()
+	since := time.Now().Add(-d.lookback)
+
+	missing, err := d.articleRepo.GetArticlesMissingFingerprints(since, crossDedupBackfillLimit)
+	if err != nil {
+		d.logger.Warn("Failed to load articles for fingerprint backfill", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	for _, article := range missing {
+		fingerprintArticle(article)
+	}
+	if err := d.articleRepo.UpdateArticleFingerprints(missing); err != nil {
+		d.logger.Warn("Failed to backfill article fingerprints", map[string]interface{}{
+			"count": len(missing),
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stored, err := d.articleRepo.GetArticleFingerprints(since)
+	if err != nil {
+		d.logger.Warn("Failed to load article fingerprints", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, row := range stored {
+		entry := &storedFingerprint{
+			externalID:    derefString(row.ExternalID),
+			title:         row.Title,
+			normalizedURL: derefString(row.NormalizedURL),
+			contentHash:   derefString(row.ContentHash),
+			publishedAt:   row.PublishedAt,
+		}
+		if row.SimHash != nil {
+			simHash := uint64(*row.SimHash)
+			entry.simHash = &simHash
+		}
+		d.add(entry)
+	}
+
+	d.loaded = true
+	d.lastPrune = time.Now()
+
+	d.logger.Info("Cross-batch deduplication index loaded", map[string]interface{}{
+		"articles":      len(stored),
+		"backfilled":    len(missing),
+		"lookback_days": int(d.lookback.Hours() / 24),
+	})
+}
+
+// prune forgets fingerprints older than the lookback window; callers hold the mutex
+func (d *CrossBatchDeduplicator) prune() {
+	if time.Since(d.lastPrune) < crossDedupPruneInterval {
+		return
+	}
+	d.lastPrune = time.Now()
+	cutoff := time.Now().Add(-d.lookback)
+
+	for id, entry := range d.entries {
+		if !entry.publishedAt.Before(cutoff) {
+			continue
+		}
+		delete(d.entries, id)
+		d.index.Remove(id)
+		if d.byURL[entry.normalizedURL] == id {
+			delete(d.byURL, entry.normalizedURL)
+		}
+		if d.byContentHash[entry.contentHash] == id {
+			delete(d.byContentHash, entry.contentHash)
+		}
+		if d.byExternalID[entry.externalID] == id {
+			delete(d.byExternalID, entry.externalID)
+		}
+	}
+
+	// Title similarities are cached per pair and only useful within the window
+	d.dedup.ClearCache()
+}
+
+// NewLog builds a deduplication_logs row for one decision. A nil pair records a unique article;
+// original is the stored article a duplicate was merged into, if known.
+func (d *CrossBatchDeduplicator) NewLog(pair *DuplicatePair, original, article *models.Article) *models.DeduplicationLog {
+	data, err := json.Marshal(map[string]interface{}{
+		"external_id":  derefString(article.ExternalID),
+		"title":        article.Title,
+		"url":          article.URL,
+		"source":       article.Source,
+		"published_at": article.PublishedAt,
+	})
+	if err != nil {
+		data = []byte("{}")
+	}
+	return d.dedup.CreateDeduplicationLog(pair, original, data)
+}
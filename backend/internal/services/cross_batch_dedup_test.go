@@ -0,0 +1,90 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+)
+
+func TestCrossBatchDeduplicatorCheck(t *testing.T) {
+	cfg := &config.Config{DedupLookbackDays: 7, SimHashMaxDistance: 8, TimeWindowHours: 1}
+	stored := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	const (
+		launch  = "ISRO successfully launches Chandrayaan-4 mission from Sriharikota spaceport on Monday morning"
+		rewrite = "ISRO launches Chandrayaan-4 mission successfully from Sriharikota spaceport Monday morning"
+	)
+
+	tests := []struct {
+		name       string
+		stored     string
+		incoming   string
+		url        string
+		offset     time.Duration
+		wantMethod string // Empty means unique
+	}{
+		{"rewrite published soon after", launch, rewrite, "https://b.example.com/story", 2 * time.Hour, "simhash"},
+		{"rewrite published just inside the window", launch, rewrite, "https://b.example.com/story", 17 * time.Hour, "simhash"},
+		{"rewrite published the next day", launch, rewrite, "https://b.example.com/story", 20 * time.Hour, ""},
+		{"rewrite published the day before", launch, rewrite, "https://b.example.com/story", -19 * time.Hour, ""},
+		// The publish window only limits SimHash matches
+		{"same URL the next day", launch, rewrite, "https://a.example.com/story?utm_source=x", 20 * time.Hour, "url_match"},
+		{"same text the next day", launch, launch + ".", "https://b.example.com/story", 20 * time.Hour, "content_hash"},
+		// Single letters don't count towards SimHash, so these pairs share a fingerprint but not a
+		// content hash; only text of crossDedupMinTokens words is matched on it
+		{"eight words", "Sensex rises 500 points as banks rally a", "Sensex rises 500 points as banks rally b", "https://b.example.com/story", time.Hour, "simhash"},
+		{"seven words", "Sensex rises 500 points as banks a", "Sensex rises 500 points as banks b", "https://b.example.com/story", time.Hour, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dedup := NewCrossBatchDeduplicator(cfg, logger.NewLogger(), nil)
+			original := &models.Article{
+				ExternalID:  stringPtr("a-1"),
+				Title:       tt.stored,
+				URL:         "https://a.example.com/story",
+				PublishedAt: stored,
+			}
+			dedup.Remember(original, "a-1")
+
+			incoming := &models.Article{
+				ExternalID:  stringPtr("b-1"),
+				Title:       tt.incoming,
+				URL:         tt.url,
+				PublishedAt: stored.Add(tt.offset),
+			}
+			match := dedup.Check(incoming)
+
+			switch {
+			case tt.wantMethod == "" && match != nil:
+				t.Errorf("matched %s by %s, want unique", match.externalID, match.pair.DetectionMethod)
+			case tt.wantMethod != "" && match == nil:
+				t.Errorf("unique, want a %s match", tt.wantMethod)
+			case match != nil && (match.pair.DetectionMethod != tt.wantMethod || match.externalID != "a-1"):
+				t.Errorf("matched %s by %s, want a-1 by %s", match.externalID, match.pair.DetectionMethod, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestCrossBatchDeduplicatorIgnoresUpdates(t *testing.T) {
+	cfg := &config.Config{DedupLookbackDays: 7, SimHashMaxDistance: 8, TimeWindowHours: 1}
+	dedup := NewCrossBatchDeduplicator(cfg, logger.NewLogger(), nil)
+
+	article := &models.Article{
+		ExternalID:  stringPtr("a-1"),
+		Title:       "ISRO successfully launches Chandrayaan-4 mission from Sriharikota spaceport on Monday morning",
+		URL:         "https://a.example.com/isro",
+		PublishedAt: time.Now(),
+	}
+	dedup.Remember(article, "a-1")
+
+	update := *article
+	update.NormalizedURL, update.ContentHash, update.SimHash = nil, nil, nil
+	if match := dedup.Check(&update); match != nil {
+		t.Errorf("an update of a stored article matched %s by %s", match.externalID, match.pair.DetectionMethod)
+	}
+}
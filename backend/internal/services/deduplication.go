@@ -64,6 +64,7 @@ type DeduplicationResult struct {
 	OriginalCount     int               `json:"original_count"`
 	DeduplicatedCount int               `json:"deduplicated_count"`
 	RemovedCount      int               `json:"removed_count"`
+	MergedCount       int               `json:"merged_count"` // Kept articles that absorbed duplicates
 	ProcessingTimeMs  int64             `json:"processing_time_ms"`
 	DuplicatePairs    []DuplicatePair   `json:"duplicate_pairs"`
 	MethodStats       MethodStats       `json:"method_stats"`
@@ -148,6 +149,9 @@ func (ds *DeduplicationService) DeduplicateArticles(articles []*models.Article)
 		keepArticles[i] = true
 	}
 
+	// Kept articles that absorbed duplicates, merged into copies so callers' articles stay untouched
+	mergedArticles := make(map[int]*models.Article)
+
 	// Process all pairs for duplicate detection
 	for i := 0; i < len(articles); i++ {
 		if !keepArticles[i] {
@@ -162,8 +166,15 @@ func (ds *DeduplicationService) DeduplicateArticles(articles []*models.Article)
 			// Apply 4-layer deduplication
 			duplicatePair := ds.detectDuplicate(articles[i], articles[j], i, j)
 			if duplicatePair != nil {
-				// Mark the later article as duplicate (keep the earlier one)
+				// Mark the later article as duplicate and merge it into the earlier one
 				keepArticles[j] = false
+				canonical, ok := mergedArticles[i]
+				if !ok {
+					copied := *articles[i]
+					canonical = &copied
+					mergedArticles[i] = canonical
+				}
+				mergeDuplicateArticle(canonical, articles[j])
 				result.DuplicatePairs = append(result.DuplicatePairs, *duplicatePair)
 
 				// Update method statistics
@@ -183,13 +194,18 @@ func (ds *DeduplicationService) DeduplicateArticles(articles []*models.Article)
 	// Collect unique articles
 	var uniqueArticles []*models.Article
 	for i, article := range articles {
-		if keepArticles[i] {
-			uniqueArticles = append(uniqueArticles, article)
+		if !keepArticles[i] {
+			continue
+		}
+		if merged, ok := mergedArticles[i]; ok {
+			article = merged
 		}
+		uniqueArticles = append(uniqueArticles, article)
 	}
 
 	// Complete result
 	processingTime := time.Since(startTime)
+	result.MergedCount = len(mergedArticles)
 	result.DeduplicatedCount = len(uniqueArticles)
 	result.RemovedCount = result.OriginalCount - result.DeduplicatedCount
 	result.ProcessingTimeMs = processingTime.Milliseconds()
@@ -203,6 +219,7 @@ func (ds *DeduplicationService) DeduplicateArticles(articles []*models.Article)
 		"original_count":      result.OriginalCount,
 		"deduplicated_count":  result.DeduplicatedCount,
 		"removed_count":       result.RemovedCount,
+		"merged_count":        result.MergedCount,
 		"processing_time_ms":  result.ProcessingTimeMs,
 		"performance_score":   result.PerformanceScore,
 		"title_matches":       result.MethodStats.TitleSimilarity,
@@ -390,12 +407,21 @@ func (ds *DeduplicationService) normalizeURL(rawURL string) string {
 	}
 	ds.cacheMutex.RUnlock()
 
+	normalized := normalizeArticleURL(rawURL)
+
+	// Cache the result
+	ds.cacheMutex.Lock()
+	ds.urlCache[rawURL] = normalized
+	ds.cacheMutex.Unlock()
+
+	return normalized
+}
+
+// normalizeArticleURL drops tracking parameters, the fragment, case and a trailing slash so
+// copies of one link compare equal; unparseable URLs are returned unchanged
+func normalizeArticleURL(rawURL string) string {
 	parsedURL, err := url.Parse(rawURL)
 	if err != nil {
-		// Cache the original URL if parsing fails
-		ds.cacheMutex.Lock()
-		ds.urlCache[rawURL] = rawURL
-		ds.cacheMutex.Unlock()
 		return rawURL
 	}
 
@@ -420,12 +446,6 @@ func (ds *DeduplicationService) normalizeURL(rawURL string) string {
 	if strings.HasSuffix(normalized, "/") && len(normalized) > 1 {
 		normalized = normalized[:len(normalized)-1]
 	}
-
-	// Cache the result
-	ds.cacheMutex.Lock()
-	ds.urlCache[rawURL] = normalized
-	ds.cacheMutex.Unlock()
-
 	return normalized
 }
 
@@ -738,19 +758,28 @@ func max(a, b int) int {
 // INTEGRATION WITH EXISTING SERVICES
 // ===============================
 
-// CreateDeduplicationLog creates a log entry for deduplication results (for database tracking)
+// CreateDeduplicationLog creates a log entry for deduplication results (for database tracking).
+// A nil pair records an article checked and found unique; originalArticle may be nil when the
+// original is not stored yet.
 func (ds *DeduplicationService) CreateDeduplicationLog(duplicatePair *DuplicatePair, originalArticle *models.Article, duplicateArticleData []byte) *models.DeduplicationLog {
-	return &models.DeduplicationLog{
-		OriginalArticleID:    &originalArticle.ID,
+	log := &models.DeduplicationLog{
 		DuplicateArticleData: duplicateArticleData,
-		TitleSimilarityScore: &duplicatePair.TitleSimilarity,
-		URLMatch:             duplicatePair.URLMatch,
-		ContentHashMatch:     duplicatePair.ContentHashMatch,
-		TimeWindowMatch:      duplicatePair.TimeWindowMatch,
-		IsDuplicate:          true,
-		DetectionMethod:      &duplicatePair.DetectionMethod,
 		CreatedAt:            time.Now(),
 	}
+	if originalArticle != nil && originalArticle.ID != 0 {
+		log.OriginalArticleID = &originalArticle.ID
+	}
+	if duplicatePair == nil {
+		return log
+	}
+
+	log.TitleSimilarityScore = &duplicatePair.TitleSimilarity
+	log.URLMatch = duplicatePair.URLMatch
+	log.ContentHashMatch = duplicatePair.ContentHashMatch
+	log.TimeWindowMatch = duplicatePair.TimeWindowMatch
+	log.IsDuplicate = true
+	log.DetectionMethod = &duplicatePair.DetectionMethod
+	return log
 }
 
 // GetMethodPriority returns the priority order of deduplication methods
@@ -815,6 +844,7 @@ func (ds *DeduplicationService) BatchDeduplicateWithCallback(articles []*models.
 	for i := range articles {
 		keepArticles[i] = true
 	}
+	mergedArticles := make(map[int]*models.Article)
 
 	// Process pairs with progress callback
 	for i := 0; i < len(articles); i++ {
@@ -830,6 +860,13 @@ func (ds *DeduplicationService) BatchDeduplicateWithCallback(articles []*models.
 			duplicatePair := ds.detectDuplicate(articles[i], articles[j], i, j)
 			if duplicatePair != nil {
 				keepArticles[j] = false
+				canonical, ok := mergedArticles[i]
+				if !ok {
+					copied := *articles[i]
+					canonical = &copied
+					mergedArticles[i] = canonical
+				}
+				mergeDuplicateArticle(canonical, articles[j])
 				result.DuplicatePairs = append(result.DuplicatePairs, *duplicatePair)
 				ds.updateMethodStats(&result.MethodStats, duplicatePair.DetectionMethod)
 			}
@@ -844,12 +881,17 @@ func (ds *DeduplicationService) BatchDeduplicateWithCallback(articles []*models.
 	// Complete processing similar to main method
 	var uniqueArticles []*models.Article
 	for i, article := range articles {
-		if keepArticles[i] {
-			uniqueArticles = append(uniqueArticles, article)
+		if !keepArticles[i] {
+			continue
+		}
+		if merged, ok := mergedArticles[i]; ok {
+			article = merged
 		}
+		uniqueArticles = append(uniqueArticles, article)
 	}
 
 	processingTime := time.Since(startTime)
+	result.MergedCount = len(mergedArticles)
 	result.DeduplicatedCount = len(uniqueArticles)
 	result.RemovedCount = result.OriginalCount - result.DeduplicatedCount
 	result.ProcessingTimeMs = processingTime.Milliseconds()
@@ -0,0 +1,316 @@
+// internal/services/diversity_ranker.go
+// GoNews - Feed Diversity Re-ranking
+// Maximal Marginal Relevance (MMR) over title similarity, shared GDELT themes and same-source runs
+
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+)
+
+const (
+	// Redundancy between two articles blends title similarity and theme overlap
+	titleRedundancyWeight = 0.65
+	themeRedundancyWeight = 0.35
+
+	// Base score blends the upstream ordering with the ranking pipeline score
+	positionPriorWeight = 0.4
+	rankingScoreWeight  = 0.6
+
+	// Redundancy at or above this level is called out in placement explanations
+	highRedundancyThreshold = 0.5
+
+	// The shared Levenshtein cache is reset once it grows past this many pairs
+	maxTitleCacheEntries = 50000
+)
+
+// DiversityRanker re-orders feed candidates so near-duplicate stories and single-source runs are spread out
+type DiversityRanker struct {
+	config       *config.Config
+	logger       *logger.Logger
+	deduplicator *DeduplicationService
+	ranking      *RankingPipeline
+}
+
+// RerankOptions controls a single re-ranking call
+type RerankOptions struct {
+	FeedType string
+	Explain  bool
+
+	// Per-request overrides (e.g. from an experiment variant)
+	Lambda  *float64
+	Weights map[string]float64
+}
+
+// RerankResult holds the re-ranked articles and, when requested, the per-placement explanations
+type RerankResult struct {
+	Articles     []*models.Article
+	Explanations []models.RankExplanation
+}
+
+// rankCandidate tracks a candidate's upstream position and precomputed features
+type rankCandidate struct {
+	article          *models.Article
+	originalPosition int
+	baseScore        float64
+	themes           map[string]bool
+	breakdown        *models.ScoreBreakdown
+}
+
+// NewDiversityRanker creates a new MMR diversity ranker scoring candidates with the given pipeline
+func NewDiversityRanker(cfg *config.Config, log *logger.Logger, ranking *RankingPipeline) *DiversityRanker {
+	return &DiversityRanker{
+		config:       cfg,
+		logger:       log,
+		deduplicator: NewDeduplicationService(cfg, log),
+		ranking:      ranking,
+	}
+}
+
+// ===============================
+// CONFIGURATION
+// ===============================
+
+// Enabled reports whether diversity re-ranking is switched on
+func (r *DiversityRanker) Enabled() bool {
+	return r.config.DiversityEnabled
+}
+
+// CandidatePoolSize returns how many candidates a feed should fetch to fill limit slots
+func (r *DiversityRanker) CandidatePoolSize(limit int) int {
+	return r.CandidatePoolSizeWith(limit, r.config.DiversityCandidatePoolSize)
+}
+
+// CandidatePoolSizeWith is CandidatePoolSize with an explicit multiplier (e.g. from an experiment)
+func (r *DiversityRanker) CandidatePoolSizeWith(limit, multiplier int) int {
+	if !r.config.DiversityEnabled || multiplier < 1 {
+		return limit
+	}
+	return limit * multiplier
+}
+
+// LambdaFor returns the relevance/novelty trade-off configured for a feed type
+func (r *DiversityRanker) LambdaFor(feedType string) float64 {
+	lambda := r.config.DiversityLambdaNewsFeed
+	if feedType == models.FeedTypePersonalized {
+		lambda = r.config.DiversityLambdaPersonalized
+	}
+	return math.Max(0, math.Min(1, lambda))
+}
+
+// ===============================
+// MMR RE-RANKING
+// ===============================
+
+// Rerank selects up to limit articles greedily by MMR score.
+// Input order is treated as the upstream relevance ranking.
+func (r *DiversityRanker) Rerank(articles []*models.Article, limit int, opts RerankOptions) *RerankResult {
+	explain := opts.Explain
+	if limit <= 0 || limit > len(articles) {
+		limit = len(articles)
+	}
+
+	result := &RerankResult{Articles: make([]*models.Article, 0, limit)}
+	if explain {
+		result.Explanations = make([]models.RankExplanation, 0, limit)
+	}
+	if len(articles) == 0 {
+		return result
+	}
+
+	r.trimTitleCache()
+
+	lambda := r.LambdaFor(opts.FeedType)
+	if opts.Lambda != nil {
+		lambda = math.Max(0, math.Min(1, *opts.Lambda))
+	}
+	remaining := r.buildCandidates(articles, opts.Weights)
+	selected := make([]*rankCandidate, 0, limit)
+
+	for len(selected) < limit && len(remaining) > 0 {
+		bestIdx := -1
+		var best models.RankExplanation
+
+		for i, candidate := range remaining {
+			scored := r.scoreCandidate(candidate, selected, lambda)
+			if bestIdx == -1 || scored.MMRScore > best.MMRScore {
+				bestIdx = i
+				best = scored
+			}
+		}
+
+		chosen := remaining[bestIdx]
+		remaining = append(remaining[:bestIdx], remaining[bestIdx+1:]...)
+		selected = append(selected, chosen)
+		result.Articles = append(result.Articles, chosen.article)
+
+		if explain {
+			best.Position = len(selected)
+			best.Reason = r.explainPlacement(&best)
+			if chosen.breakdown != nil {
+				best.Features = chosen.breakdown.Features
+			}
+			result.Explanations = append(result.Explanations, best)
+		}
+	}
+
+	return result
+}
+
+// buildCandidates precomputes base scores and theme sets for every candidate
+func (r *DiversityRanker) buildCandidates(articles []*models.Article, weights map[string]float64) []*rankCandidate {
+	candidates := make([]*rankCandidate, 0, len(articles))
+	total := float64(len(articles))
+	rctx := &RankingContext{Now: time.Now(), Weights: weights}
+
+	for i, article := range articles {
+		if article == nil {
+			continue
+		}
+
+		candidate := &rankCandidate{
+			article:          article,
+			originalPosition: i + 1,
+			themes:           articleThemes(article),
+		}
+
+		// Fall back to the stored relevance score when no pipeline is configured
+		relevance := math.Max(0, math.Min(1, article.RelevanceScore))
+		if r.ranking != nil {
+			candidate.breakdown = r.ranking.Explain(article, rctx)
+			relevance = candidate.breakdown.Score
+		}
+
+		positionPrior := 1.0 - float64(i)/total
+		candidate.baseScore = positionPriorWeight*positionPrior + rankingScoreWeight*relevance
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates
+}
+
+// scoreCandidate computes the MMR score of a candidate against the articles already placed
+func (r *DiversityRanker) scoreCandidate(candidate *rankCandidate, selected []*rankCandidate, lambda float64) models.RankExplanation {
+	explanation := models.RankExplanation{
+		OriginalPosition: candidate.originalPosition,
+		ArticleID:        candidate.article.ID,
+		Title:            candidate.article.Title,
+		Source:           candidate.article.Source,
+		BaseScore:        roundScore(candidate.baseScore),
+		Lambda:           lambda,
+	}
+
+	for _, placed := range selected {
+		titleSim := r.deduplicator.calculateTitleSimilarity(candidate.article.Title, placed.article.Title)
+		themeOverlap := jaccardOverlap(candidate.themes, placed.themes)
+		redundancy := titleRedundancyWeight*titleSim + themeRedundancyWeight*themeOverlap
+
+		if redundancy > explanation.Redundancy {
+			placedID := placed.article.ID
+			explanation.Redundancy = redundancy
+			explanation.TitleSimilarity = roundScore(titleSim)
+			explanation.ThemeOverlap = roundScore(themeOverlap)
+			explanation.MostSimilarToID = &placedID
+		}
+	}
+
+	// Count how many of the most recently placed articles share this candidate's source
+	for i := len(selected) - 1; i >= 0; i-- {
+		if !strings.EqualFold(selected[i].article.Source, candidate.article.Source) {
+			break
+		}
+		explanation.SourceRun++
+	}
+	explanation.SourcePenalty = roundScore(float64(explanation.SourceRun) * r.config.DiversitySameSourcePenalty)
+
+	mmr := lambda*candidate.baseScore - (1-lambda)*explanation.Redundancy - explanation.SourcePenalty
+	explanation.MMRScore = roundScore(mmr)
+	explanation.Redundancy = roundScore(explanation.Redundancy)
+
+	return explanation
+}
+
+// explainPlacement turns the score components of a placement into a readable reason
+func (r *DiversityRanker) explainPlacement(e *models.RankExplanation) string {
+	var reasons []string
+
+	switch {
+	case e.Position == 1:
+		reasons = append(reasons, "highest base score")
+	case e.Redundancy >= highRedundancyThreshold && e.MostSimilarToID != nil:
+		reasons = append(reasons, fmt.Sprintf("placed despite overlap with article %d (title %.2f, themes %.2f)",
+			*e.MostSimilarToID, e.TitleSimilarity, e.ThemeOverlap))
+	default:
+		reasons = append(reasons, "best relevance/novelty balance among remaining candidates")
+	}
+
+	if e.SourceRun > 0 {
+		reasons = append(reasons, fmt.Sprintf("same-source run of %d penalised by %.2f", e.SourceRun, e.SourcePenalty))
+	}
+
+	switch {
+	case e.Position < e.OriginalPosition:
+		reasons = append(reasons, fmt.Sprintf("moved up from %d", e.OriginalPosition))
+	case e.Position > e.OriginalPosition:
+		reasons = append(reasons, fmt.Sprintf("moved down from %d as earlier picks covered similar stories", e.OriginalPosition))
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// trimTitleCache keeps the Levenshtein cache bounded, since feed titles change continuously
+func (r *DiversityRanker) trimTitleCache() {
+	if r.deduplicator.GetCacheStats()["title_cache_size"] > maxTitleCacheEntries {
+		r.deduplicator.ClearCache()
+	}
+}
+
+// ===============================
+// HELPER FUNCTIONS
+// ===============================
+
+// articleThemes returns the GDELT themes of an article, falling back to tags for non-GDELT sources
+func articleThemes(article *models.Article) map[string]bool {
+	source := []string(article.GDELTThemes)
+	if len(source) == 0 {
+		source = []string(article.Tags)
+	}
+
+	themes := make(map[string]bool, len(source))
+	for _, theme := range source {
+		theme = strings.ToLower(strings.TrimSpace(theme))
+		if theme != "" {
+			themes[theme] = true
+		}
+	}
+	return themes
+}
+
+// jaccardOverlap returns |a ∩ b| / |a ∪ b| for two theme sets
+func jaccardOverlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for theme := range a {
+		if b[theme] {
+			intersection++
+		}
+	}
+
+	union := len(a) + len(b) - intersection
+	return float64(intersection) / float64(union)
+}
+
+// roundScore rounds a score to 4 decimal places for stable JSON output
+func roundScore(score float64) float64 {
+	return math.Round(score*10000) / 10000
+}
@@ -0,0 +1,445 @@
+// internal/services/entity_extractor.go
+// GoNews - Entity Extractor
+// Fills the person, organization and location fields of every article with canonical entity
+// IDs from an offline gazetteer, which admins can extend without a deploy
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/entities"
+	"backend/pkg/geo"
+	"backend/pkg/logger"
+)
+
+// entityGazetteerReloadInterval is how often admin gazetteer changes made by other instances
+// are picked up
+const entityGazetteerReloadInterval = 5 * time.Minute
+
+// ErrInvalidGazetteerEntity marks gazetteer entities rejected by validation
+var ErrInvalidGazetteerEntity = errors.New("invalid gazetteer entity")
+
+// invalidGazetteerEntityf wraps a validation message with ErrInvalidGazetteerEntity
+func invalidGazetteerEntityf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidGazetteerEntity, fmt.Sprintf(format, args...))
+}
+
+// EntityExtractor finds entities in article text. The built-in gazetteer is merged with
+// admin rows from the database, which replace built-in entities with the same ID.
+type EntityExtractor struct {
+	logger  *logger.Logger
+	repo    *repository.EntityRepository
+	builtin map[string]entities.Entry
+
+	mutex      sync.RWMutex
+	gazetteer  *entities.Gazetteer
+	custom     map[string]*models.GazetteerEntry // Active admin rows by ID
+	disabled   []string                          // Built-in IDs switched off by an admin
+	lastLoaded time.Time
+}
+
+// NewEntityExtractor creates the extractor and loads admin gazetteer rows. The built-in
+// entities include the geo gazetteer's districts and cities, so geo-tagging sees them too.
+func NewEntityExtractor(log *logger.Logger, repo *repository.EntityRepository) *EntityExtractor {
+	builtin := append(geo.Default().Entries(), entities.DefaultEntries()...)
+	extractor := &EntityExtractor{
+		logger:    log,
+		repo:      repo,
+		builtin:   make(map[string]entities.Entry, len(builtin)),
+		gazetteer: entities.NewGazetteer(builtin),
+		custom:    make(map[string]*models.GazetteerEntry),
+	}
+	for _, entry := range builtin {
+		extractor.builtin[entry.ID] = entry
+	}
+	extractor.reload()
+	return extractor
+}
+
+// ===============================
+// EXTRACTION
+// ===============================
+
+// Annotate fills the article's entity fields with canonical IDs. Names already there, such as
+// GDELT's, become canonical IDs when the gazetteer knows them and are kept as they are
+// otherwise; entities found in the headline and description are added.
+func (e *EntityExtractor) Annotate(article *models.Article) {
+	gazetteer := e.current()
+	result := gazetteer.Extract(article.Title, cleanFeedText(derefString(article.Description)))
+
+	article.GDELTPersons = canonicalEntities(gazetteer, article.GDELTPersons, entities.TypePerson, result.Persons)
+	article.GDELTOrganizations = canonicalEntities(gazetteer, article.GDELTOrganizations, entities.TypeOrganization, result.Organizations)
+	article.GDELTLocations = canonicalEntities(gazetteer, article.GDELTLocations, entities.TypeLocation, result.Locations)
+}
+
+// canonicalEntities maps known names to canonical IDs and adds the extracted IDs
+func canonicalEntities(gazetteer *entities.Gazetteer, names pq.StringArray, entityType string, extracted []string) pq.StringArray {
+	values := make(pq.StringArray, 0, len(names)+len(extracted))
+	for _, name := range names {
+		lookup := name
+		if entityType == entities.TypeLocation {
+			// GDELT writes places as "Mumbai, Maharashtra, India"
+			lookup = strings.SplitN(name, ",", 2)[0]
+		}
+		entry, ok := gazetteer.Resolve(lookup, entityType)
+		if ok && entityType == entities.TypeLocation && !inNamedCountry(gazetteer, entry, name) {
+			ok = false
+		}
+		if !ok {
+			values = append(values, name)
+			continue
+		}
+		values = append(values, entry.ID)
+		if entityType == entities.TypeLocation {
+			values = append(values, gazetteer.Ancestors(entry.ID)...)
+		}
+	}
+	return unionStrings(pq.StringArray{}, append(values, extracted...))
+}
+
+// inNamedCountry reports whether a resolved place lies in the country a GDELT name ends with,
+// so "Hyderabad, Sindh, Pakistan" doesn't become the Indian city. Names without a known
+// country pass.
+func inNamedCountry(gazetteer *entities.Gazetteer, entry *entities.Entry, name string) bool {
+	parts := strings.Split(name, ",")
+	if len(parts) < 2 {
+		return true
+	}
+	country, ok := gazetteer.Resolve(parts[len(parts)-1], entities.TypeLocation)
+	if !ok || country.Kind != entities.KindCountry || country.ID == entry.ID {
+		return true
+	}
+	for _, ancestor := range gazetteer.Ancestors(entry.ID) {
+		if ancestor == country.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract previews what the extractor finds in a headline and description
+func (e *EntityExtractor) Extract(title, description string) *models.EntityExtraction {
+	result := e.current().Extract(title, cleanFeedText(description))
+
+	extraction := &models.EntityExtraction{
+		Persons:       result.Persons,
+		Organizations: result.Organizations,
+		Locations:     result.Locations,
+		Mentions:      make([]models.EntityMention, 0, len(result.Mentions)),
+	}
+	for _, mention := range result.Mentions {
+		extraction.Mentions = append(extraction.Mentions, models.EntityMention{
+			ID:     mention.ID,
+			Name:   mention.Name,
+			Type:   mention.Type,
+			Kind:   mention.Kind,
+			Text:   mention.Text,
+			Source: mention.Source,
+		})
+	}
+	return extraction
+}
+
+// ResolveLocation returns the canonical ID of a place name such as "Bangalore"
+func (e *EntityExtractor) ResolveLocation(name string) (string, bool) {
+	entry, ok := e.current().Resolve(name, entities.TypeLocation)
+	if !ok {
+		return "", false
+	}
+	return entry.ID, true
+}
+
+// ResolveName returns the canonical name of the person, organization or place a name refers
+// to, such as "Bengaluru" for "Bangalore"
+func (e *EntityExtractor) ResolveName(name string) (string, bool) {
+	gazetteer := e.current()
+	for _, entityType := range []string{entities.TypePerson, entities.TypeOrganization, entities.TypeLocation} {
+		if entry, ok := gazetteer.Resolve(name, entityType); ok {
+			return entry.Name, true
+		}
+	}
+	return "", false
+}
+
+// LookupEntity returns the gazetteer entity with a canonical ID
+func (e *EntityExtractor) LookupEntity(id string) (*entities.Entry, bool) {
+	return e.current().Lookup(id)
+}
+
+// LocationAncestors returns the canonical IDs of the places containing a location, nearest first
+func (e *EntityExtractor) LocationAncestors(id string) []string {
+	return e.current().Ancestors(id)
+}
+
+// ===============================
+// GAZETTEER LOADING
+// ===============================
+
+// current returns the gazetteer, reloading admin rows first when they are due
+func (e *EntityExtractor) current() *entities.Gazetteer {
+	e.mutex.RLock()
+	gazetteer, due := e.gazetteer, time.Since(e.lastLoaded) >= entityGazetteerReloadInterval
+	e.mutex.RUnlock()
+
+	if due {
+		e.reload()
+		e.mutex.RLock()
+		gazetteer = e.gazetteer
+		e.mutex.RUnlock()
+	}
+	return gazetteer
+}
+
+// reload rebuilds the gazetteer from the built-in entities and admin rows. If the rows can't
+// be read the current gazetteer stays in place.
+func (e *EntityExtractor) reload() error {
+	e.mutex.Lock()
+	e.lastLoaded = time.Now()
+	e.mutex.Unlock()
+
+	rows, err := e.repo.GetGazetteerEntries()
+	if err != nil {
+		e.logger.Warn("Failed to load gazetteer entries, keeping current gazetteer", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	custom := make(map[string]*models.GazetteerEntry)
+	var disabled []string
+	overridden := make(map[string]bool)
+	for _, row := range rows {
+		overridden[row.CanonicalID] = true
+		if row.IsActive {
+			custom[row.CanonicalID] = row
+		} else if _, ok := e.builtin[row.CanonicalID]; ok {
+			disabled = append(disabled, row.CanonicalID)
+		}
+	}
+
+	merged := make([]entities.Entry, 0, len(e.builtin)+len(custom))
+	for id, entry := range e.builtin {
+		if !overridden[id] {
+			merged = append(merged, entry)
+		}
+	}
+	for _, row := range custom {
+		merged = append(merged, gazetteerEntry(row))
+	}
+	gazetteer := entities.NewGazetteer(merged)
+
+	e.mutex.Lock()
+	e.gazetteer = gazetteer
+	e.custom = custom
+	e.disabled = disabled
+	e.mutex.Unlock()
+	return nil
+}
+
+// gazetteerEntry converts an admin row to an extractor entry
+func gazetteerEntry(row *models.GazetteerEntry) entities.Entry {
+	return entities.Entry{
+		ID:            row.CanonicalID,
+		Name:          row.Name,
+		Type:          row.EntityType,
+		Kind:          derefString(row.Kind),
+		Aliases:       row.Aliases,
+		Parent:        derefString(row.ParentID),
+		CaseSensitive: row.CaseSensitive,
+		NotAfter:      row.NotAfter,
+	}
+}
+
+// ===============================
+// ADMIN
+// ===============================
+
+// ListEntities returns gazetteer entities of a type whose ID, name or aliases contain query
+func (e *EntityExtractor) ListEntities(entityType, query string) *models.GazetteerListResponse {
+	gazetteer := e.current()
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	response := &models.GazetteerListResponse{
+		Entities: []models.GazetteerEntity{},
+		Custom:   len(e.custom),
+		Disabled: append([]string{}, e.disabled...),
+	}
+	sort.Strings(response.Disabled)
+
+	for _, entry := range gazetteer.Entries() {
+		if entityType != "" && entry.Type != entityType {
+			continue
+		}
+		if query != "" && !entityMatchesQuery(entry, query) {
+			continue
+		}
+		source := models.GazetteerSourceBuiltin
+		if _, ok := e.custom[entry.ID]; ok {
+			source = models.GazetteerSourceCustom
+		}
+		response.Entities = append(response.Entities, models.GazetteerEntity{
+			ID:            entry.ID,
+			Name:          entry.Name,
+			Type:          entry.Type,
+			Kind:          entry.Kind,
+			Aliases:       append([]string{}, entry.Aliases...),
+			Parent:        entry.Parent,
+			CaseSensitive: entry.CaseSensitive,
+			NotAfter:      entry.NotAfter,
+			Source:        source,
+		})
+	}
+	response.Total = len(response.Entities)
+	return response
+}
+
+func entityMatchesQuery(entry entities.Entry, query string) bool {
+	if strings.Contains(entry.ID, query) || strings.Contains(strings.ToLower(entry.Name), query) {
+		return true
+	}
+	for _, alias := range entry.Aliases {
+		if strings.Contains(strings.ToLower(alias), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveEntity adds an entity to the gazetteer, or replaces the one with the same ID. Articles
+// ingested from then on use it; stored articles are not re-annotated.
+func (e *EntityExtractor) SaveEntity(req models.GazetteerEntityRequest, updatedBy *uuid.UUID) (*models.GazetteerEntry, error) {
+	entry, err := e.validateEntity(req)
+	if err != nil {
+		return nil, err
+	}
+	entry.IsActive = true
+	entry.UpdatedBy = updatedBy
+
+	saved, err := e.repo.UpsertGazetteerEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+	e.reload()
+
+	e.logger.Info("Gazetteer entity saved", map[string]interface{}{
+		"id":   saved.CanonicalID,
+		"type": saved.EntityType,
+	})
+	return saved, nil
+}
+
+// RemoveEntity takes an entity out of the gazetteer. Built-in entities are switched off with
+// an inactive row; saving the ID again restores it.
+func (e *EntityExtractor) RemoveEntity(id string, updatedBy *uuid.UUID) error {
+	if builtin, ok := e.builtin[id]; ok {
+		row := &models.GazetteerEntry{
+			CanonicalID: builtin.ID,
+			Name:        builtin.Name,
+			EntityType:  builtin.Type,
+			Aliases:     pq.StringArray{},
+			NotAfter:    pq.StringArray{},
+			UpdatedBy:   updatedBy,
+		}
+		if _, err := e.repo.UpsertGazetteerEntry(row); err != nil {
+			return err
+		}
+	} else if err := e.repo.DeleteGazetteerEntry(id); err != nil {
+		return err
+	}
+	e.reload()
+
+	e.logger.Info("Gazetteer entity removed", map[string]interface{}{
+		"id": id,
+	})
+	return nil
+}
+
+// validateEntity normalizes a request into a row, checking its type, ID and parent
+func (e *EntityExtractor) validateEntity(req models.GazetteerEntityRequest) (*models.GazetteerEntry, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 200 {
+		return nil, invalidGazetteerEntityf("name is required and must be at most 200 characters")
+	}
+
+	switch req.Type {
+	case entities.TypePerson, entities.TypeOrganization, entities.TypeLocation:
+	default:
+		return nil, invalidGazetteerEntityf("type must be person, organization or location")
+	}
+
+	id := strings.TrimSpace(req.ID)
+	if id == "" {
+		id = entities.Slug(name)
+	}
+	if id == "" || id != entities.Slug(id) || len(id) > 100 {
+		return nil, invalidGazetteerEntityf("id %q must be lowercase words joined by hyphens, at most 100 characters", id)
+	}
+
+	kind := strings.ToLower(strings.TrimSpace(req.Kind))
+	if len(kind) > 30 {
+		return nil, invalidGazetteerEntityf("kind must be at most 30 characters")
+	}
+
+	entry := &models.GazetteerEntry{
+		CanonicalID:   id,
+		Name:          name,
+		EntityType:    req.Type,
+		Aliases:       pq.StringArray{},
+		CaseSensitive: req.CaseSensitive,
+		NotAfter:      pq.StringArray{},
+	}
+	if kind != "" {
+		entry.Kind = &kind
+	}
+
+	seen := map[string]bool{strings.ToLower(name): true}
+	for _, alias := range req.Aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || seen[strings.ToLower(alias)] {
+			continue
+		}
+		seen[strings.ToLower(alias)] = true
+		entry.Aliases = append(entry.Aliases, alias)
+	}
+	for _, word := range req.NotAfter {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			entry.NotAfter = append(entry.NotAfter, word)
+		}
+	}
+
+	if parent := strings.TrimSpace(req.Parent); parent != "" {
+		if req.Type != entities.TypeLocation {
+			return nil, invalidGazetteerEntityf("only locations have a parent")
+		}
+		gazetteer := e.current()
+		parentEntry, ok := gazetteer.Lookup(parent)
+		if !ok || parentEntry.Type != entities.TypeLocation {
+			return nil, invalidGazetteerEntityf("parent %q is not a known location", parent)
+		}
+		if parent == id {
+			return nil, invalidGazetteerEntityf("a location can't contain itself")
+		}
+		for _, ancestor := range gazetteer.Ancestors(parent) {
+			if ancestor == id {
+				return nil, invalidGazetteerEntityf("parent %q is inside %q", parent, id)
+			}
+		}
+		entry.ParentID = &parent
+	}
+
+	return entry, nil
+}
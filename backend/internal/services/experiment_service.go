@@ -0,0 +1,660 @@
+// internal/services/experiment_service.go
+// GoNews - A/B Experimentation Service
+// Deterministic bucketing, variant parameters for feeds, exposure/outcome logging and significance testing
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Buckets per experiment; traffic allocation has 0.01% resolution
+	experimentBuckets = 10000
+
+	// Async write queue sizing for exposures and outcome events
+	experimentQueueSize      = 2000
+	experimentFlushBatchSize = 200
+	experimentFlushInterval  = 2 * time.Second
+
+	// Variants with fewer subjects than this get an "underpowered" warning in results
+	experimentMinSubjects = 100
+)
+
+var experimentKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,99}$`)
+
+// ErrInvalidExperiment marks experiment definitions or status changes rejected by validation
+var ErrInvalidExperiment = errors.New("invalid experiment")
+
+// invalidExperimentf wraps a validation message with ErrInvalidExperiment
+func invalidExperimentf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidExperiment, fmt.Sprintf(format, args...))
+}
+
+// ExperimentSubject identifies who is being bucketed: a signed-in user or an anonymous device
+type ExperimentSubject struct {
+	Key    string // "user:<uuid>" or "device:<id>"
+	UserID *uuid.UUID
+}
+
+// NewUserSubject builds a subject for a signed-in user
+func NewUserSubject(userID uuid.UUID) ExperimentSubject {
+	return ExperimentSubject{Key: "user:" + userID.String(), UserID: &userID}
+}
+
+// NewDeviceSubject builds a subject for an anonymous device
+func NewDeviceSubject(deviceID string) ExperimentSubject {
+	return ExperimentSubject{Key: "device:" + deviceID}
+}
+
+// ExperimentAssignment holds the variants a subject was bucketed into and the merged parameters
+type ExperimentAssignment struct {
+	Variants map[string]string // experiment key -> variant key
+	Params   models.ExperimentParams
+}
+
+// FloatParam returns a numeric parameter
+func (a *ExperimentAssignment) FloatParam(name string) (float64, bool) {
+	if a == nil {
+		return 0, false
+	}
+	value, ok := a.Params[name].(float64)
+	return value, ok
+}
+
+// BoolParam returns a boolean parameter (false when absent)
+func (a *ExperimentAssignment) BoolParam(name string) bool {
+	if a == nil {
+		return false
+	}
+	value, _ := a.Params[name].(bool)
+	return value
+}
+
+// WeightsParam returns a feature-weight override map
+func (a *ExperimentAssignment) WeightsParam(name string) map[string]float64 {
+	if a == nil {
+		return nil
+	}
+	raw, ok := a.Params[name].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	weights := make(map[string]float64, len(raw))
+	for feature, value := range raw {
+		if weight, ok := value.(float64); ok {
+			weights[feature] = weight
+		}
+	}
+	return weights
+}
+
+// experimentWrite is one queued exposure or outcome event
+type experimentWrite struct {
+	exposure *models.ExperimentExposure
+	event    *models.ExperimentEvent
+}
+
+// ExperimentService runs A/B experiments over feed ranking parameters
+type ExperimentService struct {
+	config *config.Config
+	logger *logger.Logger
+	repo   *repository.ExperimentRepository
+
+	// Running experiment definitions, reloaded periodically
+	running      []*models.Experiment
+	runningAt    time.Time
+	runningMutex sync.RWMutex
+
+	// Async exposure / event logging
+	writes   chan experimentWrite
+	dropped  int64
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewExperimentService creates a new experiment service
+func NewExperimentService(cfg *config.Config, log *logger.Logger, repo *repository.ExperimentRepository) *ExperimentService {
+	return &ExperimentService{
+		config:   cfg,
+		logger:   log,
+		repo:     repo,
+		writes:   make(chan experimentWrite, experimentQueueSize),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Start launches the background writer for exposures and outcome events
+func (s *ExperimentService) Start() {
+	s.wg.Add(1)
+	go s.writeLoop()
+
+	s.logger.Info("Experiment service started", map[string]interface{}{
+		"enabled":       s.config.ExperimentsEnabled,
+		"device_header": s.config.ExperimentDeviceHeader,
+	})
+}
+
+// Close flushes pending writes and stops the background writer
+func (s *ExperimentService) Close() {
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+// ===============================
+// BUCKETING & PARAMETERS
+// ===============================
+
+// Assign buckets a subject into every running experiment targeting the feed type,
+// logs the exposures and returns the merged variant parameters
+func (s *ExperimentService) Assign(feedType string, subject ExperimentSubject) *ExperimentAssignment {
+	assignment := &ExperimentAssignment{
+		Variants: map[string]string{},
+		Params:   models.ExperimentParams{},
+	}
+	if !s.config.ExperimentsEnabled || subject.Key == "" {
+		return assignment
+	}
+
+	for _, experiment := range s.runningExperiments() {
+		if experiment.FeedType != models.ExperimentFeedAll && experiment.FeedType != feedType {
+			continue
+		}
+
+		variant := s.bucket(experiment, subject.Key)
+		if variant == nil {
+			continue // Subject falls outside the allocated traffic
+		}
+
+		assignment.Variants[experiment.Key] = variant.Key
+		for name, value := range variant.Params {
+			assignment.Params[name] = value
+		}
+
+		s.enqueue(experimentWrite{exposure: &models.ExperimentExposure{
+			ExperimentID: experiment.ID,
+			VariantKey:   variant.Key,
+			SubjectKey:   subject.Key,
+			UserID:       subject.UserID,
+			FeedType:     feedType,
+		}})
+	}
+
+	return assignment
+}
+
+// RecordOutcome attributes a read or bookmark to the subject's variant in every running experiment.
+// Events from subjects never exposed to an experiment are ignored when results are aggregated.
+func (s *ExperimentService) RecordOutcome(subject ExperimentSubject, eventType string, articleID *int, dwellSeconds, scrollDepth int) {
+	if !s.config.ExperimentsEnabled || subject.Key == "" {
+		return
+	}
+
+	for _, experiment := range s.runningExperiments() {
+		variant := s.bucket(experiment, subject.Key)
+		if variant == nil {
+			continue
+		}
+
+		s.enqueue(experimentWrite{event: &models.ExperimentEvent{
+			ExperimentID: experiment.ID,
+			VariantKey:   variant.Key,
+			SubjectKey:   subject.Key,
+			EventType:    eventType,
+			ArticleID:    articleID,
+			DwellSeconds: dwellSeconds,
+			ScrollDepth:  scrollDepth,
+		}})
+	}
+}
+
+// bucket deterministically maps a subject to a variant, or nil when outside allocated traffic
+func (s *ExperimentService) bucket(experiment *models.Experiment, subjectKey string) *models.ExperimentVariant {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(experiment.Key + ":" + subjectKey))
+	bucket := int(hasher.Sum32() % experimentBuckets)
+
+	threshold := 0
+	for i := range experiment.Variants {
+		threshold += experiment.Variants[i].TrafficPercent * (experimentBuckets / 100)
+		if bucket < threshold {
+			return &experiment.Variants[i]
+		}
+	}
+	return nil
+}
+
+// runningExperiments returns cached running experiments, reloading them when stale
+func (s *ExperimentService) runningExperiments() []*models.Experiment {
+	refresh := time.Duration(s.config.ExperimentRefreshSeconds) * time.Second
+
+	s.runningMutex.RLock()
+	if !s.runningAt.IsZero() && time.Since(s.runningAt) < refresh {
+		running := s.running
+		s.runningMutex.RUnlock()
+		return running
+	}
+	s.runningMutex.RUnlock()
+
+	s.runningMutex.Lock()
+	defer s.runningMutex.Unlock()
+
+	// Another request may have reloaded while we waited for the lock
+	if !s.runningAt.IsZero() && time.Since(s.runningAt) < refresh {
+		return s.running
+	}
+
+	running, err := s.repo.GetRunningExperiments()
+	if err != nil {
+		s.logger.Error("Failed to load running experiments", map[string]interface{}{
+			"error": err.Error(),
+		})
+		// Keep serving the previous definitions and retry on the next refresh
+		s.runningAt = time.Now()
+		return s.running
+	}
+
+	s.running = running
+	s.runningAt = time.Now()
+	return s.running
+}
+
+// invalidateRunning forces the next request to reload running experiments
+func (s *ExperimentService) invalidateRunning() {
+	s.runningMutex.Lock()
+	s.runningAt = time.Time{}
+	s.runningMutex.Unlock()
+}
+
+// ===============================
+// ASYNC WRITES
+// ===============================
+
+// enqueue queues a write without blocking the request path
+func (s *ExperimentService) enqueue(write experimentWrite) {
+	select {
+	case s.writes <- write:
+	default:
+		if dropped := atomic.AddInt64(&s.dropped, 1); dropped%100 == 1 {
+			s.logger.Warn("Experiment write queue full, dropping writes", map[string]interface{}{
+				"dropped_total": atomic.LoadInt64(&s.dropped),
+			})
+		}
+	}
+}
+
+// writeLoop batches queued exposures and events into the database
+func (s *ExperimentService) writeLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(experimentFlushInterval)
+	defer ticker.Stop()
+
+	var exposures []models.ExperimentExposure
+	var events []models.ExperimentEvent
+
+	flush := func() {
+		if err := s.repo.RecordExposures(exposures); err != nil {
+			s.logger.Error("Failed to record experiment exposures", map[string]interface{}{
+				"count": len(exposures),
+				"error": err.Error(),
+			})
+		}
+		if err := s.repo.RecordEvents(events); err != nil {
+			s.logger.Error("Failed to record experiment events", map[string]interface{}{
+				"count": len(events),
+				"error": err.Error(),
+			})
+		}
+		exposures = exposures[:0]
+		events = events[:0]
+	}
+
+	for {
+		select {
+		case write := <-s.writes:
+			if write.exposure != nil {
+				exposures = append(exposures, *write.exposure)
+			}
+			if write.event != nil {
+				events = append(events, *write.event)
+			}
+			if len(exposures)+len(events) >= experimentFlushBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stopChan:
+			// Drain whatever is still queued before exiting
+			for {
+				select {
+				case write := <-s.writes:
+					if write.exposure != nil {
+						exposures = append(exposures, *write.exposure)
+					}
+					if write.event != nil {
+						events = append(events, *write.event)
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// ===============================
+// EXPERIMENT MANAGEMENT
+// ===============================
+
+// CreateExperiment validates and stores a new draft experiment
+func (s *ExperimentService) CreateExperiment(req *models.CreateExperimentRequest) (*models.Experiment, error) {
+	if !experimentKeyPattern.MatchString(req.Key) {
+		return nil, invalidExperimentf("experiment key must be 2-100 lowercase letters, digits, '-' or '_'")
+	}
+	if req.Name == "" {
+		return nil, invalidExperimentf("experiment name is required")
+	}
+
+	feedType := req.FeedType
+	if feedType == "" {
+		feedType = models.ExperimentFeedAll
+	}
+	if feedType != models.ExperimentFeedAll && feedType != models.FeedTypeNewsFeed && feedType != models.FeedTypePersonalized {
+		return nil, invalidExperimentf("unsupported feed type: %s", feedType)
+	}
+
+	if len(req.Variants) < 2 {
+		return nil, invalidExperimentf("an experiment needs at least two variants")
+	}
+
+	experiment := &models.Experiment{
+		Key:         req.Key,
+		Name:        req.Name,
+		Description: req.Description,
+		FeedType:    feedType,
+		Status:      models.ExperimentStatusDraft,
+		Variants:    make([]models.ExperimentVariant, 0, len(req.Variants)),
+	}
+
+	seen := make(map[string]bool, len(req.Variants))
+	controls, totalTraffic := 0, 0
+	for _, v := range req.Variants {
+		if !experimentKeyPattern.MatchString(v.Key) {
+			return nil, invalidExperimentf("invalid variant key: %q", v.Key)
+		}
+		if seen[v.Key] {
+			return nil, invalidExperimentf("duplicate variant key: %s", v.Key)
+		}
+		seen[v.Key] = true
+
+		if v.TrafficPercent < 0 || v.TrafficPercent > 100 {
+			return nil, invalidExperimentf("variant %s traffic must be between 0 and 100", v.Key)
+		}
+		totalTraffic += v.TrafficPercent
+		if v.IsControl {
+			controls++
+		}
+
+		if err := validateExperimentParams(v.Params); err != nil {
+			return nil, invalidExperimentf("variant %s: %v", v.Key, err)
+		}
+
+		name := v.Name
+		if name == "" {
+			name = v.Key
+		}
+		params := v.Params
+		if params == nil {
+			params = models.ExperimentParams{}
+		}
+
+		experiment.Variants = append(experiment.Variants, models.ExperimentVariant{
+			Key:            v.Key,
+			Name:           name,
+			TrafficPercent: v.TrafficPercent,
+			IsControl:      v.IsControl,
+			Params:         params,
+		})
+	}
+
+	if controls != 1 {
+		return nil, invalidExperimentf("exactly one variant must be marked as control")
+	}
+	if totalTraffic < 1 || totalTraffic > 100 {
+		return nil, invalidExperimentf("total traffic allocation must be between 1 and 100 percent, got %d", totalTraffic)
+	}
+
+	if err := s.repo.CreateExperiment(experiment); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Experiment created", map[string]interface{}{
+		"key":      experiment.Key,
+		"feed":     experiment.FeedType,
+		"variants": len(experiment.Variants),
+		"traffic":  totalTraffic,
+	})
+
+	return experiment, nil
+}
+
+// ListExperiments returns all experiments with their variants
+func (s *ExperimentService) ListExperiments() ([]*models.Experiment, error) {
+	return s.repo.GetExperiments()
+}
+
+// UpdateStatus moves an experiment to a new lifecycle state
+func (s *ExperimentService) UpdateStatus(key, status string) error {
+	switch status {
+	case models.ExperimentStatusRunning, models.ExperimentStatusPaused, models.ExperimentStatusCompleted:
+	default:
+		return invalidExperimentf("unsupported experiment status: %s", status)
+	}
+
+	if err := s.repo.UpdateExperimentStatus(key, status); err != nil {
+		return err
+	}
+	s.invalidateRunning()
+
+	s.logger.Info("Experiment status updated", map[string]interface{}{
+		"key":    key,
+		"status": status,
+	})
+
+	return nil
+}
+
+// validateExperimentParams rejects unknown parameters and wrongly typed values
+func validateExperimentParams(params models.ExperimentParams) error {
+	for name, value := range params {
+		switch name {
+		case models.ExperimentParamDiversityLambda:
+			lambda, ok := value.(float64)
+			if !ok || lambda < 0 || lambda > 1 {
+				return fmt.Errorf("%s must be a number between 0 and 1", name)
+			}
+		case models.ExperimentParamDiversityDisabled:
+			if _, ok := value.(bool); !ok {
+				return fmt.Errorf("%s must be a boolean", name)
+			}
+		case models.ExperimentParamCandidatePoolSize:
+			size, ok := value.(float64)
+			if !ok || size < 1 || size > 10 || size != math.Trunc(size) {
+				return fmt.Errorf("%s must be an integer between 1 and 10", name)
+			}
+		case models.ExperimentParamRankingWeights:
+			weights, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s must be an object of feature weights", name)
+			}
+			for feature, weight := range weights {
+				if w, ok := weight.(float64); !ok || w < 0 {
+					return fmt.Errorf("%s.%s must be a non-negative number", name, feature)
+				}
+			}
+		default:
+			return fmt.Errorf("unknown experiment parameter: %s", name)
+		}
+	}
+	return nil
+}
+
+// ===============================
+// RESULTS & SIGNIFICANCE
+// ===============================
+
+// GetResults aggregates per-variant outcomes and tests each variant against control
+func (s *ExperimentService) GetResults(key string) (*models.ExperimentResults, error) {
+	experiment, err := s.repo.GetExperimentByKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.repo.GetVariantMetrics(experiment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]models.VariantMetrics, len(metrics))
+	for _, m := range metrics {
+		byKey[m.VariantKey] = m
+	}
+
+	results := &models.ExperimentResults{
+		Experiment:  experiment,
+		Variants:    make([]models.VariantResult, 0, len(experiment.Variants)),
+		Alpha:       s.config.ExperimentSignificanceAlpha,
+		GeneratedAt: time.Now(),
+	}
+
+	var control *models.VariantResult
+	for _, variant := range experiment.Variants {
+		m := byKey[variant.Key]
+		m.VariantKey = variant.Key
+
+		result := models.VariantResult{
+			VariantMetrics: m,
+			IsControl:      variant.IsControl,
+			CTR:            safeRatio(m.Reads, m.Exposures),
+			ConversionRate: safeRatio(m.ConvertedSubjects, m.Subjects),
+			BookmarkRate:   safeRatio(m.BookmarkingSubjects, m.Subjects),
+		}
+		results.Variants = append(results.Variants, result)
+
+		if m.Subjects < experimentMinSubjects {
+			results.Warnings = append(results.Warnings, fmt.Sprintf(
+				"variant %s has %d subjects (< %d); results are underpowered", variant.Key, m.Subjects, experimentMinSubjects))
+		}
+	}
+
+	for i := range results.Variants {
+		if results.Variants[i].IsControl {
+			control = &results.Variants[i]
+			break
+		}
+	}
+	if control == nil {
+		results.Warnings = append(results.Warnings, "no control variant; significance tests skipped")
+		return results, nil
+	}
+
+	alpha := s.config.ExperimentSignificanceAlpha
+	for i := range results.Variants {
+		variant := &results.Variants[i]
+		if variant.IsControl {
+			continue
+		}
+
+		variant.Tests = []models.SignificanceTest{
+			proportionTest("conversion_rate", control.ConvertedSubjects, control.Subjects, variant.ConvertedSubjects, variant.Subjects, alpha),
+			proportionTest("bookmark_rate", control.BookmarkingSubjects, control.Subjects, variant.BookmarkingSubjects, variant.Subjects, alpha),
+			meanTest("dwell_seconds", control.DwellMean, control.DwellStdDev, control.DwellSamples,
+				variant.DwellMean, variant.DwellStdDev, variant.DwellSamples, alpha),
+		}
+	}
+
+	return results, nil
+}
+
+// proportionTest runs a two-sided two-proportion z-test
+func proportionTest(metric string, successA, totalA, successB, totalB int, alpha float64) models.SignificanceTest {
+	pA := rawRatio(successA, totalA)
+	pB := rawRatio(successB, totalB)
+	test := models.SignificanceTest{Metric: metric, Control: roundScore(pA), Variant: roundScore(pB), Lift: relativeLift(pA, pB), PValue: 1}
+
+	if totalA == 0 || totalB == 0 {
+		return test
+	}
+
+	pooled := float64(successA+successB) / float64(totalA+totalB)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(totalA) + 1/float64(totalB)))
+	if se == 0 {
+		return test
+	}
+
+	return withZScore(test, (pB-pA)/se, alpha)
+}
+
+// meanTest runs a two-sided Welch test using the normal approximation (adequate for large samples)
+func meanTest(metric string, meanA, sdA float64, nA int, meanB, sdB float64, nB int, alpha float64) models.SignificanceTest {
+	test := models.SignificanceTest{Metric: metric, Control: meanA, Variant: meanB, Lift: relativeLift(meanA, meanB), PValue: 1}
+
+	if nA < 2 || nB < 2 {
+		return test
+	}
+
+	se := math.Sqrt(sdA*sdA/float64(nA) + sdB*sdB/float64(nB))
+	if se == 0 {
+		return test
+	}
+
+	return withZScore(test, (meanB-meanA)/se, alpha)
+}
+
+// withZScore sets a test's z-score and two-sided p-value. Significance is decided on the
+// unrounded p-value; both are rounded only for display, so rounding never flips a borderline result.
+func withZScore(test models.SignificanceTest, z, alpha float64) models.SignificanceTest {
+	pValue := math.Erfc(math.Abs(z) / math.Sqrt2)
+	test.ZScore = roundScore(z)
+	test.PValue = roundScore(pValue)
+	test.Significant = pValue < alpha
+	return test
+}
+
+// safeRatio returns a/b rounded for display, or 0 when b is 0
+func safeRatio(a, b int) float64 {
+	return roundScore(rawRatio(a, b))
+}
+
+// rawRatio returns a/b, or 0 when b is 0
+func rawRatio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// relativeLift returns (variant - control) / control, or 0 when control is 0
+func relativeLift(control, variant float64) float64 {
+	if control == 0 {
+		return 0
+	}
+	return roundScore((variant - control) / control)
+}
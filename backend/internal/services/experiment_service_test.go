@@ -0,0 +1,154 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+)
+
+func TestExperimentBucketIsStable(t *testing.T) {
+	experiment := &models.Experiment{Key: "feed-ranking", Variants: []models.ExperimentVariant{
+		{Key: "control", TrafficPercent: 50, IsControl: true},
+		{Key: "treatment", TrafficPercent: 50},
+	}}
+	first := NewExperimentService(&config.Config{}, logger.NewLogger(), nil)
+	second := NewExperimentService(&config.Config{}, logger.NewLogger(), nil)
+
+	for i := 0; i < 1000; i++ {
+		subject := fmt.Sprintf("user-%d", i)
+		want := first.bucket(experiment, subject)
+		if want == nil {
+			t.Fatalf("%s is outside a fully allocated experiment", subject)
+		}
+		for j := 0; j < 3; j++ {
+			if got := first.bucket(experiment, subject); got.Key != want.Key {
+				t.Fatalf("%s moved from %s to %s", subject, want.Key, got.Key)
+			}
+		}
+		// Assignment depends only on the experiment and subject, not on the service instance
+		if got := second.bucket(experiment, subject); got.Key != want.Key {
+			t.Fatalf("%s is in %s on one instance and %s on another", subject, want.Key, got.Key)
+		}
+	}
+}
+
+func TestExperimentBucketSplitsTraffic(t *testing.T) {
+	const subjects = 20000
+	service := NewExperimentService(&config.Config{}, logger.NewLogger(), nil)
+
+	tests := []struct {
+		name     string
+		variants []models.ExperimentVariant
+	}{
+		{"even split", []models.ExperimentVariant{
+			{Key: "control", TrafficPercent: 50, IsControl: true},
+			{Key: "treatment", TrafficPercent: 50},
+		}},
+		{"uneven split", []models.ExperimentVariant{
+			{Key: "control", TrafficPercent: 70, IsControl: true},
+			{Key: "treatment", TrafficPercent: 30},
+		}},
+		{"partial allocation", []models.ExperimentVariant{
+			{Key: "control", TrafficPercent: 10, IsControl: true},
+			{Key: "treatment", TrafficPercent: 20},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := &models.Experiment{Key: "split-" + tt.name, Variants: tt.variants}
+
+			counts := make(map[string]int)
+			for i := 0; i < subjects; i++ {
+				if variant := service.bucket(experiment, fmt.Sprintf("user-%d", i)); variant != nil {
+					counts[variant.Key]++
+				} else {
+					counts[""]++
+				}
+			}
+
+			want := map[string]float64{"": 100}
+			for _, variant := range tt.variants {
+				want[variant.Key] = float64(variant.TrafficPercent)
+				want[""] -= float64(variant.TrafficPercent)
+			}
+			for key, percent := range want {
+				// Within 1.5 points, about five standard errors at this sample size
+				if got := float64(counts[key]) * 100 / subjects; math.Abs(got-percent) > 1.5 {
+					t.Errorf("variant %q got %.2f%% of subjects, want %.0f%%", key, got, percent)
+				}
+			}
+		})
+	}
+}
+
+func TestProportionTest(t *testing.T) {
+	tests := []struct {
+		name                   string
+		successA, totalA       int
+		successB, totalB       int
+		wantZ, wantP, wantLift float64
+		wantSignificant        bool
+	}{
+		{"clear lift", 200, 1000, 250, 1000, 2.6774, 0.0074, 0.25, true},
+		{"no difference", 100, 1000, 100, 1000, 0, 1, 0, false},
+		{"drop", 250, 1000, 200, 1000, -2.6774, 0.0074, -0.2, true},
+		// p = 0.04996 is displayed as 0.05 but is below alpha
+		{"just significant", 131, 1000, 162, 1000, 1.9603, 0.05, 0.2366, true},
+		// Rounding the rates first would give z = 1.9615 and p = 0.0498
+		{"just not significant", 63, 997, 86, 997, 1.9588, 0.0501, 0.3651, false},
+		{"empty variant", 10, 100, 0, 0, 0, 1, -1, false},
+		{"no conversions", 0, 100, 0, 100, 0, 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := proportionTest("conversion_rate", tt.successA, tt.totalA, tt.successB, tt.totalB, 0.05)
+
+			if test.ZScore != tt.wantZ || test.PValue != tt.wantP || test.Lift != tt.wantLift {
+				t.Errorf("z = %v, p = %v, lift = %v, want %v, %v, %v", test.ZScore, test.PValue, test.Lift, tt.wantZ, tt.wantP, tt.wantLift)
+			}
+			if test.Significant != tt.wantSignificant {
+				t.Errorf("significant = %v, want %v", test.Significant, tt.wantSignificant)
+			}
+		})
+	}
+}
+
+func TestMeanTest(t *testing.T) {
+	tests := []struct {
+		name            string
+		meanA, sdA      float64
+		nA              int
+		meanB, sdB      float64
+		nB              int
+		wantZ, wantP    float64
+		wantSignificant bool
+	}{
+		// se = sqrt(4/50 + 9/60) = 0.4796
+		{"unequal variances", 5, 2, 50, 6, 3, 60, 2.0851, 0.0371, true},
+		// se = 1, so z is the difference in means; p = 0.049996 displays as 0.05 but is below alpha
+		{"z of 1.96", 0, 10, 200, 1.96, 10, 200, 1.96, 0.05, true},
+		// Just short of the critical value, so p = 0.050002 although z and p display as 1.96 and 0.05
+		{"z just below 1.96", 0, 10, 200, 1.95995, 10, 200, 1.96, 0.05, false},
+		{"too few samples", 5, 2, 1, 6, 3, 60, 0, 1, false},
+		{"no variance", 5, 0, 50, 6, 0, 60, 0, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := meanTest("dwell_seconds", tt.meanA, tt.sdA, tt.nA, tt.meanB, tt.sdB, tt.nB, 0.05)
+
+			if test.ZScore != tt.wantZ || test.PValue != tt.wantP {
+				t.Errorf("z = %v, p = %v, want %v, %v", test.ZScore, test.PValue, tt.wantZ, tt.wantP)
+			}
+			if test.Significant != tt.wantSignificant {
+				t.Errorf("significant = %v, want %v", test.Significant, tt.wantSignificant)
+			}
+		})
+	}
+}
@@ -0,0 +1,559 @@
+// internal/services/extraction_service.go
+// GoNews - Full-Text Extraction Service
+// Fetches article pages, honours robots.txt and per-domain limits, and stores readability text
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+	"backend/pkg/summarize"
+
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// robotsCacheTTL is how long a fetched robots.txt is trusted
+	robotsCacheTTL = 24 * time.Hour
+	// robotsErrorTTL is how long a host stays blocked after robots.txt failed to load
+	robotsErrorTTL = 30 * time.Minute
+	// maxCrawlDelay caps a host's Crawl-delay so one slow site can't stall a batch
+	maxCrawlDelay = 30 * time.Second
+	// maxExtractionRedirects is how many redirects a page fetch follows
+	maxExtractionRedirects = 10
+)
+
+var (
+	// errExtractionBlocked marks pages disallowed by robots.txt
+	errExtractionBlocked = errors.New("disallowed by robots.txt")
+	// errRobotsUnavailable is a temporary block; the article is retried later
+	errRobotsUnavailable = errors.New("robots.txt unavailable")
+	errExtractionNotHTML = errors.New("page is not HTML")
+	errExtractionGone    = errors.New("page no longer exists")
+	// errExtractionPrivateAddress marks pages on hosts that resolve inside our network
+	errExtractionPrivateAddress = errors.New("host is not a public address")
+)
+
+// nonPublicNetworks are special-purpose ranges not covered by the net.IP checks in
+// isPublicAddress: "this network", carrier-grade NAT, IETF protocol assignments,
+// benchmarking and reserved space
+var nonPublicNetworks = mustParseCIDRs("0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4")
+
+// extractionHost tracks one domain's concurrency slots, robots.txt and crawl pacing
+type extractionHost struct {
+	slots chan struct{}
+
+	mutex           sync.Mutex
+	robots          *RobotsRules
+	robotsExpiresAt time.Time
+	nextFetchAt     time.Time
+}
+
+// ExtractionService enriches stored articles with the full text of their source pages
+type ExtractionService struct {
+	config *config.Config
+	logger *logger.Logger
+	repo   *repository.ExtractionRepository
+	client *http.Client
+
+	slots      chan struct{}
+	hostsMutex sync.Mutex
+	hosts      map[string]*extractionHost
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewExtractionService creates the extraction service; page fetches share the provider
+// transport mode so record/replay covers them too. Article URLs come from providers, so
+// pages are only fetched from public addresses and every redirect is checked again.
+func NewExtractionService(cfg *config.Config, log *logger.Logger, repo *repository.ExtractionRepository) *ExtractionService {
+	concurrency := cfg.ExtractionConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	service := &ExtractionService{
+		config:   cfg,
+		logger:   log,
+		repo:     repo,
+		slots:    make(chan struct{}, concurrency),
+		hosts:    make(map[string]*extractionHost),
+		stopChan: make(chan struct{}),
+	}
+	service.client = &http.Client{
+		Timeout:       time.Duration(cfg.ExtractionTimeoutSeconds) * time.Second,
+		Transport:     providerTransport(cfg, log, publicOnlyTransport()),
+		CheckRedirect: service.checkRedirect,
+	}
+	return service
+}
+
+// Start begins extracting pending articles in the background
+func (s *ExtractionService) Start() {
+	if !s.config.ExtractionEnabled {
+		s.logger.Info("Full-text extraction disabled by configuration")
+		return
+	}
+
+	interval := time.Duration(s.config.ExtractionIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	s.wg.Add(1)
+	go s.runWorker(interval)
+
+	s.logger.Info("Full-text extraction worker started", map[string]interface{}{
+		"interval":     interval.String(),
+		"batch_size":   s.config.ExtractionBatchSize,
+		"concurrency":  s.config.ExtractionConcurrency,
+		"per_domain":   s.config.ExtractionPerDomainConcurrency,
+		"user_agent":   s.config.ExtractionUserAgent,
+		"robots_token": robotsProductToken(s.config.ExtractionUserAgent),
+	})
+}
+
+// runWorker extracts one batch per tick
+func (s *ExtractionService) runWorker(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.extractPending()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// extractPending claims a batch of pending articles and extracts them concurrently
+func (s *ExtractionService) extractPending() {
+	candidates, err := s.repo.GetPendingExtractions(s.config.ExtractionBatchSize, s.config.ExtractionMaxAttempts)
+	if err != nil {
+		s.logger.Error("Failed to load pending extractions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	start := time.Now()
+	counts := make(map[string]int)
+	var countsMutex sync.Mutex
+	var batch sync.WaitGroup
+
+	for _, candidate := range candidates {
+		batch.Add(1)
+		go func(candidate *models.ExtractionCandidate) {
+			defer batch.Done()
+
+			result := s.extract(ctx, candidate)
+			if ctx.Err() != nil {
+				return // Shutting down; leave the article pending
+			}
+			if err := s.repo.SaveExtraction(result); err != nil {
+				s.logger.Error("Failed to save extraction", map[string]interface{}{
+					"article_id": candidate.ID,
+					"error":      err.Error(),
+				})
+				return
+			}
+
+			countsMutex.Lock()
+			counts[result.Status]++
+			countsMutex.Unlock()
+		}(candidate)
+	}
+	batch.Wait()
+
+	s.logger.Info("Full-text extraction batch completed", map[string]interface{}{
+		"articles":    len(candidates),
+		"results":     counts,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+}
+
+// Close stops the worker and waits for the current batch
+func (s *ExtractionService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Extraction service stopped")
+	return nil
+}
+
+// ===============================
+// ON-DEMAND EXTRACTION AND STATS
+// ===============================
+
+// ExtractArticle re-extracts one article immediately and stores the result
+func (s *ExtractionService) ExtractArticle(ctx context.Context, articleID int) (*models.ArticleExtraction, error) {
+	candidate, err := s.repo.GetExtractionCandidate(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.extract(ctx, candidate)
+	if err := s.repo.SaveExtraction(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetStats returns extraction coverage by status
+func (s *ExtractionService) GetStats() (*models.ExtractionStats, error) {
+	return s.repo.GetExtractionStats()
+}
+
+// ===============================
+// EXTRACTION PIPELINE
+// ===============================
+
+// extract fetches and parses one article page, never returning an error: failures are
+// reported through the result status so they can be stored and retried
+func (s *ExtractionService) extract(ctx context.Context, candidate *models.ExtractionCandidate) *models.ArticleExtraction {
+	start := time.Now()
+	result := &models.ArticleExtraction{
+		ArticleID: candidate.ID,
+		URL:       candidate.URL,
+	}
+	defer func() {
+		result.DurationMs = time.Since(start).Milliseconds()
+		result.ExtractedAt = time.Now()
+	}()
+
+	pageURL, err := url.Parse(candidate.URL)
+	if err != nil || (pageURL.Scheme != "http" && pageURL.Scheme != "https") || pageURL.Host == "" {
+		result.Status = models.ExtractionSkipped
+		result.Error = "unsupported article URL"
+		return result
+	}
+
+	content, err := s.fetchAndExtract(ctx, pageURL)
+	switch {
+	case err == nil:
+		result.Status = models.ExtractionExtracted
+		result.FullText = content.Text
+		result.WordCount = content.WordCount
+		result.ReadingTimeMinutes = calculateReadingTime(content.WordCount)
+		summary := summarize.Summarize(candidate.Title, content.Text)
+		result.Summary = summary.Text
+		result.SummaryLowQuality = summary.LowQuality
+		if candidate.ImageURL == nil || *candidate.ImageURL == "" {
+			result.LeadImageURL = content.LeadImageURL
+		}
+	case errors.Is(err, errExtractionBlocked):
+		result.Status = models.ExtractionBlocked
+		result.Error = err.Error()
+	case errors.Is(err, ErrNoReadableContent), errors.Is(err, errExtractionNotHTML), errors.Is(err, errExtractionGone),
+		errors.Is(err, errExtractionPrivateAddress):
+		result.Status = models.ExtractionSkipped
+		result.Error = err.Error()
+	default:
+		result.Status = models.ExtractionFailed
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// fetchAndExtract checks robots.txt, waits for a domain slot and the crawl delay, then
+// downloads and parses the page
+func (s *ExtractionService) fetchAndExtract(ctx context.Context, pageURL *url.URL) (*ExtractedContent, error) {
+	host := s.hostFor(pageURL.Host)
+
+	robots, err := s.checkRobots(ctx, host, pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Domain slot first, then a global one, so waiting on a busy host doesn't hold a global slot
+	select {
+	case host.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-host.slots }()
+
+	select {
+	case s.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-s.slots }()
+
+	if err := s.waitForCrawlDelay(ctx, host, robots.CrawlDelay(s.config.ExtractionUserAgent)); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", s.config.ExtractionUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.5")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return nil, errExtractionGone
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType != "" && !strings.Contains(strings.ToLower(contentType), "html") {
+		return nil, fmt.Errorf("%w: %s", errExtractionNotHTML, contentType)
+	}
+
+	body, err := charset.NewReader(io.LimitReader(resp.Body, s.config.ExtractionMaxBytes), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page: %w", err)
+	}
+
+	// Resolve relative links against where redirects ended up
+	return ExtractReadableContent(body, resp.Request.URL.String())
+}
+
+// hostFor returns the per-domain state, creating it on first use
+func (s *ExtractionService) hostFor(hostname string) *extractionHost {
+	hostname = strings.ToLower(hostname)
+
+	s.hostsMutex.Lock()
+	defer s.hostsMutex.Unlock()
+
+	host, ok := s.hosts[hostname]
+	if !ok {
+		perDomain := s.config.ExtractionPerDomainConcurrency
+		if perDomain <= 0 {
+			perDomain = 1
+		}
+		host = &extractionHost{slots: make(chan struct{}, perDomain)}
+		s.hosts[hostname] = host
+	}
+	return host
+}
+
+// checkRobots returns the host's robots.txt rules once they allow fetching the page
+func (s *ExtractionService) checkRobots(ctx context.Context, host *extractionHost, pageURL *url.URL) (*RobotsRules, error) {
+	robots, err := s.robotsFor(ctx, host, pageURL)
+	switch {
+	case err != nil:
+		return nil, err
+	case robots.disallowAll:
+		return nil, errRobotsUnavailable
+	case !robots.Allowed(s.config.ExtractionUserAgent, pageURL.RequestURI()):
+		return nil, errExtractionBlocked
+	}
+	return robots, nil
+}
+
+// checkRedirect applies the page checks to every redirect hop: http(s) only, robots.txt of
+// the new host, and (through the transport) public addresses only
+func (s *ExtractionService) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxExtractionRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxExtractionRedirects)
+	}
+	if (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Host == "" {
+		return fmt.Errorf("redirect to unsupported URL %q", req.URL.Redacted())
+	}
+	if ip := net.ParseIP(req.URL.Hostname()); ip != nil && !isPublicAddress(ip) {
+		return fmt.Errorf("%w: redirect to %s", errExtractionPrivateAddress, ip)
+	}
+
+	// Redirects of a robots.txt fetch aren't checked against robots.txt, which would wait on
+	// the host lock held while it loads
+	if via[0].URL.Path == "/robots.txt" {
+		return nil
+	}
+	_, err := s.checkRobots(req.Context(), s.hostFor(req.URL.Host), req.URL)
+	return err
+}
+
+// robotsFor returns the host's cached robots.txt, fetching it when missing or expired.
+// A missing file (4xx) allows everything; server and network errors block the host
+// until robotsErrorTTL passes, as RFC 9309 requires. Hosts on private addresses return
+// errExtractionPrivateAddress instead.
+func (s *ExtractionService) robotsFor(ctx context.Context, host *extractionHost, pageURL *url.URL) (*RobotsRules, error) {
+	host.mutex.Lock()
+	defer host.mutex.Unlock()
+
+	if host.robots != nil && time.Now().Before(host.robotsExpiresAt) {
+		return host.robots, nil
+	}
+
+	robotsURL := url.URL{Scheme: pageURL.Scheme, Host: pageURL.Host, Path: "/robots.txt"}
+	rules, ttl, err := s.fetchRobots(ctx, robotsURL.String())
+	if err != nil {
+		return nil, err
+	}
+	host.robots = rules
+	host.robotsExpiresAt = time.Now().Add(ttl)
+
+	return rules, nil
+}
+
+func (s *ExtractionService) fetchRobots(ctx context.Context, robotsURL string) (*RobotsRules, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return DisallowAllRobots(), robotsErrorTTL, nil
+	}
+	req.Header.Set("User-Agent", s.config.ExtractionUserAgent)
+
+	resp, err := s.client.Do(req)
+	if errors.Is(err, errExtractionPrivateAddress) {
+		return nil, 0, err
+	}
+	if err != nil {
+		s.logger.Warn("robots.txt unreachable, blocking host", map[string]interface{}{
+			"url":   robotsURL,
+			"error": err.Error(),
+		})
+		return DisallowAllRobots(), robotsErrorTTL, nil
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 500:
+		return DisallowAllRobots(), robotsErrorTTL, nil
+	case resp.StatusCode >= 400:
+		return ParseRobotsTxt(nil), robotsCacheTTL, nil
+	case resp.StatusCode != http.StatusOK:
+		return DisallowAllRobots(), robotsErrorTTL, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRobotsBytes))
+	if err != nil {
+		return DisallowAllRobots(), robotsErrorTTL, nil
+	}
+
+	return ParseRobotsTxt(data), robotsCacheTTL, nil
+}
+
+// waitForCrawlDelay spaces requests to one host by its Crawl-delay
+func (s *ExtractionService) waitForCrawlDelay(ctx context.Context, host *extractionHost, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	if delay > maxCrawlDelay {
+		delay = maxCrawlDelay
+	}
+
+	host.mutex.Lock()
+	now := time.Now()
+	wait := host.nextFetchAt.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	host.nextFetchAt = now.Add(wait + delay)
+	host.mutex.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ===============================
+// ADDRESS POLICY
+// ===============================
+
+// publicOnlyTransport is the default transport limited to public addresses. The check runs
+// on the address actually dialled, after DNS resolution, so hostnames pointing inside the
+// network are refused too. Proxies are not used, as the check would only see the proxy.
+func publicOnlyTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublicOnly,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// dialPublicOnly refuses connections to loopback, private, link-local (including cloud
+// metadata endpoints), unspecified, multicast and other special-purpose addresses
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicAddress(ip) {
+		return fmt.Errorf("%w: %s", errExtractionPrivateAddress, host)
+	}
+	return nil
+}
+
+// isPublicAddress reports whether an IP is a globally routable unicast address
+func isPublicAddress(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"backend/internal/config"
+	"backend/pkg/logger"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:4700::6810:85e5", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.10", false},
+		{"192.168.1.1", false},
+		{"fd00:ec2::254", false}, // AWS IPv6 metadata
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"ff02::1", false},
+		{"100.64.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := isPublicAddress(net.ParseIP(tt.ip)); got != tt.public {
+			t.Errorf("isPublicAddress(%s) = %v, want %v", tt.ip, got, tt.public)
+		}
+	}
+}
+
+func TestExtractionRefusesPrivateAddresses(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer server.Close()
+
+	service := newTestExtractionService()
+	pageURL, _ := url.Parse(server.URL + "/internal/admin")
+	_, err := service.fetchAndExtract(context.Background(), pageURL)
+	if !errors.Is(err, errExtractionPrivateAddress) {
+		t.Fatalf("error = %v, want errExtractionPrivateAddress", err)
+	}
+	if hits != 0 {
+		t.Errorf("server on a loopback address was reached %d times", hits)
+	}
+}
+
+func TestExtractionCheckRedirect(t *testing.T) {
+	service := newTestExtractionService()
+	host := service.hostFor("news.example.in")
+	host.robots = ParseRobotsTxt([]byte("User-agent: *\nDisallow: /private/"))
+	host.robotsExpiresAt = time.Now().Add(time.Hour)
+
+	page, _ := http.NewRequest(http.MethodGet, "https://wire.example.in/story", nil)
+	robots, _ := http.NewRequest(http.MethodGet, "https://news.example.in/robots.txt", nil)
+	tests := []struct {
+		name    string
+		target  string
+		via     []*http.Request
+		wantErr error
+	}{
+		{"allowed page", "https://news.example.in/news/story", []*http.Request{page}, nil},
+		{"disallowed by robots.txt", "https://news.example.in/private/story", []*http.Request{page}, errExtractionBlocked},
+		{"metadata address", "http://169.254.169.254/latest/meta-data/", []*http.Request{page}, errExtractionPrivateAddress},
+		{"loopback address", "http://127.0.0.1:8080/admin", []*http.Request{page}, errExtractionPrivateAddress},
+		{"robots.txt redirects skip robots rules", "https://news.example.in/private/robots", []*http.Request{robots}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			err := service.checkRedirect(req, tt.via)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkRedirect(%s) = %v, want %v", tt.target, err, tt.wantErr)
+			}
+		})
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "file:///etc/passwd", nil)
+	if err := service.checkRedirect(req, []*http.Request{page}); err == nil {
+		t.Error("redirect to a file URL was allowed")
+	}
+	req, _ = http.NewRequest(http.MethodGet, "https://news.example.in/news/story", nil)
+	if err := service.checkRedirect(req, make([]*http.Request, maxExtractionRedirects)); err == nil {
+		t.Error("redirect past the limit was allowed")
+	}
+}
+
+func newTestExtractionService() *ExtractionService {
+	return NewExtractionService(&config.Config{
+		APITransportMode:               "live",
+		ExtractionTimeoutSeconds:       5,
+		ExtractionUserAgent:            "GoNewsBot/1.0",
+		ExtractionMaxBytes:             1 << 20,
+		ExtractionConcurrency:          1,
+		ExtractionPerDomainConcurrency: 1,
+	}, logger.NewLogger(), nil)
+}
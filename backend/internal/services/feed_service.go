@@ -0,0 +1,356 @@
+// internal/services/feed_service.go
+// GoNews - RSS/Atom Feed Service
+// Background polling of publisher feeds and admin management of the feed list
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+)
+
+// ErrInvalidFeed marks feed definitions rejected by validation
+var ErrInvalidFeed = errors.New("invalid feed")
+
+// maxDueFeedsPerTick bounds how many due feeds one poller tick picks up
+const maxDueFeedsPerTick = 100
+
+// FeedService polls publisher feeds on their own intervals and manages the feed list
+type FeedService struct {
+	config   *config.Config
+	logger   *logger.Logger
+	feedRepo *repository.FeedRepository
+	news     *NewsAggregatorService
+	source   *rssSource
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewFeedService creates the feed service and registers the RSS source with the aggregator
+func NewFeedService(cfg *config.Config, log *logger.Logger, feedRepo *repository.FeedRepository, articleRepo *repository.ArticleRepository, news *NewsAggregatorService) *FeedService {
+	source := news.RegisterSource(newRSSSourceFactory(feedRepo, articleRepo)).(*rssSource)
+
+	return &FeedService{
+		config:   cfg,
+		logger:   log,
+		feedRepo: feedRepo,
+		news:     news,
+		source:   source,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Start begins polling due feeds in the background
+func (s *FeedService) Start() {
+	if !s.config.FeedsEnabled {
+		s.logger.Info("Feed polling disabled by configuration")
+		return
+	}
+
+	tick := time.Duration(s.config.FeedPollTickSeconds) * time.Second
+	if tick <= 0 {
+		tick = time.Minute
+	}
+
+	s.wg.Add(1)
+	go s.runPoller(tick)
+
+	s.logger.Info("Feed poller started", map[string]interface{}{
+		"tick":             tick.String(),
+		"default_interval": s.config.FeedDefaultPollMinutes,
+		"max_concurrent":   s.config.FeedMaxConcurrentPolls,
+	})
+}
+
+// runPoller polls whatever is due on every tick
+func (s *FeedService) runPoller(tick time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.pollDueFeeds()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// pollDueFeeds polls every due feed with bounded concurrency and stores the items
+func (s *FeedService) pollDueFeeds() {
+	feeds, err := s.feedRepo.GetDueFeeds(maxDueFeedsPerTick)
+	if err != nil {
+		s.logger.Error("Failed to load due feeds", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if len(feeds) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	concurrency := s.config.FeedMaxConcurrentPolls
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	semaphore := make(chan struct{}, concurrency)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results []models.FeedPollResult
+	)
+
+	for _, feed := range feeds {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(feed *models.NewsFeed) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result := s.pollAndStore(ctx, feed)
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+		}(feed)
+	}
+	wg.Wait()
+
+	stored, failed := 0, 0
+	for _, result := range results {
+		stored += result.Stored
+		if result.Status == models.FeedPollError {
+			failed++
+		}
+	}
+
+	s.logger.Info("Feed poll cycle completed", map[string]interface{}{
+		"feeds":  len(feeds),
+		"failed": failed,
+		"stored": stored,
+	})
+}
+
+// pollAndStore polls one feed and saves its items
+func (s *FeedService) pollAndStore(ctx context.Context, feed *models.NewsFeed) models.FeedPollResult {
+	pollCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	articles, result := s.source.pollFeed(pollCtx, feed)
+	if len(articles) == 0 {
+		return result
+	}
+
+	stored, err := s.news.StoreArticles(s.source.Name(), articles)
+	if err != nil {
+		s.logger.Error("Failed to store feed articles", map[string]interface{}{
+			"feed_id": feed.ID,
+			"error":   err.Error(),
+		})
+		if result.Error == "" {
+			result.Error = err.Error()
+		}
+		return result
+	}
+
+	result.Stored = stored
+	return result
+}
+
+// Close stops the poller
+func (s *FeedService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Feed service stopped")
+	return nil
+}
+
+// ===============================
+// ADMIN FEED MANAGEMENT
+// ===============================
+
+// ListFeeds returns every feed with its health and a summary by health state
+func (s *FeedService) ListFeeds() (*models.FeedListResponse, error) {
+	feeds, err := s.feedRepo.GetFeeds(false)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.FeedListResponse{Feeds: feeds}
+	for _, feed := range feeds {
+		feed.ComputeHealth()
+
+		response.Summary.Total++
+		if feed.IsActive {
+			response.Summary.Active++
+		}
+		switch feed.Health {
+		case models.FeedHealthHealthy:
+			response.Summary.Healthy++
+		case models.FeedHealthDegraded:
+			response.Summary.Degraded++
+		case models.FeedHealthFailing:
+			response.Summary.Failing++
+		case models.FeedHealthPending:
+			response.Summary.Pending++
+		case models.FeedHealthDisabled:
+			response.Summary.Disabled++
+		}
+	}
+
+	return response, nil
+}
+
+// GetFeed returns one feed with its health
+func (s *FeedService) GetFeed(id int) (*models.NewsFeed, error) {
+	feed, err := s.feedRepo.GetFeed(id)
+	if err != nil {
+		return nil, err
+	}
+
+	feed.ComputeHealth()
+	return feed, nil
+}
+
+// CreateFeed validates and adds a feed; it is polled on the next tick
+func (s *FeedService) CreateFeed(req *models.CreateFeedRequest) (*models.NewsFeed, error) {
+	feed := &models.NewsFeed{
+		Name:                strings.TrimSpace(req.Name),
+		URL:                 strings.TrimSpace(req.URL),
+		SiteURL:             req.SiteURL,
+		CategorySlug:        req.CategorySlug,
+		Language:            req.Language,
+		IsIndian:            true,
+		PollIntervalMinutes: req.PollIntervalMinutes,
+		IsActive:            true,
+	}
+	if req.IsIndian != nil {
+		feed.IsIndian = *req.IsIndian
+	}
+
+	if err := s.validateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	if err := s.feedRepo.CreateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Feed created", map[string]interface{}{
+		"feed_id": feed.ID,
+		"name":    feed.Name,
+		"url":     feed.URL,
+	})
+
+	feed.ComputeHealth()
+	return feed, nil
+}
+
+// UpdateFeed applies the non-nil fields of req to a feed
+func (s *FeedService) UpdateFeed(id int, req *models.UpdateFeedRequest) (*models.NewsFeed, error) {
+	feed, err := s.feedRepo.GetFeed(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		feed.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.URL != nil {
+		feed.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.SiteURL != nil {
+		feed.SiteURL = req.SiteURL
+	}
+	if req.CategorySlug != nil {
+		feed.CategorySlug = req.CategorySlug
+	}
+	if req.Language != nil {
+		feed.Language = *req.Language
+	}
+	if req.IsIndian != nil {
+		feed.IsIndian = *req.IsIndian
+	}
+	if req.PollIntervalMinutes != nil {
+		feed.PollIntervalMinutes = *req.PollIntervalMinutes
+	}
+	if req.IsActive != nil {
+		feed.IsActive = *req.IsActive
+	}
+
+	if err := s.validateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	if err := s.feedRepo.UpdateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	feed.ComputeHealth()
+	return feed, nil
+}
+
+// DeleteFeed removes a feed; its articles stay
+func (s *FeedService) DeleteFeed(id int) error {
+	return s.feedRepo.DeleteFeed(id)
+}
+
+// PollNow polls a feed immediately regardless of its schedule
+func (s *FeedService) PollNow(ctx context.Context, id int) (*models.FeedPollResult, error) {
+	feed, err := s.feedRepo.GetFeed(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.pollAndStore(ctx, feed)
+	return &result, nil
+}
+
+// validateFeed normalizes a feed and rejects unusable definitions
+func (s *FeedService) validateFeed(feed *models.NewsFeed) error {
+	if len(feed.Name) < 2 {
+		return fmt.Errorf("%w: name must be at least 2 characters", ErrInvalidFeed)
+	}
+
+	parsed, err := url.Parse(feed.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidFeed)
+	}
+
+	if feed.SiteURL != nil && *feed.SiteURL == "" {
+		feed.SiteURL = nil
+	}
+	if feed.CategorySlug != nil && *feed.CategorySlug == "" {
+		feed.CategorySlug = nil
+	}
+	if feed.Language == "" {
+		feed.Language = "en"
+	}
+
+	feed.PollIntervalMinutes = s.source.pollIntervalMinutes(feed.PollIntervalMinutes)
+	return nil
+}
@@ -7,6 +7,9 @@ package services
 import (
 	"backend/internal/config"
 	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/geo"
+	"backend/pkg/lang"
 	"backend/pkg/logger"
 	"context"
 	"fmt"
@@ -42,6 +45,13 @@ type FilterService struct {
 	indianSources     []string
 	regionalKeywords  map[string][]string
 	marketHourFilters map[string]FilterConfig
+
+	// Shared relevance features (freshness, India relevance, engagement, personalization)
+	ranking *RankingPipeline
+
+	// Article places from geo-tagging, for geographic filters
+	places  *geo.Gazetteer
+	geoRepo *repository.GeoRepository
 }
 
 // FilterServiceStats tracks filtering performance and usage
@@ -280,8 +290,9 @@ type CachedFilterResult struct {
 // CONSTRUCTOR & INITIALIZATION
 // ===============================
 
-// NewFilterService creates a new advanced filter service
-func NewFilterService(cfg *config.Config, log *logger.Logger, db *sqlx.DB, redis *redis.Client) *FilterService {
+// NewFilterService creates a new advanced filter service scoring with the shared ranking
+// pipeline, so admin weights, learning-to-rank rollouts and trends apply to filters too
+func NewFilterService(cfg *config.Config, log *logger.Logger, db *sqlx.DB, redis *redis.Client, ranking *RankingPipeline) *FilterService {
 	service := &FilterService{
 		config:       cfg,
 		logger:       log,
@@ -289,6 +300,9 @@ func NewFilterService(cfg *config.Config, log *logger.Logger, db *sqlx.DB, redis
 		redis:        redis,
 		userProfiles: make(map[string]*UserFilterProfile),
 		filterCache:  make(map[string]*CachedFilterResult),
+		ranking:      ranking,
+		places:       geo.Default(),
+		geoRepo:      repository.NewGeoRepository(db),
 		filterStats: &FilterServiceStats{
 			FilterCombinationsUsed: make(map[string]int64),
 			PopularFilters:         make(map[string]int64),
@@ -875,6 +889,8 @@ func (fs *FilterService) matchesTemporalFilters(article *models.Article, filters
 
 // applyGeographicFilters applies location-based filters
 func (fs *FilterService) applyGeographicFilters(articles []*models.Article, filters *GeographicFilters) []*models.Article {
+	fs.loadArticleLocations(articles)
+
 	var filtered []*models.Article
 
 	for _, article := range articles {
@@ -916,6 +932,51 @@ func (fs *FilterService) matchesGeographicFilters(article *models.Article, filte
 		}
 	}
 
+	// Regional languages match the language detected at ingest
+	if len(filters.RegionalLanguages) > 0 {
+		language := article.Language
+		if language == "" {
+			language = lang.English
+		}
+		found := false
+		for _, code := range lang.NormalizeAll(filters.RegionalLanguages) {
+			if code == language {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// Geo-tagged articles are matched on their places; the rest on their text
+	if len(article.Locations) > 0 {
+		return fs.matchesArticleLocations(article.Locations, filters)
+	}
+	if filters.LocalNewsOnly != nil && *filters.LocalNewsOnly {
+		return false
+	}
+
+	// Regions filter
+	if len(filters.Regions) > 0 {
+		found := false
+		for _, region := range filters.Regions {
+			for _, keyword := range fs.regionalKeywords[strings.ToLower(region)] {
+				if strings.Contains(content, keyword) {
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
 	// Indian states filter
 	if filters.IndianStatesOnly != nil && *filters.IndianStatesOnly {
 		found := false
@@ -981,6 +1042,113 @@ func (fs *FilterService) matchesGeographicFilters(article *models.Article, filte
 	return true
 }
 
+// matchesArticleLocations checks geographic filters against an article's tagged places.
+// Every tagged place is in India, so IndianStatesOnly always passes.
+func (fs *FilterService) matchesArticleLocations(locations []models.ArticleLocation, filters *GeographicFilters) bool {
+	if len(filters.States) > 0 && !fs.locationsWithin(locations, filters.States) {
+		return false
+	}
+	if len(filters.Cities) > 0 && !fs.locationsWithin(locations, filters.Cities) {
+		return false
+	}
+
+	if len(filters.Regions) > 0 {
+		found := false
+		for _, location := range locations {
+			state, ok := fs.places.Lookup(location.StateCode)
+			if !ok {
+				continue
+			}
+			for _, region := range filters.Regions {
+				if strings.EqualFold(region, state.Region) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filters.MetropolitanOnly != nil && *filters.MetropolitanOnly {
+		found := false
+		for _, location := range locations {
+			if place, ok := fs.places.Lookup(location.PlaceCode); ok && (place.Metro || fs.places.State(place).Metro) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filters.LocalNewsOnly != nil && *filters.LocalNewsOnly {
+		found := false
+		for _, location := range locations {
+			if location.Level == geo.LevelCity || location.Level == geo.LevelDistrict {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// locationsWithin reports whether any tagged place lies in one of the named places. Names
+// the gazetteer doesn't know match place names as text.
+func (fs *FilterService) locationsWithin(locations []models.ArticleLocation, names []string) bool {
+	for _, name := range names {
+		place, ok := fs.places.Resolve(name)
+		for _, location := range locations {
+			if !ok {
+				if strings.Contains(strings.ToLower(location.PlaceName), strings.ToLower(name)) {
+					return true
+				}
+				continue
+			}
+			switch place.Level {
+			case geo.LevelState:
+				if location.StateCode == place.Code {
+					return true
+				}
+			case geo.LevelDistrict:
+				if location.DistrictCode != nil && *location.DistrictCode == place.Code {
+					return true
+				}
+			default:
+				if location.CityCode != nil && *location.CityCode == place.Code {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// loadArticleLocations fills Locations for stored articles that don't have them yet
+func (fs *FilterService) loadArticleLocations(articles []*models.Article) {
+	var missing []*models.Article
+	for _, article := range articles {
+		if article.ID != 0 && article.Locations == nil {
+			missing = append(missing, article)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	if err := fs.geoRepo.AttachArticleLocations(missing); err != nil {
+		fs.logger.Warn("Failed to load article locations, matching places by text", map[string]interface{}{
+			"articles": len(missing),
+			"error":    err.Error(),
+		})
+	}
+}
+
 // applyEngagementFilters applies engagement-based filters
 func (fs *FilterService) applyEngagementFilters(articles []*models.Article, filters *EngagementFilters) []*models.Article {
 	var filtered []*models.Article
@@ -1004,9 +1172,9 @@ func (fs *FilterService) matchesEngagementFilters(article *models.Article, filte
 		return false
 	}
 
-	// Trending filter (simplified - based on view count and recency)
+	// Trending filter (reads and mentions bursting above the article's baseline)
 	if filters.TrendingOnly != nil && *filters.TrendingOnly {
-		if !article.IsTrending() {
+		if !fs.ranking.IsTrending(article) {
 			return false
 		}
 	}
@@ -1100,24 +1268,11 @@ func (fs *FilterService) scoreContentFilters(articles []*models.Article, filters
 
 // scoreTemporalFilters scores articles based on temporal filters
 func (fs *FilterService) scoreTemporalFilters(articles []*models.Article, filters *TemporalFilters, scores map[int]float64, weight float64) {
-	now := time.Now()
+	rctx := &RankingContext{Now: time.Now()}
 
 	for _, article := range articles {
-		score := 0.0
-
-		// Recency score (newer articles score higher)
-		hoursSincePublished := now.Sub(article.PublishedAt).Hours()
-		if hoursSincePublished < 1 {
-			score += 1.0 // Very recent
-		} else if hoursSincePublished < 6 {
-			score += 0.8 // Recent
-		} else if hoursSincePublished < 24 {
-			score += 0.6 // Today
-		} else if hoursSincePublished < 168 {
-			score += 0.4 // This week
-		} else {
-			score += 0.2 // Older
-		}
+		// Recency score from the ranking pipeline's freshness decay
+		score := fs.ranking.FeatureValue(models.RankingFeatureFreshness, article, rctx)
 
 		// Time-based preferences
 		if filters.MarketHoursOnly != nil && *filters.MarketHoursOnly && fs.isMarketHours(article.PublishedAt) {
@@ -1133,73 +1288,20 @@ func (fs *FilterService) scoreTemporalFilters(articles []*models.Article, filter
 
 // scoreGeographicFilters scores articles based on geographic filters
 func (fs *FilterService) scoreGeographicFilters(articles []*models.Article, filters *GeographicFilters, scores map[int]float64, weight float64) {
-	for _, article := range articles {
-		score := 0.0
-
-		content := strings.ToLower(article.Title + " " + article.Source)
-		if article.Description != nil {
-			content += " " + strings.ToLower(*article.Description)
-		}
-
-		// Indian content preference
-		if article.IsIndianContent {
-			score += 0.4
-		}
-
-		// Regional relevance
-		for _, keywords := range fs.regionalKeywords {
-			matchCount := 0
-			for _, keyword := range keywords {
-				if strings.Contains(content, keyword) {
-					matchCount++
-				}
-			}
-			if matchCount > 0 {
-				score += 0.3 * float64(matchCount) / float64(len(keywords))
-			}
-		}
-
-		// Metropolitan cities bonus
-		metropolitanCities := []string{"mumbai", "delhi", "bangalore", "chennai", "kolkata", "hyderabad"}
-		for _, city := range metropolitanCities {
-			if strings.Contains(content, city) {
-				score += 0.2
-				break
-			}
-		}
+	rctx := &RankingContext{Now: time.Now()}
 
+	for _, article := range articles {
+		score := fs.ranking.FeatureValue(models.RankingFeatureIndiaRelevance, article, rctx)
 		scores[article.ID] += score * weight
 	}
 }
 
 // scoreEngagementFilters scores articles based on engagement filters
 func (fs *FilterService) scoreEngagementFilters(articles []*models.Article, filters *EngagementFilters, scores map[int]float64, weight float64) {
-	// Find max view count for normalization
-	maxViews := 0
-	for _, article := range articles {
-		if article.ViewCount > maxViews {
-			maxViews = article.ViewCount
-		}
-	}
+	rctx := &RankingContext{Now: time.Now()}
 
 	for _, article := range articles {
-		score := 0.0
-
-		// View count score (normalized)
-		if maxViews > 0 {
-			score += 0.4 * float64(article.ViewCount) / float64(maxViews)
-		}
-
-		// Featured content bonus
-		if article.IsFeatured {
-			score += 0.3
-		}
-
-		// Trending bonus
-		if article.IsTrending() {
-			score += 0.3
-		}
-
+		score := fs.ranking.FeatureValue(models.RankingFeatureEngagement, article, rctx)
 		scores[article.ID] += score * weight
 	}
 }
@@ -1218,139 +1320,7 @@ func (fs *FilterService) scorePersonalizedFilters(articles []*models.Article, pr
 
 // calculatePersonalizationScore calculates personalization score for an article
 func (fs *FilterService) calculatePersonalizationScore(article *models.Article, profile *UserFilterProfile, level PersonalizationLevel) float64 {
-	score := 0.0
-
-	switch level {
-	case PersonalizationBasic:
-		score = fs.calculateBasicPersonalizationScore(article, profile)
-	case PersonalizationAdvanced:
-		score = fs.calculateAdvancedPersonalizationScore(article, profile)
-	case PersonalizationAI:
-		score = fs.calculateAIPersonalizationScore(article, profile)
-	default:
-		return 0.0
-	}
-
-	return score
-}
-
-// calculateBasicPersonalizationScore calculates basic personalization score
-func (fs *FilterService) calculateBasicPersonalizationScore(article *models.Article, profile *UserFilterProfile) float64 {
-	score := 0.0
-
-	// Category preferences
-	if article.CategoryID != nil {
-		for _, categoryID := range profile.PreferredCategories {
-			if *article.CategoryID == categoryID {
-				score += 0.4
-				break
-			}
-		}
-	}
-
-	// Source preferences
-	for _, source := range profile.PreferredSources {
-		if strings.EqualFold(article.Source, source) {
-			score += 0.3
-			break
-		}
-	}
-
-	// Blocked sources penalty
-	for _, source := range profile.BlockedSources {
-		if strings.EqualFold(article.Source, source) {
-			return 0.0 // Completely filter out
-		}
-	}
-
-	// Content preferences
-	if profile.ContentPreferences.IndianContentRatio > 0.5 && article.IsIndianContent {
-		score += 0.3
-	}
-
-	return score
-}
-
-// calculateAdvancedPersonalizationScore calculates advanced personalization score
-func (fs *FilterService) calculateAdvancedPersonalizationScore(article *models.Article, profile *UserFilterProfile) float64 {
-	score := fs.calculateBasicPersonalizationScore(article, profile)
-
-	// Reading history patterns
-	if article.CategoryID != nil {
-		for _, pattern := range profile.ReadingHistory {
-			if pattern.CategoryID == *article.CategoryID {
-				// Boost based on engagement score
-				score += 0.2 * pattern.EngagementScore
-				break
-			}
-		}
-	}
-
-	// Bookmark patterns
-	if article.CategoryID != nil {
-		for _, pattern := range profile.BookmarkPatterns {
-			if pattern.CategoryID == *article.CategoryID {
-				score += 0.15 * pattern.PreferenceScore
-				break
-			}
-		}
-	}
-
-	// Time-based preferences
-	articleHour := article.PublishedAt.Hour()
-	for _, timeSlot := range profile.TimeBasedPreferences.PreferredTimeSlots {
-		if articleHour >= timeSlot.StartHour && articleHour <= timeSlot.EndHour {
-			priorityBonus := 0.1 * float64(timeSlot.Priority) / 10.0
-			score += priorityBonus
-			break
-		}
-	}
-
-	// Content quality alignment
-	readingTimeMatch := 1.0
-	if profile.ContentPreferences.PreferredReadingTime > 0 {
-		diff := float64(abs(article.ReadingTimeMinutes - profile.ContentPreferences.PreferredReadingTime))
-		readingTimeMatch = 1.0 / (1.0 + diff/5.0) // Decay function
-	}
-	score += 0.1 * readingTimeMatch
-
-	return score
-}
-
-// calculateAIPersonalizationScore calculates AI-driven personalization score
-func (fs *FilterService) calculateAIPersonalizationScore(article *models.Article, profile *UserFilterProfile) float64 {
-	score := fs.calculateAdvancedPersonalizationScore(article, profile)
-
-	// Advanced pattern recognition (simplified AI-like scoring)
-
-	// Semantic similarity (simplified - based on tags and keywords)
-	semanticScore := 0.0
-	for _, pattern := range profile.BookmarkPatterns {
-		for _, keyword := range pattern.Keywords {
-			content := strings.ToLower(article.Title)
-			if article.Description != nil {
-				content += " " + strings.ToLower(*article.Description)
-			}
-
-			if strings.Contains(content, strings.ToLower(keyword)) {
-				semanticScore += 0.05
-			}
-		}
-	}
-	score += semanticScore
-
-	// Collaborative filtering (simplified - based on similar user patterns)
-	// In a real implementation, this would use machine learning
-	if profile.PersonalizationScore > 0.7 {
-		score += 0.1 // Boost for highly engaged users
-	}
-
-	// Trend alignment
-	if article.IsTrending() && profile.ContentPreferences.IndianContentRatio > 0.6 {
-		score += 0.15
-	}
-
-	return score
+	return fs.ranking.PersonalizationScore(article, profile, level)
 }
 
 // ===============================
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/geo"
+	"backend/pkg/logger"
+)
+
+func TestFilterArticlesByPlace(t *testing.T) {
+	cfg := &config.Config{}
+	log := logger.NewLogger()
+	filters := NewFilterService(cfg, log, nil, nil, NewRankingPipeline(cfg, log))
+
+	articles := []*models.Article{
+		taggedArticle(t, 1, "Pune metro line opens to commuters", "pune"),
+		taggedArticle(t, 2, "Mumbai local trains delayed by rain", "mumbai"),
+		taggedArticle(t, 3, "Bengaluru traffic police roll out new fines", "bengaluru"),
+		taggedArticle(t, 4, "Kochi water metro adds routes", "kochi"),
+		taggedArticle(t, 5, "Maharashtra cabinet approves drought relief", "MH"),
+		// Untagged articles fall back to their text
+		{ID: 6, Title: "Maharashtra power tariffs to rise", Locations: []models.ArticleLocation{}},
+		{ID: 7, Title: "Markets close higher", Locations: []models.ArticleLocation{}},
+	}
+	yes := true
+
+	tests := []struct {
+		name    string
+		filters GeographicFilters
+		want    []int
+	}{
+		{"state by name", GeographicFilters{States: []string{"Maharashtra"}}, []int{1, 2, 5, 6}},
+		{"state by code", GeographicFilters{States: []string{"KA"}}, []int{3}},
+		{"city", GeographicFilters{Cities: []string{"Pune"}}, []int{1}},
+		{"several cities", GeographicFilters{Cities: []string{"pune", "Kochi"}}, []int{1, 4}},
+		{"state and city", GeographicFilters{States: []string{"Kerala"}, Cities: []string{"Pune"}}, nil},
+		{"metropolitan", GeographicFilters{MetropolitanOnly: &yes}, []int{1, 2, 3}},
+		// A state-wide story and untagged articles aren't local news
+		{"local", GeographicFilters{States: []string{"MH"}, LocalNewsOnly: &yes}, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			geoFilters := tt.filters
+			response, err := filters.FilterArticles(context.Background(), &FilterRequest{
+				Articles:          articles,
+				FilterTypes:       []FilterType{FilterTypeGeographic},
+				FilterCombination: FilterCombinationAND,
+				GeographicFilters: &geoFilters,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []int
+			for _, article := range response.FilteredArticles {
+				got = append(got, article.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("articles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// taggedArticle returns an article tagged with one place from the bundled gazetteer
+func taggedArticle(t *testing.T, id int, title, place string) *models.Article {
+	t.Helper()
+	geoService := &GeoService{gazetteer: geo.Default()}
+	resolved, ok := geoService.gazetteer.Resolve(place)
+	if !ok {
+		t.Fatalf("gazetteer has no place %q", place)
+	}
+	location := geoService.articleLocation(resolved)
+	location.ArticleID = id
+	location.IsPrimary = true
+	return &models.Article{ID: id, Title: title, Locations: []models.ArticleLocation{location}}
+}
+
+// burstingArticles reports the listed articles as bursting
+type burstingArticles map[int]bool
+
+func (b burstingArticles) ArticleTrend(articleID int) (*models.Trend, bool) {
+	if !b[articleID] {
+		return nil, false
+	}
+	return &models.Trend{Type: models.TrendSubjectArticle, Bursting: true}, true
+}
+
+func TestFilterTrendingOnlyUsesSharedPipelineTrends(t *testing.T) {
+	cfg := &config.Config{}
+	log := logger.NewLogger()
+	ranking := NewRankingPipeline(cfg, log)
+	filters := NewFilterService(cfg, log, nil, nil, ranking)
+
+	// Trends arrive on the shared pipeline after the filter service is built, as in the server
+	ranking.SetTrends(burstingArticles{2: true})
+
+	articles := []*models.Article{
+		{ID: 1, Title: "Quiet story"},
+		{ID: 2, Title: "Bursting story"},
+		{ID: 3, Title: "Story carrying its trend", Trend: &models.Trend{Bursting: true}},
+	}
+	yes := true
+	response, err := filters.FilterArticles(context.Background(), &FilterRequest{
+		Articles:          articles,
+		FilterTypes:       []FilterType{FilterTypeEngagement},
+		FilterCombination: FilterCombinationAND,
+		EngagementFilters: &EngagementFilters{TrendingOnly: &yes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for _, article := range response.FilteredArticles {
+		got = append(got, article.ID)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("trending articles = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,340 @@
+// internal/services/geo_service.go
+// GoNews - Geo-Tagging Service
+// Resolves the places an article mentions to Indian state, district and city codes, and
+// serves "news near me" for a reader's location
+
+package services
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/geo"
+	"backend/pkg/logger"
+)
+
+const (
+	// geoMaxLocations caps the places stored per article
+	geoMaxLocations = 5
+
+	// geoProximityBoostHours is how much fresher each level of closeness (district, then city)
+	// makes an article look in "news near me"
+	geoProximityBoostHours = 12.0
+
+	// Recency window for "news near me"
+	geoNearbyDefaultDays = 3
+	geoNearbyMaxDays     = 14
+)
+
+var (
+	// ErrUnknownPlace marks a state, district or city the gazetteer doesn't know
+	ErrUnknownPlace = errors.New("unknown place")
+
+	// ErrLocationRequired is returned for "news near me" without a place or profile location
+	ErrLocationRequired = errors.New("location required")
+)
+
+// GeoService tags articles with the places they are about and finds local news
+type GeoService struct {
+	logger      *logger.Logger
+	gazetteer   *geo.Gazetteer
+	entities    *EntityExtractor
+	repo        *repository.GeoRepository
+	articleRepo *repository.ArticleRepository
+	userRepo    *repository.UserRepository
+}
+
+// NewGeoService creates the geo-tagging service
+func NewGeoService(log *logger.Logger, extractor *EntityExtractor, repo *repository.GeoRepository, articleRepo *repository.ArticleRepository, userRepo *repository.UserRepository) *GeoService {
+	return &GeoService{
+		logger:      log,
+		gazetteer:   geo.Default(),
+		entities:    extractor,
+		repo:        repo,
+		articleRepo: articleRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// ===============================
+// TAGGING
+// ===============================
+
+// geoTag is a place found for an article while tagging
+type geoTag struct {
+	place      *geo.Place
+	inHeadline bool
+	order      int
+}
+
+// Tag sets the article's Locations from its location entities, which Annotate has already
+// filled with canonical IDs (and GDELT place names it couldn't resolve). Places containing
+// another tagged place are dropped, so "Pune, Maharashtra" is stored as Pune alone; the state
+// is still on the row. Places named in the headline come first and the first is primary.
+func (s *GeoService) Tag(article *models.Article) {
+	headline := make(map[string]bool)
+	for _, id := range s.entities.Extract(article.Title, "").Locations {
+		headline[id] = true
+	}
+
+	tags := make(map[string]*geoTag)
+	for _, name := range article.GDELTLocations {
+		place, ok := s.resolveTagged(name)
+		if !ok {
+			continue
+		}
+		if tag, ok := tags[place.Code]; ok {
+			tag.inHeadline = tag.inHeadline || headline[name]
+			continue
+		}
+		tags[place.Code] = &geoTag{place: place, inHeadline: headline[name], order: len(tags)}
+	}
+
+	var kept []*geoTag
+	for _, tag := range tags {
+		broader := false
+		for _, other := range tags {
+			if other != tag && s.gazetteer.Contains(tag.place, other.place) {
+				broader = true
+				// The headline naming a state still puts its city first
+				other.inHeadline = other.inHeadline || tag.inHeadline
+				break
+			}
+		}
+		if !broader {
+			kept = append(kept, tag)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool {
+		if kept[i].inHeadline != kept[j].inHeadline {
+			return kept[i].inHeadline
+		}
+		return kept[i].order < kept[j].order
+	})
+	if len(kept) > geoMaxLocations {
+		kept = kept[:geoMaxLocations]
+	}
+
+	article.Locations = make([]models.ArticleLocation, 0, len(kept))
+	for i, tag := range kept {
+		location := s.articleLocation(tag.place)
+		location.InHeadline = tag.inHeadline
+		location.IsPrimary = i == 0
+		article.Locations = append(article.Locations, location)
+	}
+}
+
+// PreviewTags returns the places a headline and description would be tagged with
+func (s *GeoService) PreviewTags(title, description string) []models.ArticleLocation {
+	article := &models.Article{Title: title, Description: &description}
+	s.entities.Annotate(article)
+	s.Tag(article)
+	return article.Locations
+}
+
+// resolveTagged maps an article location to a place. Canonical IDs resolve directly or
+// through the places containing them (an admin-added neighbourhood resolves to its city);
+// GDELT names resolve by name unless they are outside India.
+func (s *GeoService) resolveTagged(name string) (*geo.Place, bool) {
+	if place, ok := s.gazetteer.Lookup(name); ok {
+		return place, true
+	}
+	for _, ancestor := range s.entities.LocationAncestors(name) {
+		if place, ok := s.gazetteer.Lookup(ancestor); ok {
+			return place, true
+		}
+	}
+
+	// GDELT writes places as "Lahore, Punjab, Pakistan"
+	parts := strings.Split(name, ",")
+	if len(parts) > 1 && !strings.EqualFold(strings.TrimSpace(parts[len(parts)-1]), "india") {
+		return nil, false
+	}
+	return s.gazetteer.Resolve(name)
+}
+
+// articleLocation fills the codes of a place and the places containing it
+func (s *GeoService) articleLocation(place *geo.Place) models.ArticleLocation {
+	location := models.ArticleLocation{
+		PlaceCode: place.Code,
+		PlaceName: place.Name,
+		Level:     place.Level,
+		StateCode: place.StateCode,
+	}
+	if district := s.gazetteer.District(place); district != nil {
+		location.DistrictCode = stringPtr(district.Code)
+	}
+	if place.Level == geo.LevelCity {
+		location.CityCode = stringPtr(place.Code)
+	}
+	return location
+}
+
+// ===============================
+// PLACES
+// ===============================
+
+// ResolvePlace returns the place a name, code or ID refers to. Names may list several places
+// ("Whitefield, Bangalore, Karnataka"); the first one known is used, and names the geo
+// gazetteer lacks are tried as entity aliases.
+func (s *GeoService) ResolvePlace(name string) (*geo.Place, bool) {
+	for _, part := range strings.Split(name, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if place, ok := s.gazetteer.Resolve(part); ok {
+			return place, true
+		}
+		if id, ok := s.entities.ResolveLocation(part); ok {
+			if place, ok := s.resolveTagged(id); ok {
+				return place, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// ListPlaces returns every state and union territory, or the districts and cities of one
+func (s *GeoService) ListPlaces(stateCode string) ([]models.GeoPlace, error) {
+	var places []*geo.Place
+	if stateCode == "" {
+		places = s.gazetteer.Places(geo.LevelState, "")
+	} else {
+		state, ok := s.gazetteer.Lookup(stateCode)
+		if !ok || state.Level != geo.LevelState {
+			return nil, ErrUnknownPlace
+		}
+		places = append(s.gazetteer.Places(geo.LevelDistrict, state.Code), s.gazetteer.Places(geo.LevelCity, state.Code)...)
+	}
+
+	result := make([]models.GeoPlace, 0, len(places))
+	for _, place := range places {
+		result = append(result, s.GeoPlace(place))
+	}
+	return result, nil
+}
+
+// GeoPlace describes a place with the names of the places containing it
+func (s *GeoService) GeoPlace(place *geo.Place) models.GeoPlace {
+	described := models.GeoPlace{
+		Code:      place.Code,
+		Name:      place.Name,
+		Level:     place.Level,
+		StateCode: place.StateCode,
+		Region:    place.Region,
+		Metro:     place.Metro,
+	}
+	if state := s.gazetteer.State(place); state != nil {
+		described.StateName = state.Name
+		described.Metro = described.Metro || state.Metro
+	}
+	if district := s.gazetteer.District(place); district != nil {
+		described.DistrictCode = district.Code
+		described.DistrictName = district.Name
+	}
+	return described
+}
+
+// ===============================
+// NEWS NEAR ME
+// ===============================
+
+// NearbyNews returns recent articles about the queried place, or the user's profile location,
+// and the places around it. Articles from the same city rank ahead of the district's, and
+// those ahead of the rest of the state, unless they are much older.
+func (s *GeoService) NearbyNews(userID *uuid.UUID, query models.NearbyNewsQuery) (*models.NearbyNewsResponse, error) {
+	place, source, err := s.nearbyPlace(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	days := query.Days
+	if days <= 0 {
+		days = geoNearbyDefaultDays
+	}
+	if days > geoNearbyMaxDays {
+		days = geoNearbyMaxDays
+	}
+
+	filter := models.NearbyArticleFilter{
+		StateCode:  place.StateCode,
+		Since:      time.Now().AddDate(0, 0, -days),
+		BoostHours: geoProximityBoostHours,
+		Limit:      query.Limit + 1, // One extra to tell whether there are more
+		Offset:     (query.Page - 1) * query.Limit,
+	}
+	if district := s.gazetteer.District(place); district != nil {
+		filter.DistrictCode = stringPtr(district.Code)
+	}
+	if place.Level == geo.LevelCity {
+		filter.CityCode = stringPtr(place.Code)
+	}
+
+	ids, err := s.repo.GetNearbyArticleIDs(filter)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(ids) > query.Limit
+	if hasMore {
+		ids = ids[:query.Limit]
+	}
+
+	articles, err := s.articleRepo.GetArticlesByIDsInOrder(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AttachArticleLocations(articles); err != nil {
+		return nil, err
+	}
+
+	return &models.NearbyNewsResponse{
+		Location: s.GeoPlace(place),
+		Source:   source,
+		Articles: articles,
+		Page:     query.Page,
+		Limit:    query.Limit,
+		HasMore:  hasMore,
+	}, nil
+}
+
+// nearbyPlace picks the most specific place in the query, falling back to the user's profile
+// location
+func (s *GeoService) nearbyPlace(userID *uuid.UUID, query models.NearbyNewsQuery) (*geo.Place, string, error) {
+	for _, name := range []string{query.City, query.District, query.State} {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		place, ok := s.ResolvePlace(name)
+		if !ok {
+			return nil, "", ErrUnknownPlace
+		}
+		return place, models.NearbySourceQuery, nil
+	}
+
+	if userID == nil {
+		return nil, "", ErrLocationRequired
+	}
+	user, err := s.userRepo.GetUserByID(*userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if user.Location == nil || strings.TrimSpace(*user.Location) == "" {
+		return nil, "", ErrLocationRequired
+	}
+	place, ok := s.ResolvePlace(*user.Location)
+	if !ok {
+		s.logger.Warn("Profile location not in geo gazetteer", map[string]interface{}{
+			"user_id":  userID.String(),
+			"location": *user.Location,
+		})
+		return nil, "", ErrLocationRequired
+	}
+	return place, models.NearbySourceProfile, nil
+}
@@ -0,0 +1,1145 @@
+// internal/services/ingestion_pipeline.go
+// GoNews - Staged Ingestion Pipeline
+// fetch → normalize → enrich → classify → dedupe → persist, joined by bounded channels so a slow
+// database write holds back the fetchers instead of buffering a whole cycle in memory. Records
+// that fail conversion, validation or persistence are dead-lettered with their raw payload.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/lang"
+	"backend/pkg/logger"
+	"backend/pkg/rawarchive"
+)
+
+// errIngestionDuplicate marks an item dropped by the dedupe stage
+var errIngestionDuplicate = errors.New("duplicate of an item earlier in the run")
+
+// maxIngestionFutureSkew tolerates provider clocks and time zone mistakes in published dates
+const maxIngestionFutureSkew = 24 * time.Hour
+
+// ingestionFetch is one provider request in a pipeline run
+type ingestionFetch struct {
+	source string
+	query  SourceQuery
+}
+
+// ingestionItem is one record moving through the stages
+type ingestionItem struct {
+	source  string
+	query   SourceQuery
+	kind    string          // models.DeadLetterPayloadRaw or models.DeadLetterPayloadArticle
+	payload json.RawMessage // Kept for dead-lettering; raw items are converted from it
+	article *models.Article // Set by normalize (or already set for article payloads)
+
+	mergeInto    *ingestionItem         // Set by dedupe when this item duplicates an earlier one from another record
+	mergeStored  string                 // External ID of a stored article this item duplicates
+	dedupPair    *DuplicatePair         // Why dedupe matched it to mergeStored
+	review       *models.CategoryReview // Set by classify when the category is left for an editor
+	deadLetterID int                    // Non-zero when the item is a dead letter being replayed
+}
+
+// ingestionRunOptions control side effects of a run
+type ingestionRunOptions struct {
+	persist    bool // Save accepted articles; off for dry runs
+	deadLetter bool // Store rejections in ingestion_dead_letters
+}
+
+// ingestionRejection is an item that left the pipeline early
+type ingestionRejection struct {
+	item  *ingestionItem
+	stage string
+	err   error
+}
+
+// ingestionRun collects the outcome of one Run
+type ingestionRun struct {
+	stats models.IngestionRunStats
+
+	accepted    []*ingestionItem // Passed dedupe, in arrival order
+	persisted   map[*ingestionItem]bool
+	rejections  []ingestionRejection
+	fetchErrors []error
+	dedupLogs   []*models.DeduplicationLog
+
+	categoryOnce sync.Once
+	slugToID     map[string]int
+
+	mutex sync.Mutex
+}
+
+// Articles returns every article that passed dedupe
+func (r *ingestionRun) Articles() []*models.Article {
+	articles := make([]*models.Article, 0, len(r.accepted))
+	for _, item := range r.accepted {
+		articles = append(articles, item.article)
+	}
+	return articles
+}
+
+// ArticlesForCategory returns the accepted articles fetched for one requested category
+func (r *ingestionRun) ArticlesForCategory(category string) []*models.Article {
+	var articles []*models.Article
+	for _, item := range r.accepted {
+		if item.query.Category == category {
+			articles = append(articles, item.article)
+		}
+	}
+	return articles
+}
+
+func (r *ingestionRun) reject(item *ingestionItem, stage string, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if errors.Is(err, errIngestionDuplicate) {
+		r.stats.Duplicates++
+		return
+	}
+	r.rejections = append(r.rejections, ingestionRejection{item: item, stage: stage, err: err})
+	r.stats.DeadLettered++
+}
+
+// stageMetrics accumulates counters and latencies for one stage across runs
+type stageMetrics struct {
+	in, out, dropped, deadLettered, errors int64
+
+	latencyCount int64
+	latencyTotal time.Duration
+	latencyMax   time.Duration
+	latencyLast  time.Duration
+
+	mutex sync.Mutex
+}
+
+func (m *stageMetrics) record(in, out, dropped, deadLettered, errs int64, latency time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.in += in
+	m.out += out
+	m.dropped += dropped
+	m.deadLettered += deadLettered
+	m.errors += errs
+
+	m.latencyCount++
+	m.latencyTotal += latency
+	m.latencyLast = latency
+	if latency > m.latencyMax {
+		m.latencyMax = latency
+	}
+}
+
+func (m *stageMetrics) snapshot(stage string) models.IngestionStageMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	snapshot := models.IngestionStageMetrics{
+		Stage:         stage,
+		In:            m.in,
+		Out:           m.out,
+		Dropped:       m.dropped,
+		DeadLettered:  m.deadLettered,
+		Errors:        m.errors,
+		MaxLatencyMs:  roundScore(float64(m.latencyMax) / float64(time.Millisecond)),
+		LastLatencyMs: roundScore(float64(m.latencyLast) / float64(time.Millisecond)),
+	}
+	if m.latencyCount > 0 {
+		snapshot.AvgLatencyMs = roundScore(float64(m.latencyTotal) / float64(m.latencyCount) / float64(time.Millisecond))
+	}
+	return snapshot
+}
+
+// ===============================
+// PIPELINE
+// ===============================
+
+// IngestionPipeline runs provider records through the ingestion stages
+type IngestionPipeline struct {
+	news        *NewsAggregatorService
+	sources     *SourceRegistry
+	deadLetters *repository.IngestionRepository
+	archive     rawarchive.Store // Optional; raw responses are archived when set
+	logger      *logger.Logger
+
+	bufferSize   int
+	workers      int
+	persistBatch int
+
+	metrics map[string]*stageMetrics
+
+	runs      int64
+	lastRun   *models.IngestionRunStats
+	lastRunAt time.Time
+	runMutex  sync.Mutex
+}
+
+// NewIngestionPipeline creates the pipeline used by the aggregator service
+func NewIngestionPipeline(news *NewsAggregatorService, deadLetters *repository.IngestionRepository, archive rawarchive.Store) *IngestionPipeline {
+	cfg := news.cfg
+
+	pipeline := &IngestionPipeline{
+		news:         news,
+		deadLetters:  deadLetters,
+		archive:      archive,
+		logger:       news.logger,
+		bufferSize:   maxInt(1, cfg.IngestionStageBuffer),
+		workers:      maxInt(1, cfg.IngestionStageWorkers),
+		persistBatch: maxInt(1, cfg.IngestionPersistBatch),
+		metrics:      make(map[string]*stageMetrics),
+	}
+	if news.apiClient != nil {
+		pipeline.sources = news.apiClient.Sources()
+	}
+	for _, stage := range models.IngestionStages {
+		pipeline.metrics[stage] = &stageMetrics{}
+	}
+
+	return pipeline
+}
+
+// Run fetches the planned requests plus any seed items (feed articles, replayed dead letters)
+// and returns once every item has been persisted or rejected
+func (p *IngestionPipeline) Run(ctx context.Context, fetches []ingestionFetch, seeds []*ingestionItem) *ingestionRun {
+	return p.run(ctx, fetches, seeds, ingestionRunOptions{persist: true, deadLetter: true})
+}
+
+func (p *IngestionPipeline) run(ctx context.Context, fetches []ingestionFetch, seeds []*ingestionItem, options ingestionRunOptions) *ingestionRun {
+	startTime := time.Now()
+	run := &ingestionRun{persisted: make(map[*ingestionItem]bool)}
+
+	fetched := p.fetchStage(ctx, run, fetches, seeds)
+	normalized := p.runStage(run, models.IngestionStageNormalize, p.workers, fetched, p.normalize)
+	enriched := p.runStage(run, models.IngestionStageEnrich, p.workers, normalized, p.enrich)
+	classified := p.runStage(run, models.IngestionStageClassify, p.workers, enriched, func(item *ingestionItem) error {
+		return p.classify(run, item)
+	})
+	unique := p.runStage(run, models.IngestionStageDedupe, 1, classified, p.dedupe(run, options))
+	p.persistStage(run, unique, options.persist)
+
+	if options.deadLetter {
+		p.flushDeadLetters(run)
+	}
+	if options.persist {
+		p.flushDeduplicationLogs(run)
+	}
+
+	run.stats.Duration = time.Since(startTime)
+
+	p.runMutex.Lock()
+	p.runs++
+	stats := run.stats
+	p.lastRun = &stats
+	p.lastRunAt = time.Now()
+	p.runMutex.Unlock()
+
+	p.logger.Info("Ingestion run completed", map[string]interface{}{
+		"fetches":       len(fetches),
+		"seeds":         len(seeds),
+		"fetched":       run.stats.Fetched,
+		"accepted":      run.stats.Accepted,
+		"duplicates":    run.stats.Duplicates,
+		"merged":        run.stats.Merged,
+		"persisted":     run.stats.Persisted,
+		"dead_lettered": run.stats.DeadLettered,
+		"fetch_errors":  run.stats.FetchErrors,
+		"duration":      run.stats.Duration,
+		"dry_run":       !options.persist,
+	})
+
+	return run
+}
+
+// fetchStage emits the seed items, then runs every planned request concurrently
+func (p *IngestionPipeline) fetchStage(ctx context.Context, run *ingestionRun, fetches []ingestionFetch, seeds []*ingestionItem) <-chan *ingestionItem {
+	out := make(chan *ingestionItem, p.bufferSize)
+	metrics := p.metrics[models.IngestionStageFetch]
+
+	go func() {
+		defer close(out)
+
+		for _, item := range seeds {
+			metrics.record(1, 1, 0, 0, 0, 0)
+			out <- item
+		}
+
+		var wg sync.WaitGroup
+		for _, fetch := range fetches {
+			wg.Add(1)
+			go func(fetch ingestionFetch) {
+				defer wg.Done()
+
+				startTime := time.Now()
+				items, err := p.fetch(ctx, fetch)
+				if err != nil {
+					metrics.record(1, 0, 0, 0, 1, time.Since(startTime))
+					p.logger.Error("News source fetch failed", map[string]interface{}{
+						"source":   fetch.source,
+						"category": fetch.query.Category,
+						"country":  fetch.query.Country,
+						"error":    err.Error(),
+					})
+					run.mutex.Lock()
+					run.fetchErrors = append(run.fetchErrors, err)
+					run.stats.FetchErrors++
+					run.mutex.Unlock()
+					return
+				}
+				metrics.record(1, int64(len(items)), 0, 0, 0, time.Since(startTime))
+				p.news.recordRequest(fetch.source)
+
+				run.mutex.Lock()
+				run.stats.Fetched += len(items)
+				run.mutex.Unlock()
+
+				for _, item := range items {
+					out <- item
+				}
+			}(fetch)
+		}
+		wg.Wait()
+	}()
+
+	return out
+}
+
+// fetch performs one request, keeping raw records when the source exposes them
+func (p *IngestionPipeline) fetch(ctx context.Context, fetch ingestionFetch) ([]*ingestionItem, error) {
+	source, err := p.source(fetch.source)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, raw := source.(RawNewsSource); raw {
+		records, err := p.sources.FetchRaw(ctx, fetch.source, fetch.query)
+		if err != nil {
+			return nil, err
+		}
+		p.archiveResponse(fetch, records)
+		items := make([]*ingestionItem, 0, len(records))
+		for _, record := range records {
+			items = append(items, &ingestionItem{
+				source:  fetch.source,
+				query:   fetch.query,
+				kind:    models.DeadLetterPayloadRaw,
+				payload: record,
+			})
+		}
+		return items, nil
+	}
+
+	articles, err := p.sources.Fetch(ctx, fetch.source, fetch.query)
+	if err != nil {
+		return nil, err
+	}
+	return articleItems(fetch.source, fetch.query, articles), nil
+}
+
+// source looks up a registered source by name
+func (p *IngestionPipeline) source(name string) (NewsSource, error) {
+	if p.sources == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSourceNotRegistered, name)
+	}
+	source, ok := p.sources.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSourceNotRegistered, name)
+	}
+	return source, nil
+}
+
+// archiveResponse keeps the raw records so they can be re-ingested after converter fixes
+func (p *IngestionPipeline) archiveResponse(fetch ingestionFetch, records []json.RawMessage) {
+	if p.archive == nil || len(records) == 0 {
+		return
+	}
+
+	query, err := json.Marshal(fetch.query)
+	if err == nil {
+		err = p.archive.Append(rawarchive.Entry{
+			Source:    fetch.source,
+			FetchedAt: time.Now(),
+			Query:     query,
+			Records:   records,
+		})
+	}
+	if err != nil {
+		p.logger.Warn("Failed to archive raw provider response", map[string]interface{}{
+			"source": fetch.source,
+			"error":  err.Error(),
+		})
+	}
+}
+
+// articleItems wraps already-converted articles so they can enter the pipeline
+func articleItems(source string, query SourceQuery, articles []*models.Article) []*ingestionItem {
+	items := make([]*ingestionItem, 0, len(articles))
+	for _, article := range articles {
+		items = append(items, &ingestionItem{
+			source:  source,
+			query:   query,
+			kind:    models.DeadLetterPayloadArticle,
+			article: article,
+		})
+	}
+	return items
+}
+
+// runStage applies fn to every item with the given number of workers. Items fn rejects are
+// recorded on the run and not forwarded.
+func (p *IngestionPipeline) runStage(run *ingestionRun, stage string, workers int, in <-chan *ingestionItem, fn func(*ingestionItem) error) <-chan *ingestionItem {
+	out := make(chan *ingestionItem, p.bufferSize)
+	metrics := p.metrics[stage]
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range in {
+				startTime := time.Now()
+				err := fn(item)
+				latency := time.Since(startTime)
+
+				switch {
+				case err == nil:
+					metrics.record(1, 1, 0, 0, 0, latency)
+					out <- item
+				case errors.Is(err, errIngestionDuplicate):
+					metrics.record(1, 0, 1, 0, 0, latency)
+					run.reject(item, stage, err)
+				default:
+					metrics.record(1, 0, 0, 1, 0, latency)
+					run.reject(item, stage, err)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// ===============================
+// STAGES
+// ===============================
+
+// normalize converts raw records and validates the resulting article
+func (p *IngestionPipeline) normalize(item *ingestionItem) error {
+	if item.article == nil {
+		source, err := p.source(item.source)
+		if err != nil {
+			return err
+		}
+		rawSource, ok := source.(RawNewsSource)
+		if !ok {
+			return fmt.Errorf("news source %s does not expose raw records", item.source)
+		}
+
+		article, err := rawSource.Convert(item.payload, item.query)
+		if err != nil {
+			return err
+		}
+		item.article = article
+	}
+
+	return validateIngestedArticle(item.article)
+}
+
+// validateIngestedArticle rejects articles that cannot be stored or linked to
+func validateIngestedArticle(article *models.Article) error {
+	if strings.TrimSpace(article.Title) == "" {
+		return errors.New("article has no title")
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(article.URL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("article URL %q is not an absolute http(s) URL", article.URL)
+	}
+
+	if article.PublishedAt.IsZero() {
+		return errors.New("article has no parseable published date")
+	}
+	if article.PublishedAt.After(time.Now().Add(maxIngestionFutureSkew)) {
+		return fmt.Errorf("article published date %s is in the future", article.PublishedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// enrich fills identifiers, language, India detection, sentiment, entities, places, tags, the
+// summary and reading estimates
+func (p *IngestionPipeline) enrich(item *ingestionItem) error {
+	// Work on a copy so sources and caches never see pipeline changes
+	processed := *item.article
+	processed.ID = 0
+
+	if processed.ExternalID == nil || *processed.ExternalID == "" {
+		externalID := p.news.generateExternalID(processed.URL, processed.Title)
+		processed.ExternalID = &externalID
+	}
+
+	description := p.news.getStringValue(processed.Description)
+	analyzer := p.news.contentAnalyzer
+
+	// The script decides the language; a provider's label only stands for text without letters
+	content := processed.Title + " " + description + " " + p.news.getStringValue(processed.Content)
+	if detected := lang.Detect(processed.Title + " " + description); detected != "" {
+		processed.Language = detected
+	} else if processed.Language == "" {
+		processed.Language = lang.English
+	}
+	processed.SearchTokens = nil
+	if processed.Language != lang.English {
+		processed.SearchTokens = lang.DistinctTokens(content)
+	}
+
+	processed.IsIndianContent = analyzer.IsIndianContent(processed.Title, description, processed.Source)
+	processed.SentimentScore = analyzer.ArticleSentiment(&processed)
+	p.news.entityExtractor.Annotate(&processed)
+	p.news.geo.Tag(&processed)
+	p.news.tags.Tag(&processed)
+
+	body := cleanFeedText(description) + "\n" + cleanFeedText(p.news.getStringValue(processed.Content))
+	summarizeArticle(&processed, body)
+	scoreArticleQuality(&processed, body)
+
+	// Calculate word count and reading time
+	wordCount := calculateWordCount(content)
+	processed.WordCount = wordCount
+	processed.ReadingTimeMinutes = maxInt(1, wordCount/200) // Assume 200 words per minute
+
+	// Set timestamps
+	now := time.Now()
+	processed.FetchedAt = now
+	if processed.CreatedAt.IsZero() {
+		processed.CreatedAt = now
+	}
+	processed.UpdatedAt = now
+
+	// Set defaults
+	processed.IsActive = true
+
+	item.article = &processed
+	return nil
+}
+
+// classify assigns a category and the relevance score. Categories supplied by the provider are
+// kept; otherwise the classifier predicts one, and articles it is unsure about stay
+// uncategorized and are queued for review once saved.
+func (p *IngestionPipeline) classify(run *ingestionRun, item *ingestionItem) error {
+	article := item.article
+
+	if article.CategoryID != nil && article.CategorySource == nil {
+		source := models.CategorySourceProvider
+		article.CategorySource = &source
+	}
+
+	if article.CategoryID == nil {
+		run.categoryOnce.Do(func() {
+			if _, slugToID, err := p.news.articleRepo.GetCategoryMapping(); err == nil {
+				run.slugToID = slugToID
+			} else {
+				p.logger.Warn("Category mapping unavailable during ingestion", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		})
+
+		if run.slugToID != nil {
+			prediction := p.news.classifier.Classify(article.Title, p.news.getStringValue(article.Description))
+			article.CategorySource = &prediction.Source
+			article.CategoryLabels = prediction.Labels
+			if prediction.Source == models.CategorySourceModel {
+				confidence := prediction.Confidence
+				article.CategoryConfidence = &confidence
+			}
+
+			categoryID, exists := run.slugToID[prediction.Category]
+			switch {
+			case prediction.Source == models.CategorySourceModel && (prediction.NeedsReview || !exists):
+				item.review = p.news.classifier.NewReview(p.news.getStringValue(article.ExternalID), prediction)
+			case exists:
+				article.CategoryID = &categoryID
+			default:
+				if categoryID, exists = run.slugToID["top-stories"]; !exists {
+					categoryID = 1 // Hard fallback to ID 1
+				}
+				article.CategoryID = &categoryID
+			}
+		}
+	}
+
+	article.RelevanceScore = p.news.ranking.Score(article, &RankingContext{
+		Category: p.news.getCategoryName(article.Category),
+	})
+
+	return nil
+}
+
+// dedupe returns a single-worker stage function. Records repeated within the run are dropped;
+// copies of a story seen earlier in the run (same URL or title) are merged into the first one, and
+// copies of a recently stored article are merged into that row by persist.
+func (p *IngestionPipeline) dedupe(run *ingestionRun, options ingestionRunOptions) func(*ingestionItem) error {
+	seenExternalIDs := make(map[string]bool)
+	seenURLs := make(map[string]*ingestionItem)
+	seenTitles := make(map[string]*ingestionItem)
+	cross := p.news.crossDedup
+
+	return func(item *ingestionItem) error {
+		article := item.article
+		externalID := p.news.getStringValue(article.ExternalID)
+		titleKey := strings.Join(strings.Fields(strings.ToLower(article.Title)), " ")
+
+		if seenExternalIDs[externalID] {
+			return errIngestionDuplicate
+		}
+		seenExternalIDs[externalID] = true
+		fingerprintArticle(article)
+
+		pair := &DuplicatePair{DetectionMethod: "url_match", URLMatch: true, ConfidenceLevel: "HIGH"}
+		canonical, ok := seenURLs[article.URL]
+		if !ok {
+			pair = &DuplicatePair{DetectionMethod: "title_similarity", TitleSimilarity: 1, ConfidenceLevel: "MEDIUM"}
+			canonical, ok = seenTitles[titleKey]
+		}
+		if ok {
+			// A title match's URL is a known copy too, so later records at that URL join the
+			// same canonical instead of becoming a second story
+			if _, seen := seenURLs[article.URL]; !seen {
+				seenURLs[article.URL] = canonical
+			}
+
+			// A canonical that is itself a copy of a stored article sends its copies there too
+			if canonical.mergeStored != "" {
+				item.mergeStored = canonical.mergeStored
+				item.dedupPair = pair
+				return nil
+			}
+			item.mergeInto = canonical
+			if cross != nil {
+				run.logDeduplication(cross.NewLog(pair, nil, article))
+			}
+			return nil
+		}
+
+		seenURLs[article.URL] = item
+		seenTitles[titleKey] = item
+
+		if cross == nil {
+			return nil
+		}
+		if match := cross.Check(article); match != nil {
+			item.mergeStored = match.externalID
+			item.dedupPair = &match.pair
+			if options.persist {
+				cross.Remember(article, match.externalID)
+			}
+			return nil
+		}
+		if options.persist {
+			cross.Remember(article, externalID)
+		}
+		run.logDeduplication(cross.NewLog(nil, nil, article))
+		return nil
+	}
+}
+
+// persistStage saves accepted items in batches and notifies syndication. Dry runs only
+// collect what would have been saved.
+func (p *IngestionPipeline) persistStage(run *ingestionRun, in <-chan *ingestionItem, persist bool) {
+	batch := make([]*ingestionItem, 0, p.persistBatch)
+
+	for item := range in {
+		if item.mergeInto != nil {
+			p.merge(run, item, persist)
+			continue
+		}
+		if item.mergeStored != "" && p.mergeStored(run, item, persist) {
+			continue
+		}
+
+		run.mutex.Lock()
+		run.accepted = append(run.accepted, item)
+		run.stats.Accepted++
+		run.mutex.Unlock()
+
+		if !persist {
+			continue
+		}
+		batch = append(batch, item)
+		if len(batch) >= p.persistBatch {
+			p.persist(run, batch)
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		p.persist(run, batch)
+	}
+}
+
+// merge folds a duplicate into its canonical item. The canonical article is replaced by a merged
+// copy because it may already have been handed to syndication; a canonical still waiting in the
+// current batch is saved with the merge applied, one already saved is updated in place.
+func (p *IngestionPipeline) merge(run *ingestionRun, item *ingestionItem, persist bool) {
+	canonical := item.mergeInto
+	merged := *canonical.article
+	mergeDuplicateArticle(&merged, item.article)
+
+	run.mutex.Lock()
+	canonical.article = &merged
+	alreadySaved := run.persisted[canonical]
+	run.stats.Duplicates++
+	run.stats.Merged++
+	run.mutex.Unlock()
+
+	if !persist || !alreadySaved {
+		return
+	}
+
+	if err := p.news.articleRepo.MergeIntoArticle(&merged); err != nil {
+		p.logger.Warn("Failed to merge duplicate into stored article", map[string]interface{}{
+			"external_id":   p.news.getStringValue(merged.ExternalID),
+			"duplicate_url": item.article.URL,
+			"error":         err.Error(),
+		})
+	}
+}
+
+// mergeStored folds a duplicate into the stored article it matched. It returns false when the
+// stored row is gone, so the item is saved as a new article instead.
+func (p *IngestionPipeline) mergeStored(run *ingestionRun, item *ingestionItem, persist bool) bool {
+	if !persist {
+		run.mutex.Lock()
+		run.stats.Duplicates++
+		run.stats.Merged++
+		run.mutex.Unlock()
+		return true
+	}
+
+	stored, err := p.news.articleRepo.GetArticlesByExternalIDs([]string{item.mergeStored})
+	if err != nil {
+		run.reject(item, models.IngestionStagePersist, fmt.Errorf("failed to load stored duplicate: %w", err))
+		return true
+	}
+	canonical, ok := stored[item.mergeStored]
+	if !ok {
+		return false
+	}
+
+	mergeDuplicateArticle(canonical, item.article)
+	if err := p.news.articleRepo.MergeIntoArticle(canonical); err != nil {
+		run.reject(item, models.IngestionStagePersist, fmt.Errorf("failed to merge into stored article: %w", err))
+		return true
+	}
+
+	run.mutex.Lock()
+	run.stats.Duplicates++
+	run.stats.Merged++
+	run.mutex.Unlock()
+	run.logDeduplication(p.news.crossDedup.NewLog(item.dedupPair, canonical, item.article))
+
+	if item.deadLetterID != 0 {
+		if err := p.deadLetters.MarkDeadLetterReplayed(item.deadLetterID); err != nil {
+			p.logger.Error("Failed to mark dead letter replayed", map[string]interface{}{
+				"dead_letter_id": item.deadLetterID,
+				"error":          err.Error(),
+			})
+		}
+	}
+	return true
+}
+
+// persist writes one batch. When the batch fails, articles are retried one at a time so
+// a single bad row is dead-lettered instead of the whole batch.
+func (p *IngestionPipeline) persist(run *ingestionRun, batch []*ingestionItem) {
+	metrics := p.metrics[models.IngestionStagePersist]
+	startTime := time.Now()
+
+	articles := make([]*models.Article, len(batch))
+	for i, item := range batch {
+		articles[i] = item.article
+	}
+
+	stored := batch
+	if err := p.news.articleRepo.SaveArticles(articles); err != nil {
+		p.logger.Warn("Batch save failed, saving articles individually", map[string]interface{}{
+			"count": len(batch),
+			"error": err.Error(),
+		})
+
+		stored = nil
+		for _, item := range batch {
+			if err := p.news.articleRepo.SaveArticles([]*models.Article{item.article}); err != nil {
+				run.reject(item, models.IngestionStagePersist, fmt.Errorf("failed to save article: %w", err))
+				continue
+			}
+			stored = append(stored, item)
+		}
+	}
+
+	metrics.record(int64(len(batch)), int64(len(stored)), 0, int64(len(batch)-len(stored)), 0, time.Since(startTime))
+	if len(stored) == 0 {
+		return
+	}
+
+	storedArticles := make([]*models.Article, len(stored))
+	run.mutex.Lock()
+	for i, item := range stored {
+		storedArticles[i] = item.article
+		run.persisted[item] = true
+	}
+	run.stats.Persisted += len(stored)
+	run.mutex.Unlock()
+
+	var reviews []*models.CategoryReview
+	for _, item := range stored {
+		if item.review != nil {
+			reviews = append(reviews, item.review)
+		}
+	}
+	if len(reviews) > 0 {
+		p.news.classifier.QueueReviews(reviews)
+	}
+
+	for _, item := range stored {
+		if item.deadLetterID == 0 {
+			continue
+		}
+		if err := p.deadLetters.MarkDeadLetterReplayed(item.deadLetterID); err != nil {
+			p.logger.Error("Failed to mark dead letter replayed", map[string]interface{}{
+				"dead_letter_id": item.deadLetterID,
+				"error":          err.Error(),
+			})
+		}
+	}
+
+	// Refresh published feeds and queue WebSub pings
+	if p.news.syndication != nil {
+		p.news.syndication.ArticlesStored(storedArticles)
+	}
+}
+
+// ===============================
+// DEAD LETTERS & DEDUPLICATION LOGS
+// ===============================
+
+func (r *ingestionRun) logDeduplication(log *models.DeduplicationLog) {
+	r.mutex.Lock()
+	r.dedupLogs = append(r.dedupLogs, log)
+	r.mutex.Unlock()
+}
+
+// flushDeduplicationLogs stores every dedupe decision made during the run
+func (p *IngestionPipeline) flushDeduplicationLogs(run *ingestionRun) {
+	if len(run.dedupLogs) == 0 || p.news.articleRepo == nil {
+		return
+	}
+	if err := p.news.articleRepo.CreateDeduplicationLogs(run.dedupLogs); err != nil {
+		p.logger.Error("Failed to store deduplication logs", map[string]interface{}{
+			"count": len(run.dedupLogs),
+			"error": err.Error(),
+		})
+	}
+}
+
+// flushDeadLetters stores new rejections and updates replayed dead letters that failed again
+func (p *IngestionPipeline) flushDeadLetters(run *ingestionRun) {
+	var letters []*models.IngestionDeadLetter
+
+	for _, rejection := range run.rejections {
+		item := rejection.item
+		if item.deadLetterID != 0 {
+			if err := p.deadLetters.RecordDeadLetterFailure(item.deadLetterID, rejection.stage, rejection.err.Error()); err != nil {
+				p.logger.Error("Failed to update dead letter", map[string]interface{}{
+					"dead_letter_id": item.deadLetterID,
+					"error":          err.Error(),
+				})
+			}
+			continue
+		}
+
+		letter, err := newDeadLetter(rejection)
+		if err != nil {
+			p.logger.Error("Failed to encode dead letter payload", map[string]interface{}{
+				"source": item.source,
+				"stage":  rejection.stage,
+				"error":  err.Error(),
+			})
+			continue
+		}
+		letters = append(letters, letter)
+	}
+
+	if len(letters) == 0 {
+		return
+	}
+
+	if err := p.deadLetters.CreateDeadLetters(letters); err != nil {
+		p.logger.Error("Failed to store dead letters", map[string]interface{}{
+			"count": len(letters),
+			"error": err.Error(),
+		})
+		return
+	}
+
+	p.logger.Warn("Ingestion items dead-lettered", map[string]interface{}{
+		"count": len(letters),
+	})
+}
+
+// newDeadLetter builds the stored form of a rejection. Raw records keep the provider payload;
+// everything else stores the article as converted.
+func newDeadLetter(rejection ingestionRejection) (*models.IngestionDeadLetter, error) {
+	item := rejection.item
+
+	payload := item.payload
+	kind := item.kind
+	if kind != models.DeadLetterPayloadRaw || len(payload) == 0 {
+		encoded, err := json.Marshal(item.article)
+		if err != nil {
+			return nil, err
+		}
+		payload = encoded
+		kind = models.DeadLetterPayloadArticle
+	}
+
+	query, err := json.Marshal(item.query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.IngestionDeadLetter{
+		Source:      item.source,
+		Stage:       rejection.stage,
+		PayloadKind: kind,
+		Payload:     models.RawJSON(payload),
+		Query:       models.RawJSON(query),
+		Error:       rejection.err.Error(),
+	}, nil
+}
+
+// deadLetterItem rebuilds a pipeline item from a stored dead letter
+func deadLetterItem(letter *models.IngestionDeadLetter) (*ingestionItem, error) {
+	item := &ingestionItem{
+		source:       letter.Source,
+		kind:         letter.PayloadKind,
+		payload:      json.RawMessage(letter.Payload),
+		deadLetterID: letter.ID,
+	}
+
+	if len(letter.Query) > 0 {
+		if err := json.Unmarshal(letter.Query, &item.query); err != nil {
+			return nil, fmt.Errorf("failed to decode dead letter query: %w", err)
+		}
+	}
+
+	if letter.PayloadKind == models.DeadLetterPayloadArticle {
+		article := &models.Article{}
+		if err := json.Unmarshal(letter.Payload, article); err != nil {
+			return nil, fmt.Errorf("failed to decode dead letter article: %w", err)
+		}
+		item.article = article
+	}
+
+	return item, nil
+}
+
+// ReplayDeadLetter runs one pending dead letter through the pipeline again
+func (p *IngestionPipeline) ReplayDeadLetter(ctx context.Context, id int) (*models.DeadLetterReplayResult, error) {
+	results, err := p.replay(ctx, []int{id})
+	if err != nil {
+		return nil, err
+	}
+	return &results[0], nil
+}
+
+// ReplayDeadLetters replays up to limit pending dead letters, oldest first
+func (p *IngestionPipeline) ReplayDeadLetters(ctx context.Context, source, stage string, limit int) (*models.DeadLetterBulkReplayResult, error) {
+	ids, err := p.deadLetters.GetPendingDeadLetterIDs(source, stage, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.DeadLetterBulkReplayResult{Requested: len(ids), Results: []models.DeadLetterReplayResult{}}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	results, err := p.replay(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, replay := range results {
+		if replay.Status == models.DeadLetterReplayed {
+			result.Replayed++
+		} else {
+			result.Failed++
+		}
+	}
+	result.Results = results
+
+	return result, nil
+}
+
+// replay loads dead letters and runs them through one pipeline run, skipping fetch
+func (p *IngestionPipeline) replay(ctx context.Context, ids []int) ([]models.DeadLetterReplayResult, error) {
+	results := make([]models.DeadLetterReplayResult, len(ids))
+	items := make(map[int]*ingestionItem, len(ids))
+	var seeds []*ingestionItem
+
+	for i, id := range ids {
+		letter, err := p.deadLetters.GetDeadLetter(id)
+		if err != nil {
+			if len(ids) == 1 {
+				return nil, err
+			}
+			results[i] = models.DeadLetterReplayResult{ID: id, Status: models.DeadLetterPending, Error: err.Error()}
+			continue
+		}
+		if letter.Status != models.DeadLetterPending {
+			if len(ids) == 1 {
+				return nil, repository.ErrDeadLetterResolved
+			}
+			results[i] = models.DeadLetterReplayResult{ID: id, Status: letter.Status}
+			continue
+		}
+
+		item, err := deadLetterItem(letter)
+		if err != nil {
+			if recordErr := p.deadLetters.RecordDeadLetterFailure(id, letter.Stage, err.Error()); recordErr != nil {
+				return nil, recordErr
+			}
+			results[i] = models.DeadLetterReplayResult{ID: id, Status: models.DeadLetterPending, Stage: letter.Stage, Error: err.Error()}
+			continue
+		}
+
+		items[id] = item
+		seeds = append(seeds, item)
+	}
+
+	if len(seeds) > 0 {
+		run := p.Run(ctx, nil, seeds)
+
+		rejected := make(map[*ingestionItem]ingestionRejection, len(run.rejections))
+		for _, rejection := range run.rejections {
+			rejected[rejection.item] = rejection
+		}
+
+		for i, id := range ids {
+			item, ok := items[id]
+			if !ok {
+				continue
+			}
+
+			result := models.DeadLetterReplayResult{ID: id, Status: models.DeadLetterPending}
+			switch rejection, failed := rejected[item]; {
+			case run.persisted[item]:
+				result.Status = models.DeadLetterReplayed
+				result.Persisted = true
+			case failed:
+				result.Stage = rejection.stage
+				result.Error = rejection.err.Error()
+			default:
+				// Dropped as a duplicate of another replayed item; nothing left to store
+				result.Duplicate = true
+				if err := p.deadLetters.MarkDeadLetterReplayed(id); err == nil {
+					result.Status = models.DeadLetterReplayed
+				}
+			}
+			results[i] = result
+		}
+	}
+
+	return results, nil
+}
+
+// ===============================
+// METRICS
+// ===============================
+
+// Metrics returns cumulative per-stage counters and latencies plus dead letter counts
+func (p *IngestionPipeline) Metrics() (*models.IngestionMetrics, error) {
+	metrics := &models.IngestionMetrics{
+		Stages: make([]models.IngestionStageMetrics, 0, len(models.IngestionStages)),
+		Config: map[string]int{
+			"stage_buffer":  p.bufferSize,
+			"stage_workers": p.workers,
+			"persist_batch": p.persistBatch,
+		},
+	}
+	for _, stage := range models.IngestionStages {
+		metrics.Stages = append(metrics.Stages, p.metrics[stage].snapshot(stage))
+	}
+
+	p.runMutex.Lock()
+	metrics.Runs = p.runs
+	if p.lastRun != nil {
+		lastRun := *p.lastRun
+		lastRunAt := p.lastRunAt
+		metrics.LastRun = &lastRun
+		metrics.LastRunAt = &lastRunAt
+	}
+	p.runMutex.Unlock()
+
+	counts, err := p.deadLetters.CountDeadLettersByStatus()
+	if err != nil {
+		return nil, err
+	}
+	metrics.DeadLetters = counts
+
+	return metrics, nil
+}
+
+// Close flushes and closes the raw archive
+func (p *IngestionPipeline) Close() error {
+	if p.archive == nil {
+		return nil
+	}
+	return p.archive.Close()
+}
+
+// ListDeadLetters returns a page of dead letters for the admin view
+func (p *IngestionPipeline) ListDeadLetters(filter models.DeadLetterFilter) (*models.DeadLetterListResponse, error) {
+	letters, total, err := p.deadLetters.ListDeadLetters(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := p.deadLetters.CountDeadLettersByStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeadLetterListResponse{
+		DeadLetters: letters,
+		Total:       total,
+		ByStatus:    counts,
+		Limit:       filter.Limit,
+		Offset:      filter.Offset,
+	}, nil
+}
+
+// GetDeadLetter returns one dead letter with its payload
+func (p *IngestionPipeline) GetDeadLetter(id int) (*models.IngestionDeadLetter, error) {
+	return p.deadLetters.GetDeadLetter(id)
+}
+
+// DiscardDeadLetter marks a pending dead letter as not worth replaying
+func (p *IngestionPipeline) DiscardDeadLetter(id int) error {
+	return p.deadLetters.DiscardDeadLetter(id)
+}
@@ -0,0 +1,930 @@
+// internal/services/learning_to_rank.go
+// GoNews - Learning-to-Rank from Clicks and Dwell Time
+// Impression logging, position-bias corrected pairwise training, guarded rollout of learned weights
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Async write queue sizing for impressions and clicks
+	ltrQueueSize      = 5000
+	ltrFlushBatchSize = 500
+	ltrFlushInterval  = 2 * time.Second
+
+	// Only the top of a response is logged; users rarely scroll further
+	ltrMaxImpressionsPerRequest = 50
+
+	// Dwell beyond this adds no further confidence to a click
+	ltrMaxDwellSeconds = 300
+
+	// A feature must apply to at least this share of impressions to be trained
+	ltrMinFeatureCoverage = 0.01
+
+	// Trained features never drop below this weight unless their baseline weight is zero
+	ltrMinFeatureWeight = 0.01
+
+	// Fixed seed so reruns over the same impressions produce the same model
+	ltrShuffleSeed = 20240101
+)
+
+// ErrRolloutRejected marks rollout changes that are not allowed in the current state
+var ErrRolloutRejected = errors.New("rollout rejected")
+
+// ltrWrite is one queued impression batch or click
+type ltrWrite struct {
+	impressions []models.RankingImpression
+	click       *models.RankingClick
+}
+
+// impressionFeatures is the pipeline's view of an article at the time it was shown
+type impressionFeatures struct {
+	values     []float64
+	applicable []bool
+}
+
+// rankingPair is a clicked article preferred over a skipped one from the same response
+type rankingPair struct {
+	preferred *impressionFeatures
+	skipped   *impressionFeatures
+	diff      []float64
+	weight    float64
+}
+
+// LearningToRankService learns ranking pipeline weights from feed and search interactions
+type LearningToRankService struct {
+	config      *config.Config
+	logger      *logger.Logger
+	repo        *repository.LTRRepository
+	articleRepo *repository.ArticleRepository
+	ranking     *RankingPipeline
+
+	// Active model, mirrored from the database
+	active      *models.RankingModel
+	activeMutex sync.RWMutex
+
+	// Training job state
+	jobMutex   sync.Mutex
+	jobRunning bool
+	lastResult *models.LTRJobResult
+	lastError  error
+	stateMutex sync.RWMutex
+
+	// Async impression / click logging
+	writes   chan ltrWrite
+	dropped  int64
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewLearningToRankService creates a new learning-to-rank service publishing into the given pipeline
+func NewLearningToRankService(cfg *config.Config, log *logger.Logger, repo *repository.LTRRepository, articleRepo *repository.ArticleRepository, ranking *RankingPipeline) *LearningToRankService {
+	return &LearningToRankService{
+		config:      cfg,
+		logger:      log,
+		repo:        repo,
+		articleRepo: articleRepo,
+		ranking:     ranking,
+		writes:      make(chan ltrWrite, ltrQueueSize),
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start restores the active model, launches the impression writer and schedules training
+func (s *LearningToRankService) Start() {
+	s.restoreActiveModel()
+
+	s.wg.Add(1)
+	go s.writeLoop()
+
+	if !s.config.LTREnabled {
+		s.logger.Info("Learning-to-rank training disabled by configuration")
+		return
+	}
+
+	interval := time.Duration(s.config.LTRJobIntervalHours) * time.Hour
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	s.wg.Add(1)
+	go s.runScheduler(interval)
+
+	s.logger.Info("Learning-to-rank job scheduled", map[string]interface{}{
+		"interval":     interval.String(),
+		"window_days":  s.config.LTRWindowDays,
+		"auto_publish": s.config.LTRAutoPublish,
+		"serving":      s.config.LTRServingEnabled,
+	})
+}
+
+// runScheduler trains on every tick; the first run waits a full interval so impressions can accumulate
+func (s *LearningToRankService) runScheduler(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runJob()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runJob runs one training pass bounded by a timeout
+func (s *LearningToRankService) runJob() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	if _, err := s.TrainModel(ctx, s.config.LTRAutoPublish); err != nil {
+		s.logger.Error("Learning-to-rank job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// Close stops the job and flushes queued impressions
+func (s *LearningToRankService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Learning-to-rank service stopped")
+	return nil
+}
+
+// restoreActiveModel re-applies a fully rolled out model after a restart
+func (s *LearningToRankService) restoreActiveModel() {
+	if !s.config.LTRServingEnabled {
+		s.logger.Info("Learning-to-rank serving disabled - using configured ranking weights")
+		return
+	}
+
+	model, err := s.repo.GetActiveModel()
+	if err != nil {
+		if !errors.Is(err, repository.ErrNoActiveRankingModel) {
+			s.logger.Error("Failed to load active ranking model", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	s.applyModel(model)
+}
+
+// applyModel makes a model the serving one; at 100% rollout its weights become the pipeline default
+func (s *LearningToRankService) applyModel(model *models.RankingModel) {
+	s.activeMutex.Lock()
+	s.active = model
+	s.activeMutex.Unlock()
+
+	if model.RolloutPercent >= 100 {
+		if err := s.ranking.SetWeights(model.Weights); err != nil {
+			s.logger.Error("Failed to publish learned ranking weights", map[string]interface{}{
+				"model_id": model.ID,
+				"error":    err.Error(),
+			})
+			return
+		}
+	}
+
+	s.logger.Info("Ranking model serving", map[string]interface{}{
+		"model_id":        model.ID,
+		"rollout_percent": model.RolloutPercent,
+	})
+}
+
+// ===============================
+// IMPRESSION & CLICK LOGGING
+// ===============================
+
+// LogImpressions records the articles shown to a subject, in display order, and returns the impression
+// request ID. offset is the number of results on earlier pages, so positions stay absolute.
+func (s *LearningToRankService) LogImpressions(surface string, subject ExperimentSubject, query string, articleIDs []int, offset int) string {
+	if !s.config.LTREnabled || subject.Key == "" || len(articleIDs) == 0 {
+		return ""
+	}
+
+	requestID := uuid.New().String()
+	var queryPtr *string
+	if query != "" {
+		queryPtr = &query
+	}
+
+	count := len(articleIDs)
+	if count > ltrMaxImpressionsPerRequest {
+		count = ltrMaxImpressionsPerRequest
+	}
+
+	impressions := make([]models.RankingImpression, 0, count)
+	for i := 0; i < count; i++ {
+		if articleIDs[i] <= 0 {
+			continue
+		}
+		impressions = append(impressions, models.RankingImpression{
+			RequestID:  requestID,
+			SubjectKey: subject.Key,
+			UserID:     subject.UserID,
+			Surface:    surface,
+			Query:      queryPtr,
+			ArticleID:  articleIDs[i],
+			Position:   offset + i + 1,
+		})
+	}
+
+	s.enqueue(ltrWrite{impressions: impressions})
+	return requestID
+}
+
+// RecordRead joins a read back onto the subject's latest impression of the article
+func (s *LearningToRankService) RecordRead(subject ExperimentSubject, articleID, dwellSeconds int) {
+	if !s.config.LTREnabled || subject.Key == "" || articleID <= 0 {
+		return
+	}
+
+	s.enqueue(ltrWrite{click: &models.RankingClick{
+		SubjectKey:   subject.Key,
+		ArticleID:    articleID,
+		DwellSeconds: dwellSeconds,
+	}})
+}
+
+// enqueue queues a write without blocking the request path
+func (s *LearningToRankService) enqueue(write ltrWrite) {
+	select {
+	case s.writes <- write:
+	default:
+		if dropped := atomic.AddInt64(&s.dropped, 1); dropped%100 == 1 {
+			s.logger.Warn("Impression write queue full, dropping writes", map[string]interface{}{
+				"dropped_total": atomic.LoadInt64(&s.dropped),
+			})
+		}
+	}
+}
+
+// writeLoop batches impressions and clicks; impressions are flushed first so same-batch clicks can join them
+func (s *LearningToRankService) writeLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(ltrFlushInterval)
+	defer ticker.Stop()
+
+	var impressions []models.RankingImpression
+	var clicks []models.RankingClick
+
+	add := func(write ltrWrite) {
+		impressions = append(impressions, write.impressions...)
+		if write.click != nil {
+			clicks = append(clicks, *write.click)
+		}
+	}
+
+	flush := func() {
+		if err := s.repo.RecordImpressions(impressions); err != nil {
+			s.logger.Error("Failed to record ranking impressions", map[string]interface{}{
+				"count": len(impressions),
+				"error": err.Error(),
+			})
+		}
+		if err := s.repo.RecordClicks(clicks); err != nil {
+			s.logger.Error("Failed to record ranking clicks", map[string]interface{}{
+				"count": len(clicks),
+				"error": err.Error(),
+			})
+		}
+		impressions = impressions[:0]
+		clicks = clicks[:0]
+	}
+
+	for {
+		select {
+		case write := <-s.writes:
+			add(write)
+			if len(impressions)+len(clicks) >= ltrFlushBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stopChan:
+			// Drain whatever is still queued before exiting
+			for {
+				select {
+				case write := <-s.writes:
+					add(write)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// ===============================
+// SERVING
+// ===============================
+
+// WeightsFor returns the active model's weights when the subject falls inside a partial rollout.
+// nil means the pipeline's current weights apply (including a model rolled out to everyone).
+func (s *LearningToRankService) WeightsFor(subject ExperimentSubject) map[string]float64 {
+	if !s.config.LTRServingEnabled || subject.Key == "" {
+		return nil
+	}
+
+	s.activeMutex.RLock()
+	model := s.active
+	s.activeMutex.RUnlock()
+
+	if model == nil || model.RolloutPercent <= 0 || model.RolloutPercent >= 100 {
+		return nil
+	}
+
+	hasher := fnv.New32a()
+	hasher.Write([]byte("ltr:" + strconv.Itoa(model.ID) + ":" + subject.Key))
+	if int(hasher.Sum32()%100) >= model.RolloutPercent {
+		return nil
+	}
+
+	weights := make(map[string]float64, len(model.Weights))
+	for name, weight := range model.Weights {
+		weights[name] = weight
+	}
+	return weights
+}
+
+// ===============================
+// TRAINING
+// ===============================
+
+// TrainModel fits pairwise weights on recent impressions and stores the result as a model version.
+// With publish set, a model that passes the guards starts serving at the initial rollout percentage.
+func (s *LearningToRankService) TrainModel(ctx context.Context, publish bool) (*models.LTRJobResult, error) {
+	s.jobMutex.Lock()
+	if s.jobRunning {
+		s.jobMutex.Unlock()
+		return nil, fmt.Errorf("learning-to-rank job already running")
+	}
+	s.jobRunning = true
+	s.jobMutex.Unlock()
+
+	defer func() {
+		s.jobMutex.Lock()
+		s.jobRunning = false
+		s.jobMutex.Unlock()
+	}()
+
+	startTime := time.Now()
+	windowDays := maxInt(s.config.LTRWindowDays, 1)
+	result := &models.LTRJobResult{WindowDays: windowDays}
+
+	finish := func(status, reason string) (*models.LTRJobResult, error) {
+		result.Status = status
+		result.Reason = reason
+		result.Duration = time.Since(startTime)
+		result.CompletedAt = time.Now()
+		s.recordJobResult(result, nil)
+		s.pruneImpressions()
+
+		s.logger.Info("Learning-to-rank job completed", map[string]interface{}{
+			"status":            result.Status,
+			"reason":            result.Reason,
+			"model_id":          result.ModelID,
+			"training_pairs":    result.TrainingPairs,
+			"holdout_pairs":     result.HoldoutPairs,
+			"holdout_accuracy":  result.HoldoutAccuracy,
+			"baseline_accuracy": result.BaselineAccuracy,
+			"duration":          result.Duration.String(),
+		})
+		return result, nil
+	}
+
+	records, err := s.repo.GetTrainingImpressions(startTime.AddDate(0, 0, -windowDays))
+	if err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+	result.Impressions = len(records)
+	if len(records) == 0 {
+		return finish("skipped", "no impressions with reads in the training window")
+	}
+
+	featureSets, featureNames, err := s.extractFeatures(records)
+	if err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+	if len(featureNames) == 0 {
+		return finish("skipped", "no ranking feature applies to the logged impressions")
+	}
+
+	train, holdout, requests := s.buildPairs(records, featureSets)
+	result.Requests = requests
+	result.TrainingPairs = len(train)
+	result.HoldoutPairs = len(holdout)
+	if len(train) < s.config.LTRMinPairs {
+		return finish("skipped", fmt.Sprintf("only %d training pairs, need %d", len(train), s.config.LTRMinPairs))
+	}
+
+	baseline := s.ranking.Weights()
+	learned, err := s.fitPairwise(ctx, train, featureNames, baseline)
+	if err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+	proposed := s.guardWeights(learned, baseline, featureNames)
+
+	result.Weights = proposed
+	result.TrainAccuracy = roundScore(pairAccuracy(train, featureNames, proposed))
+	result.HoldoutAccuracy = roundScore(pairAccuracy(holdout, featureNames, proposed))
+	result.BaselineAccuracy = roundScore(pairAccuracy(holdout, featureNames, baseline))
+
+	status, reason := models.RankingModelCandidate, ""
+	minHoldout := maxInt(s.config.LTRMinPairs/5, 1)
+	switch {
+	case len(holdout) < minHoldout:
+		status = models.RankingModelRejected
+		reason = fmt.Sprintf("only %d holdout pairs, need %d", len(holdout), minHoldout)
+	case result.HoldoutAccuracy < result.BaselineAccuracy+s.config.LTRMinImprovement:
+		status = models.RankingModelRejected
+		reason = fmt.Sprintf("holdout accuracy %.4f does not beat current weights %.4f by %.4f",
+			result.HoldoutAccuracy, result.BaselineAccuracy, s.config.LTRMinImprovement)
+	}
+
+	model := &models.RankingModel{
+		Status:           status,
+		Weights:          proposed,
+		BaselineWeights:  baseline,
+		TrainingPairs:    result.TrainingPairs,
+		HoldoutPairs:     result.HoldoutPairs,
+		TrainAccuracy:    result.TrainAccuracy,
+		HoldoutAccuracy:  result.HoldoutAccuracy,
+		BaselineAccuracy: result.BaselineAccuracy,
+	}
+	if reason != "" {
+		model.Notes = &reason
+	}
+	if err := s.repo.CreateModel(model); err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+	result.ModelID = model.ID
+
+	if status == models.RankingModelCandidate && publish && s.config.LTRServingEnabled {
+		if err := s.SetRollout(model.ID, s.config.LTRInitialRolloutPercent); err != nil {
+			s.recordJobResult(nil, err)
+			return nil, err
+		}
+		status = models.RankingModelActive
+	}
+
+	return finish(status, reason)
+}
+
+// extractFeatures scores every impressed article as of the time it was shown and picks the trainable features
+func (s *LearningToRankService) extractFeatures(records []models.ImpressionRecord) (map[int64]*impressionFeatures, []string, error) {
+	seen := make(map[int]bool)
+	ids := make([]int, 0)
+	for _, record := range records {
+		if !seen[record.ArticleID] {
+			seen[record.ArticleID] = true
+			ids = append(ids, record.ArticleID)
+		}
+	}
+
+	articles, err := s.articleRepo.GetArticlesByIDs(ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load impressed articles: %w", err)
+	}
+	byID := make(map[int]*models.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+
+	// Feature order follows the pipeline; coverage decides which ones are trainable
+	allNames := make([]string, 0)
+	for _, info := range s.ranking.Features() {
+		allNames = append(allNames, info.Name)
+	}
+	coverage := make([]int, len(allNames))
+
+	raw := make(map[int64]*impressionFeatures, len(records))
+	for i, record := range records {
+		article, exists := byID[record.ArticleID]
+		if !exists {
+			continue
+		}
+
+		breakdown := s.ranking.Explain(article, &RankingContext{Now: record.ShownAt})
+		features := &impressionFeatures{
+			values:     make([]float64, len(allNames)),
+			applicable: make([]bool, len(allNames)),
+		}
+		for j, feature := range breakdown.Features {
+			if j >= len(allNames) {
+				break
+			}
+			features.values[j] = feature.Value
+			features.applicable[j] = feature.Applicable
+			if feature.Applicable {
+				coverage[j]++
+			}
+		}
+		raw[int64(i)] = features
+	}
+
+	// Personalization never applies here (no profile at training time), so it keeps its weight
+	keep := make([]int, 0, len(allNames))
+	names := make([]string, 0, len(allNames))
+	for j, name := range allNames {
+		if float64(coverage[j]) >= ltrMinFeatureCoverage*float64(len(records)) && coverage[j] > 0 {
+			keep = append(keep, j)
+			names = append(names, name)
+		}
+	}
+
+	featureSets := make(map[int64]*impressionFeatures, len(raw))
+	for key, features := range raw {
+		trimmed := &impressionFeatures{
+			values:     make([]float64, len(keep)),
+			applicable: make([]bool, len(keep)),
+		}
+		for k, j := range keep {
+			trimmed.values[k] = features.values[j]
+			trimmed.applicable[k] = features.applicable[j]
+		}
+		featureSets[key] = trimmed
+	}
+
+	return featureSets, names, nil
+}
+
+// buildPairs turns each response with reads into (clicked, skipped) pairs, weighted by inverse
+// examination propensity of the clicked position and by dwell time.
+// Whole responses go to either the training or the holdout set.
+func (s *LearningToRankService) buildPairs(records []models.ImpressionRecord, featureSets map[int64]*impressionFeatures) ([]*rankingPair, []*rankingPair, int) {
+	var train, holdout []*rankingPair
+	requests := 0
+
+	for start := 0; start < len(records); {
+		end := start
+		for end < len(records) && records[end].RequestID == records[start].RequestID {
+			end++
+		}
+
+		var clicked, skipped []int
+		for i := start; i < end; i++ {
+			if featureSets[int64(i)] == nil {
+				continue
+			}
+			switch {
+			case records[i].ClickedAt == nil:
+				skipped = append(skipped, i)
+			case records[i].DwellSeconds >= s.config.LTRMinDwellSeconds:
+				clicked = append(clicked, i)
+			}
+			// Short-dwell clicks are neither a preference nor a skip
+		}
+
+		if len(clicked) > 0 && len(skipped) > 0 {
+			requests++
+			target := &train
+			if requestInHoldout(records[start].RequestID, s.config.LTRHoldoutPercent) {
+				target = &holdout
+			}
+
+			for _, c := range clicked {
+				weight := s.propensityWeight(records[c].Position)
+				weight *= 1 + math.Min(float64(records[c].DwellSeconds), ltrMaxDwellSeconds)/ltrMaxDwellSeconds
+
+				for _, k := range skipped {
+					preferred, other := featureSets[int64(c)], featureSets[int64(k)]
+					diff := make([]float64, len(preferred.values))
+					for j := range diff {
+						diff[j] = preferred.values[j] - other.values[j]
+					}
+					*target = append(*target, &rankingPair{
+						preferred: preferred,
+						skipped:   other,
+						diff:      diff,
+						weight:    weight,
+					})
+				}
+			}
+		}
+
+		start = end
+	}
+
+	return train, holdout, requests
+}
+
+// propensityWeight is the clipped inverse of the chance a user examined the position
+func (s *LearningToRankService) propensityWeight(position int) float64 {
+	if position < 1 {
+		position = 1
+	}
+	weight := math.Pow(float64(position), s.config.LTRPositionBiasExponent)
+	if s.config.LTRMaxPropensityWeight > 0 && weight > s.config.LTRMaxPropensityWeight {
+		weight = s.config.LTRMaxPropensityWeight
+	}
+	return weight
+}
+
+// fitPairwise runs projected SGD on the weighted pairwise logistic loss, starting from the current weights
+func (s *LearningToRankService) fitPairwise(ctx context.Context, pairs []*rankingPair, names []string, baseline map[string]float64) (map[string]float64, error) {
+	w := make([]float64, len(names))
+	sum := 0.0
+	for j, name := range names {
+		w[j] = baseline[name]
+		sum += w[j]
+	}
+	for j := range w {
+		if sum > 0 {
+			w[j] /= sum
+		} else {
+			w[j] = 1 / float64(len(w))
+		}
+	}
+
+	rng := rand.New(rand.NewSource(ltrShuffleSeed))
+	order := rng.Perm(len(pairs))
+	epochs := maxInt(s.config.LTREpochs, 1)
+
+	for epoch := 0; epoch < epochs; epoch++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rate := s.config.LTRLearningRate / (1 + 0.1*float64(epoch))
+		rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+		for _, idx := range order {
+			pair := pairs[idx]
+			margin := 0.0
+			for j := range w {
+				margin += w[j] * pair.diff[j]
+			}
+
+			// d/dw of weight * log(1 + e^-margin)
+			gradScale := pair.weight / (1 + math.Exp(margin))
+			for j := range w {
+				w[j] += rate * (gradScale*pair.diff[j] - s.config.LTRRegularization*w[j])
+				if w[j] < 0 {
+					w[j] = 0
+				}
+			}
+		}
+	}
+
+	learned := make(map[string]float64, len(names))
+	for j, name := range names {
+		learned[name] = w[j]
+	}
+	return learned, nil
+}
+
+// guardWeights rescales learned weights to the current budget of the trained features and caps
+// how far each weight may move in one publish. Untrained features keep their current weight.
+func (s *LearningToRankService) guardWeights(learned, baseline map[string]float64, names []string) map[string]float64 {
+	proposed := make(map[string]float64, len(baseline))
+	for name, weight := range baseline {
+		proposed[name] = weight
+	}
+
+	budget, learnedSum := 0.0, 0.0
+	for _, name := range names {
+		budget += baseline[name]
+		learnedSum += learned[name]
+	}
+	if learnedSum <= 0 || budget <= 0 {
+		return proposed
+	}
+
+	for _, name := range names {
+		current := baseline[name]
+		if current <= 0 {
+			continue // Features switched off by configuration stay off
+		}
+
+		weight := learned[name] / learnedSum * budget
+		if change := s.config.LTRMaxWeightChange; change > 0 {
+			weight = math.Max(current*(1-change), math.Min(current*(1+change), weight))
+		}
+		proposed[name] = roundScore(math.Max(weight, ltrMinFeatureWeight))
+	}
+
+	return proposed
+}
+
+// ===============================
+// ROLLOUT & ROLLBACK
+// ===============================
+
+// SetRollout activates a model (or changes the active model's rollout) for a share of subjects.
+// At 100% the learned weights become the pipeline default, including ingestion-time scoring.
+func (s *LearningToRankService) SetRollout(modelID, percent int) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("%w: rollout percent must be between 0 and 100", ErrRolloutRejected)
+	}
+	if !s.config.LTRServingEnabled {
+		return fmt.Errorf("%w: learning-to-rank serving is disabled", ErrRolloutRejected)
+	}
+
+	model, err := s.repo.GetModel(modelID)
+	if err != nil {
+		return err
+	}
+	if model.Status != models.RankingModelCandidate && model.Status != models.RankingModelActive {
+		return fmt.Errorf("%w: model %d is %s and cannot be rolled out", ErrRolloutRejected, modelID, model.Status)
+	}
+
+	// Scaling a fully rolled out model back down: the pipeline default returns to its baseline
+	s.activeMutex.RLock()
+	previous := s.active
+	s.activeMutex.RUnlock()
+	if previous != nil && previous.RolloutPercent >= 100 && percent < 100 {
+		if err := s.ranking.SetWeights(model.BaselineWeights); err != nil {
+			return fmt.Errorf("failed to restore baseline weights: %w", err)
+		}
+	}
+
+	if err := s.repo.ActivateModel(modelID, percent); err != nil {
+		return err
+	}
+
+	model.Status = models.RankingModelActive
+	model.RolloutPercent = percent
+	s.applyModel(model)
+
+	return nil
+}
+
+// Rollback withdraws the active model and restores the weights that were in place before it
+func (s *LearningToRankService) Rollback(reason string) (*models.RankingModel, error) {
+	if reason == "" {
+		reason = "manual rollback"
+	}
+
+	model, err := s.repo.RollbackActiveModel(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.activeMutex.Lock()
+	s.active = nil
+	s.activeMutex.Unlock()
+
+	if err := s.ranking.SetWeights(model.BaselineWeights); err != nil {
+		return nil, fmt.Errorf("failed to restore baseline weights: %w", err)
+	}
+
+	s.logger.Warn("Ranking model rolled back", map[string]interface{}{
+		"model_id": model.ID,
+		"reason":   reason,
+	})
+
+	return model, nil
+}
+
+// ===============================
+// STATUS
+// ===============================
+
+// ListModels returns recent model versions
+func (s *LearningToRankService) ListModels(limit int) ([]*models.RankingModel, error) {
+	return s.repo.GetModels(limit)
+}
+
+// Status returns the serving model, current weights, impression volume and last job outcome
+func (s *LearningToRankService) Status() *models.LTRStatusResponse {
+	status := &models.LTRStatusResponse{
+		Enabled:        s.config.LTREnabled,
+		ServingEnabled: s.config.LTRServingEnabled,
+		CurrentWeights: s.ranking.Weights(),
+	}
+
+	s.activeMutex.RLock()
+	status.ActiveModel = s.active
+	s.activeMutex.RUnlock()
+
+	since := time.Now().AddDate(0, 0, -maxInt(s.config.LTRWindowDays, 1))
+	if stats, err := s.repo.GetImpressionStats(since); err == nil {
+		status.Impressions = stats
+	} else {
+		s.logger.Warn("Failed to load impression stats", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	s.stateMutex.RLock()
+	status.LastJob = s.lastResult
+	if s.lastError != nil {
+		status.LastJobError = s.lastError.Error()
+	}
+	s.stateMutex.RUnlock()
+
+	return status
+}
+
+// recordJobResult stores the outcome of the latest training run
+func (s *LearningToRankService) recordJobResult(result *models.LTRJobResult, err error) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	if result != nil {
+		s.lastResult = result
+	}
+	s.lastError = err
+}
+
+// pruneImpressions deletes impressions past the retention window
+func (s *LearningToRankService) pruneImpressions() {
+	retention := maxInt(s.config.LTRRetentionDays, s.config.LTRWindowDays)
+	deleted, err := s.repo.DeleteImpressionsBefore(time.Now().AddDate(0, 0, -retention))
+	if err != nil {
+		s.logger.Warn("Failed to prune old impressions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Pruned old ranking impressions", map[string]interface{}{
+			"deleted":        deleted,
+			"retention_days": retention,
+		})
+	}
+}
+
+// ===============================
+// HELPER FUNCTIONS
+// ===============================
+
+// pairAccuracy is the weighted share of pairs the pipeline would order correctly with the given weights.
+// Scores use the pipeline's normalisation over applicable features; ties count as half.
+func pairAccuracy(pairs []*rankingPair, names []string, weights map[string]float64) float64 {
+	if len(pairs) == 0 {
+		return 0
+	}
+
+	w := make([]float64, len(names))
+	for j, name := range names {
+		w[j] = weights[name]
+	}
+
+	correct, total := 0.0, 0.0
+	for _, pair := range pairs {
+		preferred, skipped := normalizedScore(pair.preferred, w), normalizedScore(pair.skipped, w)
+		switch {
+		case preferred > skipped:
+			correct += pair.weight
+		case preferred == skipped:
+			correct += pair.weight / 2
+		}
+		total += pair.weight
+	}
+
+	return correct / total
+}
+
+// normalizedScore mirrors RankingPipeline.Explain for a precomputed feature vector
+func normalizedScore(features *impressionFeatures, w []float64) float64 {
+	total, weightSum := 0.0, 0.0
+	for j, value := range features.values {
+		if !features.applicable[j] || w[j] <= 0 {
+			continue
+		}
+		total += value * w[j]
+		weightSum += w[j]
+	}
+	if weightSum == 0 {
+		return 0
+	}
+	return total / weightSum
+}
+
+// requestInHoldout deterministically assigns a response to the holdout set
+func requestInHoldout(requestID string, percent int) bool {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(requestID))
+	return int(hasher.Sum32()%100) < percent
+}
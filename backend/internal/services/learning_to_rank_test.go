@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ltrConfig mirrors the LTR_* defaults
+func ltrConfig() *config.Config {
+	return &config.Config{
+		RankingWeightFreshness:       0.3,
+		RankingWeightIndiaRelevance:  0.2,
+		RankingWeightSourceTrust:     0.2,
+		RankingWeightEngagement:      0.1,
+		RankingWeightPersonalization: 0.1,
+		RankingWeightTopicMatch:      0.1,
+		LTRServingEnabled:            true,
+		LTRMinDwellSeconds:           10,
+		LTRPositionBiasExponent:      1.0,
+		LTRMaxPropensityWeight:       20,
+		LTRLearningRate:              0.05,
+		LTRRegularization:            0.001,
+		LTREpochs:                    20,
+		LTRHoldoutPercent:            20,
+		LTRMaxWeightChange:           0.5,
+	}
+}
+
+// learnedWeights are the weights of the model under test, trusting sources more than the config does
+var learnedWeights = models.RankingWeights{
+	models.RankingFeatureFreshness:       0.2,
+	models.RankingFeatureIndiaRelevance:  0.2,
+	models.RankingFeatureSourceTrust:     0.3,
+	models.RankingFeatureEngagement:      0.1,
+	models.RankingFeaturePersonalization: 0.1,
+	models.RankingFeatureTopicMatch:      0.1,
+}
+
+// newRolloutService returns a service whose repository stores a single candidate model, trained
+// against the weights the returned pipeline starts with
+func newRolloutService(t *testing.T, modelID int) (*LearningToRankService, *RankingPipeline) {
+	t.Helper()
+	cfg := ltrConfig()
+	log := logger.NewLogger()
+	ranking := NewRankingPipeline(cfg, log)
+
+	model := &models.RankingModel{
+		ID:              modelID,
+		Status:          models.RankingModelCandidate,
+		Weights:         learnedWeights,
+		BaselineWeights: ranking.Weights(),
+	}
+	db := &recordingDB{rows: func(query string) driver.Rows {
+		if strings.Contains(query, "ranking_models") {
+			return &rankingModelRows{model: model}
+		}
+		return nil
+	}}
+	sqlxDB := sqlx.NewDb(sql.OpenDB(db), "postgres")
+	t.Cleanup(func() { sqlxDB.Close() })
+
+	return NewLearningToRankService(cfg, log, repository.NewLTRRepository(sqlxDB), nil, ranking), ranking
+}
+
+func TestPartialRolloutServesModelToStableShare(t *testing.T) {
+	const subjects = 10000
+	ltr, ranking := newRolloutService(t, 7)
+	baseline := ranking.Weights()
+
+	// servedTo returns the subjects given the model's weights, checking each answer is stable
+	servedTo := func() map[string]bool {
+		served := make(map[string]bool)
+		for i := 0; i < subjects; i++ {
+			subject := ExperimentSubject{Key: fmt.Sprintf("device:%d", i)}
+			weights := ltr.WeightsFor(subject)
+			if weights != nil {
+				if !reflect.DeepEqual(weights, map[string]float64(learnedWeights)) {
+					t.Fatalf("%s is served %v, want the model's weights", subject.Key, weights)
+				}
+				served[subject.Key] = true
+			}
+			for repeat := 0; repeat < 3; repeat++ {
+				if again := ltr.WeightsFor(subject); (again != nil) != (weights != nil) {
+					t.Fatalf("%s moved in and out of the rollout", subject.Key)
+				}
+			}
+		}
+		return served
+	}
+
+	for _, percent := range []int{20, 50} {
+		previous := servedTo()
+		if err := ltr.SetRollout(7, percent); err != nil {
+			t.Fatal(err)
+		}
+		served := servedTo()
+
+		// Within 1.5 points, about three standard errors at this sample size
+		if share := float64(len(served)) * 100 / subjects; math.Abs(share-float64(percent)) > 1.5 {
+			t.Errorf("%d%% rollout served %.2f%% of subjects", percent, share)
+		}
+		// Widening a rollout keeps everyone who already had the model
+		for key := range previous {
+			if !served[key] {
+				t.Errorf("%s lost the model when the rollout widened to %d%%", key, percent)
+				break
+			}
+		}
+		// Everyone else is ranked with the pipeline's own weights
+		if weights := ranking.Weights(); !reflect.DeepEqual(weights, baseline) {
+			t.Errorf("%d%% rollout changed the pipeline weights to %v", percent, weights)
+		}
+	}
+
+	if weights := ltr.WeightsFor(ExperimentSubject{}); weights != nil {
+		t.Errorf("anonymous subject is served %v", weights)
+	}
+}
+
+func TestRollbackRestoresBaselineWeights(t *testing.T) {
+	for _, percent := range []int{20, 100} {
+		t.Run(fmt.Sprintf("%d%% rollout", percent), func(t *testing.T) {
+			ltr, ranking := newRolloutService(t, 7)
+			baseline := ranking.Weights()
+
+			if err := ltr.SetRollout(7, percent); err != nil {
+				t.Fatal(err)
+			}
+			if weights := ranking.Weights(); percent == 100 && !reflect.DeepEqual(weights, map[string]float64(learnedWeights)) {
+				t.Fatalf("full rollout left the pipeline on %v", weights)
+			}
+
+			model, err := ltr.Rollback("holdout regression")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if model.ID != 7 {
+				t.Errorf("rolled back model %d, want 7", model.ID)
+			}
+
+			if weights := ranking.Weights(); !reflect.DeepEqual(weights, baseline) {
+				t.Errorf("pipeline weights after rollback = %v, want %v", weights, baseline)
+			}
+			for i := 0; i < 1000; i++ {
+				if weights := ltr.WeightsFor(ExperimentSubject{Key: fmt.Sprintf("device:%d", i)}); weights != nil {
+					t.Fatalf("device:%d is still served %v after rollback", i, weights)
+				}
+			}
+		})
+	}
+}
+
+func TestTrainingRaisesPreferredFeatureWeight(t *testing.T) {
+	cfg := ltrConfig()
+	log := logger.NewLogger()
+	ltr := NewLearningToRankService(cfg, log, nil, nil, NewRankingPipeline(cfg, log))
+	baseline := ltr.ranking.Weights()
+
+	names := []string{
+		models.RankingFeatureFreshness,
+		models.RankingFeatureIndiaRelevance,
+		models.RankingFeatureSourceTrust,
+		models.RankingFeatureEngagement,
+	}
+	const preferred = 2 // Source trust
+
+	// Readers read the most trusted article of every response wherever it was shown and skip the
+	// rest; the other features are noise
+	rng := rand.New(rand.NewSource(1))
+	clickedAt := time.Now()
+	var records []models.ImpressionRecord
+	featureSets := make(map[int64]*impressionFeatures)
+	for request := 0; request < 400; request++ {
+		best, bestTrust := len(records), -1.0
+		for position := 1; position <= 5; position++ {
+			features := &impressionFeatures{values: make([]float64, len(names)), applicable: make([]bool, len(names))}
+			for j := range names {
+				features.values[j] = rng.Float64()
+				features.applicable[j] = true
+			}
+			if features.values[preferred] > bestTrust {
+				best, bestTrust = len(records), features.values[preferred]
+			}
+			featureSets[int64(len(records))] = features
+			records = append(records, models.ImpressionRecord{
+				RequestID: fmt.Sprintf("request-%d", request),
+				ArticleID: len(records) + 1,
+				Position:  position,
+			})
+		}
+		records[best].ClickedAt = &clickedAt
+		records[best].DwellSeconds = 60
+	}
+
+	train, holdout, requests := ltr.buildPairs(records, featureSets)
+	if requests != 400 || len(train)+len(holdout) != 400*4 {
+		t.Fatalf("built %d training and %d holdout pairs from %d requests", len(train), len(holdout), requests)
+	}
+
+	learned, err := ltr.fitPairwise(context.Background(), train, names, baseline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposed := ltr.guardWeights(learned, baseline, names)
+
+	for j, name := range names {
+		switch {
+		case j == preferred && proposed[name] <= baseline[name]:
+			t.Errorf("%s weight = %v, want above %v", name, proposed[name], baseline[name])
+		case j != preferred && proposed[name] > baseline[name]:
+			t.Errorf("noise feature %s weight rose from %v to %v", name, baseline[name], proposed[name])
+		}
+	}
+	for _, name := range []string{models.RankingFeaturePersonalization, models.RankingFeatureTopicMatch} {
+		if proposed[name] != baseline[name] {
+			t.Errorf("untrained %s weight moved from %v to %v", name, baseline[name], proposed[name])
+		}
+	}
+
+	if got, was := pairAccuracy(holdout, names, proposed), pairAccuracy(holdout, names, baseline); got <= was {
+		t.Errorf("holdout accuracy = %.4f, want above %.4f with the current weights", got, was)
+	}
+}
+
+// rankingModelRows is a stored ranking model as the repository reads it back
+type rankingModelRows struct {
+	model *models.RankingModel
+	done  bool
+}
+
+func (r *rankingModelRows) Columns() []string {
+	return []string{"id", "status", "weights", "baseline_weights", "rollout_percent"}
+}
+func (r *rankingModelRows) Close() error { return nil }
+
+func (r *rankingModelRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+
+	weights, err := r.model.Weights.Value()
+	if err != nil {
+		return err
+	}
+	baseline, err := r.model.BaselineWeights.Value()
+	if err != nil {
+		return err
+	}
+	dest[0], dest[1], dest[2], dest[3], dest[4] = int64(r.model.ID), r.model.Status, weights, baseline, int64(r.model.RolloutPercent)
+	return nil
+}
@@ -0,0 +1,666 @@
+// internal/services/news_source.go
+// GoNews - News Source Plugin Interface
+// Providers implement NewsSource in their own file and register a factory; the registry
+// applies shared rate limiting and circuit breaking around every fetch
+
+package services
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/lang"
+	"backend/pkg/logger"
+	"backend/pkg/summarize"
+)
+
+// Source fetch errors
+var (
+	ErrSourceNotRegistered = errors.New("news source not registered")
+	ErrSourceDisabled      = errors.New("news source disabled")
+	ErrSourceRateLimited   = errors.New("news source rate limit exceeded")
+	ErrSourceCircuitOpen   = errors.New("news source circuit breaker is open")
+)
+
+// Circuit breaker defaults shared by every source
+const (
+	sourceFailureThreshold = 5
+	sourceResetTimeout     = 5 * time.Minute
+)
+
+// ===============================
+// SOURCE CONTRACT
+// ===============================
+
+// NewsSource is one external news provider
+type NewsSource interface {
+	// Name is the stable identifier used for quotas, api_source and logging
+	Name() string
+	// Enabled reports whether the source is configured (API key present, feature flag on)
+	Enabled() bool
+	Capabilities() SourceCapabilities
+	Quota() SourceQuota
+	// Fetch performs one provider request; rate limiting and circuit breaking are applied by the registry
+	Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error)
+}
+
+// RawNewsSource is implemented by providers whose records can be fetched and converted
+// separately, so the ingestion pipeline can dead-letter one bad record and replay it later
+type RawNewsSource interface {
+	NewsSource
+	// FetchRaw performs one provider request and returns each record undecoded
+	FetchRaw(ctx context.Context, query SourceQuery) ([]json.RawMessage, error)
+	// Convert decodes one record returned by FetchRaw into an article
+	Convert(record json.RawMessage, query SourceQuery) (*models.Article, error)
+}
+
+// SourceCapabilities describes which query dimensions a provider honours
+type SourceCapabilities struct {
+	Categories  bool `json:"categories"`   // Filters by SourceQuery.Category
+	Countries   bool `json:"countries"`    // Filters by SourceQuery.Country
+	Search      bool `json:"search"`       // Accepts free-text SourceQuery.Query
+	FullContent bool `json:"full_content"` // Returns article bodies rather than snippets
+	RequiresKey bool `json:"requires_key"`
+
+	// Languages besides English accepted as SourceQuery.Language
+	Languages []string `json:"languages,omitempty"`
+}
+
+// SupportsLanguage reports whether a provider can fetch news in a language; every provider
+// fetches English
+func (c SourceCapabilities) SupportsLanguage(code string) bool {
+	if code == "" || code == lang.English {
+		return true
+	}
+	for _, supported := range c.Languages {
+		if supported == code {
+			return true
+		}
+	}
+	return false
+}
+
+// SourceQuery is a provider-neutral fetch request
+type SourceQuery struct {
+	Category string `json:"category,omitempty"`
+	Country  string `json:"country,omitempty"` // ISO code, empty for global
+	Query    string `json:"query,omitempty"`
+	Language string `json:"language,omitempty"` // ISO 639-1 code, empty for English
+	Limit    int    `json:"limit,omitempty"`
+}
+
+// languageCode is the query's language, English when unset
+func (q SourceQuery) languageCode() string {
+	if q.Language == "" {
+		return lang.English
+	}
+	return q.Language
+}
+
+// SourceQuota describes a provider's request budget and its place in fetch plans
+type SourceQuota struct {
+	Limit    int           `json:"limit"`    // Requests allowed per window
+	Window   time.Duration `json:"window"`   // Rate limit window
+	Priority int           `json:"priority"` // 1 = tried first in fallback chains
+
+	// Share of an aggregate fetch's limit requested from this source for Indian content;
+	// zero keeps the source out of aggregate fan-out (fallback only)
+	Share float64 `json:"share"`
+	// GlobalShare requests an additional country-less fetch of this share
+	GlobalShare float64 `json:"global_share"`
+}
+
+// DailyLimit scales the windowed limit to a 24 hour budget
+func (q SourceQuota) DailyLimit() int {
+	if q.Window <= 0 || q.Window >= 24*time.Hour {
+		return q.Limit
+	}
+	return q.Limit * int((24*time.Hour)/q.Window)
+}
+
+// SourceDeps are the shared dependencies handed to source factories
+type SourceDeps struct {
+	Config     *config.Config
+	HTTPClient *http.Client
+	Logger     *logger.Logger
+	Ranking    *RankingPipeline
+}
+
+// SourceFactory builds a source from shared dependencies
+type SourceFactory func(deps SourceDeps) NewsSource
+
+var (
+	sourceFactories     = make(map[string]SourceFactory)
+	sourceFactoriesLock sync.Mutex
+)
+
+// RegisterNewsSource makes a provider available to every APIClient; call it from the provider file's init
+func RegisterNewsSource(name string, factory SourceFactory) {
+	sourceFactoriesLock.Lock()
+	defer sourceFactoriesLock.Unlock()
+
+	if _, exists := sourceFactories[name]; exists {
+		panic(fmt.Sprintf("news source %q registered twice", name))
+	}
+	sourceFactories[name] = factory
+}
+
+// ===============================
+// SOURCE REGISTRY
+// ===============================
+
+// RequestCounter tracks API usage for rate limiting
+type RequestCounter struct {
+	Count     int
+	ResetTime time.Time
+	Mutex     sync.Mutex
+}
+
+// CircuitBreaker prevents excessive requests to failing APIs
+type CircuitBreaker struct {
+	FailureCount    int
+	LastFailureTime time.Time
+	State           string // "closed", "open", "half-open"
+	Threshold       int
+	ResetTimeout    time.Duration
+}
+
+// SourceRegistry holds the registered sources and guards their fetches
+type SourceRegistry struct {
+	logger *logger.Logger
+
+	sources     map[string]NewsSource
+	sourceMutex sync.RWMutex
+
+	// Rate limiting
+	requestCounts map[string]*RequestCounter
+	rateMutex     sync.RWMutex
+
+	// Circuit breaker
+	circuitBreakers map[string]*CircuitBreaker
+	cbMutex         sync.RWMutex
+}
+
+// NewSourceRegistry creates an empty registry
+func NewSourceRegistry(log *logger.Logger) *SourceRegistry {
+	return &SourceRegistry{
+		logger:          log,
+		sources:         make(map[string]NewsSource),
+		requestCounts:   make(map[string]*RequestCounter),
+		circuitBreakers: make(map[string]*CircuitBreaker),
+	}
+}
+
+// newRegisteredSources builds a registry from every registered source factory
+func newRegisteredSources(deps SourceDeps) *SourceRegistry {
+	registry := NewSourceRegistry(deps.Logger)
+
+	sourceFactoriesLock.Lock()
+	defer sourceFactoriesLock.Unlock()
+
+	for _, factory := range sourceFactories {
+		registry.Register(factory(deps))
+	}
+
+	return registry
+}
+
+// Register adds or replaces a source
+func (r *SourceRegistry) Register(source NewsSource) {
+	name := source.Name()
+
+	r.sourceMutex.Lock()
+	r.sources[name] = source
+	r.sourceMutex.Unlock()
+
+	r.cbMutex.Lock()
+	if _, exists := r.circuitBreakers[name]; !exists {
+		r.circuitBreakers[name] = &CircuitBreaker{
+			State:        "closed",
+			Threshold:    sourceFailureThreshold,
+			ResetTimeout: sourceResetTimeout,
+		}
+	}
+	r.cbMutex.Unlock()
+}
+
+// Get returns a source by name
+func (r *SourceRegistry) Get(name string) (NewsSource, bool) {
+	r.sourceMutex.RLock()
+	defer r.sourceMutex.RUnlock()
+
+	source, ok := r.sources[name]
+	return source, ok
+}
+
+// All returns every registered source ordered by priority, then name
+func (r *SourceRegistry) All() []NewsSource {
+	r.sourceMutex.RLock()
+	sources := make([]NewsSource, 0, len(r.sources))
+	for _, source := range r.sources {
+		sources = append(sources, source)
+	}
+	r.sourceMutex.RUnlock()
+
+	sort.Slice(sources, func(i, j int) bool {
+		pi, pj := sources[i].Quota().Priority, sources[j].Quota().Priority
+		if pi != pj {
+			return pi < pj
+		}
+		return sources[i].Name() < sources[j].Name()
+	})
+
+	return sources
+}
+
+// Enabled returns the configured sources in priority order
+func (r *SourceRegistry) Enabled() []NewsSource {
+	var enabled []NewsSource
+	for _, source := range r.All() {
+		if source.Enabled() {
+			enabled = append(enabled, source)
+		}
+	}
+	return enabled
+}
+
+// Fetch runs one source fetch behind the shared rate limiter and circuit breaker
+func (r *SourceRegistry) Fetch(ctx context.Context, name string, query SourceQuery) ([]*models.Article, error) {
+	source, err := r.acquire(name)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	articles, err := source.Fetch(ctx, query)
+	if err := r.complete(name, query, len(articles), startTime, err); err != nil {
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+// FetchRaw runs one undecoded fetch from a RawNewsSource behind the same guards as Fetch
+func (r *SourceRegistry) FetchRaw(ctx context.Context, name string, query SourceQuery) ([]json.RawMessage, error) {
+	source, err := r.acquire(name)
+	if err != nil {
+		return nil, err
+	}
+	rawSource, ok := source.(RawNewsSource)
+	if !ok {
+		return nil, fmt.Errorf("news source %s does not expose raw records", name)
+	}
+
+	startTime := time.Now()
+	records, err := rawSource.FetchRaw(ctx, query)
+	if err := r.complete(name, query, len(records), startTime, err); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// acquire checks that a source may be called now and counts the request against its quota
+func (r *SourceRegistry) acquire(name string) (NewsSource, error) {
+	source, ok := r.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSourceNotRegistered, name)
+	}
+	if !source.Enabled() {
+		return nil, fmt.Errorf("%w: %s", ErrSourceDisabled, name)
+	}
+
+	quota := source.Quota()
+	if !r.checkRateLimit(name, quota.Limit, quota.Window) {
+		return nil, fmt.Errorf("%w: %s", ErrSourceRateLimited, name)
+	}
+	if !r.isCircuitBreakerClosed(name) {
+		return nil, fmt.Errorf("%w: %s", ErrSourceCircuitOpen, name)
+	}
+
+	r.incrementRequestCount(name)
+	return source, nil
+}
+
+// complete feeds a fetch outcome into the circuit breaker
+func (r *SourceRegistry) complete(name string, query SourceQuery, count int, startTime time.Time, err error) error {
+	if err != nil {
+		r.recordCircuitBreakerFailure(name)
+		return fmt.Errorf("%s fetch failed: %w", name, err)
+	}
+
+	r.resetCircuitBreaker(name)
+
+	r.logger.Info("Fetched from news source", map[string]interface{}{
+		"source":   name,
+		"category": query.Category,
+		"country":  query.Country,
+		"language": query.languageCode(),
+		"count":    count,
+		"duration": time.Since(startTime),
+	})
+
+	return nil
+}
+
+// Remaining returns the requests left in the current window for each source
+func (r *SourceRegistry) Remaining() map[string]int {
+	remaining := make(map[string]int)
+
+	r.rateMutex.RLock()
+	defer r.rateMutex.RUnlock()
+
+	for _, source := range r.All() {
+		name := source.Name()
+		limit := source.Quota().Limit
+
+		counter, exists := r.requestCounts[name]
+		if !exists {
+			remaining[name] = limit
+			continue
+		}
+
+		counter.Mutex.Lock()
+		left := limit - counter.Count
+		if time.Now().After(counter.ResetTime) {
+			left = limit
+		}
+		counter.Mutex.Unlock()
+
+		if left < 0 {
+			left = 0
+		}
+		remaining[name] = left
+	}
+
+	return remaining
+}
+
+// Status returns configuration, usage and circuit breaker state for each source
+func (r *SourceRegistry) Status() map[string]interface{} {
+	remaining := r.Remaining()
+	status := make(map[string]interface{})
+
+	for _, source := range r.All() {
+		name := source.Name()
+		quota := source.Quota()
+
+		sourceStatus := map[string]interface{}{
+			"enabled":         source.Enabled(),
+			"priority":        quota.Priority,
+			"capabilities":    source.Capabilities(),
+			"limit":           quota.Limit,
+			"window":          quota.Window.String(),
+			"remaining":       remaining[name],
+			"requests_made":   0,
+			"rate_limited":    remaining[name] == 0,
+			"circuit_breaker": "closed",
+		}
+
+		r.rateMutex.RLock()
+		if counter, exists := r.requestCounts[name]; exists {
+			counter.Mutex.Lock()
+			if time.Now().Before(counter.ResetTime) {
+				sourceStatus["requests_made"] = counter.Count
+			}
+			counter.Mutex.Unlock()
+		}
+		r.rateMutex.RUnlock()
+
+		r.cbMutex.RLock()
+		if cb, exists := r.circuitBreakers[name]; exists {
+			sourceStatus["circuit_breaker"] = cb.State
+			sourceStatus["failure_count"] = cb.FailureCount
+		}
+		r.cbMutex.RUnlock()
+
+		status[name] = sourceStatus
+	}
+
+	return status
+}
+
+// ===============================
+// RATE LIMITING & CIRCUIT BREAKER
+// ===============================
+
+// checkRateLimit checks if the source is within its rate limit
+func (r *SourceRegistry) checkRateLimit(source string, limit int, window time.Duration) bool {
+	r.rateMutex.Lock()
+	defer r.rateMutex.Unlock()
+
+	counter, exists := r.requestCounts[source]
+	if !exists {
+		counter = &RequestCounter{
+			Count:     0,
+			ResetTime: time.Now().Add(window),
+		}
+		r.requestCounts[source] = counter
+	}
+
+	counter.Mutex.Lock()
+	defer counter.Mutex.Unlock()
+
+	// Reset counter if window has passed
+	if time.Now().After(counter.ResetTime) {
+		counter.Count = 0
+		counter.ResetTime = time.Now().Add(window)
+	}
+
+	return counter.Count < limit
+}
+
+// incrementRequestCount increments the request count for a source
+func (r *SourceRegistry) incrementRequestCount(source string) {
+	r.rateMutex.Lock()
+	defer r.rateMutex.Unlock()
+
+	if counter, exists := r.requestCounts[source]; exists {
+		counter.Mutex.Lock()
+		counter.Count++
+		counter.Mutex.Unlock()
+	}
+}
+
+// isCircuitBreakerClosed checks if the circuit breaker is closed (allowing requests)
+func (r *SourceRegistry) isCircuitBreakerClosed(source string) bool {
+	r.cbMutex.Lock()
+	defer r.cbMutex.Unlock()
+
+	cb, exists := r.circuitBreakers[source]
+	if !exists {
+		return true
+	}
+
+	// Check if we should reset from open to half-open
+	if cb.State == "open" && time.Since(cb.LastFailureTime) > cb.ResetTimeout {
+		cb.State = "half-open"
+		cb.FailureCount = 0
+	}
+
+	return cb.State != "open"
+}
+
+// recordCircuitBreakerFailure records a failure for the circuit breaker
+func (r *SourceRegistry) recordCircuitBreakerFailure(source string) {
+	r.cbMutex.Lock()
+	defer r.cbMutex.Unlock()
+
+	cb, exists := r.circuitBreakers[source]
+	if !exists {
+		return
+	}
+
+	cb.FailureCount++
+	cb.LastFailureTime = time.Now()
+
+	if cb.FailureCount >= cb.Threshold {
+		cb.State = "open"
+		r.logger.Warn("Circuit breaker opened", map[string]interface{}{
+			"source":   source,
+			"failures": cb.FailureCount,
+		})
+	}
+}
+
+// resetCircuitBreaker resets the circuit breaker on successful request
+func (r *SourceRegistry) resetCircuitBreaker(source string) {
+	r.cbMutex.Lock()
+	defer r.cbMutex.Unlock()
+
+	cb, exists := r.circuitBreakers[source]
+	if !exists {
+		return
+	}
+
+	if cb.State == "half-open" {
+		cb.State = "closed"
+		cb.FailureCount = 0
+		r.logger.Info("Circuit breaker closed", map[string]interface{}{
+			"source": source,
+		})
+	}
+}
+
+// ===============================
+// SHARED SOURCE HELPERS
+// ===============================
+
+// getSourceJSON performs a GET and decodes a 200 response into out
+func getSourceJSON(ctx context.Context, client *http.Client, requestURL string, headers map[string]string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// convertRawRecords converts every record from FetchRaw, skipping any that fail to decode;
+// the ingestion pipeline converts records itself so failures can be dead-lettered instead
+func convertRawRecords(source RawNewsSource, records []json.RawMessage, query SourceQuery, log *logger.Logger) []*models.Article {
+	articles := make([]*models.Article, 0, len(records))
+	for _, record := range records {
+		article, err := source.Convert(record, query)
+		if err != nil {
+			log.Warn("Skipping undecodable news source record", map[string]interface{}{
+				"source": source.Name(),
+				"error":  err.Error(),
+			})
+			continue
+		}
+		articles = append(articles, article)
+	}
+	return articles
+}
+
+// isIndiaRelatedContent determines if content is India-related
+func isIndiaRelatedContent(title, description string, keywords, countries []string) bool {
+	// Check countries
+	for _, country := range countries {
+		if strings.ToLower(country) == "in" || strings.ToLower(country) == "india" {
+			return true
+		}
+	}
+
+	// Check keywords
+	for _, keyword := range keywords {
+		if isIndianKeyword(keyword) {
+			return true
+		}
+	}
+
+	// Check title and description for Indian terms
+	content := strings.ToLower(title + " " + description)
+	indianTerms := []string{
+		"india", "indian", "delhi", "mumbai", "bangalore", "chennai", "kolkata", "hyderabad",
+		"rupee", "modi", "bjp", "congress", "bollywood", "cricket", "ipl", "bcci",
+		"sensex", "nifty", "rbi", "isro", "drdo", "aiims", "iit", "neet",
+		"karnataka", "maharashtra", "tamil nadu", "west bengal", "rajasthan", "gujarat",
+		"punjab", "haryana", "kerala", "odisha", "bihar", "jharkhand", "goa",
+	}
+
+	for _, term := range indianTerms {
+		if strings.Contains(content, term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIndianKeyword checks if a keyword is Indian
+func isIndianKeyword(keyword string) bool {
+	keyword = strings.ToLower(keyword)
+	indianKeywords := []string{
+		"india", "indian", "delhi", "mumbai", "bangalore", "chennai", "kolkata",
+		"bollywood", "cricket", "ipl", "rupee", "modi", "bjp", "congress",
+		"sensex", "nifty", "rbi", "isro", "karnataka", "maharashtra",
+	}
+
+	for _, ik := range indianKeywords {
+		if strings.Contains(keyword, ik) {
+			return true
+		}
+	}
+	return false
+}
+
+// calculateWordCount calculates word count from text content in any script
+func calculateWordCount(content string) int {
+	return lang.WordCount(content)
+}
+
+// calculateReadingTime calculates reading time in minutes (assuming 200 words per minute)
+func calculateReadingTime(wordCount int) int {
+	readingTime := wordCount / 200 // 200 words per minute average
+	if readingTime == 0 {
+		return 1 // Minimum 1 minute
+	}
+	return readingTime
+}
+
+// summarizeArticle sets the article's extractive summary of a text, flagging weak ones
+func summarizeArticle(article *models.Article, text string) {
+	summary := summarize.Summarize(article.Title, text)
+	article.Summary = nil
+	if summary.Text != "" {
+		article.Summary = &summary.Text
+	}
+	article.SummaryLowQuality = summary.LowQuality
+}
+
+// hashURL creates a hash from URL for external ID
+func hashURL(url string) string {
+	hash := md5.Sum([]byte(url))
+	return fmt.Sprintf("%x", hash)[:8] // Use first 8 characters
+}
@@ -0,0 +1,97 @@
+// internal/services/notification_service.go
+// GoNews - Notification Service
+// Sends in-app notifications to readers who opted in, once per event and within rate limits
+
+package services
+
+import (
+	"fmt"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// breakingNewsSetting is the notification_settings key readers opt into breaking news with
+const breakingNewsSetting = "breaking_news"
+
+// NotificationService fans notifications out to readers and serves their inboxes
+type NotificationService struct {
+	config *config.Config
+	logger *logger.Logger
+	repo   *repository.NotificationRepository
+}
+
+// NewNotificationService creates the notification service
+func NewNotificationService(cfg *config.Config, log *logger.Logger, repo *repository.NotificationRepository) *NotificationService {
+	return &NotificationService{
+		config: cfg,
+		logger: log,
+		repo:   repo,
+	}
+}
+
+// NotifyBreaking notifies readers who opted into breaking news about a flagged story and returns
+// how many were notified. Notifications are keyed by the story's event, so a reader is told about
+// an event once however many stories it is split across, and readers at their hourly or daily
+// limit are skipped.
+func (s *NotificationService) NotifyBreaking(story *models.BreakingStory) (int, error) {
+	body := fmt.Sprintf("%d sources are reporting this story", story.SourceCount)
+	if story.SourceCount < 2 {
+		body = "Developing story"
+	}
+
+	notification := &models.Notification{
+		Kind:      models.NotificationKindBreakingNews,
+		StoryID:   &story.ID,
+		ArticleID: story.LeadArticleID,
+		Title:     "Breaking: " + story.Headline,
+		Body:      &body,
+	}
+	dedupeKey := fmt.Sprintf("breaking:%d", story.EventID)
+
+	sent, err := s.repo.FanOut(notification, breakingNewsSetting, dedupeKey,
+		maxInt(1, s.config.BreakingUserMaxPerHour), maxInt(1, s.config.BreakingUserMaxPerDay))
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info("Breaking news notifications sent", map[string]interface{}{
+		"story_id":   story.ID,
+		"event_id":   story.EventID,
+		"recipients": sent,
+	})
+	return sent, nil
+}
+
+// Inbox returns a page of a reader's notifications with their unread count
+func (s *NotificationService) Inbox(userID uuid.UUID, unreadOnly bool, page, limit int) (*models.NotificationInbox, error) {
+	notifications, err := s.repo.ListNotifications(userID, unreadOnly, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.repo.CountUnread(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NotificationInbox{
+		Notifications: notifications,
+		Unread:        unread,
+		Page:          page,
+		Limit:         limit,
+	}, nil
+}
+
+// MarkRead marks one of a reader's notifications as read
+func (s *NotificationService) MarkRead(userID uuid.UUID, id int64) error {
+	return s.repo.MarkRead(userID, id)
+}
+
+// MarkAllRead marks all of a reader's notifications as read
+func (s *NotificationService) MarkAllRead(userID uuid.UUID) (int, error) {
+	return s.repo.MarkAllRead(userID)
+}
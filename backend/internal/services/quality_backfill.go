@@ -0,0 +1,63 @@
+// internal/services/quality_backfill.go
+// GoNews - Quality Backfill
+// Writes clickbait, filler and explicit content scores for articles stored before scoring existed
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/models"
+)
+
+// defaultQualityBackfillBatch is how many articles are scored per transaction
+const defaultQualityBackfillBatch = 500
+
+// BackfillQuality scores every stored article without a quality score, in ID order, until
+// none are left or the context is cancelled
+func (s *NewsAggregatorService) BackfillQuality(ctx context.Context, batchSize int) (*models.QualityBackfillReport, error) {
+	startTime := time.Now()
+	if batchSize <= 0 {
+		batchSize = defaultQualityBackfillBatch
+	}
+
+	report := &models.QualityBackfillReport{}
+	afterID := 0
+	for ctx.Err() == nil {
+		candidates, err := s.articleRepo.GetArticlesMissingQuality(afterID, batchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		articles := make([]*models.Article, len(candidates))
+		for i, candidate := range candidates {
+			articles[i] = &models.Article{ID: candidate.ID, Title: candidate.Title}
+			scoreArticleQuality(articles[i], cleanFeedText(derefString(candidate.Description))+"\n"+cleanFeedText(derefString(candidate.Content)))
+			if *articles[i].QualityScore < s.cfg.ContentQualityLowBelow {
+				report.LowQuality++
+			}
+			if articles[i].IsExplicit {
+				report.Explicit++
+			}
+			afterID = candidate.ID
+		}
+
+		if err := s.articleRepo.UpdateArticleQuality(articles); err != nil {
+			return report, err
+		}
+		report.Articles += len(articles)
+
+		s.logger.Info("Quality backfill batch stored", map[string]interface{}{
+			"articles": len(articles),
+			"total":    report.Articles,
+			"last_id":  afterID,
+		})
+	}
+
+	report.Duration = time.Since(startTime)
+	return report, ctx.Err()
+}
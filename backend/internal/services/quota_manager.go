@@ -28,6 +28,9 @@ type QuotaManager struct {
 	quotaUsage map[string]*QuotaTracker
 	quotaMutex sync.RWMutex
 
+	// Registered news sources; nil falls back to the static priority list
+	sources *SourceRegistry
+
 	// IST timezone
 	istLocation *time.Location
 
@@ -184,23 +187,60 @@ func (qm *QuotaManager) RequestQuota(ctx context.Context, source models.APISourc
 	}
 }
 
-// RequestQuotaIntelligent requests quota with intelligent fallback
-func (qm *QuotaManager) RequestQuotaIntelligent(ctx context.Context, category string, isIndian bool) (*QuotaResponse, error) {
-	// Try primary source first (RapidAPI)
-	if response, err := qm.RequestQuota(ctx, models.APISourceRapidAPI, category, isIndian); err == nil && response.Approved {
-		return response, nil
+// SetSources switches allocation to the registered news sources and adds trackers for any
+// source the static configuration does not know about
+func (qm *QuotaManager) SetSources(sources *SourceRegistry) {
+	qm.quotaMutex.Lock()
+	defer qm.quotaMutex.Unlock()
+
+	qm.sources = sources
+
+	for _, source := range sources.All() {
+		name := source.Name()
+		if _, exists := qm.quotaUsage[name]; exists {
+			continue
+		}
+
+		quota := source.Quota()
+		hourlyLimit := 0
+		if quota.Window > 0 && quota.Window <= time.Hour {
+			hourlyLimit = quota.Limit
+		}
+
+		qm.quotaUsage[name] = &QuotaTracker{
+			Source:      models.APISourceType(name),
+			DailyLimit:  quota.DailyLimit(),
+			HourlyLimit: hourlyLimit,
+			ResetTime:   time.Now().Add(24 * time.Hour),
+			HourlyReset: time.Now().Add(1 * time.Hour),
+		}
+	}
+}
+
+// sourceOrder returns the sources to try, highest priority first
+func (qm *QuotaManager) sourceOrder() []models.APISourceType {
+	qm.quotaMutex.RLock()
+	sources := qm.sources
+	qm.quotaMutex.RUnlock()
+
+	if sources == nil {
+		return models.GetAPISourcePriority()
 	}
 
-	// Fallback chain: NewsData -> GNews -> Mediastack
-	fallbackSources := []models.APISourceType{
-		models.APISourceNewsData,
-		models.APISourceGNews,
-		models.APISourceMediastack,
+	var order []models.APISourceType
+	for _, source := range sources.Enabled() {
+		order = append(order, models.APISourceType(source.Name()))
 	}
+	return order
+}
 
-	for _, source := range fallbackSources {
+// RequestQuotaIntelligent requests quota from each source in priority order; anything after the first is a fallback
+func (qm *QuotaManager) RequestQuotaIntelligent(ctx context.Context, category string, isIndian bool) (*QuotaResponse, error) {
+	for i, source := range qm.sourceOrder() {
 		if response, err := qm.RequestQuota(ctx, source, category, isIndian); err == nil && response.Approved {
-			qm.recordFallbackUsage(source)
+			if i > 0 {
+				qm.recordFallbackUsage(source)
+			}
 			return response, nil
 		}
 	}
@@ -281,16 +321,14 @@ func (qm *QuotaManager) processQuotaRequest(request QuotaRequest) QuotaResponse
 		}
 	}
 
-	// Check hourly quota (for RapidAPI)
-	if request.Source == models.APISourceRapidAPI {
-		if tracker.HourlyUsed >= tracker.HourlyLimit {
-			waitTime := tracker.HourlyReset.Sub(time.Now())
-			return QuotaResponse{
-				Approved: false,
-				Source:   request.Source,
-				Reason:   "RapidAPI hourly quota exceeded",
-				WaitTime: waitTime,
-			}
+	// Check hourly quota (for sources whose quota window is an hour or less)
+	if tracker.HourlyLimit > 0 && tracker.HourlyUsed >= tracker.HourlyLimit {
+		waitTime := tracker.HourlyReset.Sub(time.Now())
+		return QuotaResponse{
+			Approved: false,
+			Source:   request.Source,
+			Reason:   fmt.Sprintf("%s hourly quota exceeded (%d/%d)", request.Source, tracker.HourlyUsed, tracker.HourlyLimit),
+			WaitTime: waitTime,
 		}
 	}
 
@@ -647,19 +685,14 @@ func (qm *QuotaManager) GetCategoryUsage() map[string]CategoryQuota {
 
 // getPriority determines request priority based on source, category, and content type
 func (qm *QuotaManager) getPriority(source models.APISourceType, category string, isIndian bool) int {
-	// Base priority by source
-	var basePriority int
-	switch source {
-	case models.APISourceRapidAPI:
-		basePriority = 1
-	case models.APISourceNewsData:
-		basePriority = 2
-	case models.APISourceGNews:
-		basePriority = 3
-	case models.APISourceMediastack:
-		basePriority = 4
-	default:
-		basePriority = 5
+	// Base priority by position in the source order
+	order := qm.sourceOrder()
+	basePriority := len(order) + 1
+	for i, candidate := range order {
+		if candidate == source {
+			basePriority = i + 1
+			break
+		}
 	}
 
 	// Boost priority for Indian content
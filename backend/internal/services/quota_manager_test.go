@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+// quotaTestSource is a news source with a fixed quota
+type quotaTestSource struct {
+	name  string
+	quota SourceQuota
+}
+
+func (s quotaTestSource) Name() string                     { return s.name }
+func (s quotaTestSource) Enabled() bool                    { return true }
+func (s quotaTestSource) Capabilities() SourceCapabilities { return SourceCapabilities{} }
+func (s quotaTestSource) Quota() SourceQuota               { return s.quota }
+func (s quotaTestSource) Fetch(context.Context, SourceQuery) ([]*models.Article, error) {
+	return nil, nil
+}
+
+func TestProcessQuotaRequestEnforcesHourlyLimits(t *testing.T) {
+	// Nothing listens on port 1, so category and hourly allocation usage read as zero
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer rdb.Close()
+
+	log := logger.NewLogger()
+	qm := &QuotaManager{
+		config:         &config.Config{},
+		db:             sqlx.NewDb(sql.OpenDB(&recordingDB{}), "postgres"),
+		redis:          rdb,
+		logger:         log,
+		quotaUsage:     make(map[string]*QuotaTracker),
+		istLocation:    time.FixedZone("IST", 5*3600+30*60),
+		hourlyQuotas:   map[int]int{},
+		categoryQuotas: map[string]int{},
+		stats:          &QuotaStats{},
+	}
+
+	registry := NewSourceRegistry(log)
+	registry.Register(quotaTestSource{name: "feeds", quota: SourceQuota{Limit: 3, Window: time.Hour}})
+	registry.Register(quotaTestSource{name: "wire", quota: SourceQuota{Limit: 100, Window: 24 * time.Hour}})
+	qm.SetSources(registry)
+
+	tests := []struct {
+		source   string
+		approved int
+	}{
+		{"feeds", 3},
+		// A daily quota has no hourly limit to run into
+		{"wire", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				response := qm.processQuotaRequest(QuotaRequest{
+					Source:      models.APISourceType(tt.source),
+					Category:    "general",
+					RequestedAt: time.Now(),
+				})
+
+				switch {
+				case i < tt.approved && !response.Approved:
+					t.Fatalf("request %d denied: %s", i+1, response.Reason)
+				case i >= tt.approved && response.Approved:
+					t.Fatalf("request %d approved past the hourly limit", i+1)
+				case i >= tt.approved && (!strings.Contains(response.Reason, "hourly quota exceeded") || response.WaitTime <= 0):
+					t.Errorf("request %d denied with %q, wait %v; want the hourly limit and a wait", i+1, response.Reason, response.WaitTime)
+				}
+			}
+		})
+	}
+
+	// The next hour's window admits requests again
+	qm.quotaUsage["feeds"].HourlyReset = time.Now().Add(-time.Second)
+	qm.resetHourlyQuotas(time.Now())
+	if response := qm.processQuotaRequest(QuotaRequest{Source: "feeds", Category: "general", RequestedAt: time.Now()}); !response.Approved {
+		t.Errorf("request after the hourly reset denied: %s", response.Reason)
+	}
+}
@@ -0,0 +1,753 @@
+// internal/services/ranking_pipeline.go
+// GoNews - Unified Ranking Pipeline
+// Named feature extractors combined with configurable weights, with per-article score breakdowns
+
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/pkg/logger"
+)
+
+// ===============================
+// PIPELINE TYPES
+// ===============================
+
+// RankingFeature extracts a single normalised (0.0 to 1.0) ranking signal from an article.
+// Extract reports false when the feature has nothing to say about the article in this context,
+// in which case its weight is left out of the normalisation instead of dragging the score down.
+type RankingFeature interface {
+	Name() string
+	Description() string
+	Extract(article *models.Article, rctx *RankingContext) (value float64, applicable bool, detail string)
+}
+
+// RankingContext carries request-level inputs shared by all feature extractors
+type RankingContext struct {
+	Now                  time.Time
+	Category             string
+	Profile              *UserFilterProfile
+	PersonalizationLevel PersonalizationLevel
+
+	// Weights overrides the pipeline weights for this request (e.g. an experiment variant);
+	// features missing from the map keep their configured weight
+	Weights map[string]float64
+}
+
+// ArticleTrends looks up an article's current read and mention velocity
+type ArticleTrends interface {
+	ArticleTrend(articleID int) (*models.Trend, bool)
+}
+
+// RankingPipeline scores articles from a fixed set of named features and configurable weights
+type RankingPipeline struct {
+	config   *config.Config
+	logger   *logger.Logger
+	features []RankingFeature
+	weights  map[string]float64
+	trends   ArticleTrends // Optional; without it only articles carrying a trend count as trending
+	mutex    sync.RWMutex
+}
+
+// NewRankingPipeline creates the ranking pipeline with weights loaded from config
+func NewRankingPipeline(cfg *config.Config, log *logger.Logger) *RankingPipeline {
+	pipeline := &RankingPipeline{
+		config: cfg,
+		logger: log,
+		weights: map[string]float64{
+			models.RankingFeatureFreshness:       cfg.RankingWeightFreshness,
+			models.RankingFeatureIndiaRelevance:  cfg.RankingWeightIndiaRelevance,
+			models.RankingFeatureSourceTrust:     cfg.RankingWeightSourceTrust,
+			models.RankingFeatureEngagement:      cfg.RankingWeightEngagement,
+			models.RankingFeaturePersonalization: cfg.RankingWeightPersonalization,
+			models.RankingFeatureTopicMatch:      cfg.RankingWeightTopicMatch,
+		},
+	}
+	pipeline.features = []RankingFeature{
+		&freshnessFeature{halfLifeHours: cfg.RankingFreshnessHalfLifeHours},
+		&indiaRelevanceFeature{},
+		&sourceTrustFeature{defaultTrust: cfg.RankingDefaultSourceTrust},
+		&engagementFeature{viewsCap: cfg.RankingEngagementViewsCap, isTrending: pipeline.IsTrending},
+		&personalizationFeature{isTrending: pipeline.IsTrending},
+		&topicMatchFeature{},
+	}
+
+	return pipeline
+}
+
+// SetTrends makes articles bursting in the trending service count as trending when scored
+func (p *RankingPipeline) SetTrends(trends ArticleTrends) {
+	p.mutex.Lock()
+	p.trends = trends
+	p.mutex.Unlock()
+}
+
+// IsTrending reports whether an article's reads and mentions are bursting
+func (p *RankingPipeline) IsTrending(article *models.Article) bool {
+	if article.IsTrending() {
+		return true
+	}
+
+	p.mutex.RLock()
+	trends := p.trends
+	p.mutex.RUnlock()
+
+	if trends == nil || article.ID == 0 {
+		return false
+	}
+	trend, ok := trends.ArticleTrend(article.ID)
+	return ok && trend.Bursting
+}
+
+// ===============================
+// SCORING
+// ===============================
+
+// Score returns the combined ranking score for an article (0.0 to 1.0)
+func (p *RankingPipeline) Score(article *models.Article, rctx *RankingContext) float64 {
+	return p.Explain(article, rctx).Score
+}
+
+// Explain scores an article and returns every feature's value, weight and contribution
+func (p *RankingPipeline) Explain(article *models.Article, rctx *RankingContext) *models.ScoreBreakdown {
+	rctx = normalizeRankingContext(rctx)
+
+	p.mutex.RLock()
+	weights := p.weights
+	p.mutex.RUnlock()
+
+	if len(rctx.Weights) > 0 {
+		merged := make(map[string]float64, len(weights))
+		for name, weight := range weights {
+			merged[name] = weight
+		}
+		for name, weight := range rctx.Weights {
+			if weight >= 0 {
+				merged[name] = weight
+			}
+		}
+		weights = merged
+	}
+
+	breakdown := &models.ScoreBreakdown{
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Source:    article.Source,
+		Stored:    article.RelevanceScore,
+		Features:  make([]models.FeatureScore, 0, len(p.features)),
+	}
+
+	weightSum := 0.0
+	for _, feature := range p.features {
+		value, applicable, detail := feature.Extract(article, rctx)
+		value = math.Max(0, math.Min(1, value))
+		weight := weights[feature.Name()]
+
+		breakdown.Features = append(breakdown.Features, models.FeatureScore{
+			Name:       feature.Name(),
+			Value:      roundScore(value),
+			Weight:     weight,
+			Applicable: applicable,
+			Detail:     detail,
+		})
+
+		if applicable && weight > 0 {
+			weightSum += weight
+		}
+	}
+
+	if weightSum == 0 {
+		return breakdown
+	}
+
+	total := 0.0
+	for i := range breakdown.Features {
+		fs := &breakdown.Features[i]
+		if !fs.Applicable || fs.Weight <= 0 {
+			continue
+		}
+		fs.Contribution = roundScore(fs.Value * fs.Weight / weightSum)
+		total += fs.Value * fs.Weight / weightSum
+	}
+	breakdown.Score = roundScore(total)
+
+	return breakdown
+}
+
+// FeatureValue returns a single named feature's value, or 0 when it does not apply
+func (p *RankingPipeline) FeatureValue(name string, article *models.Article, rctx *RankingContext) float64 {
+	rctx = normalizeRankingContext(rctx)
+	for _, feature := range p.features {
+		if feature.Name() != name {
+			continue
+		}
+		value, applicable, _ := feature.Extract(article, rctx)
+		if !applicable {
+			return 0
+		}
+		return math.Max(0, math.Min(1, value))
+	}
+	return 0
+}
+
+// Rank sorts articles by pipeline score (highest first) and returns their breakdowns in the same order
+func (p *RankingPipeline) Rank(articles []*models.Article, rctx *RankingContext) ([]*models.Article, []*models.ScoreBreakdown) {
+	rctx = normalizeRankingContext(rctx)
+
+	type scoredArticle struct {
+		article   *models.Article
+		breakdown *models.ScoreBreakdown
+	}
+
+	scored := make([]scoredArticle, 0, len(articles))
+	for _, article := range articles {
+		if article == nil {
+			continue
+		}
+		scored = append(scored, scoredArticle{article, p.Explain(article, rctx)})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].breakdown.Score > scored[j].breakdown.Score
+	})
+
+	ranked := make([]*models.Article, len(scored))
+	breakdowns := make([]*models.ScoreBreakdown, len(scored))
+	for i, s := range scored {
+		ranked[i] = s.article
+		breakdowns[i] = s.breakdown
+	}
+
+	return ranked, breakdowns
+}
+
+// ===============================
+// WEIGHTS
+// ===============================
+
+// Features returns every registered feature with its description and current weight
+func (p *RankingPipeline) Features() []models.RankingFeatureInfo {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	infos := make([]models.RankingFeatureInfo, len(p.features))
+	for i, feature := range p.features {
+		infos[i] = models.RankingFeatureInfo{
+			Name:        feature.Name(),
+			Description: feature.Description(),
+			Weight:      p.weights[feature.Name()],
+		}
+	}
+	return infos
+}
+
+// Weights returns a copy of the current feature weights
+func (p *RankingPipeline) Weights() map[string]float64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	weights := make(map[string]float64, len(p.weights))
+	for name, weight := range p.weights {
+		weights[name] = weight
+	}
+	return weights
+}
+
+// SetWeights replaces the feature weights; unknown features and negative weights are rejected
+func (p *RankingPipeline) SetWeights(weights map[string]float64) error {
+	known := make(map[string]bool, len(p.features))
+	for _, feature := range p.features {
+		known[feature.Name()] = true
+	}
+
+	next := make(map[string]float64, len(p.features))
+	for name, weight := range weights {
+		if !known[name] {
+			return fmt.Errorf("unknown ranking feature: %s", name)
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return fmt.Errorf("invalid weight for ranking feature %s: %v", name, weight)
+		}
+		next[name] = weight
+	}
+
+	p.mutex.Lock()
+	p.weights = next
+	p.mutex.Unlock()
+
+	p.logger.Info("Ranking weights updated", map[string]interface{}{
+		"weights": next,
+	})
+
+	return nil
+}
+
+// PersonalizationScore returns the raw personalization score used by the filter service thresholds
+func (p *RankingPipeline) PersonalizationScore(article *models.Article, profile *UserFilterProfile, level PersonalizationLevel) float64 {
+	return p.FeatureValue(models.RankingFeaturePersonalization, article, &RankingContext{
+		Profile:              profile,
+		PersonalizationLevel: level,
+	})
+}
+
+// normalizeRankingContext fills in defaults so extractors never see a nil context
+func normalizeRankingContext(rctx *RankingContext) *RankingContext {
+	if rctx == nil {
+		return &RankingContext{Now: time.Now()}
+	}
+	if rctx.Now.IsZero() {
+		normalized := *rctx
+		normalized.Now = time.Now()
+		return &normalized
+	}
+	return rctx
+}
+
+// ===============================
+// FEATURE: FRESHNESS DECAY
+// ===============================
+
+// freshnessFeature decays exponentially with article age
+type freshnessFeature struct {
+	halfLifeHours float64
+}
+
+func (f *freshnessFeature) Name() string { return models.RankingFeatureFreshness }
+
+func (f *freshnessFeature) Description() string {
+	return fmt.Sprintf("Exponential decay on article age with a %.0fh half-life", f.halfLifeHours)
+}
+
+func (f *freshnessFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	if article.PublishedAt.IsZero() {
+		return 0, false, "no publish time"
+	}
+
+	ageHours := rctx.Now.Sub(article.PublishedAt).Hours()
+	if ageHours <= 0 || f.halfLifeHours <= 0 {
+		return 1, true, "just published"
+	}
+
+	return math.Exp(-math.Ln2 * ageHours / f.halfLifeHours), true, fmt.Sprintf("%.1fh old", ageHours)
+}
+
+// ===============================
+// FEATURE: INDIA RELEVANCE
+// ===============================
+
+// indiaRelevanceTerms covers national, political, economic and regional India signals
+var indiaRelevanceTerms = []string{
+	"india", "indian", "modi", "bjp", "congress", "lok sabha", "rajya sabha", "supreme court",
+	"rupee", "rbi", "sensex", "nifty", "isro", "drdo", "aadhaar", "gst",
+	"cricket", "ipl", "bcci", "bollywood",
+	"delhi", "mumbai", "bangalore", "bengaluru", "chennai", "kolkata", "hyderabad", "pune",
+	"maharashtra", "karnataka", "tamil nadu", "kerala", "gujarat", "rajasthan", "punjab",
+	"west bengal", "uttar pradesh", "bihar", "odisha", "assam", "telangana",
+}
+
+// indianOutlets are source name fragments of Indian publishers
+var indianOutlets = []string{
+	"timesofindia", "thehindu", "hindustantimes", "indianexpress", "ndtv", "news18",
+	"republic", "zeenews", "aajtak", "economictimes", "businessstandard", "livemint",
+	"moneycontrol", "thewire", "scroll", "theprint", "indiatoday", "deccanherald",
+}
+
+// indiaRelevanceFeature measures how strongly an article concerns India
+type indiaRelevanceFeature struct{}
+
+func (f *indiaRelevanceFeature) Name() string { return models.RankingFeatureIndiaRelevance }
+
+func (f *indiaRelevanceFeature) Description() string {
+	return "Indian content flag, Indian outlets, GDELT locations and India-specific terms"
+}
+
+func (f *indiaRelevanceFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	score := 0.0
+	var signals []string
+
+	if article.IsIndianContent {
+		score += 0.5
+		signals = append(signals, "indian_content")
+	}
+
+	source := normalizeSourceName(article.Source)
+	for _, outlet := range indianOutlets {
+		if strings.Contains(source, outlet) {
+			score += 0.2
+			signals = append(signals, "indian_outlet")
+			break
+		}
+	}
+
+	for _, location := range article.GDELTLocations {
+		if strings.Contains(strings.ToLower(location), "india") {
+			score += 0.1
+			signals = append(signals, "gdelt_location")
+			break
+		}
+	}
+
+	content := articleSearchText(article)
+	matches := 0
+	for _, term := range indiaRelevanceTerms {
+		if strings.Contains(content, term) {
+			matches++
+		}
+	}
+	if matches > 0 {
+		score += math.Min(0.3, 0.1*float64(matches))
+		signals = append(signals, fmt.Sprintf("%d_terms", matches))
+	}
+
+	return math.Min(1, score), true, strings.Join(signals, ",")
+}
+
+// ===============================
+// FEATURE: SOURCE TRUST
+// ===============================
+
+// sourceTrustRatings are editorial trust ratings keyed by normalised source name fragments
+var sourceTrustRatings = map[string]float64{
+	"reuters":          0.95,
+	"apnews":           0.95,
+	"pti":              0.9,
+	"bbc":              0.9,
+	"thehindu":         0.9,
+	"indianexpress":    0.85,
+	"livemint":         0.85,
+	"mint":             0.85,
+	"businessstandard": 0.85,
+	"economictimes":    0.8,
+	"hindustantimes":   0.8,
+	"timesofindia":     0.8,
+	"ndtv":             0.8,
+	"theprint":         0.8,
+	"scroll":           0.75,
+	"thewire":          0.75,
+	"indiatoday":       0.75,
+	"moneycontrol":     0.75,
+	"deccanherald":     0.75,
+	"aljazeera":        0.8,
+	"theguardian":      0.85,
+	"nytimes":          0.85,
+	"news18":           0.65,
+	"zeenews":          0.6,
+	"republic":         0.55,
+	"aajtak":           0.65,
+}
+
+// sourceTrustFeature rates the publisher's editorial reliability
+type sourceTrustFeature struct {
+	defaultTrust float64
+}
+
+func (f *sourceTrustFeature) Name() string { return models.RankingFeatureSourceTrust }
+
+func (f *sourceTrustFeature) Description() string {
+	return fmt.Sprintf("Editorial trust rating of the publisher (unknown sources: %.2f)", f.defaultTrust)
+}
+
+func (f *sourceTrustFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	source := normalizeSourceName(article.Source)
+	if source == "" {
+		return 0, false, "no source"
+	}
+
+	if trust, key, rated := ratedSourceTrust(source); rated {
+		return trust, true, key
+	}
+
+	return f.defaultTrust, true, "unrated"
+}
+
+// ratedSourceTrust looks up the trust rating of a normalised source name, returning the
+// rating key it matched
+func ratedSourceTrust(source string) (float64, string, bool) {
+	if trust, exists := sourceTrustRatings[source]; exists {
+		return trust, source, true
+	}
+
+	// Longer fragments first so "hindustantimes" wins over shorter partial matches
+	best, bestKey := -1.0, ""
+	for key, trust := range sourceTrustRatings {
+		if len(key) >= 5 && strings.Contains(source, key) && len(key) > len(bestKey) {
+			best, bestKey = trust, key
+		}
+	}
+	if best >= 0 {
+		return best, bestKey, true
+	}
+	return 0, "", false
+}
+
+// ===============================
+// FEATURE: ENGAGEMENT
+// ===============================
+
+// engagementFeature combines log-scaled views with editorial featuring and trending status
+type engagementFeature struct {
+	viewsCap   int
+	isTrending func(*models.Article) bool
+}
+
+func (f *engagementFeature) Name() string { return models.RankingFeatureEngagement }
+
+func (f *engagementFeature) Description() string {
+	return fmt.Sprintf("Log-scaled views (saturating at %d), featured and trending boosts", f.viewsCap)
+}
+
+func (f *engagementFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	score := 0.0
+	if f.viewsCap > 0 && article.ViewCount > 0 {
+		score += 0.6 * math.Min(1, math.Log1p(float64(article.ViewCount))/math.Log1p(float64(f.viewsCap)))
+	}
+	if article.IsFeatured {
+		score += 0.2
+	}
+	if f.isTrending(article) {
+		score += 0.2
+	}
+
+	return math.Min(1, score), true, fmt.Sprintf("%d views", article.ViewCount)
+}
+
+// ===============================
+// FEATURE: PERSONALIZATION
+// ===============================
+
+// personalizationFeature scores an article against a user's filter profile
+type personalizationFeature struct {
+	isTrending func(*models.Article) bool
+}
+
+func (f *personalizationFeature) Name() string { return models.RankingFeaturePersonalization }
+
+func (f *personalizationFeature) Description() string {
+	return "Preferred categories and sources, reading and bookmark patterns (signed-in users only)"
+}
+
+func (f *personalizationFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	if rctx.Profile == nil || rctx.PersonalizationLevel == PersonalizationNone || rctx.PersonalizationLevel == "" {
+		return 0, false, "no user profile"
+	}
+
+	// Blocked sources are filtered out completely
+	for _, source := range rctx.Profile.BlockedSources {
+		if strings.EqualFold(article.Source, source) {
+			return 0, true, "blocked source"
+		}
+	}
+
+	var score float64
+	switch rctx.PersonalizationLevel {
+	case PersonalizationBasic:
+		score = f.basicScore(article, rctx.Profile)
+	case PersonalizationAdvanced:
+		score = f.advancedScore(article, rctx.Profile)
+	case PersonalizationAI:
+		score = f.aiScore(article, rctx.Profile)
+	default:
+		return 0, false, "unknown personalization level"
+	}
+
+	return math.Min(1, score), true, string(rctx.PersonalizationLevel)
+}
+
+// basicScore matches preferred categories, sources and Indian content ratio
+func (f *personalizationFeature) basicScore(article *models.Article, profile *UserFilterProfile) float64 {
+	score := 0.0
+
+	// Category preferences
+	if article.CategoryID != nil {
+		for _, categoryID := range profile.PreferredCategories {
+			if *article.CategoryID == categoryID {
+				score += 0.4
+				break
+			}
+		}
+	}
+
+	// Source preferences
+	for _, source := range profile.PreferredSources {
+		if strings.EqualFold(article.Source, source) {
+			score += 0.3
+			break
+		}
+	}
+
+	// Content preferences
+	if profile.ContentPreferences.IndianContentRatio > 0.5 && article.IsIndianContent {
+		score += 0.3
+	}
+
+	return score
+}
+
+// advancedScore adds reading history, bookmark, time-slot and reading-time alignment
+func (f *personalizationFeature) advancedScore(article *models.Article, profile *UserFilterProfile) float64 {
+	score := f.basicScore(article, profile)
+
+	if article.CategoryID != nil {
+		// Reading history patterns
+		for _, pattern := range profile.ReadingHistory {
+			if pattern.CategoryID == *article.CategoryID {
+				score += 0.2 * pattern.EngagementScore
+				break
+			}
+		}
+
+		// Bookmark patterns
+		for _, pattern := range profile.BookmarkPatterns {
+			if pattern.CategoryID == *article.CategoryID {
+				score += 0.15 * pattern.PreferenceScore
+				break
+			}
+		}
+	}
+
+	// Time-based preferences
+	articleHour := article.PublishedAt.Hour()
+	for _, timeSlot := range profile.TimeBasedPreferences.PreferredTimeSlots {
+		if articleHour >= timeSlot.StartHour && articleHour <= timeSlot.EndHour {
+			score += 0.1 * float64(timeSlot.Priority) / 10.0
+			break
+		}
+	}
+
+	// Content length alignment
+	readingTimeMatch := 1.0
+	if profile.ContentPreferences.PreferredReadingTime > 0 {
+		diff := float64(abs(article.ReadingTimeMinutes - profile.ContentPreferences.PreferredReadingTime))
+		readingTimeMatch = 1.0 / (1.0 + diff/5.0) // Decay function
+	}
+	score += 0.1 * readingTimeMatch
+
+	return score
+}
+
+// aiScore adds bookmark keyword similarity, engaged-user and trend alignment boosts
+func (f *personalizationFeature) aiScore(article *models.Article, profile *UserFilterProfile) float64 {
+	score := f.advancedScore(article, profile)
+
+	// Semantic similarity (based on bookmark keywords)
+	content := articleSearchText(article)
+	for _, pattern := range profile.BookmarkPatterns {
+		for _, keyword := range pattern.Keywords {
+			if strings.Contains(content, strings.ToLower(keyword)) {
+				score += 0.05
+			}
+		}
+	}
+
+	// Boost for highly engaged users
+	if profile.PersonalizationScore > 0.7 {
+		score += 0.1
+	}
+
+	// Trend alignment
+	if f.isTrending(article) && profile.ContentPreferences.IndianContentRatio > 0.6 {
+		score += 0.15
+	}
+
+	return score
+}
+
+// ===============================
+// FEATURE: TOPIC MATCH
+// ===============================
+
+// topicKeywords are category keywords matched against article text and GDELT themes
+var topicKeywords = map[string][]string{
+	"politics":      {"politics", "government", "election", "policy", "parliament", "minister"},
+	"business":      {"business", "economy", "market", "finance", "earnings", "investment"},
+	"sports":        {"sports", "sport", "cricket", "football", "match", "tournament"},
+	"technology":    {"technology", "tech", "software", "innovation", "startup", "digital"},
+	"health":        {"health", "hospital", "medical", "disease", "vaccine"},
+	"entertainment": {"entertainment", "film", "movie", "bollywood", "music"},
+	"science":       {"science", "research", "space", "isro", "study"},
+}
+
+// topicMatchFeature measures fit with the requested category and richness of GDELT coverage
+type topicMatchFeature struct{}
+
+func (f *topicMatchFeature) Name() string { return models.RankingFeatureTopicMatch }
+
+func (f *topicMatchFeature) Description() string {
+	return "Category keyword match plus GDELT theme, organisation and location coverage"
+}
+
+func (f *topicMatchFeature) Extract(article *models.Article, rctx *RankingContext) (float64, bool, string) {
+	// Without a requested category, score against the article's own category
+	category := rctx.Category
+	if category == "" && article.Category != nil {
+		category = article.Category.Slug
+	}
+
+	keywords, hasCategory := topicKeywords[strings.ToLower(strings.TrimSpace(category))]
+	hasGDELT := article.HasGDELTData()
+	if !hasCategory && !hasGDELT {
+		return 0, false, "no category or GDELT data"
+	}
+
+	score := 0.0
+	var signals []string
+
+	if hasCategory {
+		content := articleSearchText(article) + " " + strings.ToLower(strings.Join(article.GDELTThemes, " "))
+		matches := 0
+		for _, keyword := range keywords {
+			if strings.Contains(content, keyword) {
+				matches++
+			}
+		}
+		score += 0.7 * math.Min(1, float64(matches)/2.0)
+		signals = append(signals, fmt.Sprintf("%d_keywords", matches))
+	}
+
+	if hasGDELT {
+		if len(article.GDELTThemes) > 2 {
+			score += 0.15
+		}
+		if len(article.GDELTOrganizations) > 1 {
+			score += 0.1
+		}
+		if len(article.GDELTLocations) > 0 {
+			score += 0.05
+		}
+		signals = append(signals, fmt.Sprintf("%d_themes", len(article.GDELTThemes)))
+	}
+
+	return math.Min(1, score), true, strings.Join(signals, ",")
+}
+
+// ===============================
+// HELPER FUNCTIONS
+// ===============================
+
+// articleSearchText returns the lower-cased title and description of an article
+func articleSearchText(article *models.Article) string {
+	content := strings.ToLower(article.Title)
+	if article.Description != nil {
+		content += " " + strings.ToLower(*article.Description)
+	}
+	return content
+}
+
+// normalizeSourceName lower-cases a source and strips everything but letters and digits
+func normalizeSourceName(source string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(source) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,586 @@
+// internal/services/readability.go
+// GoNews - Readability Content Extraction
+// Finds the main article body in a news page by paragraph scoring, with JSON-LD and Open Graph fallbacks
+
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ErrNoReadableContent is returned when a page has too little article text to keep
+var ErrNoReadableContent = errors.New("no readable content found")
+
+// minExtractedWords is the shortest body worth storing; anything less is usually a
+// teaser, consent wall or video page
+const minExtractedWords = 60
+
+var (
+	// Class/id patterns for page chrome that is removed before scoring
+	readabilityUnlikely = regexp.MustCompile(`(?i)-ad-|ai2html|banner|breadcrumb|combx|comment|community|cookie|cover-wrap|disqus|extra|footer|gdpr|header|legends|menu|related|remark|replies|rss|shoutbox|sidebar|skyscraper|social|sponsor|supplemental|ad-break|agegate|pagination|pager|popup|taboola|outbrain|newsletter|subscribe|also-read|trending|most-popular|share`)
+	// Patterns that rescue an element matched by readabilityUnlikely
+	readabilityMaybe = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow|story`)
+	// Class/id hints that adjust candidate scores
+	readabilityPositive = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`)
+	readabilityNegative = regexp.MustCompile(`(?i)-ad-|hidden|^hid$|banner|combx|comment|com-|contact|foot|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget|taboola|byline|author-bio`)
+	// Paragraphs that are in-body promos rather than article text
+	readabilityBoilerplate = regexp.MustCompile(`(?i)^(advertisement|also read|read more|read also|click here|subscribe|follow us|download the app|watch:|(also )?see:)`)
+	readabilitySpaces      = regexp.MustCompile(`\s+`)
+)
+
+// Elements dropped outright before scoring
+var readabilityStripTags = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Iframe: true,
+	atom.Form: true, atom.Nav: true, atom.Footer: true, atom.Aside: true,
+	atom.Svg: true, atom.Button: true, atom.Input: true, atom.Select: true,
+	atom.Textarea: true, atom.Object: true, atom.Embed: true, atom.Link: true,
+}
+
+// Elements that end a paragraph when collecting text
+var readabilityBlockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Li: true, atom.Ul: true, atom.Ol: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Table: true, atom.Tr: true, atom.Td: true, atom.Th: true, atom.Figure: true,
+	atom.Br: true, atom.Hr: true, atom.Dl: true, atom.Dd: true, atom.Dt: true, atom.Main: true,
+}
+
+// ExtractedContent is the readable part of an article page
+type ExtractedContent struct {
+	Title        string
+	Text         string // Paragraphs separated by blank lines
+	LeadImageURL string
+	WordCount    int
+}
+
+// readabilityPage holds page-level metadata gathered before pruning
+type readabilityPage struct {
+	base        *url.URL
+	title       string
+	metaImage   string
+	linkedImage string
+	linkedBody  string
+
+	// Candidate containers in document order, so ties resolve to the earliest
+	candidates     map[*html.Node]float64
+	candidateOrder []*html.Node
+}
+
+// ExtractReadableContent parses an HTML page and returns its main article text and lead
+// image. pageURL resolves relative image links. Input must already be UTF-8.
+func ExtractReadableContent(r io.Reader, pageURL string) (*ExtractedContent, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &readabilityPage{candidates: make(map[*html.Node]float64)}
+	page.base, _ = url.Parse(pageURL)
+	page.collectMetadata(doc)
+
+	pruneReadabilityNodes(doc)
+
+	body := findElement(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+
+	text := ""
+	if top := page.topCandidate(body); top != nil {
+		text = strings.Join(page.articleParagraphs(top), "\n\n")
+	}
+
+	// Publishers often embed the complete body in JSON-LD; prefer it when it is at least
+	// as long as what scoring found, since it carries no page chrome
+	if linkedWords := calculateWordCount(page.linkedBody); linkedWords >= minExtractedWords && linkedWords >= calculateWordCount(text) {
+		text = normalizeParagraphs(page.linkedBody)
+	}
+
+	words := calculateWordCount(text)
+	if words < minExtractedWords {
+		return nil, ErrNoReadableContent
+	}
+
+	content := &ExtractedContent{
+		Title:     page.title,
+		Text:      text,
+		WordCount: words,
+	}
+
+	switch {
+	case page.metaImage != "":
+		content.LeadImageURL = page.resolve(page.metaImage)
+	case page.linkedImage != "":
+		content.LeadImageURL = page.resolve(page.linkedImage)
+	default:
+		content.LeadImageURL = page.resolve(firstContentImage(body))
+	}
+
+	return content, nil
+}
+
+// ===============================
+// METADATA
+// ===============================
+
+// collectMetadata reads <base>, <title>, Open Graph tags and JSON-LD before scripts are stripped
+func (p *readabilityPage) collectMetadata(doc *html.Node) {
+	walkElements(doc, func(n *html.Node) {
+		switch n.DataAtom {
+		case atom.Base:
+			if href := attrValue(n, "href"); href != "" && p.base != nil {
+				if resolved, err := p.base.Parse(href); err == nil {
+					p.base = resolved
+				}
+			}
+		case atom.Title:
+			if p.title == "" {
+				p.title = nodeText(n)
+			}
+		case atom.Meta:
+			key := strings.ToLower(attrValue(n, "property"))
+			if key == "" {
+				key = strings.ToLower(attrValue(n, "name"))
+			}
+			value := strings.TrimSpace(attrValue(n, "content"))
+			switch key {
+			case "og:title":
+				if value != "" {
+					p.title = value
+				}
+			case "og:image", "og:image:url", "og:image:secure_url":
+				if p.metaImage == "" {
+					p.metaImage = value
+				}
+			case "twitter:image", "twitter:image:src":
+				if p.metaImage == "" {
+					p.metaImage = value
+				}
+			}
+		case atom.Script:
+			if strings.EqualFold(attrValue(n, "type"), "application/ld+json") {
+				p.readLinkedData(rawText(n))
+			}
+		}
+	})
+}
+
+// readLinkedData pulls articleBody and image from schema.org Article objects
+func (p *readabilityPage) readLinkedData(raw string) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return
+	}
+
+	var visit func(v interface{})
+	visit = func(v interface{}) {
+		switch value := v.(type) {
+		case []interface{}:
+			for _, item := range value {
+				visit(item)
+			}
+		case map[string]interface{}:
+			if graph, ok := value["@graph"]; ok {
+				visit(graph)
+			}
+			if !isLinkedDataArticle(value["@type"]) {
+				return
+			}
+			if body, ok := value["articleBody"].(string); ok && len(body) > len(p.linkedBody) {
+				p.linkedBody = html.UnescapeString(feedHTMLTag.ReplaceAllString(body, " "))
+			}
+			if p.linkedImage == "" {
+				p.linkedImage = linkedDataImage(value["image"])
+			}
+		}
+	}
+	visit(data)
+}
+
+func isLinkedDataArticle(t interface{}) bool {
+	switch value := t.(type) {
+	case string:
+		return strings.HasSuffix(value, "Article") || value == "BlogPosting"
+	case []interface{}:
+		for _, item := range value {
+			if isLinkedDataArticle(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func linkedDataImage(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case []interface{}:
+		if len(value) > 0 {
+			return linkedDataImage(value[0])
+		}
+	case map[string]interface{}:
+		if u, ok := value["url"].(string); ok {
+			return u
+		}
+	}
+	return ""
+}
+
+func (p *readabilityPage) resolve(ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.HasPrefix(ref, "data:") {
+		return ""
+	}
+	if p.base == nil {
+		return ref
+	}
+	resolved, err := p.base.Parse(ref)
+	if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+		return ""
+	}
+	return resolved.String()
+}
+
+// ===============================
+// PRUNING AND SCORING
+// ===============================
+
+// pruneReadabilityNodes removes scripts, navigation, hidden elements and chrome-like containers
+func pruneReadabilityNodes(doc *html.Node) {
+	var remove []*html.Node
+	walkElements(doc, func(n *html.Node) {
+		if readabilityStripTags[n.DataAtom] || isHiddenNode(n) {
+			remove = append(remove, n)
+			return
+		}
+
+		switch n.DataAtom {
+		case atom.Html, atom.Body, atom.Article, atom.Main:
+			return
+		}
+		hint := attrValue(n, "class") + " " + attrValue(n, "id")
+		if readabilityUnlikely.MatchString(hint) && !readabilityMaybe.MatchString(hint) {
+			remove = append(remove, n)
+		}
+	})
+
+	for _, n := range remove {
+		if n.Parent != nil {
+			n.Parent.RemoveChild(n)
+		}
+	}
+}
+
+func isHiddenNode(n *html.Node) bool {
+	style := strings.ReplaceAll(strings.ToLower(attrValue(n, "style")), " ", "")
+	return hasAttr(n, "hidden") ||
+		attrValue(n, "aria-hidden") == "true" ||
+		strings.Contains(style, "display:none") ||
+		strings.Contains(style, "visibility:hidden")
+}
+
+// topCandidate scores paragraph containers and returns the best one
+func (p *readabilityPage) topCandidate(body *html.Node) *html.Node {
+	walkElements(body, func(n *html.Node) {
+		if !isScorableParagraph(n) {
+			return
+		}
+
+		text := nodeText(n)
+		if len([]rune(text)) < 25 {
+			return
+		}
+
+		score := 1.0
+		score += float64(strings.Count(text, ",") + strings.Count(text, "，"))
+		score += math.Min(float64(len([]rune(text)))/100, 3)
+
+		for level, ancestor := 0, n.Parent; ancestor != nil && level < 3; level, ancestor = level+1, ancestor.Parent {
+			if ancestor.Type != html.ElementNode {
+				break
+			}
+			if _, ok := p.candidates[ancestor]; !ok {
+				p.candidates[ancestor] = initialCandidateScore(ancestor)
+				p.candidateOrder = append(p.candidateOrder, ancestor)
+			}
+			p.candidates[ancestor] += score / ancestorScoreDividers[level]
+		}
+	})
+
+	var top *html.Node
+	topScore := 0.0
+	for _, n := range p.candidateOrder {
+		score := p.candidates[n] * (1 - linkDensity(n))
+		p.candidates[n] = score
+		if top == nil || score > topScore {
+			top, topScore = n, score
+		}
+	}
+
+	if top == nil {
+		return body
+	}
+	return top
+}
+
+// ancestorScoreDividers spread a paragraph's score to its parent, grandparent and great-grandparent
+var ancestorScoreDividers = [3]float64{1, 2, 6}
+
+// isScorableParagraph reports whether n holds running text: a <p>, <pre>, <td>, or a
+// <div> with no block children (many CMSes use bare divs for paragraphs)
+func isScorableParagraph(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.P, atom.Pre, atom.Td, atom.Blockquote:
+		return true
+	case atom.Div:
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type == html.ElementNode && readabilityBlockTags[child.DataAtom] && child.DataAtom != atom.Br {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func initialCandidateScore(n *html.Node) float64 {
+	score := 0.0
+	switch n.DataAtom {
+	case atom.Div, atom.Article, atom.Section, atom.Main:
+		score = 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		score = 3
+	case atom.Address, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		score = -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		score = -5
+	}
+	return score + classWeight(n)
+}
+
+func classWeight(n *html.Node) float64 {
+	weight := 0.0
+	for _, hint := range []string{attrValue(n, "class"), attrValue(n, "id")} {
+		if hint == "" {
+			continue
+		}
+		if readabilityNegative.MatchString(hint) {
+			weight -= 25
+		}
+		if readabilityPositive.MatchString(hint) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// linkDensity is the share of a node's text that sits inside links
+func linkDensity(n *html.Node) float64 {
+	total := len(nodeText(n))
+	if total == 0 {
+		return 0
+	}
+	linked := 0
+	walkElements(n, func(child *html.Node) {
+		if child.DataAtom == atom.A {
+			linked += len(nodeText(child))
+		}
+	})
+	return float64(linked) / float64(total)
+}
+
+// ===============================
+// TEXT ASSEMBLY
+// ===============================
+
+// articleParagraphs gathers text from the top candidate and qualifying siblings, which
+// catches bodies split across several containers
+func (p *readabilityPage) articleParagraphs(top *html.Node) []string {
+	nodes := []*html.Node{top}
+	if parent := top.Parent; parent != nil && parent.Type == html.ElementNode {
+		threshold := math.Max(10, p.candidates[top]*0.2)
+		nodes = nodes[:0]
+		for sibling := parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+			if sibling.Type != html.ElementNode {
+				continue
+			}
+			if sibling == top || p.candidates[sibling] >= threshold || isStandaloneParagraph(sibling) {
+				nodes = append(nodes, sibling)
+			}
+		}
+	}
+
+	var paragraphs []string
+	for _, n := range nodes {
+		for _, paragraph := range textBlocks(n) {
+			if readabilityBoilerplate.MatchString(paragraph) {
+				continue
+			}
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	return paragraphs
+}
+
+// isStandaloneParagraph keeps sibling <p>s that read like prose
+func isStandaloneParagraph(n *html.Node) bool {
+	if n.DataAtom != atom.P {
+		return false
+	}
+	text := nodeText(n)
+	length := len([]rune(text))
+	density := linkDensity(n)
+	return (length > 80 && density < 0.25) ||
+		(length > 0 && length <= 80 && density == 0 && strings.HasSuffix(text, "."))
+}
+
+// textBlocks flattens a subtree into paragraphs, breaking at block elements
+func textBlocks(root *html.Node) []string {
+	var blocks []string
+	var current strings.Builder
+
+	flush := func() {
+		if text := strings.TrimSpace(readabilitySpaces.ReplaceAllString(current.String(), " ")); text != "" {
+			blocks = append(blocks, text)
+		}
+		current.Reset()
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			current.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if n.DataAtom == atom.Figcaption {
+				return
+			}
+			if readabilityBlockTags[n.DataAtom] {
+				flush()
+				defer flush()
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(root)
+	flush()
+
+	return blocks
+}
+
+// normalizeParagraphs tidies JSON-LD bodies, which use raw newlines between paragraphs
+func normalizeParagraphs(text string) string {
+	var paragraphs []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(readabilitySpaces.ReplaceAllString(line, " ")); line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// firstContentImage returns the first non-decorative image source in the page body
+func firstContentImage(root *html.Node) string {
+	found := ""
+	walkElements(root, func(n *html.Node) {
+		if found != "" || n.DataAtom != atom.Img {
+			return
+		}
+		src := attrValue(n, "data-src")
+		if src == "" {
+			src = attrValue(n, "src")
+		}
+		lower := strings.ToLower(src)
+		if src == "" || strings.HasPrefix(lower, "data:") || strings.HasSuffix(lower, ".svg") || strings.HasSuffix(lower, ".gif") {
+			return
+		}
+		if width := attrValue(n, "width"); width == "1" || width == "0" {
+			return
+		}
+		found = src
+	})
+	return found
+}
+
+// ===============================
+// DOM HELPERS
+// ===============================
+
+// walkElements calls fn for every element under root in document order
+func walkElements(root *html.Node, fn func(*html.Node)) {
+	for child := root.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode {
+			fn(child)
+		}
+		walkElements(child, fn)
+	}
+}
+
+func findElement(root *html.Node, tag atom.Atom) *html.Node {
+	var found *html.Node
+	walkElements(root, func(n *html.Node) {
+		if found == nil && n.DataAtom == tag {
+			found = n
+		}
+	})
+	return found
+}
+
+// nodeText is the whitespace-collapsed text content of n
+func nodeText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.TextNode {
+			b.WriteString(node.Data)
+			b.WriteByte(' ')
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(n)
+	return strings.TrimSpace(readabilitySpaces.ReplaceAllString(b.String(), " "))
+}
+
+// rawText is n's text with whitespace intact, for script bodies
+func rawText(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode {
+			b.WriteString(child.Data)
+		}
+	}
+	return b.String()
+}
+
+func attrValue(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if strings.EqualFold(attr.Key, key) {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, key string) bool {
+	for _, attr := range n.Attr {
+		if strings.EqualFold(attr.Key, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExtractReadableContent(t *testing.T) {
+	tests := []struct {
+		page      string // HTML fixture in testdata/extraction
+		url       string
+		wantErr   error
+		title     string
+		leadImage string
+		minWords  int
+		contains  []string
+		excludes  []string // Boilerplate that must be pruned
+	}{
+		{
+			page:      "ledger_jsonld.html",
+			url:       "https://dailyledger.example.in/sports/cricket/ranji-trophy-mumbai-vidarbha",
+			title:     "Ranji Trophy: Mumbai edge past Vidarbha in final-over thriller - The Daily Ledger",
+			leadImage: "https://dailyledger.example.in/media/ranji-final.jpg",
+			minWords:  120,
+			contains: []string{
+				"chasing down 214 with three balls to spare",
+				"Vidarbha's left-arm spinner took five wickets",
+				"secure a place in the knockout stage",
+			},
+			excludes: []string{"Subscribe to continue reading"},
+		},
+		{
+			page:      "metro_budget.html",
+			url:       "https://metroexpress.example.in/city/pune-civic-budget-9500-crore",
+			title:     "Pune civic body clears Rs 9,500 crore budget",
+			leadImage: "https://metroexpress.example.in/images/pune-budget.jpg",
+			minWords:  150,
+			contains: []string{
+				"approved a budget of Rs 9,500 crore",
+				"flood mitigation along the Mula and Mutha rivers",
+				"mobile clinic programme for slum settlements",
+			},
+			excludes: []string{"Also Read", "Sign in to leave a comment", "Heavy rain alert", "We use cookies", "Copyright Metro Express", "Advertisement", "Share on X"},
+		},
+		{
+			page:    "video_teaser.html",
+			url:     "https://northernchronicle.example.in/videos/monsoon-arrives-kerala",
+			wantErr: ErrNoReadableContent,
+		},
+		{
+			page:      "wire_sections.html",
+			url:       "https://deccanwire.example.in/science/isro-outreach-bengaluru",
+			title:     "ISRO outreach event draws crowds in Bengaluru",
+			leadImage: "https://deccanwire.example.in/science/img/isro-expo.jpg",
+			minWords:  130,
+			contains: []string{
+				"two-day space outreach event",
+				"small satellite projects built in their own laboratories",
+				"parking near the venue is limited",
+			},
+			excludes: []string{"E-Paper", "Most read", "morning briefing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.page, func(t *testing.T) {
+			file, err := os.Open(filepath.Join("testdata", "extraction", tt.page))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			content, err := ExtractReadableContent(file, tt.url)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extraction failed: %v", err)
+			}
+
+			if content.Title != tt.title {
+				t.Errorf("title = %q, want %q", content.Title, tt.title)
+			}
+			if content.LeadImageURL != tt.leadImage {
+				t.Errorf("lead image = %q, want %q", content.LeadImageURL, tt.leadImage)
+			}
+			if content.WordCount < tt.minWords {
+				t.Errorf("word count = %d, want at least %d", content.WordCount, tt.minWords)
+			}
+			for _, phrase := range tt.contains {
+				if !strings.Contains(content.Text, phrase) {
+					t.Errorf("text is missing %q", phrase)
+				}
+			}
+			for _, phrase := range tt.excludes {
+				if strings.Contains(content.Text, phrase) {
+					t.Errorf("text keeps boilerplate %q", phrase)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,544 @@
+// internal/services/recommendation_service.go
+// GoNews - Item-Item Collaborative Filtering Recommendations
+// Offline co-occurrence job over reading_history + bookmarks, "Recommended for you" serving with trending fallback
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxItemsPerUser bounds the O(n²) pair expansion for very heavy readers
+	maxItemsPerUser = 200
+
+	// maxUserHistory bounds how much of a user's history is excluded from recommendations
+	maxUserHistory = 500
+
+	// recommendationTrendingHours is the trending window used for cold-start fallback
+	recommendationTrendingHours = 24
+)
+
+// RecommendationService computes and serves "people also read" recommendations
+type RecommendationService struct {
+	config      *config.Config
+	logger      *logger.Logger
+	recRepo     *repository.RecommendationRepository
+	articleRepo *repository.ArticleRepository
+
+	// Offline job state
+	jobMutex   sync.Mutex
+	jobRunning bool
+	lastResult *models.RecommendationJobResult
+	lastError  error
+	stateMutex sync.RWMutex
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// RecommendationResult is a ranked set of recommended articles for a user
+type RecommendationResult struct {
+	Articles     []*models.Article
+	Source       string
+	SeedArticles int
+}
+
+// articlePair identifies an unordered article pair (first < second)
+type articlePair struct {
+	first  int
+	second int
+}
+
+// pairAccumulator accumulates co-occurrence evidence for an article pair
+type pairAccumulator struct {
+	dot   float64
+	count int
+}
+
+// NewRecommendationService creates a new recommendation service
+func NewRecommendationService(cfg *config.Config, log *logger.Logger, recRepo *repository.RecommendationRepository, articleRepo *repository.ArticleRepository) *RecommendationService {
+	return &RecommendationService{
+		config:      cfg,
+		logger:      log,
+		recRepo:     recRepo,
+		articleRepo: articleRepo,
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start launches the periodic offline neighbour computation
+func (s *RecommendationService) Start() {
+	if !s.config.RecommendationEnabled {
+		s.logger.Info("Recommendation job disabled by configuration")
+		return
+	}
+
+	interval := time.Duration(s.config.RecommendationJobIntervalMins) * time.Minute
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	s.wg.Add(1)
+	go s.runScheduler(interval)
+
+	s.logger.Info("Recommendation job scheduled", map[string]interface{}{
+		"interval":    interval.String(),
+		"window_days": s.config.RecommendationWindowDays,
+		"top_k":       s.config.RecommendationNeighborsPerArt,
+	})
+}
+
+// runScheduler recomputes neighbours immediately and then on every tick
+func (s *RecommendationService) runScheduler(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.runJob()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runJob()
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runJob runs one recomputation bounded by the job interval
+func (s *RecommendationService) runJob() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	if _, err := s.RecomputeNeighbors(ctx); err != nil {
+		s.logger.Error("Recommendation job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// Close stops the offline job and waits for an in-flight run to finish
+func (s *RecommendationService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Recommendation service stopped")
+	return nil
+}
+
+// ===============================
+// OFFLINE CO-OCCURRENCE JOB
+// ===============================
+
+// RecomputeNeighbors rebuilds the article_neighbors table from the sliding interaction window
+func (s *RecommendationService) RecomputeNeighbors(ctx context.Context) (*models.RecommendationJobResult, error) {
+	s.jobMutex.Lock()
+	if s.jobRunning {
+		s.jobMutex.Unlock()
+		return nil, fmt.Errorf("recommendation job already running")
+	}
+	s.jobRunning = true
+	s.jobMutex.Unlock()
+
+	defer func() {
+		s.jobMutex.Lock()
+		s.jobRunning = false
+		s.jobMutex.Unlock()
+	}()
+
+	startTime := time.Now()
+	windowDays := maxInt(s.config.RecommendationWindowDays, 1)
+	since := startTime.AddDate(0, 0, -windowDays)
+
+	interactions, err := s.recRepo.GetInteractionsSince(since)
+	if err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+
+	result := &models.RecommendationJobResult{
+		Interactions: len(interactions),
+		WindowDays:   windowDays,
+	}
+
+	if len(interactions) == 0 {
+		result.SkippedNoSignals = true
+		result.Duration = time.Since(startTime)
+		result.CompletedAt = time.Now()
+		s.recordJobResult(result, nil)
+		s.logger.Info("Recommendation job skipped - no interactions in window", map[string]interface{}{
+			"window_days": windowDays,
+		})
+		return result, nil
+	}
+
+	neighbors, stats, err := s.computeNeighbors(ctx, interactions)
+	if err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+
+	if err := s.recRepo.ReplaceNeighbors(neighbors); err != nil {
+		s.recordJobResult(nil, err)
+		return nil, err
+	}
+
+	result.Users = stats.users
+	result.Articles = stats.articles
+	result.CandidatePairs = stats.pairs
+	result.NeighborsStored = len(neighbors)
+	result.Duration = time.Since(startTime)
+	result.CompletedAt = time.Now()
+	s.recordJobResult(result, nil)
+
+	s.logger.Info("Recommendation neighbours recomputed", map[string]interface{}{
+		"interactions":     result.Interactions,
+		"users":            result.Users,
+		"articles":         result.Articles,
+		"candidate_pairs":  result.CandidatePairs,
+		"neighbors_stored": result.NeighborsStored,
+		"duration":         result.Duration.String(),
+	})
+
+	return result, nil
+}
+
+// neighborComputationStats reports the size of one co-occurrence computation
+type neighborComputationStats struct {
+	users    int
+	articles int
+	pairs    int
+}
+
+// computeNeighbors builds top-K cosine neighbours from user-article interaction weights
+func (s *RecommendationService) computeNeighbors(ctx context.Context, interactions []models.UserInteraction) ([]models.ArticleNeighbor, neighborComputationStats, error) {
+	// Group interactions per user (input is ordered by user, newest first)
+	userItems := make(map[uuid.UUID][]models.UserInteraction)
+	norms := make(map[int]float64)
+	for _, interaction := range interactions {
+		items := userItems[interaction.UserID]
+		if len(items) >= maxItemsPerUser {
+			continue
+		}
+		userItems[interaction.UserID] = append(items, interaction)
+		norms[interaction.ArticleID] += interaction.Weight * interaction.Weight
+	}
+
+	// Accumulate co-occurrence dot products per article pair
+	pairs := make(map[articlePair]*pairAccumulator)
+	for _, items := range userItems {
+		if err := ctx.Err(); err != nil {
+			return nil, neighborComputationStats{}, err
+		}
+
+		for i := 0; i < len(items); i++ {
+			for j := i + 1; j < len(items); j++ {
+				a, b := items[i], items[j]
+				if a.ArticleID == b.ArticleID {
+					continue
+				}
+				key := articlePair{first: a.ArticleID, second: b.ArticleID}
+				if key.first > key.second {
+					key.first, key.second = key.second, key.first
+				}
+
+				acc, exists := pairs[key]
+				if !exists {
+					acc = &pairAccumulator{}
+					pairs[key] = acc
+				}
+				acc.dot += a.Weight * b.Weight
+				acc.count++
+			}
+		}
+	}
+
+	// Convert qualifying pairs into cosine-similarity candidates for both directions
+	minCount := maxInt(s.config.RecommendationMinCoOccurrence, 1)
+	candidates := make(map[int][]models.ArticleNeighbor)
+	for key, acc := range pairs {
+		if acc.count < minCount {
+			continue
+		}
+		denominator := math.Sqrt(norms[key.first]) * math.Sqrt(norms[key.second])
+		if denominator == 0 {
+			continue
+		}
+		similarity := acc.dot / denominator
+		if similarity < s.config.RecommendationMinSimilarity {
+			continue
+		}
+
+		candidates[key.first] = append(candidates[key.first], models.ArticleNeighbor{
+			ArticleID: key.first, NeighborArticleID: key.second,
+			Similarity: similarity, CoOccurrenceCount: acc.count,
+		})
+		candidates[key.second] = append(candidates[key.second], models.ArticleNeighbor{
+			ArticleID: key.second, NeighborArticleID: key.first,
+			Similarity: similarity, CoOccurrenceCount: acc.count,
+		})
+	}
+
+	// Keep the top-K neighbours per article
+	topK := maxInt(s.config.RecommendationNeighborsPerArt, 1)
+	computedAt := time.Now()
+	neighbors := make([]models.ArticleNeighbor, 0, len(candidates)*topK)
+	for _, list := range candidates {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Similarity != list[j].Similarity {
+				return list[i].Similarity > list[j].Similarity
+			}
+			if list[i].CoOccurrenceCount != list[j].CoOccurrenceCount {
+				return list[i].CoOccurrenceCount > list[j].CoOccurrenceCount
+			}
+			return list[i].NeighborArticleID < list[j].NeighborArticleID
+		})
+
+		if len(list) > topK {
+			list = list[:topK]
+		}
+		for rank := range list {
+			list[rank].Rank = rank + 1
+			list[rank].ComputedAt = computedAt
+			neighbors = append(neighbors, list[rank])
+		}
+	}
+
+	return neighbors, neighborComputationStats{
+		users:    len(userItems),
+		articles: len(norms),
+		pairs:    len(pairs),
+	}, nil
+}
+
+// recordJobResult stores the outcome of the latest job run for stats reporting
+func (s *RecommendationService) recordJobResult(result *models.RecommendationJobResult, err error) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	if result != nil {
+		s.lastResult = result
+	}
+	s.lastError = err
+}
+
+// ===============================
+// SERVING
+// ===============================
+
+// GetRecommendationsForUser returns "Recommended for you" articles, falling back to trending on cold start
+func (s *RecommendationService) GetRecommendationsForUser(userID uuid.UUID, limit int) (*RecommendationResult, error) {
+	since := time.Now().AddDate(0, 0, -maxInt(s.config.RecommendationWindowDays, 1))
+
+	history, err := s.recRepo.GetUserInteractions(userID, since, maxUserHistory)
+	if err != nil {
+		s.logger.Warn("Failed to load user interactions for recommendations", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+		history = nil
+	}
+
+	seen := make(map[int]bool, len(history))
+	for _, interaction := range history {
+		seen[interaction.ArticleID] = true
+	}
+
+	seeds := history
+	if len(seeds) > s.config.RecommendationSeedArticles {
+		seeds = seeds[:s.config.RecommendationSeedArticles]
+	}
+
+	result := &RecommendationResult{SeedArticles: len(seeds)}
+
+	articles, err := s.collaborativeCandidates(seeds, seen, limit)
+	if err != nil {
+		s.logger.Warn("Collaborative recommendations unavailable, using trending", map[string]interface{}{
+			"user_id": userID.String(),
+			"error":   err.Error(),
+		})
+	}
+	result.Articles = articles
+
+	if len(result.Articles) >= limit {
+		result.Source = models.RecommendationSourceCollaborative
+		return result, nil
+	}
+
+	// Cold start (or sparse neighbours): top up with trending articles the user hasn't seen
+	for _, article := range result.Articles {
+		seen[article.ID] = true
+	}
+	fallback, err := s.trendingFallback(seen, limit-len(result.Articles))
+	if err != nil {
+		if len(result.Articles) == 0 {
+			return nil, err
+		}
+		s.logger.Warn("Trending fallback failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	if len(result.Articles) == 0 {
+		result.Source = models.RecommendationSourceTrending
+	} else {
+		result.Source = models.RecommendationSourceMixed
+	}
+	result.Articles = append(result.Articles, fallback...)
+
+	return result, nil
+}
+
+// GetTrendingFallback returns the cold-start feed used for anonymous users
+func (s *RecommendationService) GetTrendingFallback(limit int) (*RecommendationResult, error) {
+	articles, err := s.trendingFallback(map[int]bool{}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecommendationResult{
+		Articles: articles,
+		Source:   models.RecommendationSourceTrending,
+	}, nil
+}
+
+// GetAlsoRead returns the precomputed "people also read" neighbours for a single article
+func (s *RecommendationService) GetAlsoRead(articleID int, limit int) ([]*models.Article, error) {
+	neighbors, err := s.recRepo.GetNeighbors([]int{articleID}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		ids = append(ids, neighbor.NeighborArticleID)
+	}
+
+	return s.articleRepo.GetArticlesByIDsInOrder(ids)
+}
+
+// collaborativeCandidates scores neighbours of the seed articles, weighted by seed strength
+func (s *RecommendationService) collaborativeCandidates(seeds []models.UserInteraction, seen map[int]bool, limit int) ([]*models.Article, error) {
+	if len(seeds) == 0 {
+		return []*models.Article{}, nil
+	}
+
+	seedWeights := make(map[int]float64, len(seeds))
+	seedIDs := make([]int, 0, len(seeds))
+	for _, seed := range seeds {
+		if _, exists := seedWeights[seed.ArticleID]; !exists {
+			seedIDs = append(seedIDs, seed.ArticleID)
+		}
+		seedWeights[seed.ArticleID] += seed.Weight
+	}
+
+	neighbors, err := s.recRepo.GetNeighbors(seedIDs, s.config.RecommendationNeighborsPerArt)
+	if err != nil {
+		return []*models.Article{}, err
+	}
+
+	scores := make(map[int]float64)
+	for _, neighbor := range neighbors {
+		if seen[neighbor.NeighborArticleID] {
+			continue
+		}
+		scores[neighbor.NeighborArticleID] += neighbor.Similarity * seedWeights[neighbor.ArticleID]
+	}
+
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	return s.articleRepo.GetArticlesByIDsInOrder(ids)
+}
+
+// trendingFallback returns unseen trending articles (or the latest articles if nothing is trending)
+func (s *RecommendationService) trendingFallback(seen map[int]bool, limit int) ([]*models.Article, error) {
+	if limit <= 0 {
+		return []*models.Article{}, nil
+	}
+
+	candidates, err := s.articleRepo.GetTrendingArticles(recommendationTrendingHours, limit*3)
+	if err != nil || len(candidates) == 0 {
+		candidates, err = s.articleRepo.GetRecentArticles("", limit*3)
+		if err != nil {
+			return []*models.Article{}, fmt.Errorf("failed to load fallback articles: %w", err)
+		}
+	}
+
+	articles := make([]*models.Article, 0, limit)
+	for _, article := range candidates {
+		if seen[article.ID] {
+			continue
+		}
+		articles = append(articles, article)
+		if len(articles) >= limit {
+			break
+		}
+	}
+
+	return articles, nil
+}
+
+// ===============================
+// STATS
+// ===============================
+
+// GetStats returns the last job outcome and stored neighbour statistics
+func (s *RecommendationService) GetStats() map[string]interface{} {
+	s.stateMutex.RLock()
+	lastResult := s.lastResult
+	lastError := s.lastError
+	s.stateMutex.RUnlock()
+
+	s.jobMutex.Lock()
+	running := s.jobRunning
+	s.jobMutex.Unlock()
+
+	stats := map[string]interface{}{
+		"enabled":     s.config.RecommendationEnabled,
+		"job_running": running,
+		"last_run":    lastResult,
+		"settings": map[string]interface{}{
+			"window_days":           s.config.RecommendationWindowDays,
+			"neighbors_per_article": s.config.RecommendationNeighborsPerArt,
+			"min_co_occurrence":     s.config.RecommendationMinCoOccurrence,
+			"min_similarity":        s.config.RecommendationMinSimilarity,
+			"job_interval_minutes":  s.config.RecommendationJobIntervalMins,
+			"seed_articles":         s.config.RecommendationSeedArticles,
+		},
+	}
+	if lastError != nil {
+		stats["last_error"] = lastError.Error()
+	}
+
+	if tableStats, err := s.recRepo.GetNeighborStats(); err == nil {
+		stats["neighbor_table"] = tableStats
+	}
+
+	return stats
+}
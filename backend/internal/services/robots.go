@@ -0,0 +1,210 @@
+// internal/services/robots.go
+// GoNews - robots.txt Rules
+// RFC 9309 parsing and matching: agent groups, longest-match Allow/Disallow with * and $, Crawl-delay
+
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRobotsBytes caps how much of a robots.txt is read (RFC 9309 requires at least 500 KiB)
+const maxRobotsBytes = 512 << 10
+
+// RobotsRules is a parsed robots.txt
+type RobotsRules struct {
+	groups []robotsGroup
+	// disallowAll is set when robots.txt was unreachable because of a server error
+	disallowAll bool
+}
+
+type robotsGroup struct {
+	agents     []string
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// ParseRobotsTxt parses robots.txt content; unknown lines are ignored
+func ParseRobotsTxt(data []byte) *RobotsRules {
+	if len(data) > maxRobotsBytes {
+		data = data[:maxRobotsBytes]
+	}
+
+	rules := &RobotsRules{}
+	var current *robotsGroup
+	inAgentLines := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64<<10), maxRobotsBytes)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// Consecutive user-agent lines share one group
+			if current == nil || !inAgentLines {
+				rules.groups = append(rules.groups, robotsGroup{})
+				current = &rules.groups[len(rules.groups)-1]
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+			inAgentLines = true
+		case "allow", "disallow":
+			inAgentLines = false
+			if current == nil || value == "" {
+				continue
+			}
+			current.rules = append(current.rules, robotsRule{allow: key == "allow", pattern: value})
+		case "crawl-delay":
+			inAgentLines = false
+			if current == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	return rules
+}
+
+// DisallowAllRobots is used when robots.txt fails with a server error, which RFC 9309
+// says to treat as a full disallow
+func DisallowAllRobots() *RobotsRules {
+	return &RobotsRules{disallowAll: true}
+}
+
+// Allowed reports whether userAgent may fetch path (which may include a query string)
+func (r *RobotsRules) Allowed(userAgent, path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+	if r.disallowAll {
+		return false
+	}
+
+	rules := r.rulesFor(userAgent)
+	bestLength := -1
+	allowed := true
+	for _, rule := range rules {
+		if !robotsPatternMatches(rule.pattern, path) {
+			continue
+		}
+		length := len(rule.pattern)
+		// Longest match wins; on a tie the least restrictive (Allow) wins
+		if length > bestLength || (length == bestLength && rule.allow) {
+			bestLength = length
+			allowed = rule.allow
+		}
+	}
+
+	return allowed
+}
+
+// CrawlDelay returns the Crawl-delay for userAgent, or zero
+func (r *RobotsRules) CrawlDelay(userAgent string) time.Duration {
+	var delay time.Duration
+	for _, group := range r.matchingGroups(userAgent) {
+		if group.crawlDelay > delay {
+			delay = group.crawlDelay
+		}
+	}
+	return delay
+}
+
+func (r *RobotsRules) rulesFor(userAgent string) []robotsRule {
+	var rules []robotsRule
+	for _, group := range r.matchingGroups(userAgent) {
+		rules = append(rules, group.rules...)
+	}
+	return rules
+}
+
+// matchingGroups returns every group naming the agent's product token, else the * groups
+func (r *RobotsRules) matchingGroups(userAgent string) []robotsGroup {
+	token := robotsProductToken(userAgent)
+
+	var specific, wildcard []robotsGroup
+	for _, group := range r.groups {
+		for _, agent := range group.agents {
+			if agent == "*" {
+				wildcard = append(wildcard, group)
+				break
+			}
+			if token != "" && agent == token {
+				specific = append(specific, group)
+				break
+			}
+		}
+	}
+
+	if len(specific) > 0 {
+		return specific
+	}
+	return wildcard
+}
+
+// robotsProductToken reduces "GoNewsBot/1.0 (+https://...)" to "gonewsbot"
+func robotsProductToken(userAgent string) string {
+	token := strings.TrimSpace(userAgent)
+	if i := strings.IndexAny(token, "/ "); i >= 0 {
+		token = token[:i]
+	}
+	return strings.ToLower(token)
+}
+
+// robotsPatternMatches matches a path against a robots pattern supporting * and a trailing $
+func robotsPatternMatches(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	if anchored {
+		pattern = strings.TrimSuffix(pattern, "$")
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	position := len(parts[0])
+
+	for i, part := range parts[1:] {
+		if part == "" {
+			continue
+		}
+		last := i == len(parts)-2
+		if last && anchored {
+			return len(path)-position >= len(part) && strings.HasSuffix(path, part)
+		}
+		index := strings.Index(path[position:], part)
+		if index < 0 {
+			return false
+		}
+		position += index + len(part)
+	}
+
+	if anchored {
+		// A trailing * before $ matches anything; otherwise the pattern must consume the path
+		return strings.HasSuffix(pattern, "*") || position == len(path)
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRobotsRules(t *testing.T) {
+	const agent = "GoNewsBot/1.0"
+	tests := []struct {
+		name       string
+		robots     string
+		path       string
+		allowed    bool
+		crawlDelay time.Duration
+	}{
+		{"no rules allows everything", "", "/news/story-1", true, 0},
+		{"wildcard disallow", "User-agent: *\nDisallow: /", "/news/story-1", false, 0},
+		{"robots.txt itself is always allowed", "User-agent: *\nDisallow: /", "/robots.txt", true, 0},
+		{"specific group overrides wildcard", "User-agent: *\nDisallow: /\n\nUser-agent: gonewsbot\nAllow: /news/", "/news/story-1", true, 0},
+		{"specific group without match allows", "User-agent: *\nDisallow: /\n\nUser-agent: GoNewsBot\nDisallow: /private/", "/news/story-1", true, 0},
+		{"longest match wins", "User-agent: *\nDisallow: /news/\nAllow: /news/public/", "/news/public/story-2", true, 0},
+		{"allow wins a tie", "User-agent: *\nDisallow: /page\nAllow: /page", "/page", true, 0},
+		{"wildcard inside pattern", "User-agent: *\nDisallow: /*/amp/", "/india/amp/story-3", false, 0},
+		{"end anchor matches", "User-agent: *\nDisallow: /*.pdf$", "/reports/budget.pdf", false, 0},
+		{"end anchor does not match longer path", "User-agent: *\nDisallow: /*.pdf$", "/reports/budget.pdf?download=1", true, 0},
+		{"query strings are matched", "User-agent: *\nDisallow: /*?print=", "/news/story-4?print=1", false, 0},
+		{"grouped user agents share rules", "User-agent: otherbot\nUser-agent: gonewsbot\nDisallow: /archive/", "/archive/2019", false, 0},
+		{"comments and empty disallow", "# site rules\nUser-agent: * # everyone\nDisallow:", "/anything", true, 0},
+		{"crawl delay is read", "User-agent: *\nCrawl-delay: 2.5\nDisallow: /tmp/", "/news", true, 2500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := ParseRobotsTxt([]byte(tt.robots))
+			if allowed := rules.Allowed(agent, tt.path); allowed != tt.allowed {
+				t.Errorf("Allowed(%q) = %v, want %v", tt.path, allowed, tt.allowed)
+			}
+			if delay := rules.CrawlDelay(agent); delay != tt.crawlDelay {
+				t.Errorf("CrawlDelay = %s, want %s", delay, tt.crawlDelay)
+			}
+		})
+	}
+}
@@ -77,6 +77,9 @@ type SearchRequest struct {
 	UserID            *string                   `json:"user_id,omitempty"`
 	SessionID         *string                   `json:"session_id,omitempty"`
 	RequestContext    map[string]interface{}    `json:"request_context,omitempty"`
+
+	// Reader's content filter level ("none", "moderate", "strict"); empty means moderate
+	ContentFilterLevel string `json:"content_filter_level,omitempty"`
 }
 
 // SearchResponse represents a comprehensive search response
@@ -165,6 +168,10 @@ func (s *SearchService) Search(ctx context.Context, request *SearchRequest) (*Se
 		s.recordFailedSearch(request.Query, err)
 		return nil, fmt.Errorf("invalid search request: %w", err)
 	}
+	if request.Filters != nil {
+		policy := ContentFilterPolicyFor(s.config, request.ContentFilterLevel)
+		request.Filters.ContentFilter = &policy
+	}
 
 	// Check cache first if enabled
 	if request.EnableCache {
@@ -488,9 +495,15 @@ func (s *SearchService) generateCacheKey(request *SearchRequest) string {
 		if len(request.Filters.Sources) > 0 {
 			key += fmt.Sprintf(":src:%v", request.Filters.Sources)
 		}
+		if len(request.Filters.Languages) > 0 {
+			key += fmt.Sprintf(":lang:%v", request.Filters.Languages)
+		}
 		if request.Filters.IsIndianContent != nil {
 			key += fmt.Sprintf(":indian:%v", *request.Filters.IsIndianContent)
 		}
+		if request.Filters.ContentFilter != nil {
+			key += fmt.Sprintf(":filter:%s", request.Filters.ContentFilter.Level)
+		}
 		key += fmt.Sprintf(":page:%d:limit:%d", request.Filters.Page, request.Filters.Limit)
 		key += fmt.Sprintf(":sort:%s:%s", request.Filters.SortBy, request.Filters.SortOrder)
 	}
@@ -7,7 +7,10 @@ package services
 import (
 	"backend/internal/config"
 	"backend/internal/repository"
+	"backend/pkg/lang"
 	"backend/pkg/logger"
+	"backend/pkg/rawarchive"
+	"backend/pkg/sentiment"
 	"context"
 	"crypto/sha256"
 	"database/sql"
@@ -83,13 +86,24 @@ type NewsAggregatorService struct {
 	apiClient    *APIClient
 	quotaManager *QuotaManager
 	cacheService *CacheService
+	syndication  *SyndicationService
+	trending     *TrendingService
 
 	// Repository integration (THE CRITICAL ADDITION)
 	articleRepo *repository.ArticleRepository
 
 	// Content processing
 	deduplicator    *ContentDeduplicator
+	crossDedup      *CrossBatchDeduplicator // Checks against recently stored articles; nil without a repository
 	contentAnalyzer *ContentAnalyzer
+	ranking         *RankingPipeline
+	classifier      *CategoryClassifier
+	entityExtractor *EntityExtractor
+	geo             *GeoService
+	tags            *TagService
+
+	// Staged fetch → persist pipeline with dead-lettering
+	pipeline *IngestionPipeline
 
 	// IST timezone
 	istLocation *time.Location
@@ -97,6 +111,7 @@ type NewsAggregatorService struct {
 	// Aggregation state
 	aggregationMutex sync.RWMutex
 	lastAggregation  time.Time
+	regionalCursor   int // Next of cfg.RegionalLanguages fetched by FetchAndCacheNews
 
 	// Worker pool for concurrent processing
 	workers  int
@@ -129,7 +144,37 @@ func NewNewsAggregatorService(db *sql.DB, sqlxDB *sqlx.DB, redis *redis.Client,
 
 	// Initialize content processing components
 	service.deduplicator = NewContentDeduplicator(log)
+	if articleRepo != nil {
+		service.crossDedup = NewCrossBatchDeduplicator(cfg, log, articleRepo)
+	}
 	service.contentAnalyzer = NewContentAnalyzer(cfg, log)
+	service.classifier = NewCategoryClassifier(cfg, log, repository.NewClassifierRepository(sqlxDB))
+	service.entityExtractor = NewEntityExtractor(log, repository.NewEntityRepository(sqlxDB))
+	service.geo = NewGeoService(log, service.entityExtractor, repository.NewGeoRepository(sqlxDB), articleRepo, repository.NewUserRepository(sqlxDB))
+	service.tags = NewTagService(cfg, log, repository.NewTagRepository(sqlxDB), articleRepo, service.entityExtractor)
+	if apiClient != nil {
+		service.ranking = apiClient.RankingPipeline()
+	} else {
+		service.ranking = NewRankingPipeline(cfg, log)
+	}
+	var archive rawarchive.Store
+	if cfg.RawArchiveEnabled {
+		store, err := rawarchive.NewLocalStore(cfg.RawArchiveDir)
+		if err != nil {
+			log.Warn("Raw provider archive disabled", map[string]interface{}{
+				"dir":   cfg.RawArchiveDir,
+				"error": err.Error(),
+			})
+		} else {
+			archive = store
+		}
+	}
+	service.pipeline = NewIngestionPipeline(service, repository.NewIngestionRepository(sqlxDB), archive)
+
+	// Quota allocation follows the same registered sources as fetching
+	if apiClient != nil && quotaManager != nil {
+		quotaManager.SetSources(apiClient.Sources())
+	}
 
 	// Start worker pool
 	service.startWorkers()
@@ -144,6 +189,60 @@ func NewNewsAggregatorService(db *sql.DB, sqlxDB *sqlx.DB, redis *redis.Client,
 	return service
 }
 
+// RankingPipeline returns the shared relevance ranking pipeline
+func (s *NewsAggregatorService) RankingPipeline() *RankingPipeline {
+	return s.ranking
+}
+
+// RegisterSource adds a runtime-built source to fetching and quota allocation
+func (s *NewsAggregatorService) RegisterSource(factory SourceFactory) NewsSource {
+	source := s.apiClient.RegisterSource(factory)
+	if s.quotaManager != nil {
+		s.quotaManager.SetSources(s.apiClient.Sources())
+	}
+	return source
+}
+
+// Classifier returns the article category classifier
+func (s *NewsAggregatorService) Classifier() *CategoryClassifier {
+	return s.classifier
+}
+
+// EntityExtractor returns the gazetteer entity extractor
+func (s *NewsAggregatorService) EntityExtractor() *EntityExtractor {
+	return s.entityExtractor
+}
+
+// Geo returns the geo-tagging and local news service
+func (s *NewsAggregatorService) Geo() *GeoService {
+	return s.geo
+}
+
+// Tags returns the keyword and tag service
+func (s *NewsAggregatorService) Tags() *TagService {
+	return s.tags
+}
+
+// IngestionPipeline returns the staged ingestion pipeline for metrics and dead letter replay
+func (s *NewsAggregatorService) IngestionPipeline() *IngestionPipeline {
+	return s.pipeline
+}
+
+// StoreArticles runs articles ingested outside the aggregation cycle through the pipeline
+// from normalization onwards and returns how many were saved
+func (s *NewsAggregatorService) StoreArticles(source string, articles []*models.Article) (int, error) {
+	if len(articles) == 0 {
+		return 0, nil
+	}
+
+	run := s.pipeline.Run(context.Background(), nil, articleItems(source, SourceQuery{}, articles))
+	if run.stats.Persisted == 0 && run.stats.DeadLettered > 0 {
+		return 0, fmt.Errorf("no articles stored, %d dead-lettered", run.stats.DeadLettered)
+	}
+
+	return run.stats.Persisted, nil
+}
+
 // ===============================
 // DATABASE-FIRST NEWS FETCHING METHODS (THE FIX)
 // ===============================
@@ -156,7 +255,7 @@ func (s *NewsAggregatorService) FetchLatestNews(category string, limit int) ([]*
 	})
 
 	// STEP 1: Try to get news from DATABASE FIRST (THE FIX!)
-	articles, err := s.getNewsFromDatabase(category, limit)
+	articles, err := s.getNewsFromDatabase(category, []string{lang.English}, limit)
 	if err == nil && len(articles) >= limit {
 		s.logger.Info("Serving news from database", map[string]interface{}{
 			"category": category,
@@ -173,7 +272,7 @@ func (s *NewsAggregatorService) FetchLatestNews(category string, limit int) ([]*
 		"fetching_fresh": true,
 	})
 
-	// STEP 2: If insufficient, fetch from APIs (existing logic)
+	// STEP 2: If insufficient, fetch from APIs; the ingestion pipeline saves what it fetches
 	freshArticles, err := s.fetchFromAPIsAndCache(category, limit)
 	if err != nil {
 		// If API fetch fails, return whatever we have from database
@@ -188,24 +287,7 @@ func (s *NewsAggregatorService) FetchLatestNews(category string, limit int) ([]*
 		return nil, err
 	}
 
-	// STEP 3: SAVE NEW ARTICLES TO DATABASE (THE MISSING PIECE!)
-	if len(freshArticles) > 0 {
-		err = s.saveArticlesToDatabase(freshArticles)
-		if err != nil {
-			s.logger.Error("Failed to save articles to database", map[string]interface{}{
-				"error": err.Error(),
-				"count": len(freshArticles),
-			})
-			// Continue even if save fails - we can still return the articles
-		} else {
-			s.logger.Info("Successfully saved articles to database", map[string]interface{}{
-				"count":    len(freshArticles),
-				"category": category,
-			})
-		}
-	}
-
-	// STEP 4: Combine database + fresh articles and deduplicate
+	// STEP 3: Combine database + fresh articles and deduplicate
 	allArticles := append(articles, freshArticles...)
 	deduplicatedArticles := s.deduplicateArticles(allArticles)
 
@@ -224,8 +306,45 @@ func (s *NewsAggregatorService) FetchLatestNews(category string, limit int) ([]*
 	return deduplicatedArticles, nil
 }
 
-// getNewsFromDatabase retrieves articles from database first (DATABASE-FIRST APPROACH)
-func (s *NewsAggregatorService) getNewsFromDatabase(category string, limit int) ([]*models.Article, error) {
+// FetchLatestNewsInLanguages is FetchLatestNews for readers of languages besides English; no
+// languages means English. Stored articles in the languages come first; when there are too
+// few, the providers carrying the regional ones are fetched and the database is read again.
+func (s *NewsAggregatorService) FetchLatestNewsInLanguages(category string, languages []string, limit int) ([]*models.Article, error) {
+	var regional []string
+	for _, language := range languages {
+		if language != lang.English {
+			regional = append(regional, language)
+		}
+	}
+	if len(regional) == 0 {
+		return s.FetchLatestNews(category, limit)
+	}
+
+	articles, _ := s.getNewsFromDatabase(category, languages, limit)
+	if len(articles) >= limit {
+		return articles, nil
+	}
+
+	plan := s.buildRegionalFetchPlan(regional, s.cfg.RegionalFetchLimit)
+	if len(plan) == 0 {
+		return articles, nil
+	}
+	s.logger.Info("Insufficient regional language content, fetching from APIs", map[string]interface{}{
+		"category":       category,
+		"languages":      languages,
+		"database_count": len(articles),
+		"required":       limit,
+	})
+
+	run := s.pipeline.Run(context.Background(), plan, nil)
+	if run.stats.Persisted == 0 {
+		return articles, nil
+	}
+	return s.getNewsFromDatabase(category, languages, limit)
+}
+
+// getNewsFromDatabase retrieves articles in the languages from database first (DATABASE-FIRST APPROACH)
+func (s *NewsAggregatorService) getNewsFromDatabase(category string, languages []string, limit int) ([]*models.Article, error) {
 	// Convert category name to slug if needed
 	categorySlug := category
 	if category == "general" || category == "" {
@@ -233,7 +352,7 @@ func (s *NewsAggregatorService) getNewsFromDatabase(category string, limit int)
 	}
 
 	// Get recent articles from database
-	articles, err := s.articleRepo.GetRecentArticles(categorySlug, limit)
+	articles, err := s.articleRepo.GetRecentArticlesInLanguages(categorySlug, languages, limit)
 	if err != nil {
 		s.logger.Error("Failed to get articles from database", map[string]interface{}{
 			"error":    err.Error(),
@@ -250,131 +369,6 @@ func (s *NewsAggregatorService) getNewsFromDatabase(category string, limit int)
 	return articles, nil
 }
 
-// inferCategoryFromContent infers category from article content (title + description)
-func (s *NewsAggregatorService) inferCategoryFromContent(title, description string) string {
-	content := strings.ToLower(title + " " + description)
-
-	if strings.Contains(content, "politics") || strings.Contains(content, "government") ||
-		strings.Contains(content, "election") || strings.Contains(content, "congress") ||
-		strings.Contains(content, "bjp") || strings.Contains(content, "modi") {
-		return "politics"
-	}
-	if strings.Contains(content, "business") || strings.Contains(content, "stock") ||
-		strings.Contains(content, "market") || strings.Contains(content, "economy") ||
-		strings.Contains(content, "ipo") || strings.Contains(content, "gst") {
-		return "business"
-	}
-	if strings.Contains(content, "cricket") || strings.Contains(content, "sports") ||
-		strings.Contains(content, "ipl") {
-		return "sports"
-	}
-	if strings.Contains(content, "technology") || strings.Contains(content, "tech") {
-		return "technology"
-	}
-
-	return "top-stories" // Default fallback
-}
-
-// saveArticlesToDatabase saves articles to database (THE MISSING PIECE!)
-func (s *NewsAggregatorService) saveArticlesToDatabase(articles []*models.Article) error {
-	if len(articles) == 0 {
-		return nil
-	}
-
-	// Process articles for database storage
-	processedArticles := s.processArticlesForDatabase(articles)
-
-	// Save to database using repository
-	err := s.articleRepo.SaveArticles(processedArticles)
-	if err != nil {
-		return fmt.Errorf("failed to save articles to database: %w", err)
-	}
-
-	s.logger.Info("Articles saved to database successfully", map[string]interface{}{
-		"count": len(processedArticles),
-	})
-
-	return nil
-}
-
-// processArticlesForDatabase processes articles for database storage
-func (s *NewsAggregatorService) processArticlesForDatabase(articles []*models.Article) []*models.Article {
-	var processedArticles []*models.Article
-
-	for _, article := range articles {
-		// Create a copy to avoid modifying original
-		processed := *article
-		processed.ID = 0
-
-		// Generate external ID if not present
-		if processed.ExternalID == nil || *processed.ExternalID == "" {
-			externalID := s.generateExternalID(article.URL, article.Title)
-			processed.ExternalID = &externalID
-		}
-
-		// Set category ID from category mapping if needed
-		if processed.CategoryID == nil {
-			// Set default category based on context
-			var defaultCategoryID int
-			_, slugToID, err := s.articleRepo.GetCategoryMapping()
-			if err == nil {
-				// Try to infer category from content or use "general"
-				categorySlug := s.inferCategoryFromContent(processed.Title, s.getStringValue(processed.Description))
-				if categoryID, exists := slugToID[categorySlug]; exists {
-					defaultCategoryID = categoryID
-				} else if generalID, exists := slugToID["top-stories"]; exists {
-					defaultCategoryID = generalID // Fallback to "Top Stories"
-				} else {
-					defaultCategoryID = 1 // Hard fallback to ID 1
-				}
-				processed.CategoryID = &defaultCategoryID
-			}
-		}
-
-		// Apply content analysis
-		processed.IsIndianContent = s.contentAnalyzer.IsIndianContent(
-			processed.Title,
-			s.getStringValue(processed.Description),
-			processed.Source,
-		)
-
-		processed.RelevanceScore = s.contentAnalyzer.CalculateRelevanceScore(
-			processed.Title,
-			s.getStringValue(processed.Description),
-			s.getCategoryName(processed.Category),
-		)
-
-		processed.SentimentScore = s.contentAnalyzer.AnalyzeSentiment(
-			processed.Title,
-			s.getStringValue(processed.Description),
-		)
-
-		// Calculate word count and reading time
-		content := processed.Title + " " + s.getStringValue(processed.Description) + " " + s.getStringValue(processed.Content)
-		wordCount := len(strings.Fields(content))
-		processed.WordCount = wordCount
-		processed.ReadingTimeMinutes = maxInt(1, wordCount/200) // Assume 200 words per minute
-
-		// Set timestamps
-		now := time.Now()
-		processed.FetchedAt = now
-		if processed.CreatedAt.IsZero() {
-			processed.CreatedAt = now
-		}
-		processed.UpdatedAt = now
-
-		// Set defaults
-		processed.IsActive = true
-		if processed.Tags == nil {
-			processed.Tags = []string{}
-		}
-
-		processedArticles = append(processedArticles, &processed)
-	}
-
-	return processedArticles
-}
-
 // generateExternalID creates a unique external ID for an article
 func (s *NewsAggregatorService) generateExternalID(url, title string) string {
 	// Create a hash from URL and title for uniqueness
@@ -428,120 +422,89 @@ func (s *NewsAggregatorService) fetchFromAPIsAndCache(category string, limit int
 		}
 	}
 
-	// Fetch from multiple APIs concurrently (EXISTING LOGIC PRESERVED)
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	var allArticles []*models.Article
-	var errors []error
-
-	// Channel to collect results
-	resultChan := make(chan []*models.Article, 4)
-	errorChan := make(chan error, 4)
-
-	// NewsData.io (Primary source - 150/day)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if s.canMakeRequest("newsdata") {
-			articles, err := s.apiClient.FetchNewsFromNewsData(category, "in", limit/4)
-			if err != nil {
-				s.logger.Error("NewsData.io fetch failed", map[string]interface{}{
-					"error": err.Error(),
-				})
-				errorChan <- err
-			} else {
-				s.recordRequest("newsdata")
-				resultChan <- articles
-			}
-		} else {
-			s.logger.Warn("NewsData.io quota exhausted")
+	run := s.pipeline.Run(context.Background(), s.buildFetchPlan(category, limit), nil)
+
+	allArticles := run.Articles()
+	if len(allArticles) == 0 {
+		if len(run.fetchErrors) > 0 {
+			return nil, fmt.Errorf("all API sources failed: %v", run.fetchErrors)
 		}
-	}()
-
-	// GNews (Secondary source - 75/day)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if s.canMakeRequest("gnews") {
-			articles, err := s.apiClient.FetchNewsFromGNews(category, "in", limit/4)
-			if err != nil {
-				s.logger.Error("GNews fetch failed", map[string]interface{}{
-					"error": err.Error(),
-				})
-				errorChan <- err
-			} else {
-				s.recordRequest("gnews")
-				resultChan <- articles
-			}
-		} else {
-			s.logger.Warn("GNews quota exhausted")
+		return nil, fmt.Errorf("no articles fetched from any source")
+	}
+
+	return s.selectAndCacheArticles(category, limit, allArticles, len(run.fetchErrors)), nil
+}
+
+// buildFetchPlan fans a category fetch out to every enabled source that takes a share of
+// aggregate fetches and still has quota
+func (s *NewsAggregatorService) buildFetchPlan(category string, limit int) []ingestionFetch {
+	var plan []ingestionFetch
+	for _, source := range s.apiClient.Sources().Enabled() {
+		if !s.canMakeRequest(source.Name()) {
+			s.logger.Warn("News source quota exhausted", map[string]interface{}{
+				"source": source.Name(),
+			})
+			continue
 		}
-	}()
-
-	// Mediastack (Backup source - 3/day, use sparingly)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if s.canMakeRequest("mediastack") {
-			articles, err := s.apiClient.FetchNewsFromMediastack(category, "in", limit/8)
-			if err != nil {
-				s.logger.Error("Mediastack fetch failed", map[string]interface{}{
-					"error": err.Error(),
-				})
-				errorChan <- err
-			} else {
-				s.recordRequest("mediastack")
-				resultChan <- articles
-			}
-		} else {
-			s.logger.Warn("Mediastack quota exhausted")
+
+		quota := source.Quota()
+		if quota.Share > 0 {
+			plan = append(plan, ingestionFetch{source.Name(), SourceQuery{
+				Category: category,
+				Country:  "in",
+				Limit:    maxInt(1, int(float64(limit)*quota.Share)),
+			}})
 		}
-	}()
-
-	// Global news for international perspective (25% of content)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if s.canMakeRequest("gnews") {
-			articles, err := s.apiClient.FetchNewsFromGNews(category, "", limit/8)
-			if err != nil {
-				s.logger.Error("Global news fetch failed", map[string]interface{}{
-					"error": err.Error(),
-				})
-				errorChan <- err
-			} else {
-				s.recordRequest("gnews")
-				resultChan <- articles
-			}
+		// Global news for international perspective
+		if quota.GlobalShare > 0 {
+			plan = append(plan, ingestionFetch{source.Name(), SourceQuery{
+				Category: category,
+				Limit:    maxInt(1, int(float64(limit)*quota.GlobalShare)),
+			}})
 		}
-	}()
-
-	// Wait for all goroutines to complete
-	go func() {
-		wg.Wait()
-		close(resultChan)
-		close(errorChan)
-	}()
-
-	// Collect results
-	for articles := range resultChan {
-		mu.Lock()
-		allArticles = append(allArticles, articles...)
-		mu.Unlock()
 	}
+	return plan
+}
 
-	// Collect errors
-	for err := range errorChan {
-		errors = append(errors, err)
+// buildRegionalFetchPlan requests Indian news in each language from every enabled source that
+// carries it, takes a share of aggregate fetches and still has quota
+func (s *NewsAggregatorService) buildRegionalFetchPlan(languages []string, limit int) []ingestionFetch {
+	var plan []ingestionFetch
+	for _, language := range languages {
+		for _, source := range s.apiClient.Sources().Enabled() {
+			if source.Quota().Share <= 0 || !source.Capabilities().SupportsLanguage(language) {
+				continue
+			}
+			if !s.canMakeRequest(source.Name()) {
+				continue
+			}
+			plan = append(plan, ingestionFetch{source.Name(), SourceQuery{
+				Country:  "in",
+				Language: language,
+				Limit:    maxInt(1, limit),
+			}})
+		}
 	}
+	return plan
+}
 
-	if len(allArticles) == 0 {
-		if len(errors) > 0 {
-			return nil, fmt.Errorf("all API sources failed: %v", errors)
-		}
-		return nil, fmt.Errorf("no articles fetched from any source")
+// nextRegionalLanguage rotates through the configured regional languages, so each refresh
+// spends quota on one of them
+func (s *NewsAggregatorService) nextRegionalLanguage() (string, bool) {
+	if len(s.cfg.RegionalLanguages) == 0 {
+		return "", false
 	}
 
+	s.aggregationMutex.Lock()
+	defer s.aggregationMutex.Unlock()
+
+	language := lang.Normalize(s.cfg.RegionalLanguages[s.regionalCursor%len(s.cfg.RegionalLanguages)])
+	s.regionalCursor++
+	return language, language != ""
+}
+
+// selectAndCacheArticles applies the India-first mix to freshly ingested articles and caches the result
+func (s *NewsAggregatorService) selectAndCacheArticles(category string, limit int, allArticles []*models.Article, apiErrors int) []*models.Article {
 	// Apply India-first content strategy (EXISTING LOGIC PRESERVED)
 	indianArticles, globalArticles := s.categorizeByOrigin(allArticles)
 
@@ -600,10 +563,10 @@ func (s *NewsAggregatorService) fetchFromAPIsAndCache(category string, limit int
 		"after_dedup":     len(deduplicatedArticles),
 		"indian_articles": len(indianArticles),
 		"global_articles": len(globalArticles),
-		"api_errors":      len(errors),
+		"api_errors":      apiErrors,
 	})
 
-	return deduplicatedArticles, nil
+	return deduplicatedArticles
 }
 
 // ===============================
@@ -615,17 +578,18 @@ func (s *NewsAggregatorService) FetchNewsByCategory(category string, limit int)
 	return s.FetchLatestNews(category, limit)
 }
 
-// SearchNews searches for news articles with database integration
-func (s *NewsAggregatorService) SearchNews(query string, category string, limit int) ([]*models.Article, error) {
+// SearchNews searches for news articles in the languages (English when none) with database integration
+func (s *NewsAggregatorService) SearchNews(query string, category string, languages []string, limit int) ([]*models.Article, error) {
 	s.logger.Info("Searching news with database integration", map[string]interface{}{
-		"query":    query,
-		"category": category,
-		"limit":    limit,
+		"query":     query,
+		"category":  category,
+		"languages": languages,
+		"limit":     limit,
 	})
 
 	// First try database search (if search repo is available)
 	// For now, fallback to existing search logic
-	articles, err := s.FetchLatestNews(category, limit*2)
+	articles, err := s.FetchLatestNewsInLanguages(category, languages, limit*2)
 	if err != nil {
 		return nil, err
 	}
@@ -641,12 +605,26 @@ func (s *NewsAggregatorService) SearchNews(query string, category string, limit
 	return filteredArticles, nil
 }
 
-// GetTrendingNews fetches trending news with database integration
+// GetTrendingNews fetches the articles bursting now, falling back to recent well-scored
+// articles while nothing is (or before trends are first computed)
 func (s *NewsAggregatorService) GetTrendingNews(limit int) ([]*models.Article, error) {
+	if s.trending != nil {
+		trendingArticles, err := s.trending.TrendingArticles(limit)
+		if err == nil && len(trendingArticles) > 0 {
+			s.logger.Info("Serving trending news by velocity", map[string]interface{}{
+				"count": len(trendingArticles),
+			})
+			return trendingArticles, nil
+		}
+	}
+
 	// Try to get trending from database first
 	if s.articleRepo != nil {
 		trendingArticles, err := s.articleRepo.GetTrendingArticles(24, limit)
 		if err == nil && len(trendingArticles) > 0 {
+			if s.trending != nil {
+				s.trending.Annotate(trendingArticles)
+			}
 			s.logger.Info("Serving trending news from database", map[string]interface{}{
 				"count": len(trendingArticles),
 			})
@@ -679,8 +657,6 @@ func (s *NewsAggregatorService) GetCategoryMapping() (map[int]string, map[string
 // - filterArticlesByQuery
 // - getDynamicTTL
 // - FetchAndCacheNews
-// - fetchCategoryNewsSimple
-// - CategoryResult struct
 // - ContentDeduplicator
 // - ContentAnalyzer
 // - Worker pool methods
@@ -701,6 +677,18 @@ func (s *NewsAggregatorService) SetCacheService(cache *CacheService) {
 	s.cacheService = cache
 }
 
+// SetSyndicationService sets the outbound feed service notified when articles are saved
+func (s *NewsAggregatorService) SetSyndicationService(syndication *SyndicationService) {
+	s.syndication = syndication
+}
+
+// SetTrendingService serves trending news by read and mention velocity, and lets the ranking
+// pipeline boost articles bursting now
+func (s *NewsAggregatorService) SetTrendingService(trending *TrendingService) {
+	s.trending = trending
+	s.ranking.SetTrends(trending)
+}
+
 // ===============================
 // QUOTA MANAGEMENT (PRESERVED)
 // ===============================
@@ -822,71 +810,56 @@ func (s *NewsAggregatorService) getDynamicTTL(category string) int {
 // LEGACY COMPREHENSIVE METHODS (PRESERVED)
 // ===============================
 
-// FetchAndCacheNews fetches news from all sources with intelligent orchestration
+// FetchAndCacheNews refreshes every category whose database content is thin with one
+// ingestion pipeline run, then caches per-category and aggregated results
 func (s *NewsAggregatorService) FetchAndCacheNews(ctx context.Context) error {
 	startTime := time.Now()
 	s.logger.Info("Starting comprehensive news aggregation")
 
-	// Get IST time for optimization
-	istNow := time.Now().In(s.istLocation)
-
 	// Define categories to fetch
 	categories := []string{"general", "business", "sports", "technology", "health", "politics"}
+	const categoryLimit = 20
 
-	// Channel for collecting results
-	resultsChan := make(chan CategoryResult, len(categories))
-	var wg sync.WaitGroup
-
-	// Fetch news for each category concurrently
+	// Database first: only categories without enough stored articles are fetched
+	databaseArticles := make(map[string][]*models.Article, len(categories))
+	var plan []ingestionFetch
+	var totalArticles []*models.Article
 	for _, category := range categories {
-		wg.Add(1)
-		go func(cat string) {
-			defer wg.Done()
+		articles, _ := s.getNewsFromDatabase(category, []string{lang.English}, categoryLimit)
+		if len(articles) >= categoryLimit {
+			totalArticles = append(totalArticles, articles...)
+			continue
+		}
+		databaseArticles[category] = articles
+		plan = append(plan, s.buildFetchPlan(category, categoryLimit)...)
+	}
 
-			result := s.fetchCategoryNewsSimple(ctx, cat, istNow)
-			resultsChan <- result
-		}(category)
+	// One regional language per refresh; its articles are stored but kept out of the English caches
+	if language, ok := s.nextRegionalLanguage(); ok {
+		plan = append(plan, s.buildRegionalFetchPlan([]string{language}, s.cfg.RegionalFetchLimit)...)
 	}
 
-	// Wait for all category fetches to complete
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
+	run := s.pipeline.Run(ctx, plan, nil)
 
-	// Collect and aggregate results
-	var totalArticles []*models.Article
-	var totalFetched, totalProcessed, totalDuplicates int
 	var failedCategories []string
-
-	for result := range resultsChan {
-		if result.Success {
-			totalArticles = append(totalArticles, result.Articles...)
-			totalFetched += result.TotalFetched
-			totalProcessed += result.TotalProcessed
-			totalDuplicates += result.Duplicates
-		} else {
-			failedCategories = append(failedCategories, result.Category)
-			s.logger.Error("Category fetch failed", map[string]interface{}{
-				"category": result.Category,
-				"error":    result.Error,
-			})
+	for category, stored := range databaseArticles {
+		var fresh []*models.Article
+		if articles := run.ArticlesForCategory(category); len(articles) > 0 {
+			fresh = s.selectAndCacheArticles(category, categoryLimit, articles, 0)
 		}
-	}
 
-	// Save all articles to database (THE CRITICAL ADDITION)
-	if len(totalArticles) > 0 {
-		err := s.saveArticlesToDatabase(totalArticles)
-		if err != nil {
-			s.logger.Error("Failed to save aggregated articles to database", map[string]interface{}{
-				"error": err.Error(),
-				"count": len(totalArticles),
-			})
-		} else {
-			s.logger.Info("Successfully saved aggregated articles to database", map[string]interface{}{
-				"count": len(totalArticles),
+		combined := s.deduplicateArticles(append(stored, fresh...))
+		if len(combined) > categoryLimit {
+			combined = combined[:categoryLimit]
+		}
+		if len(combined) == 0 {
+			failedCategories = append(failedCategories, category)
+			s.logger.Error("Category fetch failed", map[string]interface{}{
+				"category": category,
 			})
+			continue
 		}
+		totalArticles = append(totalArticles, combined...)
 	}
 
 	// Cache aggregated results
@@ -914,12 +887,14 @@ func (s *NewsAggregatorService) FetchAndCacheNews(ctx context.Context) error {
 	duration := time.Since(startTime)
 	s.logger.Info("News aggregation completed", map[string]interface{}{
 		"total_articles":     len(totalArticles),
-		"total_fetched":      totalFetched,
-		"total_processed":    totalProcessed,
-		"duplicates_removed": totalDuplicates,
+		"categories_fetched": len(databaseArticles),
+		"total_fetched":      run.stats.Fetched,
+		"duplicates_removed": run.stats.Duplicates,
+		"database_saved":     run.stats.Persisted,
+		"dead_lettered":      run.stats.DeadLettered,
+		"fetch_errors":       run.stats.FetchErrors,
 		"failed_categories":  len(failedCategories),
 		"duration":           duration,
-		"database_saved":     len(totalArticles),
 	})
 
 	// Return error if too many categories failed
@@ -930,49 +905,10 @@ func (s *NewsAggregatorService) FetchAndCacheNews(ctx context.Context) error {
 	return nil
 }
 
-// fetchCategoryNewsSimple fetches news for a category using database-first method
-func (s *NewsAggregatorService) fetchCategoryNewsSimple(ctx context.Context, category string, istTime time.Time) CategoryResult {
-	result := CategoryResult{
-		Category:  category,
-		Success:   false,
-		StartTime: time.Now(),
-	}
-
-	// Use database-first approach
-	articles, err := s.FetchLatestNews(category, 20)
-	if err != nil {
-		result.Error = err
-		result.Duration = time.Since(result.StartTime)
-		return result
-	}
-
-	result.Articles = articles
-	result.TotalFetched = len(articles)
-	result.TotalProcessed = len(articles)
-	result.Duplicates = 0 // Already deduplicated in FetchLatestNews
-	result.Success = len(articles) > 0
-	result.Duration = time.Since(result.StartTime)
-
-	return result
-}
-
 // ===============================
 // SUPPORTING STRUCTS & HELPER METHODS (PRESERVED)
 // ===============================
 
-// CategoryResult represents the result of fetching news for a category
-type CategoryResult struct {
-	Category       string            `json:"category"`
-	Articles       []*models.Article `json:"articles"`
-	TotalFetched   int               `json:"total_fetched"`
-	TotalProcessed int               `json:"total_processed"`
-	Duplicates     int               `json:"duplicates"`
-	Success        bool              `json:"success"`
-	Error          error             `json:"error,omitempty"`
-	StartTime      time.Time         `json:"start_time"`
-	Duration       time.Duration     `json:"duration"`
-}
-
 // ContentDeduplicator handles duplicate detection
 type ContentDeduplicator struct {
 	logger     *logger.Logger
@@ -983,10 +919,14 @@ type ContentDeduplicator struct {
 
 // ContentAnalyzer handles content analysis
 type ContentAnalyzer struct {
-	config *config.Config
-	logger *logger.Logger
+	config    *config.Config
+	logger    *logger.Logger
+	sentiment *sentiment.Analyzer
 }
 
+// sentimentTitleWeight is the headline's share of an article's sentiment; it frames the story
+const sentimentTitleWeight = 0.6
+
 // NewContentDeduplicator creates a new content deduplicator
 func NewContentDeduplicator(log *logger.Logger) *ContentDeduplicator {
 	return &ContentDeduplicator{
@@ -999,40 +939,52 @@ func NewContentDeduplicator(log *logger.Logger) *ContentDeduplicator {
 // NewContentAnalyzer creates a new content analyzer
 func NewContentAnalyzer(cfg *config.Config, log *logger.Logger) *ContentAnalyzer {
 	return &ContentAnalyzer{
-		config: cfg,
-		logger: log,
+		config:    cfg,
+		logger:    log,
+		sentiment: sentiment.New(),
 	}
 }
 
-// DeduplicateArticles removes duplicate articles
+// DeduplicateArticles merges duplicate articles into the first copy seen
 func (cd *ContentDeduplicator) DeduplicateArticles(articles []*models.Article) []*models.Article {
 	cd.mutex.Lock()
 	defer cd.mutex.Unlock()
 
 	var uniqueArticles []*models.Article
-	seenTitles := make(map[string]bool)
-	seenURLs := make(map[string]bool)
+	seenTitles := make(map[string]int) // Title hash -> index in uniqueArticles
+	seenURLs := make(map[string]int)
+	merged := make(map[int]bool)
 
 	for _, article := range articles {
 		// Create title hash for similarity comparison
 		titleHash := cd.generateTitleHash(article.Title)
 
-		// Skip if we've seen this title or URL
-		if seenTitles[titleHash] || seenURLs[article.URL] {
+		// Merge into the first article with this title or URL
+		index, seen := seenTitles[titleHash]
+		if !seen {
+			index, seen = seenURLs[article.URL]
+		}
+		if seen {
+			if !merged[index] {
+				copied := *uniqueArticles[index]
+				uniqueArticles[index] = &copied
+				merged[index] = true
+			}
+			mergeDuplicateArticle(uniqueArticles[index], article)
 			continue
 		}
 
 		// Mark as seen
-		seenTitles[titleHash] = true
-		seenURLs[article.URL] = true
+		seenTitles[titleHash] = len(uniqueArticles)
+		seenURLs[article.URL] = len(uniqueArticles)
 
 		uniqueArticles = append(uniqueArticles, article)
 	}
 
 	cd.logger.Info("Deduplication completed", map[string]interface{}{
-		"original_count":     len(articles),
-		"unique_count":       len(uniqueArticles),
-		"duplicates_removed": len(articles) - len(uniqueArticles),
+		"original_count":    len(articles),
+		"unique_count":      len(uniqueArticles),
+		"duplicates_merged": len(articles) - len(uniqueArticles),
 	})
 
 	return uniqueArticles
@@ -1066,64 +1018,24 @@ func (ca *ContentAnalyzer) IsIndianContent(title, description, source string) bo
 	return false
 }
 
-// CalculateRelevanceScore calculates content relevance score
-func (ca *ContentAnalyzer) CalculateRelevanceScore(title, description, category string) float64 {
-	score := 0.5 // Base score
-
-	content := strings.ToLower(title + " " + description)
-
-	// Category-specific keywords
-	categoryKeywords := map[string][]string{
-		"politics":   {"politics", "government", "election", "policy"},
-		"business":   {"business", "economy", "market", "finance"},
-		"sports":     {"sports", "cricket", "football", "match"},
-		"technology": {"technology", "tech", "software", "innovation"},
-	}
-
-	if keywords, exists := categoryKeywords[category]; exists {
-		for _, keyword := range keywords {
-			if strings.Contains(content, keyword) {
-				score += 0.1
-			}
-		}
-	}
-
-	// Cap at 1.0
-	if score > 1.0 {
-		score = 1.0
-	}
-
-	return score
-}
-
-// AnalyzeSentiment performs basic sentiment analysis
+// AnalyzeSentiment scores the headline and description from -1 to 1 with the lexicon
+// analyzer, which handles negation, intensifiers and market, sports and Hinglish terms
 func (ca *ContentAnalyzer) AnalyzeSentiment(title, description string) float64 {
-	// Simple sentiment analysis (in production, use proper ML models)
-	content := strings.ToLower(title + " " + description)
-
-	positiveWords := []string{"good", "great", "success", "win", "positive", "growth"}
-	negativeWords := []string{"bad", "fail", "loss", "negative", "decline", "crisis"}
-
-	score := 0.0
-	for _, word := range positiveWords {
-		if strings.Contains(content, word) {
-			score += 0.1
-		}
-	}
-	for _, word := range negativeWords {
-		if strings.Contains(content, word) {
-			score -= 0.1
-		}
+	titleScore := ca.sentiment.Score(title)
+	if strings.TrimSpace(description) == "" {
+		return roundScore(titleScore)
 	}
+	descriptionScore := ca.sentiment.Score(description)
+	return roundScore(sentimentTitleWeight*titleScore + (1-sentimentTitleWeight)*descriptionScore)
+}
 
-	// Normalize to -1 to 1 range
-	if score > 1.0 {
-		score = 1.0
-	} else if score < -1.0 {
-		score = -1.0
+// ArticleSentiment prefers GDELT's tone, computed from the full article text, over the
+// lexicon score of the headline and description
+func (ca *ContentAnalyzer) ArticleSentiment(article *models.Article) float64 {
+	if article.GDELTTone != nil {
+		return sentiment.FromGDELTTone(*article.GDELTTone)
 	}
-
-	return score
+	return ca.AnalyzeSentiment(article.Title, derefString(article.Description))
 }
 
 // ===============================
@@ -1144,6 +1056,9 @@ func (s *NewsAggregatorService) Close() error {
 	close(s.stopChan)
 	s.wg.Wait()
 
+	if s.pipeline != nil {
+		s.pipeline.Close()
+	}
 	if s.quotaManager != nil {
 		s.quotaManager.Close()
 	}
@@ -0,0 +1,371 @@
+// internal/services/source_gdelt.go
+// GoNews - GDELT News Source
+// Free DOC 2.0 article list API; primary source with an hourly request budget
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+	"backend/pkg/lang"
+	"backend/pkg/logger"
+	"backend/pkg/sentiment"
+
+	"github.com/lib/pq"
+)
+
+func init() {
+	RegisterNewsSource(string(models.APISourceGDELT), newGDELTSource)
+}
+
+// ===============================
+// GDELT API RESPONSE STRUCTURES
+// ===============================
+
+// GDELTResponse represents GDELT API response
+type GDELTResponse struct {
+	Articles []GDELTArticle `json:"articles"`
+}
+
+// GDELTArticle represents an article from GDELT API
+type GDELTArticle struct {
+	URL            string          `json:"url"`
+	URLMobile      string          `json:"urlmobile"`
+	Title          string          `json:"title"`
+	Domain         string          `json:"domain"`
+	Language       string          `json:"language"`
+	SourceCountry  string          `json:"sourcecountry"`
+	PublishDate    string          `json:"publishdate"`
+	Tone           float64         `json:"tone"`
+	SocialImageURL string          `json:"socialimage"`
+	Mentions       []GDELTMention  `json:"mentions,omitempty"`
+	Themes         []string        `json:"themes,omitempty"`
+	Locations      []GDELTLocation `json:"locations,omitempty"`
+	Organizations  []string        `json:"organizations,omitempty"`
+	Persons        []string        `json:"persons,omitempty"`
+}
+
+// GDELTMention represents mentions in GDELT articles
+type GDELTMention struct {
+	Name   string  `json:"name"`
+	Offset int     `json:"offset"`
+	Tone   float64 `json:"tone"`
+	Type   string  `json:"type"`
+}
+
+// GDELTLocation represents locations mentioned in GDELT articles
+type GDELTLocation struct {
+	Name        string  `json:"name"`
+	CountryCode string  `json:"countrycode"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Type        string  `json:"type"`
+}
+
+// ===============================
+// GDELT SOURCE
+// ===============================
+
+// gdeltSource fetches from the GDELT DOC API
+type gdeltSource struct {
+	deps SourceDeps
+
+	baseURL    string
+	enabled    bool
+	maxRecords int
+	sourceLang string
+}
+
+func newGDELTSource(deps SourceDeps) NewsSource {
+	baseURL, _, _, lang, _, maxRecords, enabled := deps.Config.GetGDELTConfig()
+
+	return &gdeltSource{
+		deps:       deps,
+		baseURL:    baseURL,
+		enabled:    enabled,
+		maxRecords: maxRecords,
+		sourceLang: lang,
+	}
+}
+
+func (s *gdeltSource) Name() string { return string(models.APISourceGDELT) }
+
+func (s *gdeltSource) Enabled() bool { return s.enabled && s.baseURL != "" }
+
+func (s *gdeltSource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{
+		Categories: true, Countries: true, Search: true,
+		Languages: []string{
+			lang.Hindi, lang.Marathi, lang.Bengali, lang.Tamil, lang.Telugu, lang.Kannada,
+			lang.Malayalam, lang.Gujarati, lang.Punjabi, lang.Odia, lang.Urdu,
+		},
+	}
+}
+
+func (s *gdeltSource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:    s.deps.Config.GetSimpleAPIQuotas()["gdelt"],
+		Window:   time.Hour,
+		Priority: 1,
+		Share:    0.25,
+	}
+}
+
+// Fetch converts every record from one GDELT request
+func (s *gdeltSource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	records, err := s.FetchRaw(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawRecords(s, records, query, s.deps.Logger), nil
+}
+
+// FetchRaw fetches news from GDELT API (PRIMARY - 24,000/day)
+func (s *gdeltSource) FetchRaw(ctx context.Context, query SourceQuery) ([]json.RawMessage, error) {
+	params := url.Values{}
+	params.Add("format", "json")
+	params.Add("mode", "artlist")
+	params.Add("maxrecords", strconv.Itoa(min2(query.Limit, s.maxRecords)))
+	// GDELT searches the English translation of other languages, so the English query terms
+	// below work for every source language
+	sourceLang := s.sourceLang
+	if language, ok := lang.Get(query.Language); ok && language.Code != lang.English {
+		sourceLang = language.Code3
+	}
+	params.Add("sourcelang", sourceLang)
+	params.Add("timespan", "3d") // Last 3 days
+
+	// An explicit search replaces the category/country query
+	searchQuery := query.Query
+	if searchQuery == "" {
+		searchQuery = buildGDELTQuery(query.Category, query.Country)
+	}
+	if searchQuery != "" {
+		params.Add("query", searchQuery)
+	}
+
+	// Add India-specific filters if country is India
+	if strings.EqualFold(query.Country, "in") {
+		params.Add("sourcecountry", "IN")
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", s.baseURL, params.Encode())
+
+	var gdeltResponse struct {
+		Articles []json.RawMessage `json:"articles"`
+	}
+	if err := getSourceJSON(ctx, s.deps.HTTPClient, fullURL, nil, &gdeltResponse); err != nil {
+		return nil, err
+	}
+
+	return gdeltResponse.Articles, nil
+}
+
+// Convert decodes one GDELT record
+func (s *gdeltSource) Convert(record json.RawMessage, query SourceQuery) (*models.Article, error) {
+	var item GDELTArticle
+	if err := json.Unmarshal(record, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode GDELT article: %w", err)
+	}
+	return convertGDELTToArticle(item, query.Category, s.deps.Ranking, s.deps.Logger), nil
+}
+
+// buildGDELTQuery builds search query based on category and country
+func buildGDELTQuery(category, country string) string {
+	var queryParts []string
+	isIndia := strings.EqualFold(country, "in")
+
+	// Category-specific queries
+	switch strings.ToLower(category) {
+	case "politics", "political":
+		queryParts = append(queryParts, "politics OR government OR election OR parliament OR minister")
+	case "business", "finance":
+		queryParts = append(queryParts, "business OR economy OR market OR finance OR stock OR trade")
+	case "sports":
+		queryParts = append(queryParts, "sports OR cricket OR football OR tennis OR olympics OR IPL")
+	case "technology", "tech":
+		queryParts = append(queryParts, "technology OR tech OR software OR AI OR internet OR digital")
+	case "health":
+		queryParts = append(queryParts, "health OR medical OR hospital OR disease OR medicine OR vaccine")
+	case "entertainment":
+		queryParts = append(queryParts, "entertainment OR bollywood OR movie OR film OR celebrity OR music")
+	case "breaking":
+		queryParts = append(queryParts, "breaking OR urgent OR latest OR developing")
+	default:
+		// For general news, use India-specific terms if country is India
+		if isIndia {
+			queryParts = append(queryParts, "India OR Indian OR Delhi OR Mumbai OR Bangalore")
+		} else {
+			queryParts = append(queryParts, "news OR latest")
+		}
+	}
+
+	// Add India-specific terms for Indian content (kept short to avoid long queries)
+	if isIndia {
+		queryParts = append(queryParts, "India", "Modi")
+	}
+
+	return strings.Join(queryParts, " OR ")
+}
+
+// convertGDELTToArticle converts GDELT article to our Article model
+func convertGDELTToArticle(item GDELTArticle, category string, ranking *RankingPipeline, log *logger.Logger) *models.Article {
+	// Parse publish date (GDELT format: YYYYMMDDHHMMSS)
+	publishedAt := parseGDELTDate(item.PublishDate, log)
+
+	var imageURL *string
+	if item.SocialImageURL != "" {
+		imageURL = &item.SocialImageURL
+	}
+
+	// Generate description from themes and organizations (GDELT doesn't provide description)
+	description := generateDescriptionFromGDELT(item)
+
+	// Calculate word count and reading time (estimate from title)
+	wordCount := calculateWordCount(item.Title)
+
+	// Convert themes and organizations to tags
+	var tags pq.StringArray
+	tags = append(tags, item.Themes...)
+	tags = append(tags, item.Organizations...)
+
+	// Keep GDELT entities on the article for ranking and diversity features
+	var locations pq.StringArray
+	for _, loc := range item.Locations {
+		locations = append(locations, loc.Name)
+	}
+	tone := item.Tone
+
+	article := &models.Article{
+		ExternalID:         strPtr(fmt.Sprintf("gdelt_%s", hashURL(item.URL))),
+		Title:              item.Title,
+		Description:        &description,
+		Content:            &description, // GDELT doesn't provide full content
+		URL:                item.URL,
+		ImageURL:           imageURL,
+		Source:             item.Domain,
+		PublishedAt:        publishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    isGDELTIndiaRelated(item),
+		SentimentScore:     sentiment.FromGDELTTone(item.Tone), // GDELT tone rescaled to -1..1
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               tags,
+		Language:           lang.Normalize(item.Language), // GDELT names languages ("Hindi")
+		GDELTTone:          &tone,
+		GDELTThemes:        pq.StringArray(item.Themes),
+		GDELTOrganizations: pq.StringArray(item.Organizations),
+		GDELTPersons:       pq.StringArray(item.Persons),
+		GDELTLocations:     locations,
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: category})
+	return article
+}
+
+// parseGDELTDate parses GDELT date format (YYYYMMDDHHMMSS)
+func parseGDELTDate(dateStr string, log *logger.Logger) time.Time {
+	if len(dateStr) < 14 {
+		return time.Now() // Fallback to current time
+	}
+
+	parsedTime, err := time.Parse("20060102150405", dateStr)
+	if err != nil {
+		log.Warn("Failed to parse GDELT date", map[string]interface{}{
+			"date_string": dateStr,
+			"error":       err.Error(),
+		})
+		return time.Now()
+	}
+
+	return parsedTime
+}
+
+// generateDescriptionFromGDELT creates description from GDELT metadata
+func generateDescriptionFromGDELT(item GDELTArticle) string {
+	var parts []string
+
+	// Add top themes
+	if len(item.Themes) > 0 {
+		topThemes := item.Themes
+		if len(topThemes) > 3 {
+			topThemes = topThemes[:3]
+		}
+		parts = append(parts, "Themes: "+strings.Join(topThemes, ", "))
+	}
+
+	// Add organizations
+	if len(item.Organizations) > 0 {
+		topOrgs := item.Organizations
+		if len(topOrgs) > 2 {
+			topOrgs = topOrgs[:2]
+		}
+		parts = append(parts, "Organizations: "+strings.Join(topOrgs, ", "))
+	}
+
+	// Add locations
+	if len(item.Locations) > 0 {
+		var locationNames []string
+		for _, loc := range item.Locations {
+			locationNames = append(locationNames, loc.Name)
+			if len(locationNames) >= 2 {
+				break
+			}
+		}
+		parts = append(parts, "Locations: "+strings.Join(locationNames, ", "))
+	}
+
+	if len(parts) == 0 {
+		return "News article from " + item.Domain
+	}
+
+	return strings.Join(parts, ". ")
+}
+
+// isGDELTIndiaRelated determines if GDELT content is India-related
+func isGDELTIndiaRelated(item GDELTArticle) bool {
+	// Check source country
+	if strings.EqualFold(item.SourceCountry, "IN") {
+		return true
+	}
+
+	// Check locations
+	for _, loc := range item.Locations {
+		if strings.EqualFold(loc.CountryCode, "IN") ||
+			strings.Contains(strings.ToLower(loc.Name), "india") {
+			return true
+		}
+	}
+
+	// Check title for Indian terms
+	title := strings.ToLower(item.Title)
+	indianTerms := []string{
+		"india", "indian", "delhi", "mumbai", "bangalore", "chennai", "kolkata",
+		"modi", "bjp", "congress", "rupee", "bollywood", "cricket", "ipl",
+	}
+
+	for _, term := range indianTerms {
+		if strings.Contains(title, term) {
+			return true
+		}
+	}
+
+	// Check themes for India-related content
+	for _, theme := range item.Themes {
+		if strings.Contains(strings.ToLower(theme), "india") {
+			return true
+		}
+	}
+
+	return false
+}
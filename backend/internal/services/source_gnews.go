@@ -0,0 +1,181 @@
+// internal/services/source_gnews.go
+// GoNews - GNews News Source
+// Keyed daily-quota search API; also supplies the global share of aggregate fetches
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"backend/internal/models"
+	"backend/pkg/lang"
+
+	"github.com/lib/pq"
+)
+
+func init() {
+	RegisterNewsSource(string(models.APISourceGNews), newGNewsSource)
+}
+
+// GNewsResponse represents GNews API response
+type GNewsResponse struct {
+	TotalArticles int            `json:"totalArticles"`
+	Articles      []GNewsArticle `json:"articles"`
+}
+
+// GNewsArticle represents an article from GNews
+type GNewsArticle struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Content     string `json:"content"`
+	URL         string `json:"url"`
+	Image       string `json:"image"`
+	PublishedAt string `json:"publishedAt"`
+	Source      struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"source"`
+}
+
+// gnewsSource fetches from GNews
+type gnewsSource struct {
+	deps   SourceDeps
+	apiKey string
+}
+
+func newGNewsSource(deps SourceDeps) NewsSource {
+	return &gnewsSource{
+		deps:   deps,
+		apiKey: deps.Config.GetSimpleAPIKeys()["gnews"],
+	}
+}
+
+func (s *gnewsSource) Name() string { return string(models.APISourceGNews) }
+
+func (s *gnewsSource) Enabled() bool { return s.apiKey != "" }
+
+func (s *gnewsSource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{
+		Categories: true, Countries: true, Search: true, RequiresKey: true,
+		Languages: []string{lang.Hindi, lang.Marathi, lang.Tamil, lang.Telugu, lang.Malayalam},
+	}
+}
+
+func (s *gnewsSource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:       s.deps.Config.GetSimpleAPIQuotas()["gnews"],
+		Window:      24 * time.Hour,
+		Priority:    4,
+		Share:       0.25,
+		GlobalShare: 0.125,
+	}
+}
+
+// Fetch converts every record from one GNews request
+func (s *gnewsSource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	records, err := s.FetchRaw(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawRecords(s, records, query, s.deps.Logger), nil
+}
+
+// FetchRaw fetches news from GNews API (75/day)
+func (s *gnewsSource) FetchRaw(ctx context.Context, query SourceQuery) ([]json.RawMessage, error) {
+	params := url.Values{}
+	params.Add("token", s.apiKey)
+	params.Add("lang", query.languageCode())
+	params.Add("max", strconv.Itoa(query.Limit))
+	params.Add("sortby", "publishedAt")
+
+	// GNews is search-only, so categories become the search terms; English terms find little
+	// in other languages, which search for their word for news instead
+	searchQuery := query.Query
+	if searchQuery == "" && query.languageCode() != lang.English {
+		language, _ := lang.Get(query.Language)
+		searchQuery = language.NewsWord
+	}
+	if searchQuery == "" {
+		searchQuery = "latest news"
+		if query.Category != "" && query.Category != "general" {
+			searchQuery = query.Category
+		}
+		if query.Country == "in" {
+			searchQuery += " India"
+		}
+	}
+	params.Add("q", searchQuery)
+
+	if query.Country != "" {
+		params.Add("country", query.Country)
+	}
+
+	var newsResponse struct {
+		Articles []json.RawMessage `json:"articles"`
+	}
+	if err := getSourceJSON(ctx, s.deps.HTTPClient, s.deps.Config.GNewsBaseURL+"?"+params.Encode(), nil, &newsResponse); err != nil {
+		return nil, err
+	}
+
+	return newsResponse.Articles, nil
+}
+
+// Convert decodes one GNews record
+func (s *gnewsSource) Convert(record json.RawMessage, query SourceQuery) (*models.Article, error) {
+	var item GNewsArticle
+	if err := json.Unmarshal(record, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode GNews article: %w", err)
+	}
+	article := convertGNewsToArticle(item, query.Category, s.deps.Ranking)
+	article.Language = query.languageCode()
+	return article, nil
+}
+
+// convertGNewsToArticle converts GNews article to our Article model
+func convertGNewsToArticle(item GNewsArticle, category string, ranking *RankingPipeline) *models.Article {
+	publishedAt, _ := time.Parse(time.RFC3339, item.PublishedAt)
+
+	var imageURL *string
+	if item.Image != "" {
+		imageURL = &item.Image
+	}
+
+	var description *string
+	if item.Description != "" {
+		description = &item.Description
+	}
+
+	var content *string
+	if item.Content != "" {
+		content = &item.Content
+	}
+
+	wordCount := calculateWordCount(item.Content)
+
+	article := &models.Article{
+		ExternalID:         strPtr(fmt.Sprintf("gnews_%s", hashURL(item.URL))),
+		Title:              item.Title,
+		Description:        description,
+		Content:            content,
+		URL:                item.URL,
+		ImageURL:           imageURL,
+		Source:             item.Source.Name,
+		PublishedAt:        publishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    isIndiaRelatedContent(item.Title, item.Description, nil, nil),
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               pq.StringArray{}, // GNews doesn't provide tags
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: category})
+	return article
+}
@@ -0,0 +1,169 @@
+// internal/services/source_mediastack.go
+// GoNews - Mediastack News Source
+// Keyed emergency backup with a very small daily quota
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"backend/internal/models"
+	"backend/pkg/lang"
+
+	"github.com/lib/pq"
+)
+
+func init() {
+	RegisterNewsSource(string(models.APISourceMediastack), newMediastackSource)
+}
+
+// MediastackResponse represents Mediastack API response
+type MediastackResponse struct {
+	Pagination struct {
+		Limit  int `json:"limit"`
+		Offset int `json:"offset"`
+		Count  int `json:"count"`
+		Total  int `json:"total"`
+	} `json:"pagination"`
+	Data []MediastackArticle `json:"data"`
+}
+
+// MediastackArticle represents an article from Mediastack
+type MediastackArticle struct {
+	Author      string    `json:"author"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	Source      string    `json:"source"`
+	Image       *string   `json:"image"`
+	Category    string    `json:"category"`
+	Language    string    `json:"language"`
+	Country     string    `json:"country"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// mediastackSource fetches from Mediastack
+type mediastackSource struct {
+	deps   SourceDeps
+	apiKey string
+}
+
+func newMediastackSource(deps SourceDeps) NewsSource {
+	return &mediastackSource{
+		deps:   deps,
+		apiKey: deps.Config.GetSimpleAPIKeys()["mediastack"],
+	}
+}
+
+func (s *mediastackSource) Name() string { return string(models.APISourceMediastack) }
+
+func (s *mediastackSource) Enabled() bool { return s.apiKey != "" }
+
+func (s *mediastackSource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{Categories: true, Countries: true, Search: true, RequiresKey: true}
+}
+
+func (s *mediastackSource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:    s.deps.Config.GetSimpleAPIQuotas()["mediastack"],
+		Window:   24 * time.Hour,
+		Priority: 5,
+		Share:    0.125,
+	}
+}
+
+// Fetch converts every record from one Mediastack request
+func (s *mediastackSource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	records, err := s.FetchRaw(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawRecords(s, records, query, s.deps.Logger), nil
+}
+
+// FetchRaw fetches news from Mediastack API (BACKUP - use sparingly)
+func (s *mediastackSource) FetchRaw(ctx context.Context, query SourceQuery) ([]json.RawMessage, error) {
+	params := url.Values{}
+	params.Add("access_key", s.apiKey)
+	params.Add("languages", "en")
+	params.Add("limit", strconv.Itoa(query.Limit))
+	params.Add("sort", "published_desc")
+
+	if query.Category != "" && query.Category != "general" {
+		params.Add("categories", query.Category)
+	}
+	if query.Country != "" {
+		params.Add("countries", query.Country)
+	}
+	if query.Query != "" {
+		params.Add("keywords", query.Query)
+	}
+
+	var newsResponse struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := getSourceJSON(ctx, s.deps.HTTPClient, s.deps.Config.MediastackBaseURL+"?"+params.Encode(), nil, &newsResponse); err != nil {
+		return nil, err
+	}
+
+	return newsResponse.Data, nil
+}
+
+// Convert decodes one Mediastack record
+func (s *mediastackSource) Convert(record json.RawMessage, query SourceQuery) (*models.Article, error) {
+	var item MediastackArticle
+	if err := json.Unmarshal(record, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode Mediastack article: %w", err)
+	}
+	return convertMediastackToArticle(item, s.deps.Ranking), nil
+}
+
+// convertMediastackToArticle converts Mediastack article to our Article model
+func convertMediastackToArticle(item MediastackArticle, ranking *RankingPipeline) *models.Article {
+	var author *string
+	if item.Author != "" {
+		author = &item.Author
+	}
+
+	var description *string
+	if item.Description != "" {
+		description = &item.Description
+	}
+
+	var countries []string
+	if item.Country != "" {
+		countries = append(countries, item.Country)
+	}
+
+	// Mediastack only provides descriptions, so estimates come from those
+	wordCount := calculateWordCount(item.Description)
+
+	article := &models.Article{
+		ExternalID:         strPtr(fmt.Sprintf("mediastack_%s", hashURL(item.URL))),
+		Title:              item.Title,
+		Description:        description,
+		Content:            description,
+		URL:                item.URL,
+		ImageURL:           item.Image,
+		Source:             item.Source,
+		Author:             author,
+		PublishedAt:        item.PublishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    isIndiaRelatedContent(item.Title, item.Description, nil, countries),
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               pq.StringArray{}, // Mediastack doesn't provide tags
+		Language:           lang.Normalize(item.Language),
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: item.Category})
+	return article
+}
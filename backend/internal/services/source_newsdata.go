@@ -0,0 +1,192 @@
+// internal/services/source_newsdata.go
+// GoNews - NewsData.io News Source
+// Keyed daily-quota API with category, country and keyword filters
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+	"backend/pkg/lang"
+
+	"github.com/lib/pq"
+)
+
+func init() {
+	RegisterNewsSource(string(models.APISourceNewsData), newNewsDataSource)
+}
+
+// NewsDataResponse represents NewsData.io API response
+type NewsDataResponse struct {
+	Status       string            `json:"status"`
+	TotalResults int               `json:"totalResults"`
+	Results      []NewsDataArticle `json:"results"`
+	NextPage     string            `json:"nextPage"`
+}
+
+// NewsDataArticle represents an article from NewsData.io
+type NewsDataArticle struct {
+	ArticleID   string   `json:"article_id"`
+	Title       string   `json:"title"`
+	Link        string   `json:"link"`
+	Keywords    []string `json:"keywords"`
+	Creator     []string `json:"creator"`
+	VideoURL    *string  `json:"video_url"`
+	Description string   `json:"description"`
+	Content     string   `json:"content"`
+	PubDate     string   `json:"pubDate"`
+	ImageURL    *string  `json:"image_url"`
+	SourceID    string   `json:"source_id"`
+	Category    []string `json:"category"`
+	Country     []string `json:"country"`
+	Language    string   `json:"language"`
+}
+
+// newsDataSource fetches from NewsData.io
+type newsDataSource struct {
+	deps   SourceDeps
+	apiKey string
+}
+
+func newNewsDataSource(deps SourceDeps) NewsSource {
+	return &newsDataSource{
+		deps:   deps,
+		apiKey: deps.Config.GetSimpleAPIKeys()["newsdata"],
+	}
+}
+
+func (s *newsDataSource) Name() string { return string(models.APISourceNewsData) }
+
+func (s *newsDataSource) Enabled() bool { return s.apiKey != "" }
+
+func (s *newsDataSource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{
+		Categories: true, Countries: true, Search: true, FullContent: true, RequiresKey: true,
+		Languages: []string{
+			lang.Hindi, lang.Marathi, lang.Bengali, lang.Tamil, lang.Telugu, lang.Kannada,
+			lang.Malayalam, lang.Gujarati, lang.Punjabi, lang.Odia, lang.Urdu,
+		},
+	}
+}
+
+func (s *newsDataSource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:    s.deps.Config.GetSimpleAPIQuotas()["newsdata"],
+		Window:   24 * time.Hour,
+		Priority: 3,
+		Share:    0.25,
+	}
+}
+
+// Fetch converts every record from one NewsData.io request
+func (s *newsDataSource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	records, err := s.FetchRaw(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawRecords(s, records, query, s.deps.Logger), nil
+}
+
+// FetchRaw fetches news from NewsData.io API (150/day)
+func (s *newsDataSource) FetchRaw(ctx context.Context, query SourceQuery) ([]json.RawMessage, error) {
+	params := url.Values{}
+	params.Add("apikey", s.apiKey)
+	params.Add("language", query.languageCode())
+	params.Add("size", strconv.Itoa(query.Limit))
+
+	if query.Category != "" && query.Category != "general" {
+		params.Add("category", query.Category)
+	}
+	if query.Country != "" {
+		params.Add("country", query.Country)
+	}
+	if query.Query != "" {
+		params.Add("q", query.Query)
+	}
+
+	var newsResponse struct {
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := getSourceJSON(ctx, s.deps.HTTPClient, s.deps.Config.NewsDataBaseURL+"?"+params.Encode(), nil, &newsResponse); err != nil {
+		return nil, err
+	}
+
+	return newsResponse.Results, nil
+}
+
+// Convert decodes one NewsData.io record
+func (s *newsDataSource) Convert(record json.RawMessage, query SourceQuery) (*models.Article, error) {
+	var item NewsDataArticle
+	if err := json.Unmarshal(record, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode NewsData.io article: %w", err)
+	}
+	return convertNewsDataToArticle(item, s.deps.Ranking), nil
+}
+
+// convertNewsDataToArticle converts NewsData.io article to our Article model
+func convertNewsDataToArticle(item NewsDataArticle, ranking *RankingPipeline) *models.Article {
+	publishedAt, _ := time.Parse("2006-01-02 15:04:05", item.PubDate)
+
+	var author *string
+	if len(item.Creator) > 0 {
+		authorStr := strings.Join(item.Creator, ", ")
+		author = &authorStr
+	}
+
+	var description *string
+	if item.Description != "" {
+		description = &item.Description
+	}
+
+	var content *string
+	if item.Content != "" {
+		content = &item.Content
+	}
+
+	var externalID *string
+	if item.ArticleID != "" {
+		externalID = &item.ArticleID
+	}
+
+	var tags pq.StringArray
+	if len(item.Keywords) > 0 {
+		tags = pq.StringArray(item.Keywords)
+	}
+
+	wordCount := calculateWordCount(item.Content)
+
+	article := &models.Article{
+		ExternalID:         externalID,
+		Title:              item.Title,
+		Description:        description,
+		Content:            content,
+		URL:                item.Link,
+		ImageURL:           item.ImageURL,
+		Source:             item.SourceID,
+		Author:             author,
+		PublishedAt:        publishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    isIndiaRelatedContent(item.Title, item.Description, item.Keywords, item.Country),
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               tags,
+		Language:           lang.Normalize(item.Language), // NewsData.io names languages ("hindi")
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	var category string
+	if len(item.Category) > 0 {
+		category = item.Category[0]
+	}
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: category})
+	return article
+}
@@ -0,0 +1,149 @@
+// internal/services/source_rapidapi.go
+// GoNews - RapidAPI News Source
+// Round-robins the configured RapidAPI news hosts; fallback only, not part of aggregate fan-out
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"backend/internal/models"
+
+	"github.com/lib/pq"
+)
+
+const defaultRapidAPIEndpoint = "news-api14.p.rapidapi.com"
+
+func init() {
+	RegisterNewsSource(string(models.APISourceRapidAPI), newRapidAPISource)
+}
+
+// rapidAPISource fetches from RapidAPI-hosted news endpoints
+type rapidAPISource struct {
+	deps SourceDeps
+
+	apiKey    string
+	endpoints []string
+	rateLimit int
+
+	currentEndpoint int
+	endpointMutex   sync.Mutex
+}
+
+func newRapidAPISource(deps SourceDeps) NewsSource {
+	apiKey, _, _, endpoints := deps.Config.GetRapidAPIConfig()
+	rateLimit, _, _ := deps.Config.GetRapidAPIRateConfig()
+
+	return &rapidAPISource{
+		deps:      deps,
+		apiKey:    apiKey,
+		endpoints: endpoints,
+		rateLimit: rateLimit,
+	}
+}
+
+func (s *rapidAPISource) Name() string { return string(models.APISourceRapidAPI) }
+
+func (s *rapidAPISource) Enabled() bool { return s.apiKey != "" }
+
+func (s *rapidAPISource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{RequiresKey: true}
+}
+
+func (s *rapidAPISource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:    s.rateLimit,
+		Window:   time.Hour,
+		Priority: 2,
+	}
+}
+
+// Fetch fetches from the next RapidAPI endpoint in rotation
+func (s *rapidAPISource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	endpoint := s.nextEndpoint()
+
+	headers := map[string]string{
+		"X-RapidAPI-Key":  s.apiKey,
+		"X-RapidAPI-Host": endpoint,
+		"User-Agent":      "GoNews/1.0",
+		"Accept":          "application/json",
+	}
+
+	var apiResponse NewsAPIResponse
+	if err := getSourceJSON(ctx, s.deps.HTTPClient, fmt.Sprintf("https://%s/news", endpoint), headers, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	articles := make([]*models.Article, 0, len(apiResponse.Articles))
+	for _, item := range apiResponse.Articles {
+		articles = append(articles, convertExternalToArticle(item, query.Category, s.deps.Ranking))
+	}
+
+	return articles, nil
+}
+
+// nextEndpoint returns the next configured host with round-robin
+func (s *rapidAPISource) nextEndpoint() string {
+	s.endpointMutex.Lock()
+	defer s.endpointMutex.Unlock()
+
+	if len(s.endpoints) == 0 {
+		return defaultRapidAPIEndpoint
+	}
+
+	endpoint := s.endpoints[s.currentEndpoint]
+	s.currentEndpoint = (s.currentEndpoint + 1) % len(s.endpoints)
+	return endpoint
+}
+
+// convertExternalToArticle converts a generic news API article to our Article model
+func convertExternalToArticle(item models.ExternalArticle, category string, ranking *RankingPipeline) *models.Article {
+	description := ""
+	if item.Description != nil {
+		description = *item.Description
+	}
+
+	var countries []string
+	if item.Country != nil {
+		countries = append(countries, *item.Country)
+	}
+	if item.Category != nil && category == "" {
+		category = *item.Category
+	}
+
+	externalID := item.ID
+	if externalID == nil || *externalID == "" {
+		externalID = strPtr(fmt.Sprintf("rapidapi_%s", hashURL(item.URL)))
+	}
+
+	wordCount := 0
+	if item.Content != nil {
+		wordCount = calculateWordCount(*item.Content)
+	}
+
+	article := &models.Article{
+		ExternalID:         externalID,
+		Title:              item.Title,
+		Description:        item.Description,
+		Content:            item.Content,
+		URL:                item.URL,
+		ImageURL:           item.ImageURL,
+		Source:             item.Source,
+		Author:             item.Author,
+		PublishedAt:        item.PublishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    isIndiaRelatedContent(item.Title, description, nil, countries),
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               pq.StringArray{},
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: category})
+	return article
+}
@@ -0,0 +1,746 @@
+// internal/services/source_rss.go
+// GoNews - RSS/Atom News Source
+// Polls publisher feeds (RSS 2.0, RSS 1.0/RDF, Atom, media:content) with conditional GET and per-feed intervals
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/models"
+	"backend/internal/repository"
+
+	"github.com/lib/pq"
+)
+
+// SourceRSS is the registry name of the feed source
+const SourceRSS = "rss"
+
+const (
+	// maxFeedBytes bounds how much of a feed document is read
+	maxFeedBytes = 10 << 20
+
+	// maxFeedBackoff caps the retry delay for failing feeds
+	maxFeedBackoff = 24 * time.Hour
+
+	// maxFeedTags bounds how many item categories become article tags
+	maxFeedTags = 10
+)
+
+var (
+	feedHTMLTag   = regexp.MustCompile(`(?s)<[^>]*>`)
+	feedImgSrc    = regexp.MustCompile(`(?i)<img[^>]+src=["']([^"']+)["']`)
+	feedSpaceRuns = regexp.MustCompile(`\s+`)
+)
+
+// ===============================
+// FEED DOCUMENT STRUCTURES
+// ===============================
+
+// rssDocument covers RSS 2.0 (items under channel) and RSS 1.0/RDF (items at the root)
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title          string           `xml:"title"`
+	Link           string           `xml:"link"`
+	GUID           string           `xml:"guid"`
+	Description    string           `xml:"description"`
+	ContentEncoded string           `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate        string           `xml:"pubDate"`
+	DCDate         string           `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Author         string           `xml:"author"`
+	Creator        string           `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Categories     []string         `xml:"category"`
+	Enclosures     []feedEnclosure  `xml:"enclosure"`
+	MediaContent   []feedMedia      `xml:"http://search.yahoo.com/mrss/ content"`
+	MediaThumbnail []feedMedia      `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	MediaGroup     []feedMediaGroup `xml:"http://search.yahoo.com/mrss/ group"`
+}
+
+// atomDocument is an Atom 1.0 feed
+type atomDocument struct {
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID             string           `xml:"id"`
+	Title          string           `xml:"title"`
+	Links          []atomLink       `xml:"link"`
+	Summary        string           `xml:"summary"`
+	Content        string           `xml:"content"`
+	Published      string           `xml:"published"`
+	Updated        string           `xml:"updated"`
+	Authors        []atomPerson     `xml:"author"`
+	Categories     []atomCategory   `xml:"category"`
+	MediaContent   []feedMedia      `xml:"http://search.yahoo.com/mrss/ content"`
+	MediaThumbnail []feedMedia      `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	MediaGroup     []feedMediaGroup `xml:"http://search.yahoo.com/mrss/ group"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+type feedEnclosure struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type feedMedia struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Medium string `xml:"medium,attr"`
+}
+
+type feedMediaGroup struct {
+	Content   []feedMedia `xml:"http://search.yahoo.com/mrss/ content"`
+	Thumbnail []feedMedia `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+}
+
+// feedItem is a parsed item independent of the feed format
+type feedItem struct {
+	GUID        string
+	Title       string
+	Link        string
+	Summary     string
+	Content     string
+	Author      string
+	PublishedAt time.Time
+	ImageURL    string
+	Categories  []string
+}
+
+// ===============================
+// RSS SOURCE
+// ===============================
+
+// rssSource polls the configured publisher feeds
+type rssSource struct {
+	deps        SourceDeps
+	feedRepo    *repository.FeedRepository
+	articleRepo *repository.ArticleRepository
+
+	// Feeds being polled right now, so the poller and aggregate fetches don't double-fetch
+	inFlight      map[int]bool
+	inFlightMutex sync.Mutex
+}
+
+// newRSSSourceFactory binds the feed and article repositories into a source factory
+func newRSSSourceFactory(feedRepo *repository.FeedRepository, articleRepo *repository.ArticleRepository) SourceFactory {
+	return func(deps SourceDeps) NewsSource {
+		return &rssSource{
+			deps:        deps,
+			feedRepo:    feedRepo,
+			articleRepo: articleRepo,
+			inFlight:    make(map[int]bool),
+		}
+	}
+}
+
+func (s *rssSource) Name() string { return SourceRSS }
+
+func (s *rssSource) Enabled() bool { return s.deps.Config.FeedsEnabled }
+
+func (s *rssSource) Capabilities() SourceCapabilities {
+	return SourceCapabilities{Categories: true, FullContent: true}
+}
+
+// Quota counts aggregate fetches, each of which polls only the feeds that are due
+func (s *rssSource) Quota() SourceQuota {
+	return SourceQuota{
+		Limit:    120,
+		Window:   time.Hour,
+		Priority: 2,
+		Share:    0.25,
+	}
+}
+
+// Fetch polls due feeds matching the category and returns their new items.
+// Feeds that are not due or answer 304 contribute nothing; the poller has already stored their items.
+func (s *rssSource) Fetch(ctx context.Context, query SourceQuery) ([]*models.Article, error) {
+	maxFeeds := s.deps.Config.FeedMaxConcurrentPolls
+	if maxFeeds <= 0 {
+		maxFeeds = 4
+	}
+
+	feeds, err := s.feedRepo.GetDueFeeds(maxFeeds * 4)
+	if err != nil {
+		return nil, err
+	}
+
+	var articles []*models.Article
+	polled := 0
+	for _, feed := range feeds {
+		if polled >= maxFeeds || (query.Limit > 0 && len(articles) >= query.Limit) {
+			break
+		}
+		if !feedMatchesCategory(feed, query.Category) {
+			continue
+		}
+
+		polled++
+		feedArticles, _ := s.pollFeed(ctx, feed)
+		articles = append(articles, feedArticles...)
+	}
+
+	if query.Limit > 0 && len(articles) > query.Limit {
+		articles = articles[:query.Limit]
+	}
+
+	return articles, nil
+}
+
+// feedMatchesCategory reports whether a feed serves the requested category
+func feedMatchesCategory(feed *models.NewsFeed, category string) bool {
+	if category == "" || category == "general" || category == "all" {
+		return true
+	}
+	return feed.CategorySlug != nil && strings.EqualFold(*feed.CategorySlug, category)
+}
+
+// pollFeed fetches one feed, records the outcome against it and returns its items as articles
+func (s *rssSource) pollFeed(ctx context.Context, feed *models.NewsFeed) ([]*models.Article, models.FeedPollResult) {
+	result := models.FeedPollResult{FeedID: feed.ID, FeedName: feed.Name}
+
+	if !s.acquire(feed.ID) {
+		result.Status = models.FeedPollSkipped
+		return nil, result
+	}
+	defer s.release(feed.ID)
+
+	startTime := time.Now()
+	items, outcome := s.fetchFeed(ctx, feed)
+	outcome.Duration = time.Since(startTime)
+	outcome.ItemCount = len(items)
+	outcome.NextPollAt = s.nextPollAt(feed, outcome.Status == models.FeedPollError)
+
+	if err := s.feedRepo.RecordPoll(feed.ID, outcome); err != nil {
+		s.deps.Logger.Error("Failed to record feed poll", map[string]interface{}{
+			"feed_id": feed.ID,
+			"error":   err.Error(),
+		})
+	}
+
+	result.Status = outcome.Status
+	result.StatusCode = outcome.StatusCode
+	result.Items = len(items)
+	result.Duration = outcome.Duration
+	if outcome.Err != nil {
+		result.Error = outcome.Err.Error()
+		s.deps.Logger.Warn("Feed poll failed", map[string]interface{}{
+			"feed_id":     feed.ID,
+			"feed":        feed.Name,
+			"status_code": outcome.StatusCode,
+			"error":       outcome.Err.Error(),
+		})
+	}
+
+	categoryID := s.resolveCategoryID(feed.CategorySlug)
+	articles := make([]*models.Article, 0, len(items))
+	for _, item := range items {
+		articles = append(articles, convertFeedItemToArticle(item, feed, categoryID, s.deps.Ranking))
+	}
+
+	return articles, result
+}
+
+// fetchFeed performs the conditional GET and parses a 200 response
+func (s *rssSource) fetchFeed(ctx context.Context, feed *models.NewsFeed) ([]feedItem, models.FeedPollOutcome) {
+	outcome := models.FeedPollOutcome{Status: models.FeedPollError}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
+	if err != nil {
+		outcome.Err = fmt.Errorf("failed to create feed request: %w", err)
+		return nil, outcome
+	}
+	req.Header.Set("User-Agent", s.deps.Config.FeedUserAgent)
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.8, */*;q=0.5")
+	if feed.ETag != nil && *feed.ETag != "" {
+		req.Header.Set("If-None-Match", *feed.ETag)
+	}
+	if feed.LastModified != nil && *feed.LastModified != "" {
+		req.Header.Set("If-Modified-Since", *feed.LastModified)
+	}
+
+	resp, err := s.deps.HTTPClient.Do(req)
+	if err != nil {
+		outcome.Err = fmt.Errorf("feed request failed: %w", err)
+		return nil, outcome
+	}
+	defer resp.Body.Close()
+
+	outcome.StatusCode = resp.StatusCode
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		outcome.Status = models.FeedPollNotModified
+		return nil, outcome
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		outcome.Err = fmt.Errorf("feed returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		return nil, outcome
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedBytes))
+	if err != nil {
+		outcome.Err = fmt.Errorf("failed to read feed: %w", err)
+		return nil, outcome
+	}
+
+	items, err := parseFeed(body)
+	if err != nil {
+		outcome.Err = err
+		return nil, outcome
+	}
+
+	maxItems := s.deps.Config.FeedMaxItemsPerPoll
+	if maxItems > 0 && len(items) > maxItems {
+		items = items[:maxItems]
+	}
+
+	outcome.Status = models.FeedPollOK
+	outcome.ETag = resp.Header.Get("ETag")
+	outcome.LastModified = resp.Header.Get("Last-Modified")
+	return items, outcome
+}
+
+// nextPollAt schedules the next poll at the feed's interval, backing off exponentially on failure
+func (s *rssSource) nextPollAt(feed *models.NewsFeed, failed bool) time.Time {
+	interval := time.Duration(s.pollIntervalMinutes(feed.PollIntervalMinutes)) * time.Minute
+
+	if failed {
+		// ConsecutiveFailures is the count before this failure
+		exponent := math.Min(float64(feed.ConsecutiveFailures+1), 6)
+		interval = time.Duration(float64(interval) * math.Pow(2, exponent))
+		if interval > maxFeedBackoff {
+			interval = maxFeedBackoff
+		}
+	}
+
+	return time.Now().Add(interval)
+}
+
+// pollIntervalMinutes clamps a requested interval to the configured floor
+func (s *rssSource) pollIntervalMinutes(minutes int) int {
+	if minutes <= 0 {
+		minutes = s.deps.Config.FeedDefaultPollMinutes
+	}
+	if floor := s.deps.Config.FeedMinPollMinutes; minutes < floor {
+		minutes = floor
+	}
+	return minutes
+}
+
+// resolveCategoryID maps a feed's category slug to a category ID
+func (s *rssSource) resolveCategoryID(slug *string) *int {
+	if slug == nil || *slug == "" || s.articleRepo == nil {
+		return nil
+	}
+
+	_, slugToID, err := s.articleRepo.GetCategoryMapping()
+	if err != nil {
+		return nil
+	}
+
+	if id, ok := slugToID[*slug]; ok {
+		return &id
+	}
+	return nil
+}
+
+func (s *rssSource) acquire(feedID int) bool {
+	s.inFlightMutex.Lock()
+	defer s.inFlightMutex.Unlock()
+
+	if s.inFlight[feedID] {
+		return false
+	}
+	s.inFlight[feedID] = true
+	return true
+}
+
+func (s *rssSource) release(feedID int) {
+	s.inFlightMutex.Lock()
+	delete(s.inFlight, feedID)
+	s.inFlightMutex.Unlock()
+}
+
+// ===============================
+// PARSING
+// ===============================
+
+// parseFeed detects the document format from its root element and parses its items
+func parseFeed(body []byte) ([]feedItem, error) {
+	root, err := feedRootElement(body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root {
+	case "rss", "RDF":
+		var doc rssDocument
+		if err := newFeedDecoder(body).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
+		}
+		items := append(doc.Channel.Items, doc.Items...)
+		parsed := make([]feedItem, 0, len(items))
+		for _, item := range items {
+			if fi, ok := item.toFeedItem(); ok {
+				parsed = append(parsed, fi)
+			}
+		}
+		return parsed, nil
+
+	case "feed":
+		var doc atomDocument
+		if err := newFeedDecoder(body).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to parse Atom feed: %w", err)
+		}
+		parsed := make([]feedItem, 0, len(doc.Entries))
+		for _, entry := range doc.Entries {
+			if fi, ok := entry.toFeedItem(); ok {
+				parsed = append(parsed, fi)
+			}
+		}
+		return parsed, nil
+	}
+
+	return nil, fmt.Errorf("unsupported feed format: <%s>", root)
+}
+
+// feedRootElement returns the local name of the document's root element
+func feedRootElement(body []byte) (string, error) {
+	decoder := newFeedDecoder(body)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("failed to read feed document: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+// newFeedDecoder builds a lenient decoder that accepts Latin-1 encoded feeds and HTML entities.
+// AutoClose is left off: HTML treats <link> as a void element, which would drop RSS item links.
+func newFeedDecoder(body []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	decoder.CharsetReader = feedCharsetReader
+	return decoder
+}
+
+// feedCharsetReader converts single-byte Western encodings to UTF-8
+func feedCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8", "us-ascii", "ascii":
+		return input, nil
+	case "iso-8859-1", "latin1", "latin-1", "windows-1252", "cp1252":
+		raw, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 0, len(raw)+len(raw)/4)
+		for _, b := range raw {
+			buf = utf8.AppendRune(buf, rune(b))
+		}
+		return bytes.NewReader(buf), nil
+	}
+	return nil, fmt.Errorf("unsupported feed charset %q", charset)
+}
+
+// toFeedItem normalizes an RSS item; items without a link or title are dropped
+func (item rssItem) toFeedItem() (feedItem, bool) {
+	link := strings.TrimSpace(item.Link)
+	if link == "" && strings.HasPrefix(strings.TrimSpace(item.GUID), "http") {
+		link = strings.TrimSpace(item.GUID)
+	}
+	title := cleanFeedText(item.Title)
+	if link == "" || title == "" {
+		return feedItem{}, false
+	}
+
+	author := strings.TrimSpace(item.Creator)
+	if author == "" {
+		author = strings.TrimSpace(item.Author)
+	}
+
+	published := parseFeedDate(item.PubDate)
+	if published.IsZero() {
+		published = parseFeedDate(item.DCDate)
+	}
+
+	guid := strings.TrimSpace(item.GUID)
+	if guid == "" {
+		guid = link
+	}
+
+	image := pickFeedImage(item.MediaContent, item.MediaThumbnail, item.MediaGroup)
+	if image == "" {
+		for _, enclosure := range item.Enclosures {
+			if strings.HasPrefix(enclosure.Type, "image/") && enclosure.URL != "" {
+				image = enclosure.URL
+				break
+			}
+		}
+	}
+	if image == "" {
+		image = firstFeedImage(item.ContentEncoded + item.Description)
+	}
+
+	return feedItem{
+		GUID:        guid,
+		Title:       title,
+		Link:        link,
+		Summary:     cleanFeedText(item.Description),
+		Content:     cleanFeedText(item.ContentEncoded),
+		Author:      author,
+		PublishedAt: published,
+		ImageURL:    image,
+		Categories:  item.Categories,
+	}, true
+}
+
+// toFeedItem normalizes an Atom entry; entries without a link or title are dropped
+func (entry atomEntry) toFeedItem() (feedItem, bool) {
+	var link string
+	for _, l := range entry.Links {
+		if l.Rel == "" || l.Rel == "alternate" {
+			link = strings.TrimSpace(l.Href)
+			break
+		}
+	}
+	if link == "" && len(entry.Links) > 0 {
+		link = strings.TrimSpace(entry.Links[0].Href)
+	}
+	title := cleanFeedText(entry.Title)
+	if link == "" || title == "" {
+		return feedItem{}, false
+	}
+
+	published := parseFeedDate(entry.Published)
+	if published.IsZero() {
+		published = parseFeedDate(entry.Updated)
+	}
+
+	var author string
+	if len(entry.Authors) > 0 {
+		author = strings.TrimSpace(entry.Authors[0].Name)
+	}
+
+	var categories []string
+	for _, category := range entry.Categories {
+		if category.Label != "" {
+			categories = append(categories, category.Label)
+		} else {
+			categories = append(categories, category.Term)
+		}
+	}
+
+	image := pickFeedImage(entry.MediaContent, entry.MediaThumbnail, entry.MediaGroup)
+	if image == "" {
+		for _, l := range entry.Links {
+			if l.Rel == "enclosure" && strings.HasPrefix(l.Type, "image/") {
+				image = l.Href
+				break
+			}
+		}
+	}
+	if image == "" {
+		image = firstFeedImage(entry.Content + entry.Summary)
+	}
+
+	guid := strings.TrimSpace(entry.ID)
+	if guid == "" {
+		guid = link
+	}
+
+	return feedItem{
+		GUID:        guid,
+		Title:       title,
+		Link:        link,
+		Summary:     cleanFeedText(entry.Summary),
+		Content:     cleanFeedText(entry.Content),
+		Author:      author,
+		PublishedAt: published,
+		ImageURL:    image,
+		Categories:  categories,
+	}, true
+}
+
+// pickFeedImage prefers media:content images, then thumbnails, then the same inside media:group
+func pickFeedImage(contents, thumbnails []feedMedia, groups []feedMediaGroup) string {
+	for _, group := range groups {
+		contents = append(contents, group.Content...)
+		thumbnails = append(thumbnails, group.Thumbnail...)
+	}
+
+	for _, media := range contents {
+		if media.URL == "" {
+			continue
+		}
+		if media.Medium == "image" || strings.HasPrefix(media.Type, "image/") ||
+			(media.Medium == "" && media.Type == "") {
+			return media.URL
+		}
+	}
+	for _, media := range thumbnails {
+		if media.URL != "" {
+			return media.URL
+		}
+	}
+	return ""
+}
+
+// firstFeedImage returns the first <img> source in an HTML fragment
+func firstFeedImage(fragment string) string {
+	if match := feedImgSrc.FindStringSubmatch(fragment); len(match) == 2 {
+		return html.UnescapeString(match[1])
+	}
+	return ""
+}
+
+// cleanFeedText strips markup and entities and collapses whitespace
+func cleanFeedText(text string) string {
+	text = feedHTMLTag.ReplaceAllString(text, " ")
+	text = html.UnescapeString(text)
+	return strings.TrimSpace(feedSpaceRuns.ReplaceAllString(text, " "))
+}
+
+// feedDateLayouts are the date formats seen in the wild, most common first
+var feedDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 02 Jan 2006 15:04 -0700",
+	"02 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC822Z,
+	time.RFC822,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"Mon, 02 Jan 2006 15:04:05",
+}
+
+// parseFeedDate parses an RSS/Atom date, returning the zero time if no layout matches
+func parseFeedDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range feedDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+// convertFeedItemToArticle maps a feed item onto our Article model
+func convertFeedItemToArticle(item feedItem, feed *models.NewsFeed, categoryID *int, ranking *RankingPipeline) *models.Article {
+	publishedAt := item.PublishedAt
+	if publishedAt.IsZero() || publishedAt.After(time.Now().Add(time.Hour)) {
+		publishedAt = time.Now()
+	}
+
+	var description, content, imageURL, author *string
+	if item.Summary != "" {
+		description = strPtr(item.Summary)
+	}
+	if item.Content != "" {
+		content = strPtr(item.Content)
+	} else {
+		content = description
+	}
+	if item.ImageURL != "" {
+		imageURL = strPtr(item.ImageURL)
+	}
+	if item.Author != "" {
+		author = strPtr(item.Author)
+	}
+
+	tags := pq.StringArray{}
+	seen := make(map[string]bool)
+	for _, category := range item.Categories {
+		tag := cleanFeedText(category)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+		if len(tags) >= maxFeedTags {
+			break
+		}
+	}
+
+	wordCount := calculateWordCount(item.Content)
+	if wordCount == 0 {
+		wordCount = calculateWordCount(item.Summary)
+	}
+
+	var categorySlug string
+	if feed.CategorySlug != nil {
+		categorySlug = *feed.CategorySlug
+	}
+
+	article := &models.Article{
+		ExternalID:         strPtr(fmt.Sprintf("rss_%d_%s", feed.ID, hashURL(item.GUID))),
+		Title:              item.Title,
+		Description:        description,
+		Content:            content,
+		URL:                item.Link,
+		ImageURL:           imageURL,
+		Source:             feed.Name,
+		Author:             author,
+		CategoryID:         categoryID,
+		PublishedAt:        publishedAt,
+		FetchedAt:          time.Now(),
+		IsIndianContent:    feed.IsIndian || isIndiaRelatedContent(item.Title, item.Summary, item.Categories, nil),
+		WordCount:          wordCount,
+		ReadingTimeMinutes: calculateReadingTime(wordCount),
+		Tags:               tags,
+		IsActive:           true,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	article.RelevanceScore = ranking.Score(article, &RankingContext{Category: categorySlug})
+	return article
+}
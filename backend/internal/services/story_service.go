@@ -0,0 +1,600 @@
+// internal/services/story_service.go
+// GoNews - Story Clustering Service
+// Groups articles about the same event into stories, incrementally, by comparing new articles
+// with recent stories on title words, text fingerprints, GDELT themes and entities, and time
+
+package services
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/fingerprint"
+	"backend/pkg/logger"
+)
+
+const (
+	// Signal weights; a signal missing on either side is left out and the rest renormalized
+	storyTitleWeight   = 0.45
+	storySimHashWeight = 0.25
+	storyEntityWeight  = 0.20
+	storyThemeWeight   = 0.10
+
+	// SimHash distances at or below storySimHashNear count as the same report; beyond
+	// storySimHashFar the fingerprints say nothing
+	storySimHashNear = 8
+	storySimHashFar  = 24
+
+	// storyTimeDecayHours is how quickly the gap to a story's time span lowers the score;
+	// at most storyTimePenalty of the score is lost to time
+	storyTimeDecayHours = 24.0
+	storyTimePenalty    = 0.3
+
+	// storyMinSignalWeight keeps entity and theme overlap out of the score when an article only
+	// has labels most stories share (a lone "India" location says nothing about the event)
+	storyMinSignalWeight = 2.0
+
+	// storyMaxMembersCompared limits comparisons to a story's most recent articles
+	storyMaxMembersCompared = 25
+
+	// storyMaxPostings skips terms shared by this many stories when looking for candidates
+	storyMaxPostings = 300
+
+	// storyMaxLabels caps the themes and entities stored per story
+	storyMaxLabels = 30
+)
+
+// storyStopWords are title words too common in headlines to tie two articles to one event
+var storyStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true, "into": true, "over": true,
+	"after": true, "amid": true, "says": true, "said": true, "will": true, "are": true, "was": true,
+	"has": true, "have": true, "its": true, "this": true, "that": true, "new": true, "news": true,
+	"live": true, "updates": true, "latest": true, "today": true, "how": true, "why": true,
+	"what": true, "who": true, "his": true, "her": true, "their": true, "not": true, "but": true,
+	"all": true, "out": true, "about": true, "more": true, "than": true, "top": true,
+}
+
+// StoryService clusters articles into stories in the background and serves story pages
+type StoryService struct {
+	config      *config.Config
+	logger      *logger.Logger
+	repo        *repository.StoryRepository
+	articleRepo *repository.ArticleRepository
+
+	clusterMutex sync.Mutex // One clustering pass at a time (worker and admin trigger)
+	stopChan     chan struct{}
+	wg           sync.WaitGroup
+}
+
+// NewStoryService creates the story clustering service
+func NewStoryService(cfg *config.Config, log *logger.Logger, repo *repository.StoryRepository, articleRepo *repository.ArticleRepository) *StoryService {
+	return &StoryService{
+		config:      cfg,
+		logger:      log,
+		repo:        repo,
+		articleRepo: articleRepo,
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start begins clustering new articles in the background
+func (s *StoryService) Start() {
+	if !s.config.StoryClusteringEnabled {
+		s.logger.Info("Story clustering disabled by configuration")
+		return
+	}
+
+	interval := time.Duration(s.config.StoryClusterIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 2 * time.Minute
+	}
+
+	s.wg.Add(1)
+	go s.runWorker(interval)
+
+	s.logger.Info("Story clustering worker started", map[string]interface{}{
+		"interval":     interval.String(),
+		"batch_size":   s.config.StoryClusterBatchSize,
+		"window_hours": s.config.StoryWindowHours,
+		"threshold":    s.config.StorySimilarityThreshold,
+	})
+}
+
+// runWorker runs one clustering pass per tick
+func (s *StoryService) runWorker(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.ClusterPending(); err != nil {
+				s.logger.Error("Story clustering pass failed", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// Close stops the background worker
+func (s *StoryService) Close() {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Story clustering service stopped")
+}
+
+// ===============================
+// CLUSTERING
+// ===============================
+
+// ClusterPending assigns articles not yet in a story to the most similar recent story, or
+// starts a new story for them. Stories are rebuilt from the database on each pass, so the
+// job resumes where it left off after a restart.
+func (s *StoryService) ClusterPending() (*models.StoryClusterRun, error) {
+	s.clusterMutex.Lock()
+	defer s.clusterMutex.Unlock()
+
+	startTime := time.Now()
+	window := s.window()
+	since := startTime.Add(-window)
+
+	members, err := s.repo.GetActiveStoryArticles(since)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.repo.GetUnclusteredArticles(since, maxInt(1, s.config.StoryClusterBatchSize))
+	if err != nil {
+		return nil, err
+	}
+
+	index := newStoryIndex()
+	for _, member := range members {
+		if member.StoryID != nil {
+			index.add(*member.StoryID, newStoryFeatures(member))
+		}
+	}
+
+	run := &models.StoryClusterRun{Articles: len(pending)}
+	for _, article := range pending {
+		features := newStoryFeatures(article)
+
+		cluster, similarity := index.best(features, window)
+		if cluster != nil && similarity >= s.config.StorySimilarityThreshold {
+			cluster.add(features)
+			err := s.repo.AddToStory(cluster.id, article.ArticleID, roundScore(similarity),
+				topStoryLabels(cluster.themes), topStoryLabels(cluster.entities))
+			if err != nil {
+				s.logger.Warn("Failed to add article to story", map[string]interface{}{
+					"article_id": article.ArticleID,
+					"story_id":   cluster.id,
+					"error":      err.Error(),
+				})
+				continue
+			}
+			index.indexTerms(cluster.id, features)
+			run.Joined++
+			continue
+		}
+
+		storyID, err := s.repo.CreateStory(article, storyLabelList(features.themes), storyLabelList(features.entities))
+		if err != nil {
+			s.logger.Warn("Failed to create story", map[string]interface{}{
+				"article_id": article.ArticleID,
+				"error":      err.Error(),
+			})
+			continue
+		}
+		index.add(storyID, features)
+		run.Created++
+	}
+
+	run.Stories = len(index.clusters)
+	run.DurationMs = time.Since(startTime).Milliseconds()
+
+	if run.Articles > 0 {
+		s.logger.Info("Story clustering pass completed", map[string]interface{}{
+			"articles":       run.Articles,
+			"joined":         run.Joined,
+			"created":        run.Created,
+			"active_stories": run.Stories,
+			"duration_ms":    run.DurationMs,
+		})
+	}
+	return run, nil
+}
+
+// window is how long after its newest article a story can still gain articles
+func (s *StoryService) window() time.Duration {
+	return time.Duration(maxInt(1, s.config.StoryWindowHours)) * time.Hour
+}
+
+// ===============================
+// STORY PAGES & FEED GROUPING
+// ===============================
+
+// GetTimeline returns a story with its articles ordered oldest first
+func (s *StoryService) GetTimeline(storyID int) (*models.StoryTimeline, error) {
+	story, err := s.repo.GetStory(storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.repo.GetStoryArticleIDs(storyID)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := s.articleRepo.GetArticlesByIDsInOrder(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, article := range articles {
+		if story.LeadArticleID != nil && article.ID == *story.LeadArticleID {
+			story.LeadArticle = article
+		}
+	}
+
+	return &models.StoryTimeline{Story: story, Articles: articles}, nil
+}
+
+// ListStories returns recently updated stories with their lead articles
+func (s *StoryService) ListStories(categoryID *int, minArticles, limit, offset int) ([]*models.Story, error) {
+	stories, err := s.repo.ListStories(categoryID, minArticles, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	leadIDs := make([]int, 0, len(stories))
+	for _, story := range stories {
+		if story.LeadArticleID != nil {
+			leadIDs = append(leadIDs, *story.LeadArticleID)
+		}
+	}
+	leads, err := s.articleRepo.GetArticlesByIDs(leadIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*models.Article, len(leads))
+	for _, article := range leads {
+		byID[article.ID] = article
+	}
+	for _, story := range stories {
+		if story.LeadArticleID != nil {
+			story.LeadArticle = byID[*story.LeadArticleID]
+		}
+	}
+	return stories, nil
+}
+
+// CollapseByStory keeps only the highest ranked article of each multi-article story and
+// attaches the story card to it. Articles in no story, or alone in theirs, pass through.
+// Ranked articles may be shared with the cache, so cards go on copies.
+func (s *StoryService) CollapseByStory(articles []*models.Article) []*models.Article {
+	ids := make([]int, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+
+	cards, err := s.repo.GetStoryCards(ids)
+	if err != nil {
+		s.logger.Warn("Failed to load story cards, returning ungrouped feed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return articles
+	}
+
+	seen := make(map[int]bool, len(cards))
+	collapsed := make([]*models.Article, 0, len(articles))
+	for _, article := range articles {
+		card := cards[article.ID]
+		if card == nil || card.ArticleCount < 2 {
+			collapsed = append(collapsed, article)
+			continue
+		}
+		if seen[card.ID] {
+			continue
+		}
+		seen[card.ID] = true
+
+		grouped := *article
+		grouped.Story = card
+		collapsed = append(collapsed, &grouped)
+	}
+	return collapsed
+}
+
+// ===============================
+// SIMILARITY
+// ===============================
+
+// storyFeatures is what clustering compares for one article
+type storyFeatures struct {
+	tokens      map[string]bool
+	entities    map[string]bool
+	themes      map[string]bool
+	simHash     *uint64
+	publishedAt time.Time
+}
+
+// storyCluster is one story during a clustering pass
+type storyCluster struct {
+	id       int
+	members  []*storyFeatures
+	entities map[string]int
+	themes   map[string]int
+	first    time.Time
+	last     time.Time
+}
+
+// storyIndex holds the pass's stories and, per term, the stories using it, which both finds
+// candidates and weights terms by how rare they are across stories
+type storyIndex struct {
+	clusters map[int]*storyCluster
+	byToken  map[string]map[int]bool
+	byEntity map[string]map[int]bool
+	byTheme  map[string]map[int]bool
+}
+
+func newStoryIndex() *storyIndex {
+	return &storyIndex{
+		clusters: make(map[int]*storyCluster),
+		byToken:  make(map[string]map[int]bool),
+		byEntity: make(map[string]map[int]bool),
+		byTheme:  make(map[string]map[int]bool),
+	}
+}
+
+// newStoryFeatures extracts distinctive title words, GDELT labels and the SimHash
+func newStoryFeatures(article *models.StoryArticle) *storyFeatures {
+	features := &storyFeatures{
+		tokens:      make(map[string]bool),
+		entities:    make(map[string]bool),
+		themes:      make(map[string]bool),
+		publishedAt: article.PublishedAt,
+	}
+
+	for _, token := range fingerprint.Tokens(article.Title) {
+		if len(token) > 2 && !storyStopWords[token] {
+			features.tokens[token] = true
+		}
+	}
+	for _, labels := range [][]string{article.GDELTPersons, article.GDELTOrganizations, article.GDELTLocations} {
+		for _, label := range labels {
+			if label = strings.ToLower(strings.TrimSpace(label)); label != "" {
+				features.entities[label] = true
+			}
+		}
+	}
+	for _, theme := range article.GDELTThemes {
+		if theme = strings.ToLower(strings.TrimSpace(theme)); theme != "" {
+			features.themes[theme] = true
+		}
+	}
+	if article.SimHash != nil {
+		simHash := uint64(*article.SimHash)
+		features.simHash = &simHash
+	}
+	return features
+}
+
+// add puts an article in a story, creating the story if it is new to the index
+func (x *storyIndex) add(storyID int, features *storyFeatures) {
+	cluster, exists := x.clusters[storyID]
+	if !exists {
+		cluster = &storyCluster{
+			id:       storyID,
+			entities: make(map[string]int),
+			themes:   make(map[string]int),
+		}
+		x.clusters[storyID] = cluster
+	}
+	cluster.add(features)
+	x.indexTerms(storyID, features)
+}
+
+// indexTerms records that a story uses the article's terms
+func (x *storyIndex) indexTerms(storyID int, features *storyFeatures) {
+	addStoryPostings(x.byToken, features.tokens, storyID)
+	addStoryPostings(x.byEntity, features.entities, storyID)
+	addStoryPostings(x.byTheme, features.themes, storyID)
+}
+
+func addStoryPostings(postings map[string]map[int]bool, terms map[string]bool, storyID int) {
+	for term := range terms {
+		if postings[term] == nil {
+			postings[term] = make(map[int]bool)
+		}
+		postings[term][storyID] = true
+	}
+}
+
+// add appends an article to the story's members and label counts
+func (c *storyCluster) add(features *storyFeatures) {
+	c.members = append(c.members, features)
+	for entity := range features.entities {
+		c.entities[entity]++
+	}
+	for theme := range features.themes {
+		c.themes[theme]++
+	}
+	if c.first.IsZero() || features.publishedAt.Before(c.first) {
+		c.first = features.publishedAt
+	}
+	if features.publishedAt.After(c.last) {
+		c.last = features.publishedAt
+	}
+}
+
+// gap is how far a time falls outside the story's span
+func (c *storyCluster) gap(at time.Time) time.Duration {
+	switch {
+	case at.Before(c.first):
+		return c.first.Sub(at)
+	case at.After(c.last):
+		return at.Sub(c.last)
+	}
+	return 0
+}
+
+// best returns the most similar story sharing a distinctive title word or entity with the article
+func (x *storyIndex) best(features *storyFeatures, window time.Duration) (*storyCluster, float64) {
+	candidates := make(map[int]bool)
+	for _, lookup := range []struct {
+		terms    map[string]bool
+		postings map[string]map[int]bool
+	}{
+		{features.tokens, x.byToken},
+		{features.entities, x.byEntity},
+	} {
+		for term := range lookup.terms {
+			stories := lookup.postings[term]
+			if len(stories) > storyMaxPostings {
+				continue
+			}
+			for storyID := range stories {
+				candidates[storyID] = true
+			}
+		}
+	}
+
+	var best *storyCluster
+	bestScore := 0.0
+	for storyID := range candidates {
+		cluster := x.clusters[storyID]
+		score := x.similarity(features, cluster, window)
+		if score > bestScore || (score == bestScore && best != nil && cluster.id < best.id) {
+			best, bestScore = cluster, score
+		}
+	}
+	return best, bestScore
+}
+
+// similarity combines the signals between an article and a story into a 0-1 score. Each
+// text signal is the best match against the story's recent articles, so a story that drifts
+// over days still matches follow-ups on its latest turn.
+func (x *storyIndex) similarity(features *storyFeatures, cluster *storyCluster, window time.Duration) float64 {
+	gap := cluster.gap(features.publishedAt)
+	if gap > window {
+		return 0
+	}
+
+	members := cluster.members
+	if len(members) > storyMaxMembersCompared {
+		members = members[len(members)-storyMaxMembersCompared:]
+	}
+
+	title, entities, themes := 0.0, 0.0, 0.0
+	distance := -1
+	for _, member := range members {
+		title = math.Max(title, x.weightedDice(features.tokens, member.tokens, x.byToken))
+		entities = math.Max(entities, x.weightedJaccard(features.entities, member.entities, x.byEntity))
+		themes = math.Max(themes, x.weightedJaccard(features.themes, member.themes, x.byTheme))
+		if features.simHash != nil && member.simHash != nil {
+			if d := fingerprint.Distance(*features.simHash, *member.simHash); distance < 0 || d < distance {
+				distance = d
+			}
+		}
+	}
+
+	score, weight := storyTitleWeight*title, storyTitleWeight
+	if distance >= 0 {
+		closeness := float64(storySimHashFar-distance) / float64(storySimHashFar-storySimHashNear)
+		score += storySimHashWeight * math.Max(0, math.Min(1, closeness))
+		weight += storySimHashWeight
+	}
+	if len(cluster.entities) > 0 && x.termWeight(features.entities, x.byEntity) >= storyMinSignalWeight {
+		score += storyEntityWeight * entities
+		weight += storyEntityWeight
+	}
+	if len(cluster.themes) > 0 && x.termWeight(features.themes, x.byTheme) >= storyMinSignalWeight {
+		score += storyThemeWeight * themes
+		weight += storyThemeWeight
+	}
+
+	recency := math.Exp(-gap.Hours() / storyTimeDecayHours)
+	return score / weight * (1 - storyTimePenalty + storyTimePenalty*recency)
+}
+
+// idf weights a term by how few stories use it; a term every story uses weighs 1
+func (x *storyIndex) idf(term string, postings map[string]map[int]bool) float64 {
+	return 1 + math.Log(float64(len(x.clusters)+1)/float64(len(postings[term])+1))
+}
+
+// termWeight is the total idf of a set of terms
+func (x *storyIndex) termWeight(terms map[string]bool, postings map[string]map[int]bool) float64 {
+	total := 0.0
+	for term := range terms {
+		total += x.idf(term, postings)
+	}
+	return total
+}
+
+// weightedJaccard is the Jaccard overlap of two term sets with each term weighted by idf,
+// so sharing a rare name counts for more than sharing a common word
+func (x *storyIndex) weightedJaccard(a, b map[string]bool, postings map[string]map[int]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared, union := 0.0, 0.0
+	for term := range a {
+		weight := x.idf(term, postings)
+		union += weight
+		if b[term] {
+			shared += weight
+		}
+	}
+	for term := range b {
+		if !a[term] {
+			union += x.idf(term, postings)
+		}
+	}
+	return shared / union
+}
+
+// weightedDice is the Dice overlap of two idf-weighted term sets. Headlines are short and
+// outlets word them differently, so titles use Dice, which credits a few shared rare words
+// more than Jaccard does.
+func (x *storyIndex) weightedDice(a, b map[string]bool, postings map[string]map[int]bool) float64 {
+	jaccard := x.weightedJaccard(a, b, postings)
+	return 2 * jaccard / (1 + jaccard)
+}
+
+// topStoryLabels returns a story's most common labels, most common first
+func topStoryLabels(counts map[string]int) []string {
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	if len(labels) > storyMaxLabels {
+		labels = labels[:storyMaxLabels]
+	}
+	return labels
+}
+
+// storyLabelList returns one article's labels in stable order
+func storyLabelList(set map[string]bool) []string {
+	counts := make(map[string]int, len(set))
+	for label := range set {
+		counts[label] = 1
+	}
+	return topStoryLabels(counts)
+}
@@ -0,0 +1,85 @@
+// internal/services/summary_backfill.go
+// GoNews - Summary Backfill
+// Writes extractive summaries for articles stored before summaries existed, preferring the
+// extracted full text and falling back to the provider description and content
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/models"
+	"backend/pkg/summarize"
+)
+
+// defaultSummaryBackfillBatch is how many articles are summarized per transaction
+const defaultSummaryBackfillBatch = 500
+
+// BackfillSummaries summarizes every stored article without a summary, in ID order, until
+// none are left or the context is cancelled
+func (s *NewsAggregatorService) BackfillSummaries(ctx context.Context, batchSize int) (*models.SummaryBackfillReport, error) {
+	startTime := time.Now()
+	if batchSize <= 0 {
+		batchSize = defaultSummaryBackfillBatch
+	}
+
+	report := &models.SummaryBackfillReport{}
+	afterID := 0
+	for ctx.Err() == nil {
+		candidates, err := s.articleRepo.GetArticlesMissingSummary(afterID, batchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		articles := make([]*models.Article, len(candidates))
+		for i, candidate := range candidates {
+			articles[i] = summarizeCandidate(candidate)
+			if candidate.FullText != nil && !articles[i].SummaryLowQuality {
+				report.FromFull++
+			}
+			if articles[i].SummaryLowQuality {
+				report.LowQuality++
+			}
+			afterID = candidate.ID
+		}
+
+		if err := s.articleRepo.UpdateArticleSummaries(articles); err != nil {
+			return report, err
+		}
+		report.Articles += len(articles)
+
+		s.logger.Info("Summary backfill batch stored", map[string]interface{}{
+			"articles": len(articles),
+			"total":    report.Articles,
+			"last_id":  afterID,
+		})
+	}
+
+	report.Duration = time.Since(startTime)
+	return report, ctx.Err()
+}
+
+// summarizeCandidate summarizes the extracted full text when it gives a usable summary, and the
+// provider text otherwise, as ingest and extraction would have
+func summarizeCandidate(candidate models.SummaryCandidate) *models.Article {
+	article := &models.Article{
+		ID:          candidate.ID,
+		Title:       candidate.Title,
+		Description: candidate.Description,
+		Content:     candidate.Content,
+	}
+
+	if candidate.FullText != nil {
+		if full := summarize.Summarize(candidate.Title, *candidate.FullText); !full.LowQuality {
+			article.Summary = &full.Text
+			return article
+		}
+	}
+
+	summarizeArticle(article, cleanFeedText(derefString(candidate.Description))+"\n"+cleanFeedText(derefString(candidate.Content)))
+	return article
+}
@@ -0,0 +1,387 @@
+// internal/services/syndication_render.go
+// GoNews - Outbound Feed Rendering
+// RSS 2.0, Atom 1.0 and JSON Feed 1.1 documents with self and WebSub hub links
+
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+)
+
+// Content types served for each feed format
+var feedContentTypes = map[string]string{
+	models.FeedFormatRSS:  "application/rss+xml; charset=utf-8",
+	models.FeedFormatAtom: "application/atom+xml; charset=utf-8",
+	models.FeedFormatJSON: "application/feed+json; charset=utf-8",
+}
+
+// feedRenderOptions carries the absolute URLs a document links to
+type feedRenderOptions struct {
+	BaseURL    string
+	HubURL     string
+	TTLMinutes int
+}
+
+// ===============================
+// RSS 2.0
+// ===============================
+
+type rssOutDocument struct {
+	XMLName    xml.Name      `xml:"rss"`
+	Version    string        `xml:"version,attr"`
+	XMLNSAtom  string        `xml:"xmlns:atom,attr"`
+	XMLNSMedia string        `xml:"xmlns:media,attr"`
+	XMLNSDC    string        `xml:"xmlns:dc,attr"`
+	Channel    rssOutChannel `xml:"channel"`
+}
+
+type rssOutChannel struct {
+	Title         string        `xml:"title"`
+	Link          string        `xml:"link"`
+	Description   string        `xml:"description"`
+	Language      string        `xml:"language"`
+	LastBuildDate string        `xml:"lastBuildDate"`
+	TTL           int           `xml:"ttl,omitempty"`
+	AtomLinks     []atomOutLink `xml:"atom:link"`
+	Items         []rssOutItem  `xml:"item"`
+}
+
+type rssOutGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssOutMedia struct {
+	URL    string `xml:"url,attr"`
+	Medium string `xml:"medium,attr"`
+}
+
+type rssOutItem struct {
+	Title       string       `xml:"title"`
+	Link        string       `xml:"link"`
+	GUID        rssOutGUID   `xml:"guid"`
+	Description string       `xml:"description,omitempty"`
+	PubDate     string       `xml:"pubDate"`
+	Creator     string       `xml:"dc:creator,omitempty"`
+	Categories  []string     `xml:"category"`
+	Media       *rssOutMedia `xml:"media:content,omitempty"`
+}
+
+// ===============================
+// ATOM 1.0
+// ===============================
+
+type atomOutFeed struct {
+	XMLName  xml.Name       `xml:"feed"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	ID       string         `xml:"id"`
+	Title    string         `xml:"title"`
+	Subtitle string         `xml:"subtitle,omitempty"`
+	Updated  string         `xml:"updated"`
+	Links    []atomOutLink  `xml:"link"`
+	Entries  []atomOutEntry `xml:"entry"`
+}
+
+type atomOutLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomOutText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomOutPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomOutCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomOutEntry struct {
+	ID         string            `xml:"id"`
+	Title      string            `xml:"title"`
+	Links      []atomOutLink     `xml:"link"`
+	Published  string            `xml:"published"`
+	Updated    string            `xml:"updated"`
+	Summary    *atomOutText      `xml:"summary,omitempty"`
+	Author     atomOutPerson     `xml:"author"`
+	Categories []atomOutCategory `xml:"category"`
+}
+
+// ===============================
+// JSON FEED 1.1
+// ===============================
+
+type jsonFeedDocument struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Language    string         `json:"language,omitempty"`
+	Hubs        []jsonFeedHub  `json:"hubs,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedHub struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	Summary       string           `json:"summary,omitempty"`
+	ContentText   string           `json:"content_text"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified,omitempty"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+// ===============================
+// RENDERING
+// ===============================
+
+// renderSyndicationFeed renders a feed in the requested format and computes its validators
+func renderSyndicationFeed(feed *models.SyndicationFeed, format string, opts feedRenderOptions) (*models.RenderedFeed, error) {
+	contentType, ok := feedContentTypes[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported feed format %q", format)
+	}
+
+	selfURL := opts.BaseURL + feed.Path + "." + format
+	updated := feed.UpdatedAt
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	updated = updated.UTC().Truncate(time.Second)
+
+	// Private feeds are never announced to the hub
+	hubURL := opts.HubURL
+	if feed.Private {
+		hubURL = ""
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	switch format {
+	case models.FeedFormatRSS:
+		body, err = renderRSS(feed, selfURL, hubURL, updated, opts)
+	case models.FeedFormatAtom:
+		body, err = renderAtom(feed, selfURL, hubURL, updated, opts)
+	case models.FeedFormatJSON:
+		body, err = renderJSONFeed(feed, selfURL, hubURL, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s feed: %w", format, err)
+	}
+
+	sum := sha256.Sum256(body)
+	return &models.RenderedFeed{
+		Body:         body,
+		ContentType:  contentType,
+		ETag:         `"` + hex.EncodeToString(sum[:12]) + `"`,
+		LastModified: updated,
+		SelfURL:      selfURL,
+		Private:      feed.Private,
+	}, nil
+}
+
+func renderRSS(feed *models.SyndicationFeed, selfURL, hubURL string, updated time.Time, opts feedRenderOptions) ([]byte, error) {
+	channel := rssOutChannel{
+		Title:         feed.Title,
+		Link:          opts.BaseURL,
+		Description:   feed.Description,
+		Language:      "en-in",
+		LastBuildDate: updated.Format(time.RFC1123Z),
+		TTL:           opts.TTLMinutes,
+		AtomLinks:     []atomOutLink{{Href: selfURL, Rel: "self", Type: "application/rss+xml"}},
+	}
+	if hubURL != "" {
+		channel.AtomLinks = append(channel.AtomLinks, atomOutLink{Href: hubURL, Rel: "hub"})
+	}
+
+	for _, article := range feed.Articles {
+		item := rssOutItem{
+			Title:       article.Title,
+			Link:        article.URL,
+			GUID:        rssOutGUID{IsPermaLink: "false", Value: syndicationArticleID(article)},
+			Description: derefString(article.Description),
+			PubDate:     article.PublishedAt.UTC().Format(time.RFC1123Z),
+			Creator:     derefString(article.Author),
+			Categories:  syndicationCategories(article),
+		}
+		if article.ImageURL != nil && *article.ImageURL != "" {
+			item.Media = &rssOutMedia{URL: *article.ImageURL, Medium: "image"}
+		}
+		channel.Items = append(channel.Items, item)
+	}
+
+	return marshalFeedXML(rssOutDocument{
+		Version:    "2.0",
+		XMLNSAtom:  "http://www.w3.org/2005/Atom",
+		XMLNSMedia: "http://search.yahoo.com/mrss/",
+		XMLNSDC:    "http://purl.org/dc/elements/1.1/",
+		Channel:    channel,
+	})
+}
+
+func renderAtom(feed *models.SyndicationFeed, selfURL, hubURL string, updated time.Time, opts feedRenderOptions) ([]byte, error) {
+	doc := atomOutFeed{
+		XMLNS:    "http://www.w3.org/2005/Atom",
+		ID:       selfURL,
+		Title:    feed.Title,
+		Subtitle: feed.Description,
+		Updated:  updated.Format(time.RFC3339),
+		Links: []atomOutLink{
+			{Href: selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: opts.BaseURL, Rel: "alternate"},
+		},
+	}
+	if hubURL != "" {
+		doc.Links = append(doc.Links, atomOutLink{Href: hubURL, Rel: "hub"})
+	}
+
+	for _, article := range feed.Articles {
+		author := derefString(article.Author)
+		if author == "" {
+			author = article.Source
+		}
+		if author == "" {
+			author = "GoNews"
+		}
+
+		entry := atomOutEntry{
+			ID:        syndicationArticleID(article),
+			Title:     article.Title,
+			Links:     []atomOutLink{{Href: article.URL, Rel: "alternate"}},
+			Published: article.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:   articleModifiedAt(article).Format(time.RFC3339),
+			Author:    atomOutPerson{Name: author},
+		}
+		if description := derefString(article.Description); description != "" {
+			entry.Summary = &atomOutText{Type: "text", Value: description}
+		}
+		if article.ImageURL != nil && *article.ImageURL != "" {
+			entry.Links = append(entry.Links, atomOutLink{Href: *article.ImageURL, Rel: "enclosure"})
+		}
+		for _, category := range syndicationCategories(article) {
+			entry.Categories = append(entry.Categories, atomOutCategory{Term: category})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalFeedXML(doc)
+}
+
+func renderJSONFeed(feed *models.SyndicationFeed, selfURL, hubURL string, opts feedRenderOptions) ([]byte, error) {
+	doc := jsonFeedDocument{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: opts.BaseURL,
+		FeedURL:     selfURL,
+		Description: feed.Description,
+		Language:    "en-IN",
+		Items:       make([]jsonFeedItem, 0, len(feed.Articles)),
+	}
+	if hubURL != "" {
+		doc.Hubs = []jsonFeedHub{{Type: "WebSub", URL: hubURL}}
+	}
+
+	for _, article := range feed.Articles {
+		description := derefString(article.Description)
+		content := derefString(article.Content)
+		if content == "" {
+			content = description
+		}
+
+		item := jsonFeedItem{
+			ID:            syndicationArticleID(article),
+			URL:           article.URL,
+			Title:         article.Title,
+			Summary:       description,
+			ContentText:   content,
+			Image:         derefString(article.ImageURL),
+			DatePublished: article.PublishedAt.UTC().Format(time.RFC3339),
+			DateModified:  articleModifiedAt(article).Format(time.RFC3339),
+			Tags:          syndicationCategories(article),
+		}
+		if author := derefString(article.Author); author != "" {
+			item.Authors = []jsonFeedAuthor{{Name: author}}
+		}
+		doc.Items = append(doc.Items, item)
+	}
+
+	return json.Marshal(doc)
+}
+
+// marshalFeedXML adds the XML declaration to an indented document
+func marshalFeedXML(doc interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// syndicationArticleID is a stable, non-URL identifier for an article across all feed formats
+func syndicationArticleID(article *models.Article) string {
+	return fmt.Sprintf("urn:gonews:article:%d", article.ID)
+}
+
+// syndicationCategories lists the article category followed by its tags
+func syndicationCategories(article *models.Article) []string {
+	var categories []string
+	seen := make(map[string]bool)
+	add := func(value string) {
+		value = strings.TrimSpace(value)
+		if value != "" && !seen[strings.ToLower(value)] {
+			seen[strings.ToLower(value)] = true
+			categories = append(categories, value)
+		}
+	}
+
+	if article.Category != nil {
+		add(article.Category.Name)
+	}
+	for _, tag := range article.Tags {
+		add(tag)
+	}
+	return categories
+}
+
+// articleModifiedAt is the later of publish and update time
+func articleModifiedAt(article *models.Article) time.Time {
+	if article.UpdatedAt.After(article.PublishedAt) {
+		return article.UpdatedAt.UTC()
+	}
+	return article.PublishedAt.UTC()
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,675 @@
+// internal/services/syndication_service.go
+// GoNews - Outbound Feed Service
+// Category, trending, search and private per-user feeds with caching and WebSub hub pings
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnknownFeed marks feed requests for a category or format that doesn't exist
+	ErrUnknownFeed = errors.New("unknown feed")
+
+	// ErrInvalidFeedRequest marks feed queries, saved searches and token names rejected by validation
+	ErrInvalidFeedRequest = errors.New("invalid feed request")
+
+	// ErrFeedTokenLimit marks token creation beyond the per-user limit
+	ErrFeedTokenLimit = errors.New("feed token limit reached")
+)
+
+// feedTokenPrefix marks private feed secrets so they are recognizable if leaked
+const feedTokenPrefix = "gnf_"
+
+// cachedFeed is a rendered feed held until it expires, new articles land or it is evicted
+type cachedFeed struct {
+	feed      *models.RenderedFeed
+	expiresAt time.Time
+	usedAt    time.Time // Last served, for least recently used eviction
+}
+
+// SyndicationService publishes articles as RSS, Atom and JSON feeds
+type SyndicationService struct {
+	config         *config.Config
+	logger         *logger.Logger
+	repo           *repository.SyndicationRepository
+	articleRepo    *repository.ArticleRepository
+	engagementRepo *repository.EngagementRepository
+	search         *SearchService
+	httpClient     *http.Client
+
+	// Rendered feeds by path and format
+	cache      map[string]*cachedFeed
+	cacheMutex sync.Mutex
+
+	// Topics changed since the last hub ping
+	pendingTopics map[string]bool
+	pendingMutex  sync.Mutex
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewSyndicationService creates a new outbound feed service
+func NewSyndicationService(cfg *config.Config, log *logger.Logger, repo *repository.SyndicationRepository, articleRepo *repository.ArticleRepository, engagementRepo *repository.EngagementRepository, search *SearchService) *SyndicationService {
+	return &SyndicationService{
+		config:         cfg,
+		logger:         log,
+		repo:           repo,
+		articleRepo:    articleRepo,
+		engagementRepo: engagementRepo,
+		search:         search,
+		httpClient:     &http.Client{Timeout: 10 * time.Second},
+		cache:          make(map[string]*cachedFeed),
+		pendingTopics:  make(map[string]bool),
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start begins batching WebSub hub pings when a hub is configured
+func (s *SyndicationService) Start() {
+	if s.config.WebSubHubURL == "" {
+		s.logger.Info("WebSub hub not configured - feed change pings disabled")
+		return
+	}
+
+	interval := time.Duration(s.config.WebSubPingIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	s.wg.Add(1)
+	go s.runHubPinger(interval)
+
+	s.logger.Info("WebSub hub pinger started", map[string]interface{}{
+		"hub":      s.config.WebSubHubURL,
+		"interval": interval.String(),
+	})
+}
+
+// Close stops the hub pinger after a final flush
+func (s *SyndicationService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Syndication service stopped")
+	return nil
+}
+
+// ===============================
+// PUBLIC FEEDS
+// ===============================
+
+// CategoryFeed returns the latest articles in a category
+func (s *SyndicationService) CategoryFeed(slug, format string) (*models.RenderedFeed, error) {
+	_, slugToID, err := s.articleRepo.GetCategoryMapping()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := slugToID[slug]; !ok {
+		return nil, fmt.Errorf("%w: category %q", ErrUnknownFeed, slug)
+	}
+
+	path := "/feeds/category/" + slug
+	return s.cached(path, format, func() (*models.SyndicationFeed, error) {
+		articles, err := s.articleRepo.GetArticlesByCategory(slug, s.itemLimit(), 0)
+		if err != nil {
+			return nil, err
+		}
+
+		name := slug
+		if len(articles) > 0 && articles[0].Category != nil {
+			name = articles[0].Category.Name
+		}
+
+		return &models.SyndicationFeed{
+			Title:       "GoNews - " + name,
+			Description: "Latest " + name + " news from GoNews",
+			Path:        path,
+			Articles:    articles,
+		}, nil
+	})
+}
+
+// TrendingFeed returns the articles trending over the last day
+func (s *SyndicationService) TrendingFeed(format string) (*models.RenderedFeed, error) {
+	path := "/feeds/trending"
+	return s.cached(path, format, func() (*models.SyndicationFeed, error) {
+		articles, err := s.articleRepo.GetTrendingArticles(24, s.itemLimit())
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.SyndicationFeed{
+			Title:       "GoNews - Trending",
+			Description: "Trending news on GoNews over the last 24 hours",
+			Path:        path,
+			Articles:    articles,
+		}, nil
+	})
+}
+
+// SearchFeed returns the newest articles matching an ad-hoc query
+func (s *SyndicationService) SearchFeed(ctx context.Context, query, categorySlug, format string) (*models.RenderedFeed, error) {
+	query = strings.TrimSpace(query)
+	if len(query) < 2 {
+		return nil, fmt.Errorf("%w: query must be at least 2 characters", ErrInvalidFeedRequest)
+	}
+
+	values := url.Values{"q": {query}}
+	if categorySlug != "" {
+		values.Set("category", categorySlug)
+	}
+	path := "/feeds/search"
+
+	return s.cachedAs(path+"?"+values.Encode(), path, format, func() (*models.SyndicationFeed, error) {
+		articles, err := s.searchArticles(ctx, query, categorySlug)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.SyndicationFeed{
+			Title:       "GoNews - Search: " + query,
+			Description: "Newest GoNews articles matching \"" + query + "\"",
+			Path:        path,
+			Articles:    articles,
+		}, nil
+	})
+}
+
+// ===============================
+// PRIVATE TOKEN FEEDS
+// ===============================
+
+// BookmarksFeed returns the token owner's bookmarked articles
+func (s *SyndicationService) BookmarksFeed(secret, format string) (*models.RenderedFeed, error) {
+	token, err := s.resolveToken(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/feeds/private/" + secret + "/bookmarks"
+	return s.cached(path, format, func() (*models.SyndicationFeed, error) {
+		ids, _, err := s.engagementRepo.GetBookmarkedArticleIDs(token.UserID, s.itemLimit(), 0)
+		if err != nil {
+			return nil, err
+		}
+
+		articles, err := s.articleRepo.GetArticlesByIDsInOrder(ids)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.SyndicationFeed{
+			Title:       "GoNews - My Bookmarks",
+			Description: "Articles bookmarked on GoNews",
+			Path:        path,
+			Private:     true,
+			Articles:    articles,
+		}, nil
+	})
+}
+
+// SavedSearchFeed returns the newest articles matching one of the token owner's saved searches
+func (s *SyndicationService) SavedSearchFeed(ctx context.Context, secret string, searchID int, format string) (*models.RenderedFeed, error) {
+	token, err := s.resolveToken(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.GetSavedSearch(token.UserID, searchID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/feeds/private/%s/searches/%d", secret, searchID)
+	return s.cached(path, format, func() (*models.SyndicationFeed, error) {
+		var categorySlug string
+		if saved.CategorySlug != nil {
+			categorySlug = *saved.CategorySlug
+		}
+
+		articles, err := s.searchArticles(ctx, saved.Query, categorySlug)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.SyndicationFeed{
+			Title:       "GoNews - " + saved.Name,
+			Description: "Newest GoNews articles matching \"" + saved.Query + "\"",
+			Path:        path,
+			Private:     true,
+			Articles:    articles,
+		}, nil
+	})
+}
+
+// resolveToken maps a feed secret onto its active token
+func (s *SyndicationService) resolveToken(secret string) (*models.FeedToken, error) {
+	if !strings.HasPrefix(secret, feedTokenPrefix) {
+		return nil, repository.ErrFeedTokenNotFound
+	}
+	return s.repo.GetActiveFeedTokenByHash(hashFeedToken(secret))
+}
+
+// ===============================
+// TOKEN & SAVED SEARCH MANAGEMENT
+// ===============================
+
+// CreateFeedToken issues a new private feed token; the secret is returned only here
+func (s *SyndicationService) CreateFeedToken(userID uuid.UUID, req *models.CreateFeedTokenRequest) (*models.FeedTokenCreatedResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 100 {
+		return nil, fmt.Errorf("%w: name must be 1-100 characters", ErrInvalidFeedRequest)
+	}
+
+	active, err := s.repo.CountActiveFeedTokens(userID)
+	if err != nil {
+		return nil, err
+	}
+	if limit := s.config.FeedTokensPerUser; limit > 0 && active >= limit {
+		return nil, fmt.Errorf("%w: revoke an existing token first (limit %d)", ErrFeedTokenLimit, limit)
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	secret := feedTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	token := &models.FeedToken{
+		UserID:      userID,
+		Name:        name,
+		TokenHash:   hashFeedToken(secret),
+		TokenPrefix: secret[:len(feedTokenPrefix)+6],
+	}
+	if err := s.repo.CreateFeedToken(token); err != nil {
+		return nil, err
+	}
+
+	feeds := make(map[string]string)
+	for _, format := range models.FeedFormats {
+		feeds["bookmarks_"+format] = s.config.PublicBaseURL + "/feeds/private/" + secret + "/bookmarks." + format
+	}
+
+	return &models.FeedTokenCreatedResponse{
+		Token:  token,
+		Secret: secret,
+		Feeds:  feeds,
+	}, nil
+}
+
+// ListFeedTokens returns a user's feed tokens without their secrets
+func (s *SyndicationService) ListFeedTokens(userID uuid.UUID) ([]*models.FeedToken, error) {
+	return s.repo.GetFeedTokens(userID)
+}
+
+// RevokeFeedToken stops a token's feeds from resolving and drops anything cached for them
+func (s *SyndicationService) RevokeFeedToken(userID uuid.UUID, id int) error {
+	if err := s.repo.RevokeFeedToken(userID, id); err != nil {
+		return err
+	}
+
+	s.cacheMutex.Lock()
+	for key, entry := range s.cache {
+		if entry.feed.Private {
+			delete(s.cache, key)
+		}
+	}
+	s.cacheMutex.Unlock()
+
+	return nil
+}
+
+// CreateSavedSearch stores a search whose results are published as a private feed
+func (s *SyndicationService) CreateSavedSearch(userID uuid.UUID, req *models.CreateSavedSearchRequest) (*models.SavedSearch, error) {
+	search := &models.SavedSearch{
+		UserID:       userID,
+		Name:         strings.TrimSpace(req.Name),
+		Query:        strings.TrimSpace(req.Query),
+		CategorySlug: req.CategorySlug,
+	}
+
+	if search.Name == "" || len(search.Name) > 100 {
+		return nil, fmt.Errorf("%w: name must be 1-100 characters", ErrInvalidFeedRequest)
+	}
+	if len(search.Query) < 2 || len(search.Query) > 500 {
+		return nil, fmt.Errorf("%w: query must be 2-500 characters", ErrInvalidFeedRequest)
+	}
+	if search.CategorySlug != nil {
+		if *search.CategorySlug == "" {
+			search.CategorySlug = nil
+		} else {
+			_, slugToID, err := s.articleRepo.GetCategoryMapping()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := slugToID[*search.CategorySlug]; !ok {
+				return nil, fmt.Errorf("%w: unknown category %q", ErrInvalidFeedRequest, *search.CategorySlug)
+			}
+		}
+	}
+
+	if err := s.repo.CreateSavedSearch(search); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// ListSavedSearches returns a user's saved searches
+func (s *SyndicationService) ListSavedSearches(userID uuid.UUID) ([]*models.SavedSearch, error) {
+	return s.repo.GetSavedSearches(userID)
+}
+
+// DeleteSavedSearch removes one of a user's saved searches
+func (s *SyndicationService) DeleteSavedSearch(userID uuid.UUID, id int) error {
+	return s.repo.DeleteSavedSearch(userID, id)
+}
+
+// ===============================
+// CACHING
+// ===============================
+
+// cached renders a feed through the cache keyed by its path
+func (s *SyndicationService) cached(path, format string, build func() (*models.SyndicationFeed, error)) (*models.RenderedFeed, error) {
+	return s.cachedAs(path, path, format, build)
+}
+
+// cachedAs renders a feed through the cache under an explicit key
+func (s *SyndicationService) cachedAs(key, path, format string, build func() (*models.SyndicationFeed, error)) (*models.RenderedFeed, error) {
+	if _, ok := feedContentTypes[format]; !ok {
+		return nil, fmt.Errorf("%w: format %q", ErrUnknownFeed, format)
+	}
+
+	key = key + "#" + format
+	if feed := s.cachedFeed(key); feed != nil {
+		return feed, nil
+	}
+
+	feed, err := build()
+	if err != nil {
+		return nil, err
+	}
+	feed.UpdatedAt = latestArticleTime(feed.Articles)
+
+	rendered, err := renderSyndicationFeed(feed, format, feedRenderOptions{
+		BaseURL:    s.config.PublicBaseURL,
+		HubURL:     s.config.WebSubHubURL,
+		TTLMinutes: s.cacheTTL() / 60,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.storeFeed(key, rendered)
+	return rendered, nil
+}
+
+// cachedFeed returns an unexpired cached feed and marks it used, dropping it once expired
+func (s *SyndicationService) cachedFeed(key string) *models.RenderedFeed {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	entry, ok := s.cache[key]
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	if !now.Before(entry.expiresAt) {
+		delete(s.cache, key)
+		return nil
+	}
+	entry.usedAt = now
+	return entry.feed
+}
+
+// storeFeed caches a rendered feed. Search feeds are keyed by any query string a caller sends,
+// so when the cache is full expired entries are swept and then the least recently used evicted.
+func (s *SyndicationService) storeFeed(key string, feed *models.RenderedFeed) {
+	now := time.Now()
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	if _, exists := s.cache[key]; !exists && len(s.cache) >= s.cacheEntries() {
+		for cachedKey, entry := range s.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(s.cache, cachedKey)
+			}
+		}
+		for len(s.cache) >= s.cacheEntries() {
+			oldestKey, oldest := "", now
+			for cachedKey, entry := range s.cache {
+				if oldestKey == "" || entry.usedAt.Before(oldest) {
+					oldestKey, oldest = cachedKey, entry.usedAt
+				}
+			}
+			delete(s.cache, oldestKey)
+		}
+	}
+
+	s.cache[key] = &cachedFeed{feed: feed, expiresAt: now.Add(time.Duration(s.cacheTTL()) * time.Second), usedAt: now}
+}
+
+// CacheMaxAge is the Cache-Control max-age for feed responses, in seconds
+func (s *SyndicationService) CacheMaxAge() int {
+	return s.cacheTTL()
+}
+
+// HubURL is the WebSub hub advertised by public feeds, empty when disabled
+func (s *SyndicationService) HubURL() string {
+	return s.config.WebSubHubURL
+}
+
+func (s *SyndicationService) cacheTTL() int {
+	if s.config.SyndicationCacheSeconds > 0 {
+		return s.config.SyndicationCacheSeconds
+	}
+	return 300
+}
+
+func (s *SyndicationService) cacheEntries() int {
+	if s.config.SyndicationCacheEntries > 0 {
+		return s.config.SyndicationCacheEntries
+	}
+	return 500
+}
+
+func (s *SyndicationService) itemLimit() int {
+	if s.config.SyndicationItemLimit > 0 {
+		return s.config.SyndicationItemLimit
+	}
+	return 50
+}
+
+// ===============================
+// WEBSUB
+// ===============================
+
+// ArticlesStored drops stale rendered feeds and queues hub pings for the public feeds the articles appear in
+func (s *SyndicationService) ArticlesStored(articles []*models.Article) {
+	if len(articles) == 0 {
+		return
+	}
+
+	s.cacheMutex.Lock()
+	s.cache = make(map[string]*cachedFeed)
+	s.cacheMutex.Unlock()
+
+	if s.config.WebSubHubURL == "" {
+		return
+	}
+
+	idToSlug, _, err := s.articleRepo.GetCategoryMapping()
+	if err != nil {
+		s.logger.Warn("Failed to map article categories for WebSub", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	paths := map[string]bool{"/feeds/trending": true}
+	for _, article := range articles {
+		if article.CategoryID == nil {
+			continue
+		}
+		if slug, ok := idToSlug[*article.CategoryID]; ok {
+			paths["/feeds/category/"+slug] = true
+		}
+	}
+
+	s.pendingMutex.Lock()
+	for path := range paths {
+		for _, format := range models.FeedFormats {
+			s.pendingTopics[s.config.PublicBaseURL+path+"."+format] = true
+		}
+	}
+	s.pendingMutex.Unlock()
+}
+
+// runHubPinger flushes queued topics to the hub on every tick and once more on shutdown
+func (s *SyndicationService) runHubPinger(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flushHubPings()
+		case <-s.stopChan:
+			s.flushHubPings()
+			return
+		}
+	}
+}
+
+// flushHubPings sends one publish notification per changed topic
+func (s *SyndicationService) flushHubPings() {
+	s.pendingMutex.Lock()
+	topics := make([]string, 0, len(s.pendingTopics))
+	for topic := range s.pendingTopics {
+		topics = append(topics, topic)
+	}
+	s.pendingTopics = make(map[string]bool)
+	s.pendingMutex.Unlock()
+
+	if len(topics) == 0 {
+		return
+	}
+	sort.Strings(topics)
+
+	failed := 0
+	for _, topic := range topics {
+		if err := s.pingHub(topic); err != nil {
+			failed++
+			s.logger.Warn("WebSub hub ping failed", map[string]interface{}{
+				"topic": topic,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	s.logger.Info("WebSub hub notified", map[string]interface{}{
+		"topics": len(topics),
+		"failed": failed,
+	})
+}
+
+// pingHub sends a WebSub publish notification for one topic
+func (s *SyndicationService) pingHub(topic string) error {
+	form := url.Values{
+		"hub.mode": {"publish"},
+		"hub.url":  {topic},
+	}
+
+	resp, err := s.httpClient.PostForm(s.config.WebSubHubURL, form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("hub returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ===============================
+// HELPERS
+// ===============================
+
+// searchArticles runs a full-text search newest first
+func (s *SyndicationService) searchArticles(ctx context.Context, query, categorySlug string) ([]*models.Article, error) {
+	filters := &repository.SearchFilters{
+		Query:  query,
+		SortBy: "date",
+		Page:   1,
+		Limit:  s.itemLimit(),
+	}
+
+	if categorySlug != "" {
+		_, slugToID, err := s.articleRepo.GetCategoryMapping()
+		if err != nil {
+			return nil, err
+		}
+		categoryID, ok := slugToID[categorySlug]
+		if !ok {
+			return nil, fmt.Errorf("%w: category %q", ErrUnknownFeed, categorySlug)
+		}
+		filters.CategoryIDs = []int{categoryID}
+	}
+
+	response, err := s.search.Search(ctx, &SearchRequest{
+		Query:       query,
+		Filters:     filters,
+		EnableCache: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]*models.Article, 0, len(response.Results))
+	for _, result := range response.Results {
+		if result.Article != nil {
+			articles = append(articles, result.Article)
+		}
+	}
+	return articles, nil
+}
+
+// latestArticleTime is the newest modification among the articles, for Last-Modified
+func latestArticleTime(articles []*models.Article) time.Time {
+	var latest time.Time
+	for _, article := range articles {
+		if modified := articleModifiedAt(article); modified.After(latest) {
+			latest = modified
+		}
+	}
+	return latest
+}
+
+// hashFeedToken is the stored form of a feed secret
+func hashFeedToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
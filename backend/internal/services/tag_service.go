@@ -0,0 +1,171 @@
+// internal/services/tag_service.go
+// GoNews - Tag Service
+// Tags every article with normalized provider tags and keywords extracted against a rolling
+// corpus of recent articles, and lists the articles for a tag
+
+package services
+
+import (
+	"errors"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/keywords"
+	"backend/pkg/logger"
+)
+
+// tagKeywordCandidates is how many extracted keywords are considered per article
+const tagKeywordCandidates = 10
+
+// ErrUnknownTag marks a tag slug not in the catalogue
+var ErrUnknownTag = errors.New("unknown tag")
+
+// TagService extracts keywords and normalizes tags
+type TagService struct {
+	logger      *logger.Logger
+	repo        *repository.TagRepository
+	articleRepo *repository.ArticleRepository
+	corpus      *keywords.Corpus
+	normalizer  *keywords.Normalizer
+	maxTags     int
+}
+
+// NewTagService creates the tag service and seeds the keyword corpus with recent articles.
+// Names the entity gazetteer knows are tagged with their canonical spelling.
+func NewTagService(cfg *config.Config, log *logger.Logger, repo *repository.TagRepository, articleRepo *repository.ArticleRepository, extractor *EntityExtractor) *TagService {
+	service := &TagService{
+		logger:      log,
+		repo:        repo,
+		articleRepo: articleRepo,
+		corpus:      keywords.NewCorpus(cfg.KeywordCorpusSize),
+		normalizer:  keywords.NewNormalizer(extractor.ResolveName),
+		maxTags:     maxInt(1, cfg.MaxArticleTags),
+	}
+
+	texts, err := repo.GetRecentArticleTexts(cfg.KeywordCorpusSize)
+	if err != nil {
+		log.Warn("Keyword corpus starts empty", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	for _, text := range texts {
+		service.corpus.Add(text)
+	}
+
+	return service
+}
+
+// ===============================
+// TAGGING
+// ===============================
+
+// Tag replaces the article's tags with normalized provider tags and extracted keywords, and
+// adds the article to the keyword corpus. Provider tags fill at most half the slots ahead of
+// keywords, so long GDELT theme lists can't crowd keywords out; any slots left go to the rest.
+func (s *TagService) Tag(article *models.Article) {
+	title, body := tagText(article)
+	extracted := s.corpus.Extract(title, body, tagKeywordCandidates)
+	s.corpus.Add(title + "\n" + body)
+
+	article.Tags = s.pickTags(s.normalizer.NormalizeAll(article.Tags), extracted)
+}
+
+// Preview shows how a headline, description and provider tags would be tagged, without
+// adding them to the corpus
+func (s *TagService) Preview(title, description string, providerTags []string) *models.TagPreview {
+	article := &models.Article{Title: title, Description: &description}
+	title, body := tagText(article)
+	extracted := s.corpus.Extract(title, body, tagKeywordCandidates)
+	provider := s.normalizer.NormalizeAll(providerTags)
+
+	preview := &models.TagPreview{
+		Keywords:     make([]models.ExtractedKeyword, 0, len(extracted)),
+		ProviderTags: make([]string, 0, len(provider)),
+		Tags:         s.pickTags(provider, extracted),
+		CorpusSize:   s.corpus.Size(),
+	}
+	for _, keyword := range extracted {
+		preview.Keywords = append(preview.Keywords, models.ExtractedKeyword{Phrase: keyword.Phrase, Score: keyword.Score})
+	}
+	for _, tag := range provider {
+		preview.ProviderTags = append(preview.ProviderTags, tag.Name)
+	}
+	return preview
+}
+
+// pickTags merges normalized provider tags and extracted keywords into at most maxTags names
+func (s *TagService) pickTags(provider []keywords.Tag, extracted []keywords.Keyword) []string {
+	phrases := make([]string, len(extracted))
+	for i, keyword := range extracted {
+		phrases[i] = keyword.Phrase
+	}
+	found := s.normalizer.NormalizeAll(phrases)
+
+	leading := len(provider)
+	if leading > s.maxTags/2 {
+		leading = s.maxTags / 2
+	}
+	ordered := append(append(append([]keywords.Tag{}, provider[:leading]...), found...), provider[leading:]...)
+
+	tags := []string{}
+	seen := make(map[string]bool)
+	for _, tag := range ordered {
+		if len(tags) >= s.maxTags {
+			break
+		}
+		if !seen[tag.Slug] {
+			seen[tag.Slug] = true
+			tags = append(tags, tag.Name)
+		}
+	}
+	return tags
+}
+
+// tagText is the headline and the plain text of the description and content
+func tagText(article *models.Article) (string, string) {
+	return article.Title, cleanFeedText(derefString(article.Description)) + "\n" + cleanFeedText(derefString(article.Content))
+}
+
+// ===============================
+// TAG PAGES
+// ===============================
+
+// PopularTags returns the tags carried by the most articles
+func (s *TagService) PopularTags(limit int) ([]models.Tag, error) {
+	return s.repo.GetPopularTags(limit)
+}
+
+// TagArticles returns one page of the articles carrying a tag. The tag may be given as its slug
+// or its name.
+func (s *TagService) TagArticles(tag string, page, limit int) (*models.TagArticlesResponse, error) {
+	found, err := s.repo.GetTagBySlug(keywords.Slug(tag))
+	if errors.Is(err, repository.ErrTagNotFound) {
+		return nil, ErrUnknownTag
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.repo.GetArticleIDsForTag(found.ID, limit+1, (page-1)*limit) // One extra to tell whether there are more
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(ids) > limit
+	if hasMore {
+		ids = ids[:limit]
+	}
+
+	articles, err := s.articleRepo.GetArticlesByIDsInOrder(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TagArticlesResponse{
+		Tag:      found,
+		Articles: articles,
+		Page:     page,
+		Limit:    limit,
+		HasMore:  hasMore,
+	}, nil
+}
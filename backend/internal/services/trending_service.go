@@ -0,0 +1,447 @@
+// internal/services/trending_service.go
+// GoNews - Velocity Trending
+// Counts reads per article in time buckets and recomputes, every minute, how fast each article,
+// entity and tag is being read and reported against its own baseline, flagging bursts
+
+package services
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/models"
+	"backend/internal/repository"
+	"backend/pkg/logger"
+)
+
+const (
+	// Async read queue sizing
+	trendingQueueSize     = 5000
+	trendingFlushInterval = 5 * time.Second
+
+	// trendingPruneInterval is how often read buckets older than the baseline are deleted
+	trendingPruneInterval = time.Hour
+
+	// trendingMaxSubjects bounds the most active subjects of each type scored per refresh
+	trendingMaxSubjects = 500
+)
+
+// ErrTrendingUnavailable marks trends requested before the first refresh finished
+var ErrTrendingUnavailable = errors.New("trends not computed yet")
+
+// trendingSubjectTypes are refreshed in this order
+var trendingSubjectTypes = []string{models.TrendSubjectArticle, models.TrendSubjectEntity, models.TrendSubjectTag}
+
+// trendRead is one queued article read
+type trendRead struct {
+	articleID int
+	at        time.Time
+}
+
+// trendReadKey identifies an article's bucket while reads are batched
+type trendReadKey struct {
+	articleID int
+	bucket    time.Time
+}
+
+// trendSnapshot is the result of one refresh
+type trendSnapshot struct {
+	articles  map[int]*models.Trend     // Every scored article, bursting or not
+	bursting  map[string][]models.Trend // Bursting subjects by type, strongest burst first
+	updatedAt time.Time
+}
+
+// TrendingService tracks read and mention velocity and detects bursts
+type TrendingService struct {
+	config      *config.Config
+	logger      *logger.Logger
+	repo        *repository.TrendingRepository
+	articleRepo *repository.ArticleRepository
+	extractor   *EntityExtractor
+
+	bucket   time.Duration
+	window   time.Duration
+	baseline time.Duration
+
+	snapshot      *trendSnapshot
+	snapshotMutex sync.RWMutex
+
+	// Async read logging
+	reads    chan trendRead
+	dropped  int64
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewTrendingService creates the trending service. Entities are reported by their gazetteer
+// name; names the gazetteer doesn't know are left out.
+func NewTrendingService(cfg *config.Config, log *logger.Logger, repo *repository.TrendingRepository, articleRepo *repository.ArticleRepository, extractor *EntityExtractor) *TrendingService {
+	bucket := time.Duration(maxInt(1, cfg.TrendingBucketMinutes)) * time.Minute
+	window := time.Duration(cfg.TrendingWindowMinutes) * time.Minute
+	if window < bucket {
+		window = bucket
+	}
+	baseline := time.Duration(cfg.TrendingBaselineHours) * time.Hour
+	if baseline < window {
+		baseline = window
+	}
+
+	return &TrendingService{
+		config:      cfg,
+		logger:      log,
+		repo:        repo,
+		articleRepo: articleRepo,
+		extractor:   extractor,
+		bucket:      bucket,
+		window:      window.Truncate(bucket),
+		baseline:    baseline.Truncate(bucket),
+		reads:       make(chan trendRead, trendingQueueSize),
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start launches the read writer and the refresh loop
+func (s *TrendingService) Start() {
+	if !s.config.TrendingEnabled {
+		s.logger.Info("Velocity trending disabled by configuration")
+		return
+	}
+
+	s.wg.Add(1)
+	go s.run()
+
+	s.logger.Info("Velocity trending started", map[string]interface{}{
+		"bucket":          s.bucket.String(),
+		"window":          s.window.String(),
+		"baseline":        s.baseline.String(),
+		"burst_threshold": s.config.TrendingBurstThreshold,
+	})
+}
+
+// Close stops the loops and flushes queued reads
+func (s *TrendingService) Close() error {
+	close(s.stopChan)
+	s.wg.Wait()
+	s.logger.Info("Trending service stopped")
+	return nil
+}
+
+// run flushes reads every few seconds, refreshes trends and prunes old buckets
+func (s *TrendingService) run() {
+	defer s.wg.Done()
+
+	refreshInterval := time.Duration(maxInt(10, s.config.TrendingRefreshSeconds)) * time.Second
+	flushTicker := time.NewTicker(trendingFlushInterval)
+	refreshTicker := time.NewTicker(refreshInterval)
+	pruneTicker := time.NewTicker(trendingPruneInterval)
+	defer flushTicker.Stop()
+	defer refreshTicker.Stop()
+	defer pruneTicker.Stop()
+
+	pending := make(map[trendReadKey]int)
+	add := func(read trendRead) {
+		pending[trendReadKey{articleID: read.articleID, bucket: read.at.Truncate(s.bucket)}]++
+	}
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		counts := make([]models.ArticleReadCount, 0, len(pending))
+		for key, reads := range pending {
+			counts = append(counts, models.ArticleReadCount{ArticleID: key.articleID, Bucket: key.bucket, Reads: reads})
+		}
+		if err := s.repo.RecordReads(counts); err != nil {
+			s.logger.Error("Failed to record article reads", map[string]interface{}{
+				"count": len(counts),
+				"error": err.Error(),
+			})
+		}
+		pending = make(map[trendReadKey]int)
+	}
+
+	s.refresh()
+
+	for {
+		select {
+		case read := <-s.reads:
+			add(read)
+		case <-flushTicker.C:
+			flush()
+		case <-refreshTicker.C:
+			// Reads so far count towards this refresh
+			flush()
+			s.refresh()
+		case <-pruneTicker.C:
+			s.prune()
+		case <-s.stopChan:
+			// Drain whatever is still queued before exiting
+			for {
+				select {
+				case read := <-s.reads:
+					add(read)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// RecordRead counts a read of an article towards its velocity and its entities' and tags'
+func (s *TrendingService) RecordRead(articleID int) {
+	if !s.config.TrendingEnabled || articleID <= 0 {
+		return
+	}
+
+	select {
+	case s.reads <- trendRead{articleID: articleID, at: time.Now()}:
+	default:
+		if dropped := atomic.AddInt64(&s.dropped, 1); dropped%100 == 1 {
+			s.logger.Warn("Trending read queue full, dropping reads", map[string]interface{}{
+				"dropped_total": atomic.LoadInt64(&s.dropped),
+			})
+		}
+	}
+}
+
+// prune deletes read buckets no refresh looks at any more
+func (s *TrendingService) prune() {
+	deleted, err := s.repo.DeleteReadsBefore(time.Now().Add(-s.window - s.baseline - s.bucket))
+	if err != nil {
+		s.logger.Warn("Failed to prune article reads", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Pruned old article reads", map[string]interface{}{
+			"deleted": deleted,
+		})
+	}
+}
+
+// ===============================
+// BURST DETECTION
+// ===============================
+
+// refresh scores every active subject against its baseline and publishes a new snapshot. The
+// current window ends with the bucket in progress, so velocity moves with every flush.
+func (s *TrendingService) refresh() {
+	now := time.Now()
+	windowStart := now.Truncate(s.bucket).Add(s.bucket - s.window)
+	since := windowStart.Add(-s.baseline)
+
+	// Reads before the first recorded one are unknown rather than zero; until a whole window of
+	// history exists, velocities are reported but nothing counts as bursting
+	first, err := s.repo.GetFirstReadBucket()
+	if err != nil {
+		s.logger.Warn("Failed to load first read bucket", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if first != nil && first.After(since) {
+		since = *first
+		if since.After(windowStart) {
+			since = windowStart
+		}
+	}
+	baselineBuckets := float64(windowStart.Sub(since)) / float64(s.bucket)
+	windowBuckets := float64(now.Sub(windowStart)) / float64(s.bucket)
+	warm := baselineBuckets >= float64(s.window/s.bucket)
+
+	snapshot := &trendSnapshot{
+		articles:  make(map[int]*models.Trend),
+		bursting:  make(map[string][]models.Trend),
+		updatedAt: now,
+	}
+	for _, subjectType := range trendingSubjectTypes {
+		counts, err := s.repo.GetTrendCounts(subjectType, since, windowStart, s.bucket, s.config.TrendingMentionWeight, s.config.TrendingMinActivity, trendingMaxSubjects)
+		if err != nil {
+			s.logger.Error("Failed to load trend counts", map[string]interface{}{
+				"type":  subjectType,
+				"error": err.Error(),
+			})
+			return
+		}
+
+		for _, count := range counts {
+			trend, ok := s.score(subjectType, count, baselineBuckets, windowBuckets)
+			if !ok {
+				continue
+			}
+			trend.Bursting = warm && trend.BurstScore >= s.config.TrendingBurstThreshold
+
+			if subjectType == models.TrendSubjectArticle {
+				if id, err := strconv.Atoi(trend.Key); err == nil {
+					article := trend
+					snapshot.articles[id] = &article
+				}
+			}
+			if trend.Bursting {
+				snapshot.bursting[subjectType] = append(snapshot.bursting[subjectType], trend)
+			}
+		}
+
+		sort.SliceStable(snapshot.bursting[subjectType], func(i, j int) bool {
+			return snapshot.bursting[subjectType][i].BurstScore > snapshot.bursting[subjectType][j].BurstScore
+		})
+	}
+
+	s.snapshotMutex.Lock()
+	s.snapshot = snapshot
+	s.snapshotMutex.Unlock()
+
+	s.logger.Debug("Trends refreshed", map[string]interface{}{
+		"articles": len(snapshot.bursting[models.TrendSubjectArticle]),
+		"entities": len(snapshot.bursting[models.TrendSubjectEntity]),
+		"tags":     len(snapshot.bursting[models.TrendSubjectTag]),
+		"warm":     warm,
+		"duration": time.Since(now).String(),
+	})
+}
+
+// score compares a subject's window activity with what its baseline predicts. The spread
+// assumes at least Poisson noise plus the minimum activity, so a subject never seen before
+// needs more than a couple of articles or a handful of reads to burst.
+func (s *TrendingService) score(subjectType string, count models.TrendCounts, baselineBuckets, windowBuckets float64) (models.Trend, bool) {
+	trend := models.Trend{
+		Type:     subjectType,
+		Key:      count.Key,
+		Name:     count.Name,
+		Reads:    count.Reads,
+		Mentions: count.Mentions,
+	}
+	if subjectType == models.TrendSubjectEntity {
+		entry, ok := s.extractor.LookupEntity(count.Key)
+		if !ok {
+			return trend, false // A provider's spelling the gazetteer doesn't know
+		}
+		trend.Name = entry.Name
+		trend.EntityType = entry.Type
+	}
+
+	mean, variance := 0.0, 0.0
+	if baselineBuckets > 0 {
+		mean = count.BaselineActivity / baselineBuckets
+		variance = math.Max(0, count.BaselineSquares/baselineBuckets-mean*mean)
+	}
+
+	activity := float64(count.Reads) + s.config.TrendingMentionWeight*float64(count.Mentions)
+	expected := mean * windowBuckets
+	spread := math.Sqrt(windowBuckets*math.Max(variance, mean) + math.Max(1, s.config.TrendingMinActivity))
+
+	trend.Velocity = roundScore(activity / (windowBuckets * s.bucket.Hours()))
+	trend.BaselineVelocity = roundScore(mean / s.bucket.Hours())
+	trend.GrowthRate = roundScore((activity+1)/(expected+1) - 1)
+	trend.BurstScore = roundScore((activity - expected) / spread)
+	return trend, true
+}
+
+// ===============================
+// SERVING
+// ===============================
+
+// ArticleTrend returns an article's velocity from the latest refresh
+func (s *TrendingService) ArticleTrend(articleID int) (*models.Trend, bool) {
+	s.snapshotMutex.RLock()
+	defer s.snapshotMutex.RUnlock()
+
+	if s.snapshot == nil {
+		return nil, false
+	}
+	trend, ok := s.snapshot.articles[articleID]
+	return trend, ok
+}
+
+// Annotate sets the trend of each article active enough to have one
+func (s *TrendingService) Annotate(articles []*models.Article) {
+	for _, article := range articles {
+		if trend, ok := s.ArticleTrend(article.ID); ok {
+			article.Trend = trend
+		}
+	}
+}
+
+// TrendingArticles returns the articles bursting now, strongest burst first
+func (s *TrendingService) TrendingArticles(limit int) ([]*models.Article, error) {
+	trends, err := s.bursting(models.TrendSubjectArticle, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(trends))
+	for _, trend := range trends {
+		if id, err := strconv.Atoi(trend.Key); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	articles, err := s.articleRepo.GetArticlesByIDsInOrder(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Annotate(articles)
+	return articles, nil
+}
+
+// TrendingEntities returns the people, organizations and places bursting now
+func (s *TrendingService) TrendingEntities(limit int) ([]models.Trend, error) {
+	return s.bursting(models.TrendSubjectEntity, limit)
+}
+
+// TrendingTags returns the tags bursting now
+func (s *TrendingService) TrendingTags(limit int) ([]models.Trend, error) {
+	return s.bursting(models.TrendSubjectTag, limit)
+}
+
+// Trending returns the bursting articles, entities and tags together
+func (s *TrendingService) Trending(limit int) (*models.TrendingResponse, error) {
+	articles, err := s.TrendingArticles(limit)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := s.TrendingEntities(limit)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.TrendingTags(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	s.snapshotMutex.RLock()
+	updatedAt := s.snapshot.updatedAt
+	s.snapshotMutex.RUnlock()
+
+	return &models.TrendingResponse{
+		Articles:      articles,
+		Entities:      entities,
+		Tags:          tags,
+		WindowMinutes: int(s.window / time.Minute),
+		BaselineHours: int(s.baseline / time.Hour),
+		UpdatedAt:     updatedAt,
+	}, nil
+}
+
+// bursting returns up to limit bursting subjects of a type from the latest refresh
+func (s *TrendingService) bursting(subjectType string, limit int) ([]models.Trend, error) {
+	s.snapshotMutex.RLock()
+	defer s.snapshotMutex.RUnlock()
+
+	if s.snapshot == nil {
+		return nil, ErrTrendingUnavailable
+	}
+	trends := s.snapshot.bursting[subjectType]
+	if len(trends) > limit {
+		trends = trends[:limit]
+	}
+	return append([]models.Trend{}, trends...), nil
+}
@@ -0,0 +1,445 @@
+// Package entities finds people, organizations and places in news text with a gazetteer of
+// known names and their aliases, plus a few capitalization rules for names it doesn't know.
+// Every match resolves to a canonical ID (a lowercase slug such as "narendra-modi"), so the
+// same entity written as "PM Modi" or "Narendra Modi" compares equal across articles.
+package entities
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Entity types, matching the article fields they fill
+const (
+	TypePerson       = "person"
+	TypeOrganization = "organization"
+	TypeLocation     = "location"
+)
+
+// Mention sources
+const (
+	SourceGazetteer = "gazetteer"
+	SourceRule      = "rule"
+)
+
+// maxAncestors bounds the parent chain followed for a location (city, state, country)
+const maxAncestors = 4
+
+// Entry is a gazetteer entity and the names it is written as
+type Entry struct {
+	ID            string   `json:"id"` // Canonical ID, a slug of the name unless chosen otherwise
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Kind          string   `json:"kind,omitempty"` // e.g. politician, company, party, team, state, city
+	Aliases       []string `json:"aliases,omitempty"`
+	Parent        string   `json:"parent,omitempty"` // Canonical ID of the place containing a location
+	CaseSensitive bool     `json:"case_sensitive,omitempty"`
+	NotAfter      []string `json:"not_after,omitempty"` // Preceding words that rule a match out ("US Congress")
+}
+
+// Mention is an entity found in text
+type Mention struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Kind   string `json:"kind,omitempty"`
+	Text   string `json:"text"` // As written
+	Source string `json:"source"`
+}
+
+// Result lists the canonical IDs found in text by type. Locations include the places that
+// contain them, so a Pune story also counts as a Maharashtra and an India story.
+type Result struct {
+	Persons       []string  `json:"persons"`
+	Organizations []string  `json:"organizations"`
+	Locations     []string  `json:"locations"`
+	Mentions      []Mention `json:"mentions"`
+}
+
+// alias is one way of writing an entry, as lowercase tokens
+type alias struct {
+	tokens []string
+	exact  string // Required spelling when the alias is matched case-sensitively
+	entry  *Entry
+}
+
+// Gazetteer indexes entries by the first token of each alias. It is read-only once built
+// and safe for concurrent use.
+type Gazetteer struct {
+	entries map[string]*Entry
+	index   map[string][]*alias // First token -> aliases, longest first
+}
+
+// NewGazetteer indexes entries. Later entries replace earlier ones with the same ID.
+func NewGazetteer(entries []Entry) *Gazetteer {
+	g := &Gazetteer{
+		entries: make(map[string]*Entry, len(entries)),
+		index:   make(map[string][]*alias),
+	}
+	for i := range entries {
+		entry := entries[i]
+		if entry.ID == "" {
+			entry.ID = Slug(entry.Name)
+		}
+		g.entries[entry.ID] = &entry
+	}
+
+	for _, entry := range g.entries {
+		for _, name := range append([]string{entry.Name}, entry.Aliases...) {
+			words := tokenize(name)
+			if len(words) == 0 {
+				continue
+			}
+			a := &alias{tokens: make([]string, len(words)), entry: entry}
+			for i, w := range words {
+				a.tokens[i] = w.lower
+			}
+			if entry.CaseSensitive || isAcronym(name) {
+				a.exact = joinOriginal(words)
+			}
+			g.index[a.tokens[0]] = append(g.index[a.tokens[0]], a)
+		}
+	}
+	for first, aliases := range g.index {
+		sort.Slice(aliases, func(i, j int) bool {
+			if len(aliases[i].tokens) != len(aliases[j].tokens) {
+				return len(aliases[i].tokens) > len(aliases[j].tokens)
+			}
+			return aliases[i].entry.ID < aliases[j].entry.ID
+		})
+		g.index[first] = aliases
+	}
+	return g
+}
+
+// Lookup returns the entry with a canonical ID
+func (g *Gazetteer) Lookup(id string) (*Entry, bool) {
+	entry, ok := g.entries[id]
+	return entry, ok
+}
+
+// Entries returns every entry, sorted by type and ID
+func (g *Gazetteer) Entries() []Entry {
+	entries := make([]Entry, 0, len(g.entries))
+	for _, entry := range g.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Type != entries[j].Type {
+			return entries[i].Type < entries[j].Type
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	return entries
+}
+
+// Resolve returns the entry a whole name refers to, such as a GDELT person or organization,
+// ignoring case. Names that only contain a known alias don't resolve.
+func (g *Gazetteer) Resolve(name, entityType string) (*Entry, bool) {
+	words := tokenize(name)
+	if len(words) == 0 {
+		return nil, false
+	}
+	for _, a := range g.index[words[0].lower] {
+		if a.entry.Type == entityType && len(a.tokens) == len(words) && matchTokens(a, words, 0, false) {
+			return a.entry, true
+		}
+	}
+	return nil, false
+}
+
+// Ancestors returns the canonical IDs of the places containing a location, nearest first
+func (g *Gazetteer) Ancestors(id string) []string {
+	var ancestors []string
+	entry, ok := g.entries[id]
+	for i := 0; ok && entry.Parent != "" && i < maxAncestors; i++ {
+		ancestors = append(ancestors, entry.Parent)
+		entry, ok = g.entries[entry.Parent]
+	}
+	return ancestors
+}
+
+// ===============================
+// EXTRACTION
+// ===============================
+
+// Extract finds entities in each text. Texts are matched separately so a name can't run
+// from a headline into its description.
+func (g *Gazetteer) Extract(texts ...string) *Result {
+	result := &Result{Persons: []string{}, Organizations: []string{}, Locations: []string{}}
+	seen := make(map[string]bool)
+
+	add := func(mention Mention) {
+		key := mention.Type + ":" + mention.ID
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		result.Mentions = append(result.Mentions, mention)
+
+		switch mention.Type {
+		case TypePerson:
+			result.Persons = append(result.Persons, mention.ID)
+		case TypeOrganization:
+			result.Organizations = append(result.Organizations, mention.ID)
+		case TypeLocation:
+			result.Locations = append(result.Locations, mention.ID)
+			for _, ancestor := range g.Ancestors(mention.ID) {
+				if key := TypeLocation + ":" + ancestor; !seen[key] {
+					seen[key] = true
+					result.Locations = append(result.Locations, ancestor)
+				}
+			}
+		}
+	}
+
+	for _, text := range texts {
+		for _, mention := range g.extractText(text) {
+			add(mention)
+		}
+	}
+	return result
+}
+
+func (g *Gazetteer) extractText(text string) []Mention {
+	words := tokenize(text)
+	if len(words) == 0 {
+		return nil
+	}
+	shouting := !hasLower(text) // Acronyms can't be told from shouted words
+	consumed := make([]bool, len(words))
+
+	var mentions []Mention
+	for i := 0; i < len(words); {
+		a := g.match(words, i, shouting)
+		if a == nil {
+			i++
+			continue
+		}
+		end := i + len(a.tokens)
+		for j := i; j < end; j++ {
+			consumed[j] = true
+		}
+		mentions = append(mentions, Mention{
+			ID:     a.entry.ID,
+			Name:   a.entry.Name,
+			Type:   a.entry.Type,
+			Kind:   a.entry.Kind,
+			Text:   joinOriginal(words[i:end]),
+			Source: SourceGazetteer,
+		})
+		i = end
+	}
+
+	// Capitalization says little in Title Case headlines or shouted text
+	if !shouting && !isTitleCase(words) {
+		mentions = append(mentions, applyRules(words, consumed)...)
+	}
+	return mentions
+}
+
+// match returns the longest alias starting at words[start]
+func (g *Gazetteer) match(words []word, start int, shouting bool) *alias {
+	for _, a := range g.index[words[start].lower] {
+		if start+len(a.tokens) > len(words) {
+			continue
+		}
+		if a.exact != "" && shouting {
+			continue
+		}
+		if !matchTokens(a, words, start, a.exact != "") {
+			continue
+		}
+		if start > 0 && ruledOut(a.entry, words[start-1].lower) {
+			continue
+		}
+		return a
+	}
+	return nil
+}
+
+func matchTokens(a *alias, words []word, start int, exact bool) bool {
+	for j, token := range a.tokens {
+		if words[start+j].lower != token {
+			return false
+		}
+	}
+	return !exact || joinOriginal(words[start:start+len(a.tokens)]) == a.exact
+}
+
+func ruledOut(entry *Entry, previous string) bool {
+	for _, word := range entry.NotAfter {
+		if strings.EqualFold(word, previous) {
+			return true
+		}
+	}
+	return false
+}
+
+// ===============================
+// RULES
+// ===============================
+
+// honorifics introduce a person's name
+var honorifics = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "dr": true, "shri": true, "sri": true, "smt": true,
+	"justice": true, "prof": true, "adv": true, "kumari": true,
+}
+
+// organizationSuffixes end a company or institution name
+var organizationSuffixes = map[string]bool{
+	"ltd": true, "limited": true, "industries": true, "corporation": true, "corp": true,
+	"bank": true, "motors": true, "pharma": true, "pharmaceuticals": true, "technologies": true,
+	"infotech": true, "finance": true, "insurance": true, "airlines": true, "airways": true,
+	"group": true, "enterprises": true, "laboratories": true, "labs": true, "university": true,
+}
+
+// ruleStopWords are capitalized at the start of a sentence but never start a name
+var ruleStopWords = map[string]bool{
+	"the": true, "a": true, "an": true, "in": true, "on": true, "at": true, "for": true,
+	"of": true, "and": true, "to": true, "as": true, "by": true, "with": true, "from": true,
+	"this": true, "that": true, "after": true, "before": true, "while": true, "when": true,
+	"shares": true, "stocks": true, "according": true,
+}
+
+// maxRuleNameWords bounds how many capitalized words a rule takes as a name
+const maxRuleNameWords = 3
+
+// applyRules finds names the gazetteer doesn't know: a capitalized name after an honorific
+// is a person, and capitalized words ending in a suffix such as "Bank" are an organization
+func applyRules(words []word, consumed []bool) []Mention {
+	var mentions []Mention
+
+	for i := 0; i < len(words); i++ {
+		if consumed[i] || !honorifics[words[i].lower] || !words[i].capitalized {
+			continue
+		}
+		end := i + 1
+		for end < len(words) && end-i-1 < maxRuleNameWords && !consumed[end] && words[end].capitalized && !ruleStopWords[words[end].lower] {
+			end++
+		}
+		if end == i+1 {
+			continue
+		}
+		name := joinOriginal(words[i+1 : end])
+		mentions = append(mentions, Mention{ID: Slug(name), Name: name, Type: TypePerson, Text: joinOriginal(words[i:end]), Source: SourceRule})
+		for j := i; j < end; j++ {
+			consumed[j] = true
+		}
+		i = end - 1
+	}
+
+	for i := 0; i < len(words); i++ {
+		if consumed[i] || !organizationSuffixes[words[i].lower] || !words[i].capitalized {
+			continue
+		}
+		// "Small Finance Bank" ends at its last suffix
+		end := i
+		for end+1 < len(words) && !consumed[end+1] && words[end+1].capitalized && organizationSuffixes[words[end+1].lower] {
+			end++
+		}
+		start := i
+		for start > 0 && i-start < maxRuleNameWords && !consumed[start-1] && words[start-1].capitalized && !ruleStopWords[words[start-1].lower] {
+			start--
+		}
+		if start == i {
+			continue
+		}
+		name := joinOriginal(words[start : end+1])
+		mentions = append(mentions, Mention{ID: Slug(name), Name: name, Type: TypeOrganization, Text: name, Source: SourceRule})
+		for j := start; j <= end; j++ {
+			consumed[j] = true
+		}
+		i = end
+	}
+
+	return mentions
+}
+
+// ===============================
+// TOKENIZING
+// ===============================
+
+type word struct {
+	text        string
+	lower       string
+	capitalized bool
+}
+
+// tokenize splits text into words on anything but letters and digits, dropping a trailing
+// possessive so "Modi's" matches "Modi"
+func tokenize(text string) []word {
+	text = strings.ReplaceAll(text, "’", "'")
+	text = strings.NewReplacer("'s ", " ", "'S ", " ").Replace(text + " ")
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make([]word, 0, len(fields))
+	for _, field := range fields {
+		first := []rune(field)[0]
+		words = append(words, word{
+			text:        field,
+			lower:       strings.ToLower(field),
+			capitalized: unicode.IsUpper(first),
+		})
+	}
+	return words
+}
+
+// Slug returns the canonical ID form of a name: lowercase words joined by hyphens
+func Slug(name string) string {
+	words := tokenize(name)
+	parts := make([]string, len(words))
+	for i, w := range words {
+		parts[i] = w.lower
+	}
+	return strings.Join(parts, "-")
+}
+
+func joinOriginal(words []word) string {
+	parts := make([]string, len(words))
+	for i, w := range words {
+		parts[i] = w.text
+	}
+	return strings.Join(parts, " ")
+}
+
+// isAcronym reports whether a name is written in capitals, like "SBI" or "J&K"
+func isAcronym(name string) bool {
+	return hasUpper(name) && !hasLower(name)
+}
+
+func hasLower(text string) bool {
+	for _, r := range text {
+		if unicode.IsLower(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasUpper(text string) bool {
+	for _, r := range text {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTitleCase reports whether most words are capitalized, as in many headlines
+func isTitleCase(words []word) bool {
+	letters, capitalized := 0, 0
+	for _, w := range words {
+		if ruleStopWords[w.lower] || !unicode.IsLetter([]rune(w.text)[0]) {
+			continue
+		}
+		letters++
+		if w.capitalized {
+			capitalized++
+		}
+	}
+	return letters >= 4 && float64(capitalized) > 0.8*float64(letters)
+}
@@ -0,0 +1,373 @@
+package entities
+
+// Kinds used by the built-in gazetteer
+const (
+	KindPolitician  = "politician"
+	KindOfficial    = "official"
+	KindCompany     = "company"
+	KindParty       = "party"
+	KindInstitution = "institution"
+	KindTeam        = "team"
+	KindCountry     = "country"
+	KindState       = "state"
+	KindTerritory   = "union-territory"
+	KindDistrict    = "district"
+	KindCity        = "city"
+)
+
+// DefaultEntries returns the built-in gazetteer: Indian politicians and officials, parties,
+// institutions, NSE-listed companies, sports teams, states, union territories and major
+// cities. Acronym aliases ("SBI") only match in capitals; entries whose names are also
+// ordinary words are case-sensitive.
+func DefaultEntries() []Entry {
+	var entries []Entry
+	entries = append(entries, defaultPlaces()...)
+	entries = append(entries, defaultPeople()...)
+	entries = append(entries, defaultOrganizations()...)
+	return entries
+}
+
+func place(id, name, kind, parent string, aliases ...string) Entry {
+	return Entry{ID: id, Name: name, Type: TypeLocation, Kind: kind, Parent: parent, Aliases: aliases}
+}
+
+func person(id, name, kind string, aliases ...string) Entry {
+	return Entry{ID: id, Name: name, Type: TypePerson, Kind: kind, Aliases: aliases}
+}
+
+func organization(id, name, kind string, aliases ...string) Entry {
+	return Entry{ID: id, Name: name, Type: TypeOrganization, Kind: kind, Aliases: aliases}
+}
+
+// caseSensitive marks an entry whose names are also ordinary words ("Reliance", "Titan")
+func caseSensitive(entry Entry, notAfter ...string) Entry {
+	entry.CaseSensitive = true
+	entry.NotAfter = notAfter
+	return entry
+}
+
+func defaultPlaces() []Entry {
+	return []Entry{
+		place("india", "India", KindCountry, "", "Bharat"),
+		place("pakistan", "Pakistan", KindCountry, ""),
+		place("china", "China", KindCountry, ""),
+		place("bangladesh", "Bangladesh", KindCountry, ""),
+		place("nepal", "Nepal", KindCountry, ""),
+		place("sri-lanka", "Sri Lanka", KindCountry, ""),
+		place("united-states", "United States", KindCountry, "", "US", "USA", "U S"),
+		place("united-kingdom", "United Kingdom", KindCountry, "", "UK", "Britain"),
+		place("russia", "Russia", KindCountry, ""),
+		place("ukraine", "Ukraine", KindCountry, ""),
+		place("israel", "Israel", KindCountry, ""),
+		place("australia", "Australia", KindCountry, ""),
+
+		// States
+		place("andhra-pradesh", "Andhra Pradesh", KindState, "india", "Andhra"),
+		place("arunachal-pradesh", "Arunachal Pradesh", KindState, "india", "Arunachal"),
+		place("assam", "Assam", KindState, "india"),
+		place("bihar", "Bihar", KindState, "india"),
+		place("chhattisgarh", "Chhattisgarh", KindState, "india"),
+		place("goa", "Goa", KindState, "india"),
+		place("gujarat", "Gujarat", KindState, "india"),
+		place("haryana", "Haryana", KindState, "india"),
+		place("himachal-pradesh", "Himachal Pradesh", KindState, "india", "Himachal"),
+		place("jharkhand", "Jharkhand", KindState, "india"),
+		place("karnataka", "Karnataka", KindState, "india"),
+		place("kerala", "Kerala", KindState, "india"),
+		place("madhya-pradesh", "Madhya Pradesh", KindState, "india"),
+		place("maharashtra", "Maharashtra", KindState, "india"),
+		place("manipur", "Manipur", KindState, "india"),
+		place("meghalaya", "Meghalaya", KindState, "india"),
+		place("mizoram", "Mizoram", KindState, "india"),
+		place("nagaland", "Nagaland", KindState, "india"),
+		place("odisha", "Odisha", KindState, "india", "Orissa"),
+		place("punjab", "Punjab", KindState, "india"),
+		place("rajasthan", "Rajasthan", KindState, "india"),
+		place("sikkim", "Sikkim", KindState, "india"),
+		place("tamil-nadu", "Tamil Nadu", KindState, "india"),
+		place("telangana", "Telangana", KindState, "india"),
+		place("tripura", "Tripura", KindState, "india"),
+		place("uttar-pradesh", "Uttar Pradesh", KindState, "india", "UP"),
+		place("uttarakhand", "Uttarakhand", KindState, "india"),
+		place("west-bengal", "West Bengal", KindState, "india", "Bengal"),
+
+		// Union territories
+		place("delhi", "Delhi", KindTerritory, "india", "NCT of Delhi"),
+		place("jammu-and-kashmir", "Jammu and Kashmir", KindTerritory, "india", "Jammu & Kashmir", "J&K"),
+		place("ladakh", "Ladakh", KindTerritory, "india"),
+		place("puducherry", "Puducherry", KindTerritory, "india", "Pondicherry"),
+		place("chandigarh", "Chandigarh", KindTerritory, "india"),
+		place("andaman-and-nicobar-islands", "Andaman and Nicobar Islands", KindTerritory, "india", "Andaman"),
+		place("lakshadweep", "Lakshadweep", KindTerritory, "india"),
+		place("dadra-nagar-haveli-daman-diu", "Dadra and Nagar Haveli and Daman and Diu", KindTerritory, "india", "Daman and Diu"),
+
+		// Cities
+		place("mumbai", "Mumbai", KindCity, "maharashtra", "Bombay"),
+		place("pune", "Pune", KindCity, "maharashtra", "Poona"),
+		place("nagpur", "Nagpur", KindCity, "maharashtra"),
+		place("nashik", "Nashik", KindCity, "maharashtra"),
+		place("thane", "Thane", KindCity, "maharashtra"),
+		place("new-delhi", "New Delhi", KindCity, "delhi"),
+		place("bengaluru", "Bengaluru", KindCity, "karnataka", "Bangalore"),
+		place("mysuru", "Mysuru", KindCity, "karnataka", "Mysore"),
+		place("chennai", "Chennai", KindCity, "tamil-nadu", "Madras"),
+		place("coimbatore", "Coimbatore", KindCity, "tamil-nadu"),
+		place("madurai", "Madurai", KindCity, "tamil-nadu"),
+		place("hyderabad", "Hyderabad", KindCity, "telangana"),
+		place("kolkata", "Kolkata", KindCity, "west-bengal", "Calcutta"),
+		place("ahmedabad", "Ahmedabad", KindCity, "gujarat"),
+		place("surat", "Surat", KindCity, "gujarat"),
+		place("vadodara", "Vadodara", KindCity, "gujarat", "Baroda"),
+		place("rajkot", "Rajkot", KindCity, "gujarat"),
+		place("gandhinagar", "Gandhinagar", KindCity, "gujarat"),
+		place("jaipur", "Jaipur", KindCity, "rajasthan"),
+		place("jodhpur", "Jodhpur", KindCity, "rajasthan"),
+		place("udaipur", "Udaipur", KindCity, "rajasthan"),
+		place("lucknow", "Lucknow", KindCity, "uttar-pradesh"),
+		place("kanpur", "Kanpur", KindCity, "uttar-pradesh"),
+		place("varanasi", "Varanasi", KindCity, "uttar-pradesh", "Banaras", "Benares"),
+		place("prayagraj", "Prayagraj", KindCity, "uttar-pradesh", "Allahabad"),
+		place("agra", "Agra", KindCity, "uttar-pradesh"),
+		place("noida", "Noida", KindCity, "uttar-pradesh"),
+		place("ghaziabad", "Ghaziabad", KindCity, "uttar-pradesh"),
+		place("ayodhya", "Ayodhya", KindCity, "uttar-pradesh"),
+		place("gurugram", "Gurugram", KindCity, "haryana", "Gurgaon"),
+		place("faridabad", "Faridabad", KindCity, "haryana"),
+		place("patna", "Patna", KindCity, "bihar"),
+		place("ranchi", "Ranchi", KindCity, "jharkhand"),
+		place("bhubaneswar", "Bhubaneswar", KindCity, "odisha"),
+		place("cuttack", "Cuttack", KindCity, "odisha"),
+		place("raipur", "Raipur", KindCity, "chhattisgarh"),
+		place("bhopal", "Bhopal", KindCity, "madhya-pradesh"),
+		place("indore", "Indore", KindCity, "madhya-pradesh"),
+		place("thiruvananthapuram", "Thiruvananthapuram", KindCity, "kerala", "Trivandrum"),
+		place("kochi", "Kochi", KindCity, "kerala", "Cochin"),
+		place("kozhikode", "Kozhikode", KindCity, "kerala", "Calicut"),
+		place("visakhapatnam", "Visakhapatnam", KindCity, "andhra-pradesh", "Vizag"),
+		place("vijayawada", "Vijayawada", KindCity, "andhra-pradesh"),
+		place("amaravati", "Amaravati", KindCity, "andhra-pradesh"),
+		place("guwahati", "Guwahati", KindCity, "assam"),
+		place("shillong", "Shillong", KindCity, "meghalaya"),
+		place("imphal", "Imphal", KindCity, "manipur"),
+		place("agartala", "Agartala", KindCity, "tripura"),
+		place("aizawl", "Aizawl", KindCity, "mizoram"),
+		place("kohima", "Kohima", KindCity, "nagaland"),
+		place("itanagar", "Itanagar", KindCity, "arunachal-pradesh"),
+		place("gangtok", "Gangtok", KindCity, "sikkim"),
+		place("dehradun", "Dehradun", KindCity, "uttarakhand"),
+		place("shimla", "Shimla", KindCity, "himachal-pradesh"),
+		place("srinagar", "Srinagar", KindCity, "jammu-and-kashmir"),
+		place("jammu", "Jammu", KindCity, "jammu-and-kashmir"),
+		place("leh", "Leh", KindCity, "ladakh"),
+		place("panaji", "Panaji", KindCity, "goa", "Panjim"),
+		place("amritsar", "Amritsar", KindCity, "punjab"),
+		place("ludhiana", "Ludhiana", KindCity, "punjab"),
+	}
+}
+
+func defaultPeople() []Entry {
+	return []Entry{
+		caseSensitive(person("narendra-modi", "Narendra Modi", KindPolitician, "PM Modi", "Modi"), "nirav", "lalit", "sushil"),
+		person("amit-shah", "Amit Shah", KindPolitician),
+		person("rahul-gandhi", "Rahul Gandhi", KindPolitician),
+		person("sonia-gandhi", "Sonia Gandhi", KindPolitician),
+		person("priyanka-gandhi-vadra", "Priyanka Gandhi Vadra", KindPolitician, "Priyanka Gandhi"),
+		person("mallikarjun-kharge", "Mallikarjun Kharge", KindPolitician, "Kharge"),
+		person("arvind-kejriwal", "Arvind Kejriwal", KindPolitician, "Kejriwal"),
+		person("mamata-banerjee", "Mamata Banerjee", KindPolitician, "Mamata"),
+		caseSensitive(person("yogi-adityanath", "Yogi Adityanath", KindPolitician, "Adityanath", "CM Yogi")),
+		person("nitish-kumar", "Nitish Kumar", KindPolitician),
+		person("tejashwi-yadav", "Tejashwi Yadav", KindPolitician, "Tejashwi"),
+		person("lalu-prasad-yadav", "Lalu Prasad Yadav", KindPolitician, "Lalu Prasad", "Lalu Yadav"),
+		person("akhilesh-yadav", "Akhilesh Yadav", KindPolitician, "Akhilesh"),
+		person("mayawati", "Mayawati", KindPolitician),
+		person("sharad-pawar", "Sharad Pawar", KindPolitician),
+		person("ajit-pawar", "Ajit Pawar", KindPolitician),
+		person("uddhav-thackeray", "Uddhav Thackeray", KindPolitician),
+		person("eknath-shinde", "Eknath Shinde", KindPolitician),
+		person("devendra-fadnavis", "Devendra Fadnavis", KindPolitician, "Fadnavis"),
+		person("nirmala-sitharaman", "Nirmala Sitharaman", KindPolitician, "Sitharaman"),
+		person("rajnath-singh", "Rajnath Singh", KindPolitician),
+		person("s-jaishankar", "S Jaishankar", KindPolitician, "Jaishankar", "Subrahmanyam Jaishankar"),
+		person("nitin-gadkari", "Nitin Gadkari", KindPolitician, "Gadkari"),
+		person("piyush-goyal", "Piyush Goyal", KindPolitician),
+		person("jp-nadda", "JP Nadda", KindPolitician, "J P Nadda", "Jagat Prakash Nadda", "Nadda"),
+		person("droupadi-murmu", "Droupadi Murmu", KindPolitician, "President Murmu"),
+		person("jagdeep-dhankhar", "Jagdeep Dhankhar", KindPolitician, "Dhankhar"),
+		person("om-birla", "Om Birla", KindPolitician),
+		caseSensitive(person("mk-stalin", "M K Stalin", KindPolitician, "MK Stalin", "Stalin"), "joseph"),
+		person("pinarayi-vijayan", "Pinarayi Vijayan", KindPolitician),
+		person("siddaramaiah", "Siddaramaiah", KindPolitician),
+		person("dk-shivakumar", "D K Shivakumar", KindPolitician, "DK Shivakumar", "Shivakumar"),
+		person("revanth-reddy", "A Revanth Reddy", KindPolitician, "Revanth Reddy"),
+		person("n-chandrababu-naidu", "N Chandrababu Naidu", KindPolitician, "Chandrababu Naidu"),
+		person("ys-jagan-mohan-reddy", "Y S Jagan Mohan Reddy", KindPolitician, "Jagan Mohan Reddy", "YS Jagan"),
+		person("himanta-biswa-sarma", "Himanta Biswa Sarma", KindPolitician, "Himanta"),
+		person("bhupendra-patel", "Bhupendra Patel", KindPolitician),
+		person("bhajan-lal-sharma", "Bhajan Lal Sharma", KindPolitician),
+		person("mohan-yadav", "Mohan Yadav", KindPolitician),
+		person("hemant-soren", "Hemant Soren", KindPolitician),
+		person("naveen-patnaik", "Naveen Patnaik", KindPolitician),
+		person("mohan-charan-majhi", "Mohan Charan Majhi", KindPolitician),
+		person("omar-abdullah", "Omar Abdullah", KindPolitician),
+		person("mehbooba-mufti", "Mehbooba Mufti", KindPolitician),
+		person("bhagwant-mann", "Bhagwant Mann", KindPolitician),
+		person("asaduddin-owaisi", "Asaduddin Owaisi", KindPolitician, "Owaisi"),
+		person("shashi-tharoor", "Shashi Tharoor", KindPolitician, "Tharoor"),
+		person("jairam-ramesh", "Jairam Ramesh", KindPolitician),
+		person("sanjay-raut", "Sanjay Raut", KindPolitician),
+		person("ashok-gehlot", "Ashok Gehlot", KindPolitician, "Gehlot"),
+		person("sachin-pilot", "Sachin Pilot", KindPolitician),
+		person("shivraj-singh-chouhan", "Shivraj Singh Chouhan", KindPolitician, "Shivraj Chouhan"),
+		person("manohar-lal-khattar", "Manohar Lal Khattar", KindPolitician, "Khattar"),
+		person("nayab-singh-saini", "Nayab Singh Saini", KindPolitician),
+		person("smriti-irani", "Smriti Irani", KindPolitician),
+		person("anurag-thakur", "Anurag Thakur", KindPolitician),
+		person("jyotiraditya-scindia", "Jyotiraditya Scindia", KindPolitician, "Scindia"),
+		person("ashwini-vaishnaw", "Ashwini Vaishnaw", KindPolitician, "Vaishnaw"),
+		person("hardeep-singh-puri", "Hardeep Singh Puri", KindPolitician, "Hardeep Puri"),
+		person("dharmendra-pradhan", "Dharmendra Pradhan", KindPolitician),
+		person("mansukh-mandaviya", "Mansukh Mandaviya", KindPolitician, "Mandaviya"),
+		person("kiren-rijiju", "Kiren Rijiju", KindPolitician, "Rijiju"),
+		person("arjun-ram-meghwal", "Arjun Ram Meghwal", KindPolitician, "Meghwal"),
+		person("chirag-paswan", "Chirag Paswan", KindPolitician),
+		person("manmohan-singh", "Manmohan Singh", KindPolitician),
+		person("sanjay-malhotra", "Sanjay Malhotra", KindOfficial),
+		person("shaktikanta-das", "Shaktikanta Das", KindOfficial),
+	}
+}
+
+func defaultOrganizations() []Entry {
+	return []Entry{
+		// Parties
+		organization("bjp", "Bharatiya Janata Party", KindParty, "BJP"),
+		caseSensitive(organization("indian-national-congress", "Indian National Congress", KindParty, "Congress", "INC", "Congress party"),
+			"us", "s", "american", "trinamool", "nationalist", "youth"),
+		organization("aap", "Aam Aadmi Party", KindParty, "AAP"),
+		organization("tmc", "All India Trinamool Congress", KindParty, "Trinamool Congress", "Trinamool", "TMC"),
+		organization("samajwadi-party", "Samajwadi Party", KindParty),
+		organization("bsp", "Bahujan Samaj Party", KindParty, "BSP"),
+		organization("shiv-sena", "Shiv Sena", KindParty),
+		organization("ncp", "Nationalist Congress Party", KindParty, "NCP"),
+		organization("dmk", "Dravida Munnetra Kazhagam", KindParty, "DMK"),
+		organization("aiadmk", "All India Anna Dravida Munnetra Kazhagam", KindParty, "AIADMK"),
+		organization("jdu", "Janata Dal (United)", KindParty, "JD(U)", "JDU"),
+		organization("rjd", "Rashtriya Janata Dal", KindParty, "RJD"),
+		organization("cpi-m", "Communist Party of India (Marxist)", KindParty, "CPI(M)", "CPM"),
+		organization("tdp", "Telugu Desam Party", KindParty, "TDP"),
+		organization("ysrcp", "YSR Congress Party", KindParty, "YSRCP", "YSR Congress"),
+		organization("brs", "Bharat Rashtra Samithi", KindParty, "BRS"),
+		organization("bjd", "Biju Janata Dal", KindParty, "BJD"),
+		organization("jmm", "Jharkhand Mukti Morcha", KindParty, "JMM"),
+
+		// Institutions
+		organization("reserve-bank-of-india", "Reserve Bank of India", KindInstitution, "RBI", "Reserve Bank"),
+		organization("sebi", "Securities and Exchange Board of India", KindInstitution, "SEBI", "Sebi"),
+		organization("isro", "Indian Space Research Organisation", KindInstitution, "ISRO", "Isro"),
+		organization("drdo", "Defence Research and Development Organisation", KindInstitution, "DRDO"),
+		organization("bcci", "Board of Control for Cricket in India", KindInstitution, "BCCI"),
+		organization("election-commission-of-india", "Election Commission of India", KindInstitution, "Election Commission", "ECI"),
+		organization("nse", "National Stock Exchange", KindInstitution, "NSE"),
+		organization("bse", "Bombay Stock Exchange", KindInstitution, "BSE"),
+		organization("niti-aayog", "NITI Aayog", KindInstitution),
+		organization("cbi", "Central Bureau of Investigation", KindInstitution, "CBI"),
+		organization("enforcement-directorate", "Enforcement Directorate", KindInstitution, "ED"),
+		organization("nia", "National Investigation Agency", KindInstitution, "NIA"),
+
+		// NSE-listed companies
+		caseSensitive(organization("reliance-industries", "Reliance Industries", KindCompany, "Reliance", "RIL")),
+		organization("tcs", "Tata Consultancy Services", KindCompany, "TCS"),
+		organization("hdfc-bank", "HDFC Bank", KindCompany),
+		organization("icici-bank", "ICICI Bank", KindCompany),
+		organization("infosys", "Infosys", KindCompany),
+		organization("state-bank-of-india", "State Bank of India", KindCompany, "SBI"),
+		organization("bharti-airtel", "Bharti Airtel", KindCompany, "Airtel"),
+		organization("itc", "ITC Ltd", KindCompany, "ITC"),
+		organization("hindustan-unilever", "Hindustan Unilever", KindCompany, "HUL"),
+		organization("larsen-and-toubro", "Larsen & Toubro", KindCompany, "Larsen and Toubro", "L&T"),
+		organization("kotak-mahindra-bank", "Kotak Mahindra Bank", KindCompany, "Kotak Bank", "Kotak"),
+		organization("axis-bank", "Axis Bank", KindCompany),
+		organization("bajaj-finance", "Bajaj Finance", KindCompany),
+		organization("bajaj-finserv", "Bajaj Finserv", KindCompany),
+		organization("bajaj-auto", "Bajaj Auto", KindCompany),
+		organization("asian-paints", "Asian Paints", KindCompany),
+		organization("maruti-suzuki", "Maruti Suzuki", KindCompany, "Maruti"),
+		organization("mahindra-and-mahindra", "Mahindra & Mahindra", KindCompany, "Mahindra and Mahindra", "M&M"),
+		organization("hcl-technologies", "HCL Technologies", KindCompany, "HCLTech", "HCL Tech"),
+		organization("wipro", "Wipro", KindCompany),
+		organization("tech-mahindra", "Tech Mahindra", KindCompany),
+		organization("sun-pharma", "Sun Pharmaceutical Industries", KindCompany, "Sun Pharma", "Sun Pharmaceutical"),
+		caseSensitive(organization("titan-company", "Titan Company", KindCompany, "Titan")),
+		organization("ultratech-cement", "UltraTech Cement", KindCompany, "UltraTech"),
+		organization("nestle-india", "Nestle India", KindCompany),
+		organization("ntpc", "NTPC", KindCompany),
+		organization("power-grid", "Power Grid Corporation of India", KindCompany, "Power Grid", "PowerGrid"),
+		organization("ongc", "Oil and Natural Gas Corporation", KindCompany, "ONGC"),
+		organization("coal-india", "Coal India", KindCompany),
+		organization("tata-motors", "Tata Motors", KindCompany),
+		organization("tata-steel", "Tata Steel", KindCompany),
+		organization("tata-power", "Tata Power", KindCompany),
+		organization("tata-consumer-products", "Tata Consumer Products", KindCompany, "Tata Consumer"),
+		organization("jsw-steel", "JSW Steel", KindCompany),
+		organization("hindalco", "Hindalco Industries", KindCompany, "Hindalco"),
+		organization("adani-enterprises", "Adani Enterprises", KindCompany),
+		organization("adani-ports", "Adani Ports and SEZ", KindCompany, "Adani Ports"),
+		organization("adani-green-energy", "Adani Green Energy", KindCompany, "Adani Green"),
+		organization("adani-power", "Adani Power", KindCompany),
+		organization("grasim-industries", "Grasim Industries", KindCompany, "Grasim"),
+		organization("britannia", "Britannia Industries", KindCompany, "Britannia"),
+		organization("cipla", "Cipla", KindCompany),
+		organization("dr-reddys-laboratories", "Dr Reddy's Laboratories", KindCompany, "Dr Reddy's"),
+		organization("divis-laboratories", "Divi's Laboratories", KindCompany, "Divi's Labs"),
+		organization("eicher-motors", "Eicher Motors", KindCompany),
+		organization("hero-motocorp", "Hero MotoCorp", KindCompany),
+		organization("apollo-hospitals", "Apollo Hospitals", KindCompany),
+		organization("indusind-bank", "IndusInd Bank", KindCompany),
+		organization("sbi-life-insurance", "SBI Life Insurance", KindCompany, "SBI Life"),
+		organization("hdfc-life", "HDFC Life Insurance", KindCompany, "HDFC Life"),
+		organization("bharat-electronics", "Bharat Electronics", KindCompany, "BEL"),
+		organization("bpcl", "Bharat Petroleum", KindCompany, "BPCL"),
+		organization("indian-oil", "Indian Oil Corporation", KindCompany, "Indian Oil", "IndianOil"),
+		organization("trent", "Trent Ltd", KindCompany),
+		organization("shriram-finance", "Shriram Finance", KindCompany),
+		organization("lic", "Life Insurance Corporation of India", KindCompany, "LIC"),
+		organization("zomato", "Zomato", KindCompany),
+		organization("paytm", "One 97 Communications", KindCompany, "Paytm"),
+		organization("nykaa", "FSN E-Commerce Ventures", KindCompany, "Nykaa"),
+		organization("vedanta", "Vedanta", KindCompany),
+		organization("hal", "Hindustan Aeronautics", KindCompany, "HAL"),
+		organization("sail", "Steel Authority of India", KindCompany, "SAIL"),
+		organization("irctc", "IRCTC", KindCompany),
+		caseSensitive(organization("indigo", "InterGlobe Aviation", KindCompany, "IndiGo")),
+		organization("dmart", "Avenue Supermarts", KindCompany, "DMart"),
+		organization("pidilite", "Pidilite Industries", KindCompany, "Pidilite"),
+		organization("godrej-consumer-products", "Godrej Consumer Products", KindCompany),
+		organization("dabur", "Dabur India", KindCompany, "Dabur"),
+		organization("marico", "Marico", KindCompany),
+		caseSensitive(organization("yes-bank", "Yes Bank", KindCompany)),
+		organization("bank-of-baroda", "Bank of Baroda", KindCompany),
+		organization("punjab-national-bank", "Punjab National Bank", KindCompany, "PNB"),
+		organization("canara-bank", "Canara Bank", KindCompany),
+		organization("vodafone-idea", "Vodafone Idea", KindCompany),
+		organization("jio-financial-services", "Jio Financial Services", KindCompany, "Jio Financial"),
+
+		// Sports teams
+		organization("india-cricket-team", "India national cricket team", KindTeam, "Team India", "Men in Blue", "Indian cricket team"),
+		organization("india-women-cricket-team", "India women's national cricket team", KindTeam, "Women in Blue", "Indian women's cricket team"),
+		organization("mumbai-indians", "Mumbai Indians", KindTeam, "MI"),
+		organization("chennai-super-kings", "Chennai Super Kings", KindTeam, "CSK"),
+		organization("royal-challengers-bengaluru", "Royal Challengers Bengaluru", KindTeam, "Royal Challengers Bangalore", "RCB"),
+		organization("kolkata-knight-riders", "Kolkata Knight Riders", KindTeam, "KKR"),
+		organization("delhi-capitals", "Delhi Capitals", KindTeam),
+		organization("sunrisers-hyderabad", "Sunrisers Hyderabad", KindTeam, "SRH"),
+		organization("rajasthan-royals", "Rajasthan Royals", KindTeam),
+		organization("punjab-kings", "Punjab Kings", KindTeam, "PBKS"),
+		organization("lucknow-super-giants", "Lucknow Super Giants", KindTeam, "LSG"),
+		organization("gujarat-titans", "Gujarat Titans", KindTeam),
+		organization("kerala-blasters", "Kerala Blasters", KindTeam),
+		organization("mohun-bagan-super-giant", "Mohun Bagan Super Giant", KindTeam, "Mohun Bagan"),
+		organization("east-bengal-fc", "East Bengal FC", KindTeam, "East Bengal"),
+		organization("bengaluru-fc", "Bengaluru FC", KindTeam),
+		organization("mumbai-city-fc", "Mumbai City FC", KindTeam),
+	}
+}
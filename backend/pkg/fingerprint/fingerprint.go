@@ -0,0 +1,210 @@
+// Package fingerprint computes SimHash fingerprints of article text and finds near-duplicates
+// with a banded locality-sensitive hashing index instead of comparing every pair.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Tokens lowercases text and splits it into words, dropping punctuation
+func Tokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SimHash returns the 64-bit SimHash of the text's words, weighted by how often each occurs.
+// Words rather than longer shingles keep headline-length rewrites within a few bits of each
+// other. Empty text hashes to 0.
+func SimHash(text string) uint64 {
+	counts := make(map[string]int)
+	for _, token := range Tokens(text) {
+		if len(token) > 1 {
+			counts[token]++
+		}
+	}
+	if len(counts) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	for token, count := range counts {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(token))
+		sum := hasher.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit] += count
+			} else {
+				weights[bit] -= count
+			}
+		}
+	}
+
+	var hash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			hash |= 1 << uint(bit)
+		}
+	}
+	return hash
+}
+
+// Distance is the number of differing bits between two fingerprints
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// ContentHash returns the SHA-256 of the text's words, so whitespace, case and punctuation
+// differences don't change it
+func ContentHash(text string) string {
+	sum := sha256.Sum256([]byte(strings.Join(Tokens(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ===============================
+// LSH BANDING INDEX
+// ===============================
+
+// Match is an indexed fingerprint near a queried one
+type Match struct {
+	ID       int
+	Distance int
+}
+
+// Index finds fingerprints within a Hamming distance. Each fingerprint is split into bands; two
+// fingerprints that differ in at most bands-1 bits must agree on at least one band, so looking up
+// the query's bands finds every match within that distance without scanning the whole index.
+type Index struct {
+	bands   []band
+	buckets []map[uint64][]int
+	hashes  map[int]uint64
+
+	mutex sync.RWMutex
+}
+
+type band struct {
+	shift uint
+	mask  uint64
+}
+
+// NewIndex creates an index that finds every fingerprint within maxDistance bits
+func NewIndex(maxDistance int) *Index {
+	count := maxDistance + 1
+	if count < 1 {
+		count = 1
+	}
+	if count > 64 {
+		count = 64
+	}
+
+	index := &Index{
+		bands:   make([]band, count),
+		buckets: make([]map[uint64][]int, count),
+		hashes:  make(map[int]uint64),
+	}
+
+	width := 64 / count
+	for i := range index.bands {
+		shift := uint(i * width)
+		size := width
+		if i == count-1 {
+			size = 64 - i*width // The last band takes the leftover bits
+		}
+		mask := uint64(1)<<uint(size) - 1
+		if size == 64 {
+			mask = ^uint64(0)
+		}
+		index.bands[i] = band{shift: shift, mask: mask}
+		index.buckets[i] = make(map[uint64][]int)
+	}
+	return index
+}
+
+// Add indexes a fingerprint under id, replacing any fingerprint already stored for it
+func (x *Index) Add(id int, hash uint64) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	if _, exists := x.hashes[id]; exists {
+		x.remove(id)
+	}
+	x.hashes[id] = hash
+	for i, b := range x.bands {
+		key := (hash >> b.shift) & b.mask
+		x.buckets[i][key] = append(x.buckets[i][key], id)
+	}
+}
+
+// Remove drops id from the index
+func (x *Index) Remove(id int) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.remove(id)
+}
+
+func (x *Index) remove(id int) {
+	hash, exists := x.hashes[id]
+	if !exists {
+		return
+	}
+	delete(x.hashes, id)
+
+	for i, b := range x.bands {
+		key := (hash >> b.shift) & b.mask
+		ids := x.buckets[i][key]
+		for j, candidate := range ids {
+			if candidate == id {
+				ids = append(ids[:j], ids[j+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(x.buckets[i], key)
+		} else {
+			x.buckets[i][key] = ids
+		}
+	}
+}
+
+// Near returns indexed fingerprints within maxDistance bits of hash, closest first
+func (x *Index) Near(hash uint64, maxDistance int) []Match {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	seen := make(map[int]bool)
+	var matches []Match
+	for i, b := range x.bands {
+		for _, id := range x.buckets[i][(hash>>b.shift)&b.mask] {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if distance := Distance(hash, x.hashes[id]); distance <= maxDistance {
+				matches = append(matches, Match{ID: id, Distance: distance})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	return matches
+}
+
+// Len returns the number of indexed fingerprints
+func (x *Index) Len() int {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return len(x.hashes)
+}
@@ -0,0 +1,220 @@
+package fingerprint
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// maxDistance matches the SIMHASH_MAX_DISTANCE default
+const maxDistance = 8
+
+func TestSimHashDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		near bool
+	}{
+		{
+			"case and punctuation",
+			"Sensex rises 500 points as banks rally, Nifty closes above 22,000",
+			"SENSEX RISES 500 POINTS AS BANKS RALLY; NIFTY CLOSES ABOVE 22,000!",
+			true,
+		},
+		{
+			"reordered rewrite",
+			"ISRO successfully launches Chandrayaan-4 mission from Sriharikota spaceport on Monday morning",
+			"ISRO launches Chandrayaan-4 mission successfully from Sriharikota spaceport Monday morning",
+			true,
+		},
+		{
+			"reworded ending",
+			"Sensex rises 500 points as banks rally while Nifty closes above 22,000 on Friday",
+			"Sensex rises 500 points as banks rally; Nifty ends above 22,000 on Friday",
+			true,
+		},
+		{
+			"different stories",
+			"Sensex rises 500 points as banks rally while Nifty closes above 22,000 on Friday",
+			"Heavy rain lashes Mumbai as IMD issues orange alert for the city and suburbs",
+			false,
+		},
+		{
+			"different topics",
+			"India beat Australia by six wickets to win the World Cup final in Ahmedabad",
+			"Parliament passes the new data protection bill after a heated debate in Lok Sabha",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance := Distance(SimHash(tt.a), SimHash(tt.b))
+			if near := distance <= maxDistance; near != tt.near {
+				t.Errorf("distance = %d, near = %v, want %v", distance, near, tt.near)
+			}
+		})
+	}
+
+	if hash := SimHash(" ... "); hash != 0 {
+		t.Errorf("SimHash of text without words = %x, want 0", hash)
+	}
+}
+
+func TestIndexNearFindsEveryMatchWithinDistance(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	base := rng.Uint64()
+
+	index := NewIndex(maxDistance)
+	hashes := make(map[int]uint64)
+	add := func(id int, hash uint64) {
+		index.Add(id, hash)
+		hashes[id] = hash
+	}
+
+	// Neighbours at every distance up to two past the limit, with the flipped bits spread over
+	// all bands so no band is left intact by accident
+	id := 1
+	for distance := 0; distance <= maxDistance+2; distance++ {
+		for variant := 0; variant < 8; variant++ {
+			hash := base
+			for _, bit := range rng.Perm(64)[:distance] {
+				hash ^= 1 << uint(bit)
+			}
+			add(id, hash)
+			id++
+		}
+	}
+	// Unrelated fingerprints
+	for ; id < 1000; id++ {
+		add(id, rng.Uint64())
+	}
+
+	for _, query := range []uint64{base, base ^ 0xff, rng.Uint64()} {
+		var want []Match
+		for id, hash := range hashes {
+			if distance := Distance(query, hash); distance <= maxDistance {
+				want = append(want, Match{ID: id, Distance: distance})
+			}
+		}
+		got := index.Near(query, maxDistance)
+
+		if len(got) != len(want) {
+			t.Fatalf("Near(%x) found %d matches, want %d", query, len(got), len(want))
+		}
+		for i, match := range got {
+			if match.Distance > maxDistance || Distance(query, hashes[match.ID]) != match.Distance {
+				t.Errorf("Near(%x) returned %+v", query, match)
+			}
+			if i > 0 && got[i-1].Distance > match.Distance {
+				t.Errorf("Near(%x) is not ordered closest first: %+v", query, got)
+				break
+			}
+		}
+	}
+}
+
+func TestIndexAddReplacesAndRemoveClearsBuckets(t *testing.T) {
+	first := SimHash("ISRO successfully launches Chandrayaan-4 mission from Sriharikota spaceport on Monday morning")
+	second := SimHash("Heavy rain lashes Mumbai as IMD issues orange alert for the city and suburbs")
+
+	tests := []struct {
+		name  string
+		build func(index *Index)
+		want  map[uint64][]Match
+		len   int
+	}{
+		{
+			name:  "add",
+			build: func(index *Index) { index.Add(1, first) },
+			want:  map[uint64][]Match{first: {{ID: 1}}, second: nil},
+			len:   1,
+		},
+		{
+			name: "add replaces an existing id",
+			build: func(index *Index) {
+				index.Add(1, first)
+				index.Add(1, second)
+			},
+			want: map[uint64][]Match{first: nil, second: {{ID: 1}}},
+			len:  1,
+		},
+		{
+			name: "re-adding the same fingerprint keeps one entry",
+			build: func(index *Index) {
+				index.Add(1, first)
+				index.Add(1, first)
+			},
+			want: map[uint64][]Match{first: {{ID: 1}}},
+			len:  1,
+		},
+		{
+			name: "remove",
+			build: func(index *Index) {
+				index.Add(1, first)
+				index.Add(2, first)
+				index.Remove(1)
+			},
+			want: map[uint64][]Match{first: {{ID: 2}}},
+			len:  1,
+		},
+		{
+			name: "remove unknown id",
+			build: func(index *Index) {
+				index.Add(1, first)
+				index.Remove(7)
+			},
+			want: map[uint64][]Match{first: {{ID: 1}}},
+			len:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := NewIndex(maxDistance)
+			tt.build(index)
+
+			for hash, want := range tt.want {
+				if got := index.Near(hash, maxDistance); !reflect.DeepEqual(got, want) {
+					t.Errorf("Near(%x) = %+v, want %+v", hash, got, want)
+				}
+			}
+			if index.Len() != tt.len {
+				t.Errorf("Len = %d, want %d", index.Len(), tt.len)
+			}
+
+			// Every bucket entry belongs to an indexed fingerprint, once per band
+			for i, buckets := range index.buckets {
+				entries := 0
+				for key, ids := range buckets {
+					if len(ids) == 0 {
+						t.Errorf("band %d keeps an empty bucket %x", i, key)
+					}
+					for _, id := range ids {
+						hash, ok := index.hashes[id]
+						if !ok || (hash>>index.bands[i].shift)&index.bands[i].mask != key {
+							t.Errorf("band %d bucket %x holds stale id %d", i, key, id)
+						}
+					}
+					entries += len(ids)
+				}
+				if entries != tt.len {
+					t.Errorf("band %d holds %d entries, want %d", i, entries, tt.len)
+				}
+			}
+		})
+	}
+
+	// Removing everything leaves no buckets behind
+	index := NewIndex(maxDistance)
+	index.Add(1, first)
+	index.Add(2, second)
+	index.Add(1, second)
+	index.Remove(1)
+	index.Remove(2)
+	for i, buckets := range index.buckets {
+		if len(buckets) != 0 {
+			t.Errorf("band %d keeps %d buckets after every id was removed", i, len(buckets))
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Package geo places news in India's administrative hierarchy. A bundled gazetteer of states
+// and union territories, districts and cities gives every place a code, so an article about
+// Navi Mumbai is tagged with the city, its district (Thane) and its state (Maharashtra), and
+// readers can be matched to news at any of those levels.
+//
+// States use their ISO 3166-2:IN subdivision codes ("MH"); districts are the state code and a
+// slug of the name ("mh-thane"); cities are slugs ("navi-mumbai"). Places also carry the
+// canonical ID the entities package uses for them, so extracted locations map straight to
+// codes.
+package geo
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"backend/pkg/entities"
+)
+
+// Levels of the hierarchy, broadest first
+const (
+	LevelState    = "state"
+	LevelDistrict = "district"
+	LevelCity     = "city"
+)
+
+// Regions group states the way editions and regional desks do
+const (
+	RegionNorth     = "north"
+	RegionSouth     = "south"
+	RegionEast      = "east"
+	RegionWest      = "west"
+	RegionCentral   = "central"
+	RegionNortheast = "northeast"
+)
+
+// Place is a state, district or city
+type Place struct {
+	Code         string   `json:"code"`
+	ID           string   `json:"id"` // Canonical entity ID
+	Name         string   `json:"name"`
+	Level        string   `json:"level"`
+	StateCode    string   `json:"state_code"`
+	DistrictCode string   `json:"district_code,omitempty"` // Cities only
+	Region       string   `json:"region"`
+	Metro        bool     `json:"metro,omitempty"` // Metropolitan city (Delhi counts as one)
+	Aliases      []string `json:"aliases,omitempty"`
+
+	qualified bool // A district only written as "<name> district" in text
+}
+
+// Gazetteer indexes places by code, entity ID and name. It is read-only once built and safe
+// for concurrent use.
+type Gazetteer struct {
+	places []*Place
+	byKey  map[string]*Place   // Lowercase codes and entity IDs
+	byName map[string][]*Place // Lowercase names and aliases, most specific level first
+}
+
+var (
+	defaultOnce      sync.Once
+	defaultGazetteer *Gazetteer
+)
+
+// Default returns the bundled gazetteer
+func Default() *Gazetteer {
+	defaultOnce.Do(func() {
+		defaultGazetteer = New(defaultPlaces())
+	})
+	return defaultGazetteer
+}
+
+// New indexes places. States must come before their districts and districts before their
+// cities, so regions are inherited down the hierarchy.
+func New(places []Place) *Gazetteer {
+	g := &Gazetteer{
+		byKey:  make(map[string]*Place, len(places)*2),
+		byName: make(map[string][]*Place, len(places)),
+	}
+	for i := range places {
+		place := places[i]
+		if place.Region == "" {
+			if state, ok := g.byKey[strings.ToLower(place.StateCode)]; ok {
+				place.Region = state.Region
+			}
+		}
+		g.places = append(g.places, &place)
+		g.byKey[strings.ToLower(place.Code)] = &place
+		g.byKey[strings.ToLower(place.ID)] = &place
+		for _, name := range append([]string{place.Name}, place.Aliases...) {
+			key := strings.ToLower(name)
+			g.byName[key] = append(g.byName[key], &place)
+		}
+	}
+
+	// A name shared by a city and its district (Pune) means the city in text
+	cityNames := make(map[string]bool)
+	for _, place := range g.places {
+		if place.Level == LevelCity {
+			cityNames[strings.ToLower(place.Name)] = true
+		}
+	}
+	for _, place := range g.places {
+		if place.Level == LevelDistrict && cityNames[strings.ToLower(place.Name)] {
+			place.qualified = true
+		}
+	}
+
+	for _, candidates := range g.byName {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return levelRank(candidates[i].Level) > levelRank(candidates[j].Level)
+		})
+	}
+	return g
+}
+
+// levelRank orders levels by how specific they are
+func levelRank(level string) int {
+	switch level {
+	case LevelCity:
+		return 2
+	case LevelDistrict:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Lookup returns the place with a code or canonical entity ID
+func (g *Gazetteer) Lookup(key string) (*Place, bool) {
+	place, ok := g.byKey[strings.ToLower(strings.TrimSpace(key))]
+	return place, ok
+}
+
+// Resolve returns the place a name, code or ID refers to. A name shared by several places
+// resolves to the most specific, so "Pune" is the city and "Pune district" the district.
+// Names with commas ("Pune, Maharashtra") resolve to their first known part.
+func (g *Gazetteer) Resolve(name string) (*Place, bool) {
+	for _, part := range strings.Split(name, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		if place, ok := g.Lookup(part); ok {
+			return place, true
+		}
+		if candidates := g.byName[part]; len(candidates) > 0 {
+			return candidates[0], true
+		}
+		if base := strings.TrimSuffix(part, " district"); base != part {
+			for _, candidate := range g.byName[strings.TrimSpace(base)] {
+				if candidate.Level == LevelDistrict {
+					return candidate, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// State returns the state or union territory containing a place
+func (g *Gazetteer) State(place *Place) *Place {
+	if place.Level == LevelState {
+		return place
+	}
+	return g.byKey[strings.ToLower(place.StateCode)]
+}
+
+// District returns the district containing a city, or the district itself
+func (g *Gazetteer) District(place *Place) *Place {
+	switch place.Level {
+	case LevelDistrict:
+		return place
+	case LevelCity:
+		return g.byKey[place.DistrictCode]
+	default:
+		return nil
+	}
+}
+
+// Contains reports whether outer is inner or one of the places containing it
+func (g *Gazetteer) Contains(outer, inner *Place) bool {
+	switch outer.Level {
+	case LevelState:
+		return inner.StateCode == outer.Code
+	case LevelDistrict:
+		return inner.Code == outer.Code || inner.DistrictCode == outer.Code
+	default:
+		return inner.Code == outer.Code
+	}
+}
+
+// Places returns every place at a level, optionally within one state, sorted by name
+func (g *Gazetteer) Places(level, stateCode string) []*Place {
+	var places []*Place
+	for _, place := range g.places {
+		if place.Level != level {
+			continue
+		}
+		if stateCode != "" && !strings.EqualFold(place.StateCode, stateCode) {
+			continue
+		}
+		places = append(places, place)
+	}
+	sort.Slice(places, func(i, j int) bool { return places[i].Name < places[j].Name })
+	return places
+}
+
+// Entries returns the districts and cities as entity gazetteer entries, so the entity
+// extractor finds them in text. Cities sit under their district and districts under their
+// state. Names are matched case-sensitively; a district sharing its name with a city is only
+// matched as "<name> district".
+func (g *Gazetteer) Entries() []entities.Entry {
+	var entries []entities.Entry
+	for _, place := range g.places {
+		switch place.Level {
+		case LevelDistrict:
+			entry := entities.Entry{
+				ID:            place.ID,
+				Name:          place.Name + " district",
+				Type:          entities.TypeLocation,
+				Kind:          entities.KindDistrict,
+				CaseSensitive: true,
+			}
+			if state := g.State(place); state != nil {
+				entry.Parent = state.ID
+			}
+			if !place.qualified {
+				entry.Aliases = append([]string{place.Name}, place.Aliases...)
+			}
+			entries = append(entries, entry)
+		case LevelCity:
+			entry := entities.Entry{
+				ID:            place.ID,
+				Name:          place.Name,
+				Type:          entities.TypeLocation,
+				Kind:          entities.KindCity,
+				Aliases:       place.Aliases,
+				CaseSensitive: true,
+			}
+			if district := g.District(place); district != nil {
+				entry.Parent = district.ID
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
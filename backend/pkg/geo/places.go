@@ -0,0 +1,633 @@
+package geo
+
+import "strings"
+
+func state(code, id, name, region string, aliases ...string) Place {
+	return Place{Code: code, ID: id, Name: name, Level: LevelState, StateCode: code, Region: region, Aliases: aliases}
+}
+
+func district(stateCode, slug, name string, aliases ...string) Place {
+	code := strings.ToLower(stateCode) + "-" + slug
+	return Place{Code: code, ID: code, Name: name, Level: LevelDistrict, StateCode: stateCode, Aliases: aliases}
+}
+
+// city places a city in a district, given as "<state>-<district slug>"
+func city(id, name, districtCode string, aliases ...string) Place {
+	stateCode := strings.ToUpper(strings.SplitN(districtCode, "-", 2)[0])
+	return Place{Code: id, ID: id, Name: name, Level: LevelCity, StateCode: stateCode, DistrictCode: districtCode, Aliases: aliases}
+}
+
+func metro(place Place) Place {
+	place.Metro = true
+	return place
+}
+
+// qualified marks a district whose name is also an ordinary word or a person's name ("Mandi",
+// "Anand"), so text only matches "<name> district"
+func qualified(place Place) Place {
+	place.qualified = true
+	return place
+}
+
+// defaultPlaces returns every state and union territory with their most newsworthy districts
+// and cities. State IDs match the entities package; cities it already knows keep its IDs.
+func defaultPlaces() []Place {
+	var places []Place
+	places = append(places, defaultStates()...)
+	places = append(places, defaultDistricts()...)
+	places = append(places, defaultCities()...)
+	return places
+}
+
+func defaultStates() []Place {
+	return []Place{
+		state("AP", "andhra-pradesh", "Andhra Pradesh", RegionSouth),
+		state("AR", "arunachal-pradesh", "Arunachal Pradesh", RegionNortheast),
+		state("AS", "assam", "Assam", RegionNortheast),
+		state("BR", "bihar", "Bihar", RegionEast),
+		state("CG", "chhattisgarh", "Chhattisgarh", RegionCentral),
+		state("GA", "goa", "Goa", RegionWest),
+		state("GJ", "gujarat", "Gujarat", RegionWest),
+		state("HR", "haryana", "Haryana", RegionNorth),
+		state("HP", "himachal-pradesh", "Himachal Pradesh", RegionNorth),
+		state("JH", "jharkhand", "Jharkhand", RegionEast),
+		state("KA", "karnataka", "Karnataka", RegionSouth),
+		state("KL", "kerala", "Kerala", RegionSouth),
+		state("MP", "madhya-pradesh", "Madhya Pradesh", RegionCentral),
+		state("MH", "maharashtra", "Maharashtra", RegionWest),
+		state("MN", "manipur", "Manipur", RegionNortheast),
+		state("ML", "meghalaya", "Meghalaya", RegionNortheast),
+		state("MZ", "mizoram", "Mizoram", RegionNortheast),
+		state("NL", "nagaland", "Nagaland", RegionNortheast),
+		state("OD", "odisha", "Odisha", RegionEast, "Orissa"),
+		state("PB", "punjab", "Punjab", RegionNorth),
+		state("RJ", "rajasthan", "Rajasthan", RegionWest),
+		state("SK", "sikkim", "Sikkim", RegionNortheast),
+		state("TN", "tamil-nadu", "Tamil Nadu", RegionSouth),
+		state("TG", "telangana", "Telangana", RegionSouth),
+		state("TR", "tripura", "Tripura", RegionNortheast),
+		state("UP", "uttar-pradesh", "Uttar Pradesh", RegionCentral),
+		state("UK", "uttarakhand", "Uttarakhand", RegionNorth),
+		state("WB", "west-bengal", "West Bengal", RegionEast),
+
+		// Union territories
+		metro(state("DL", "delhi", "Delhi", RegionNorth, "NCT of Delhi")),
+		state("JK", "jammu-and-kashmir", "Jammu and Kashmir", RegionNorth, "Jammu & Kashmir"),
+		state("LA", "ladakh", "Ladakh", RegionNorth),
+		state("CH", "chandigarh", "Chandigarh", RegionNorth),
+		state("PY", "puducherry", "Puducherry", RegionSouth, "Pondicherry"),
+		state("AN", "andaman-and-nicobar-islands", "Andaman and Nicobar Islands", RegionEast),
+		state("LD", "lakshadweep", "Lakshadweep", RegionSouth),
+		state("DH", "dadra-nagar-haveli-daman-diu", "Dadra and Nagar Haveli and Daman and Diu", RegionWest),
+	}
+}
+
+func defaultDistricts() []Place {
+	return []Place{
+		// Andhra Pradesh
+		district("AP", "visakhapatnam", "Visakhapatnam"),
+		qualified(district("AP", "ntr", "NTR")),
+		district("AP", "guntur", "Guntur"),
+		district("AP", "tirupati", "Tirupati"),
+		district("AP", "nellore", "Nellore"),
+		district("AP", "kurnool", "Kurnool"),
+		district("AP", "anantapur", "Anantapur", "Anantapuramu"),
+		district("AP", "east-godavari", "East Godavari"),
+		district("AP", "srikakulam", "Srikakulam"),
+		district("AP", "kadapa", "Kadapa", "YSR Kadapa"),
+
+		// Arunachal Pradesh
+		district("AR", "papum-pare", "Papum Pare"),
+		district("AR", "tawang", "Tawang"),
+
+		// Assam
+		district("AS", "kamrup-metropolitan", "Kamrup Metropolitan"),
+		district("AS", "dibrugarh", "Dibrugarh"),
+		district("AS", "jorhat", "Jorhat"),
+		district("AS", "cachar", "Cachar"),
+		district("AS", "sonitpur", "Sonitpur"),
+		district("AS", "nagaon", "Nagaon"),
+
+		// Bihar
+		district("BR", "patna", "Patna"),
+		district("BR", "gaya", "Gaya"),
+		district("BR", "muzaffarpur", "Muzaffarpur"),
+		district("BR", "bhagalpur", "Bhagalpur"),
+		district("BR", "darbhanga", "Darbhanga"),
+		district("BR", "nalanda", "Nalanda"),
+		district("BR", "purnia", "Purnia"),
+
+		// Chhattisgarh
+		district("CG", "raipur", "Raipur"),
+		district("CG", "durg", "Durg"),
+		district("CG", "bilaspur", "Bilaspur"),
+		district("CG", "bastar", "Bastar"),
+		district("CG", "dantewada", "Dantewada"),
+		district("CG", "sukma", "Sukma"),
+
+		// Goa
+		district("GA", "north-goa", "North Goa"),
+		district("GA", "south-goa", "South Goa"),
+
+		// Gujarat
+		district("GJ", "ahmedabad", "Ahmedabad"),
+		district("GJ", "surat", "Surat"),
+		district("GJ", "vadodara", "Vadodara"),
+		district("GJ", "rajkot", "Rajkot"),
+		district("GJ", "gandhinagar", "Gandhinagar"),
+		district("GJ", "bhavnagar", "Bhavnagar"),
+		district("GJ", "jamnagar", "Jamnagar"),
+		district("GJ", "kutch", "Kutch", "Kachchh"),
+		district("GJ", "junagadh", "Junagadh"),
+		qualified(district("GJ", "anand", "Anand")),
+
+		// Haryana
+		district("HR", "gurugram", "Gurugram"),
+		district("HR", "faridabad", "Faridabad"),
+		district("HR", "panipat", "Panipat"),
+		district("HR", "ambala", "Ambala"),
+		district("HR", "hisar", "Hisar"),
+		district("HR", "rohtak", "Rohtak"),
+		district("HR", "karnal", "Karnal"),
+		district("HR", "sonipat", "Sonipat"),
+		district("HR", "nuh", "Nuh", "Mewat"),
+		district("HR", "panchkula", "Panchkula"),
+
+		// Himachal Pradesh
+		district("HP", "shimla", "Shimla"),
+		district("HP", "kangra", "Kangra"),
+		district("HP", "kullu", "Kullu"),
+		qualified(district("HP", "mandi", "Mandi")),
+		district("HP", "chamba", "Chamba"),
+		district("HP", "bilaspur", "Bilaspur"),
+		qualified(district("HP", "una", "Una")),
+		district("HP", "solan", "Solan"),
+		district("HP", "kinnaur", "Kinnaur"),
+		district("HP", "lahaul-and-spiti", "Lahaul and Spiti"),
+
+		// Jharkhand
+		district("JH", "ranchi", "Ranchi"),
+		district("JH", "dhanbad", "Dhanbad"),
+		district("JH", "east-singhbhum", "East Singhbhum"),
+		district("JH", "bokaro", "Bokaro"),
+		district("JH", "deoghar", "Deoghar"),
+		district("JH", "hazaribagh", "Hazaribagh"),
+
+		// Karnataka
+		district("KA", "bengaluru-urban", "Bengaluru Urban"),
+		district("KA", "mysuru", "Mysuru"),
+		district("KA", "dakshina-kannada", "Dakshina Kannada"),
+		district("KA", "belagavi", "Belagavi"),
+		district("KA", "dharwad", "Dharwad"),
+		district("KA", "kalaburagi", "Kalaburagi"),
+		district("KA", "udupi", "Udupi"),
+		district("KA", "shivamogga", "Shivamogga", "Shimoga"),
+		district("KA", "ballari", "Ballari", "Bellary"),
+		qualified(district("KA", "hassan", "Hassan")),
+		district("KA", "kodagu", "Kodagu", "Coorg"),
+
+		// Kerala
+		district("KL", "thiruvananthapuram", "Thiruvananthapuram"),
+		district("KL", "ernakulam", "Ernakulam"),
+		district("KL", "kozhikode", "Kozhikode"),
+		district("KL", "thrissur", "Thrissur"),
+		district("KL", "kannur", "Kannur"),
+		district("KL", "malappuram", "Malappuram"),
+		district("KL", "wayanad", "Wayanad"),
+		district("KL", "kollam", "Kollam"),
+		district("KL", "alappuzha", "Alappuzha"),
+		district("KL", "idukki", "Idukki"),
+		district("KL", "palakkad", "Palakkad"),
+		district("KL", "kottayam", "Kottayam"),
+		district("KL", "pathanamthitta", "Pathanamthitta"),
+
+		// Madhya Pradesh
+		district("MP", "bhopal", "Bhopal"),
+		district("MP", "indore", "Indore"),
+		district("MP", "gwalior", "Gwalior"),
+		district("MP", "jabalpur", "Jabalpur"),
+		district("MP", "ujjain", "Ujjain"),
+		qualified(district("MP", "sagar", "Sagar")),
+		district("MP", "rewa", "Rewa"),
+		district("MP", "satna", "Satna"),
+
+		// Maharashtra
+		district("MH", "mumbai", "Mumbai"),
+		district("MH", "pune", "Pune"),
+		district("MH", "nagpur", "Nagpur"),
+		district("MH", "nashik", "Nashik"),
+		district("MH", "thane", "Thane"),
+		district("MH", "chhatrapati-sambhajinagar", "Chhatrapati Sambhajinagar", "Aurangabad"),
+		district("MH", "kolhapur", "Kolhapur"),
+		district("MH", "solapur", "Solapur"),
+		district("MH", "raigad", "Raigad"),
+		district("MH", "ratnagiri", "Ratnagiri"),
+		district("MH", "sindhudurg", "Sindhudurg"),
+		district("MH", "satara", "Satara"),
+		district("MH", "sangli", "Sangli"),
+		district("MH", "ahilyanagar", "Ahilyanagar", "Ahmednagar"),
+		district("MH", "jalgaon", "Jalgaon"),
+		district("MH", "amravati", "Amravati"),
+		district("MH", "akola", "Akola"),
+		district("MH", "latur", "Latur"),
+		district("MH", "nanded", "Nanded"),
+		district("MH", "beed", "Beed"),
+		district("MH", "palghar", "Palghar"),
+		district("MH", "chandrapur", "Chandrapur"),
+		district("MH", "gadchiroli", "Gadchiroli"),
+
+		// Manipur
+		district("MN", "imphal-west", "Imphal West"),
+		district("MN", "imphal-east", "Imphal East"),
+		district("MN", "churachandpur", "Churachandpur"),
+		district("MN", "bishnupur", "Bishnupur"),
+		district("MN", "kangpokpi", "Kangpokpi"),
+
+		// Meghalaya
+		district("ML", "east-khasi-hills", "East Khasi Hills"),
+		district("ML", "west-garo-hills", "West Garo Hills"),
+
+		// Mizoram
+		district("MZ", "aizawl", "Aizawl"),
+
+		// Nagaland
+		district("NL", "kohima", "Kohima"),
+		district("NL", "dimapur", "Dimapur"),
+
+		// Odisha
+		district("OD", "khordha", "Khordha", "Khurda"),
+		district("OD", "cuttack", "Cuttack"),
+		qualified(district("OD", "puri", "Puri")),
+		district("OD", "ganjam", "Ganjam"),
+		district("OD", "sundargarh", "Sundargarh"),
+		district("OD", "balasore", "Balasore", "Baleswar"),
+		district("OD", "sambalpur", "Sambalpur"),
+		district("OD", "mayurbhanj", "Mayurbhanj"),
+
+		// Punjab
+		district("PB", "ludhiana", "Ludhiana"),
+		district("PB", "amritsar", "Amritsar"),
+		district("PB", "jalandhar", "Jalandhar"),
+		district("PB", "patiala", "Patiala"),
+		district("PB", "sas-nagar", "SAS Nagar", "Sahibzada Ajit Singh Nagar"),
+		district("PB", "bathinda", "Bathinda"),
+
+		// Rajasthan
+		district("RJ", "jaipur", "Jaipur"),
+		district("RJ", "jodhpur", "Jodhpur"),
+		district("RJ", "udaipur", "Udaipur"),
+		district("RJ", "kota", "Kota"),
+		district("RJ", "ajmer", "Ajmer"),
+		district("RJ", "bikaner", "Bikaner"),
+		district("RJ", "alwar", "Alwar"),
+		district("RJ", "jaisalmer", "Jaisalmer"),
+		district("RJ", "bharatpur", "Bharatpur"),
+
+		// Sikkim
+		district("SK", "gangtok", "Gangtok"),
+
+		// Tamil Nadu
+		district("TN", "chennai", "Chennai"),
+		district("TN", "coimbatore", "Coimbatore"),
+		district("TN", "madurai", "Madurai"),
+		district("TN", "tiruchirappalli", "Tiruchirappalli"),
+		qualified(district("TN", "salem", "Salem")),
+		district("TN", "tirunelveli", "Tirunelveli"),
+		district("TN", "vellore", "Vellore"),
+		district("TN", "thoothukudi", "Thoothukudi"),
+		district("TN", "kanniyakumari", "Kanniyakumari", "Kanyakumari"),
+		district("TN", "nilgiris", "Nilgiris", "The Nilgiris"),
+		qualified(district("TN", "erode", "Erode")),
+		district("TN", "tiruppur", "Tiruppur"),
+		district("TN", "kancheepuram", "Kancheepuram", "Kanchipuram"),
+		district("TN", "chengalpattu", "Chengalpattu"),
+
+		// Telangana
+		district("TG", "hyderabad", "Hyderabad"),
+		district("TG", "rangareddy", "Rangareddy", "Ranga Reddy"),
+		district("TG", "medchal-malkajgiri", "Medchal Malkajgiri"),
+		district("TG", "warangal", "Warangal"),
+		district("TG", "karimnagar", "Karimnagar"),
+		district("TG", "nizamabad", "Nizamabad"),
+		district("TG", "khammam", "Khammam"),
+
+		// Tripura
+		district("TR", "west-tripura", "West Tripura"),
+
+		// Uttar Pradesh
+		district("UP", "lucknow", "Lucknow"),
+		district("UP", "kanpur-nagar", "Kanpur Nagar"),
+		district("UP", "varanasi", "Varanasi"),
+		district("UP", "prayagraj", "Prayagraj"),
+		district("UP", "agra", "Agra"),
+		district("UP", "gautam-buddh-nagar", "Gautam Buddh Nagar"),
+		district("UP", "ghaziabad", "Ghaziabad"),
+		district("UP", "ayodhya", "Ayodhya"),
+		district("UP", "meerut", "Meerut"),
+		district("UP", "gorakhpur", "Gorakhpur"),
+		district("UP", "mathura", "Mathura"),
+		district("UP", "bareilly", "Bareilly"),
+		district("UP", "aligarh", "Aligarh"),
+		district("UP", "moradabad", "Moradabad"),
+		district("UP", "jhansi", "Jhansi"),
+		district("UP", "saharanpur", "Saharanpur"),
+		district("UP", "muzaffarnagar", "Muzaffarnagar"),
+		district("UP", "lakhimpur-kheri", "Lakhimpur Kheri"),
+		district("UP", "hathras", "Hathras"),
+		district("UP", "unnao", "Unnao"),
+		district("UP", "sambhal", "Sambhal"),
+
+		// Uttarakhand
+		district("UK", "dehradun", "Dehradun"),
+		district("UK", "haridwar", "Haridwar"),
+		district("UK", "nainital", "Nainital"),
+		district("UK", "udham-singh-nagar", "Udham Singh Nagar"),
+		district("UK", "chamoli", "Chamoli"),
+		district("UK", "rudraprayag", "Rudraprayag"),
+		district("UK", "uttarkashi", "Uttarkashi"),
+		district("UK", "pithoragarh", "Pithoragarh"),
+		district("UK", "tehri-garhwal", "Tehri Garhwal"),
+
+		// West Bengal
+		district("WB", "kolkata", "Kolkata"),
+		district("WB", "howrah", "Howrah"),
+		district("WB", "north-24-parganas", "North 24 Parganas"),
+		district("WB", "south-24-parganas", "South 24 Parganas"),
+		district("WB", "darjeeling", "Darjeeling"),
+		district("WB", "hooghly", "Hooghly"),
+		district("WB", "paschim-bardhaman", "Paschim Bardhaman"),
+		district("WB", "murshidabad", "Murshidabad"),
+		qualified(district("WB", "nadia", "Nadia")),
+		district("WB", "jalpaiguri", "Jalpaiguri"),
+		district("WB", "paschim-medinipur", "Paschim Medinipur"),
+
+		// Delhi
+		district("DL", "new-delhi", "New Delhi"),
+		district("DL", "central-delhi", "Central Delhi"),
+		district("DL", "north-delhi", "North Delhi"),
+		district("DL", "south-delhi", "South Delhi"),
+		district("DL", "east-delhi", "East Delhi"),
+		district("DL", "west-delhi", "West Delhi"),
+		district("DL", "north-east-delhi", "North East Delhi"),
+		district("DL", "north-west-delhi", "North West Delhi"),
+		district("DL", "south-west-delhi", "South West Delhi"),
+		district("DL", "south-east-delhi", "South East Delhi"),
+		district("DL", "shahdara", "Shahdara"),
+
+		// Jammu and Kashmir
+		district("JK", "srinagar", "Srinagar"),
+		district("JK", "jammu", "Jammu"),
+		district("JK", "anantnag", "Anantnag"),
+		district("JK", "baramulla", "Baramulla"),
+		district("JK", "pulwama", "Pulwama"),
+		district("JK", "kathua", "Kathua"),
+		district("JK", "poonch", "Poonch"),
+		district("JK", "rajouri", "Rajouri"),
+		district("JK", "kupwara", "Kupwara"),
+		district("JK", "udhampur", "Udhampur"),
+		district("JK", "reasi", "Reasi"),
+		district("JK", "doda", "Doda"),
+		district("JK", "kishtwar", "Kishtwar"),
+		district("JK", "shopian", "Shopian"),
+		district("JK", "kulgam", "Kulgam"),
+
+		// Ladakh
+		district("LA", "leh", "Leh"),
+		district("LA", "kargil", "Kargil"),
+
+		// Andaman and Nicobar Islands
+		district("AN", "south-andaman", "South Andaman"),
+	}
+}
+
+func defaultCities() []Place {
+	return []Place{
+		// Andhra Pradesh
+		city("visakhapatnam", "Visakhapatnam", "ap-visakhapatnam", "Vizag"),
+		city("vijayawada", "Vijayawada", "ap-ntr"),
+		city("amaravati", "Amaravati", "ap-guntur"),
+		city("guntur", "Guntur", "ap-guntur"),
+		city("tirupati", "Tirupati", "ap-tirupati", "Tirumala"),
+		city("nellore", "Nellore", "ap-nellore"),
+		city("kurnool", "Kurnool", "ap-kurnool"),
+		city("rajahmundry", "Rajahmundry", "ap-east-godavari", "Rajamahendravaram"),
+
+		// Arunachal Pradesh
+		city("itanagar", "Itanagar", "ar-papum-pare"),
+		city("tawang", "Tawang", "ar-tawang"),
+
+		// Assam
+		city("guwahati", "Guwahati", "as-kamrup-metropolitan"),
+		city("dibrugarh", "Dibrugarh", "as-dibrugarh"),
+		city("jorhat", "Jorhat", "as-jorhat"),
+		city("silchar", "Silchar", "as-cachar"),
+		city("tezpur", "Tezpur", "as-sonitpur"),
+
+		// Bihar
+		city("patna", "Patna", "br-patna"),
+		city("gaya", "Gaya", "br-gaya"),
+		city("bodh-gaya", "Bodh Gaya", "br-gaya", "Bodhgaya"),
+		city("muzaffarpur", "Muzaffarpur", "br-muzaffarpur"),
+		city("bhagalpur", "Bhagalpur", "br-bhagalpur"),
+		city("darbhanga", "Darbhanga", "br-darbhanga"),
+
+		// Chhattisgarh
+		city("raipur", "Raipur", "cg-raipur"),
+		city("bhilai", "Bhilai", "cg-durg"),
+		city("bilaspur", "Bilaspur", "cg-bilaspur"),
+		city("jagdalpur", "Jagdalpur", "cg-bastar"),
+
+		// Goa
+		city("panaji", "Panaji", "ga-north-goa", "Panjim"),
+		city("margao", "Margao", "ga-south-goa", "Madgaon"),
+		city("vasco-da-gama", "Vasco da Gama", "ga-south-goa"),
+
+		// Gujarat
+		metro(city("ahmedabad", "Ahmedabad", "gj-ahmedabad")),
+		city("surat", "Surat", "gj-surat"),
+		city("vadodara", "Vadodara", "gj-vadodara", "Baroda"),
+		city("rajkot", "Rajkot", "gj-rajkot"),
+		city("gandhinagar", "Gandhinagar", "gj-gandhinagar"),
+		city("bhavnagar", "Bhavnagar", "gj-bhavnagar"),
+		city("jamnagar", "Jamnagar", "gj-jamnagar"),
+		city("bhuj", "Bhuj", "gj-kutch"),
+		city("junagadh", "Junagadh", "gj-junagadh"),
+
+		// Haryana
+		city("gurugram", "Gurugram", "hr-gurugram", "Gurgaon"),
+		city("faridabad", "Faridabad", "hr-faridabad"),
+		city("panipat", "Panipat", "hr-panipat"),
+		city("ambala", "Ambala", "hr-ambala"),
+		city("hisar", "Hisar", "hr-hisar"),
+		city("rohtak", "Rohtak", "hr-rohtak"),
+		city("karnal", "Karnal", "hr-karnal"),
+		city("sonipat", "Sonipat", "hr-sonipat"),
+		city("panchkula", "Panchkula", "hr-panchkula"),
+
+		// Himachal Pradesh
+		city("shimla", "Shimla", "hp-shimla"),
+		city("dharamshala", "Dharamshala", "hp-kangra", "Dharamsala", "McLeod Ganj"),
+		city("manali", "Manali", "hp-kullu"),
+		city("solan", "Solan", "hp-solan"),
+
+		// Jharkhand
+		city("ranchi", "Ranchi", "jh-ranchi"),
+		city("jamshedpur", "Jamshedpur", "jh-east-singhbhum"),
+		city("dhanbad", "Dhanbad", "jh-dhanbad"),
+		city("bokaro", "Bokaro", "jh-bokaro", "Bokaro Steel City"),
+		city("deoghar", "Deoghar", "jh-deoghar"),
+
+		// Karnataka
+		metro(city("bengaluru", "Bengaluru", "ka-bengaluru-urban")),
+		city("mysuru", "Mysuru", "ka-mysuru"),
+		city("mangaluru", "Mangaluru", "ka-dakshina-kannada", "Mangalore"),
+		city("hubballi", "Hubballi", "ka-dharwad", "Hubli"),
+		city("belagavi", "Belagavi", "ka-belagavi", "Belgaum"),
+		city("kalaburagi", "Kalaburagi", "ka-kalaburagi", "Gulbarga"),
+		city("udupi", "Udupi", "ka-udupi"),
+
+		// Kerala
+		city("thiruvananthapuram", "Thiruvananthapuram", "kl-thiruvananthapuram"),
+		city("kochi", "Kochi", "kl-ernakulam"),
+		city("kozhikode", "Kozhikode", "kl-kozhikode"),
+		city("thrissur", "Thrissur", "kl-thrissur", "Trichur"),
+		city("kannur", "Kannur", "kl-kannur"),
+		city("kollam", "Kollam", "kl-kollam"),
+		city("alappuzha", "Alappuzha", "kl-alappuzha", "Alleppey"),
+
+		// Madhya Pradesh
+		city("bhopal", "Bhopal", "mp-bhopal"),
+		city("indore", "Indore", "mp-indore"),
+		city("gwalior", "Gwalior", "mp-gwalior"),
+		city("jabalpur", "Jabalpur", "mp-jabalpur"),
+		city("ujjain", "Ujjain", "mp-ujjain"),
+
+		// Maharashtra
+		metro(city("mumbai", "Mumbai", "mh-mumbai")),
+		metro(city("pune", "Pune", "mh-pune")),
+		city("pimpri-chinchwad", "Pimpri-Chinchwad", "mh-pune", "Pimpri Chinchwad"),
+		city("nagpur", "Nagpur", "mh-nagpur"),
+		city("nashik", "Nashik", "mh-nashik", "Nasik"),
+		city("thane", "Thane", "mh-thane"),
+		city("navi-mumbai", "Navi Mumbai", "mh-thane"),
+		city("chhatrapati-sambhajinagar", "Chhatrapati Sambhajinagar", "mh-chhatrapati-sambhajinagar"),
+		city("kolhapur", "Kolhapur", "mh-kolhapur"),
+		city("solapur", "Solapur", "mh-solapur"),
+		city("amravati", "Amravati", "mh-amravati"),
+		city("nanded", "Nanded", "mh-nanded"),
+
+		// Manipur
+		city("imphal", "Imphal", "mn-imphal-west"),
+
+		// Meghalaya
+		city("shillong", "Shillong", "ml-east-khasi-hills"),
+		city("tura", "Tura", "ml-west-garo-hills"),
+
+		// Mizoram
+		city("aizawl", "Aizawl", "mz-aizawl"),
+
+		// Nagaland
+		city("kohima", "Kohima", "nl-kohima"),
+		city("dimapur", "Dimapur", "nl-dimapur"),
+
+		// Odisha
+		city("bhubaneswar", "Bhubaneswar", "od-khordha"),
+		city("cuttack", "Cuttack", "od-cuttack"),
+		city("berhampur", "Berhampur", "od-ganjam", "Brahmapur"),
+		city("rourkela", "Rourkela", "od-sundargarh"),
+		city("sambalpur", "Sambalpur", "od-sambalpur"),
+
+		// Punjab
+		city("ludhiana", "Ludhiana", "pb-ludhiana"),
+		city("amritsar", "Amritsar", "pb-amritsar"),
+		city("jalandhar", "Jalandhar", "pb-jalandhar"),
+		city("patiala", "Patiala", "pb-patiala"),
+		city("mohali", "Mohali", "pb-sas-nagar"),
+		city("bathinda", "Bathinda", "pb-bathinda"),
+
+		// Rajasthan
+		city("jaipur", "Jaipur", "rj-jaipur"),
+		city("jodhpur", "Jodhpur", "rj-jodhpur"),
+		city("udaipur", "Udaipur", "rj-udaipur"),
+		city("kota", "Kota", "rj-kota"),
+		city("ajmer", "Ajmer", "rj-ajmer"),
+		city("bikaner", "Bikaner", "rj-bikaner"),
+		city("jaisalmer", "Jaisalmer", "rj-jaisalmer"),
+
+		// Sikkim
+		city("gangtok", "Gangtok", "sk-gangtok"),
+
+		// Tamil Nadu
+		metro(city("chennai", "Chennai", "tn-chennai")),
+		city("coimbatore", "Coimbatore", "tn-coimbatore"),
+		city("madurai", "Madurai", "tn-madurai"),
+		city("tiruchirappalli", "Tiruchirappalli", "tn-tiruchirappalli", "Trichy"),
+		city("tirunelveli", "Tirunelveli", "tn-tirunelveli"),
+		city("vellore", "Vellore", "tn-vellore"),
+		city("thoothukudi", "Thoothukudi", "tn-thoothukudi", "Tuticorin"),
+		city("ooty", "Ooty", "tn-nilgiris", "Udhagamandalam"),
+		city("tiruppur", "Tiruppur", "tn-tiruppur"),
+
+		// Telangana
+		metro(city("hyderabad", "Hyderabad", "tg-hyderabad")),
+		city("secunderabad", "Secunderabad", "tg-hyderabad"),
+		city("warangal", "Warangal", "tg-warangal"),
+		city("karimnagar", "Karimnagar", "tg-karimnagar"),
+		city("nizamabad", "Nizamabad", "tg-nizamabad"),
+
+		// Tripura
+		city("agartala", "Agartala", "tr-west-tripura"),
+
+		// Uttar Pradesh
+		city("lucknow", "Lucknow", "up-lucknow"),
+		city("kanpur", "Kanpur", "up-kanpur-nagar"),
+		city("varanasi", "Varanasi", "up-varanasi"),
+		city("prayagraj", "Prayagraj", "up-prayagraj"),
+		city("agra", "Agra", "up-agra"),
+		city("noida", "Noida", "up-gautam-buddh-nagar"),
+		city("greater-noida", "Greater Noida", "up-gautam-buddh-nagar"),
+		city("ghaziabad", "Ghaziabad", "up-ghaziabad"),
+		city("ayodhya", "Ayodhya", "up-ayodhya"),
+		city("meerut", "Meerut", "up-meerut"),
+		city("gorakhpur", "Gorakhpur", "up-gorakhpur"),
+		city("mathura", "Mathura", "up-mathura"),
+		city("vrindavan", "Vrindavan", "up-mathura"),
+		city("bareilly", "Bareilly", "up-bareilly"),
+		city("aligarh", "Aligarh", "up-aligarh"),
+		city("moradabad", "Moradabad", "up-moradabad"),
+		city("jhansi", "Jhansi", "up-jhansi"),
+
+		// Uttarakhand
+		city("dehradun", "Dehradun", "uk-dehradun", "Dehra Dun"),
+		city("rishikesh", "Rishikesh", "uk-dehradun"),
+		city("haridwar", "Haridwar", "uk-haridwar", "Hardwar"),
+		city("nainital", "Nainital", "uk-nainital"),
+		city("haldwani", "Haldwani", "uk-nainital"),
+		city("joshimath", "Joshimath", "uk-chamoli", "Jyotirmath"),
+
+		// West Bengal
+		metro(city("kolkata", "Kolkata", "wb-kolkata")),
+		city("howrah", "Howrah", "wb-howrah"),
+		city("siliguri", "Siliguri", "wb-darjeeling"),
+		city("darjeeling", "Darjeeling", "wb-darjeeling"),
+		city("durgapur", "Durgapur", "wb-paschim-bardhaman"),
+		city("asansol", "Asansol", "wb-paschim-bardhaman"),
+
+		// Delhi
+		metro(city("new-delhi", "New Delhi", "dl-new-delhi")),
+
+		// Jammu and Kashmir
+		city("srinagar", "Srinagar", "jk-srinagar"),
+		city("jammu", "Jammu", "jk-jammu"),
+		city("gulmarg", "Gulmarg", "jk-baramulla"),
+		city("pahalgam", "Pahalgam", "jk-anantnag"),
+		city("katra", "Katra", "jk-reasi"),
+
+		// Ladakh
+		city("leh", "Leh", "la-leh"),
+		city("kargil", "Kargil", "la-kargil"),
+
+		// Andaman and Nicobar Islands
+		city("sri-vijaya-puram", "Sri Vijaya Puram", "an-south-andaman", "Port Blair"),
+	}
+}
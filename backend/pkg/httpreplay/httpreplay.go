@@ -0,0 +1,242 @@
+// Package httpreplay provides an http.RoundTripper that records responses to fixture
+// files and replays them, so provider clients can run offline and deterministically.
+package httpreplay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode selects how the transport treats requests
+type Mode string
+
+const (
+	// ModeLive passes requests straight through
+	ModeLive Mode = "live"
+	// ModeRecord passes requests through and saves every response as a fixture
+	ModeRecord Mode = "record"
+	// ModeReplay serves responses from fixtures and never touches the network
+	ModeReplay Mode = "replay"
+)
+
+// ErrFixtureNotFound is returned in replay mode when no fixture matches a request
+var ErrFixtureNotFound = errors.New("no recorded fixture for request")
+
+// redactedParams are query parameters that carry credentials; they are blanked in
+// fixture keys and files so fixtures can be committed and replayed with any key
+var redactedParams = map[string]bool{
+	"apikey":     true,
+	"api_key":    true,
+	"access_key": true,
+	"token":      true,
+	"key":        true,
+}
+
+// Fixture is one recorded exchange
+type Fixture struct {
+	Request    FixtureRequest  `json:"request"`
+	Response   FixtureResponse `json:"response"`
+	RecordedAt time.Time       `json:"recorded_at"`
+}
+
+// FixtureRequest identifies the recorded request
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// FixtureResponse is the recorded response
+type FixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Transport records or replays HTTP exchanges
+type Transport struct {
+	mode Mode
+	dir  string
+	next http.RoundTripper
+
+	writeMutex sync.Mutex
+}
+
+// NewTransport creates a transport; next is used in live and record modes and
+// defaults to http.DefaultTransport. Unknown modes behave as live.
+func NewTransport(mode Mode, dir string, next http.RoundTripper) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	switch mode {
+	case ModeRecord, ModeReplay:
+	default:
+		mode = ModeLive
+	}
+
+	return &Transport{mode: mode, dir: dir, next: next}
+}
+
+// Mode returns the effective mode
+func (t *Transport) Mode() Mode {
+	return t.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch t.mode {
+	case ModeReplay:
+		return t.replay(req)
+	case ModeRecord:
+		return t.record(req)
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *Transport) replay(req *http.Request) (*http.Response, error) {
+	key := RequestKey(req)
+	data, err := os.ReadFile(t.fixturePath(req, key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s %s", ErrFixtureNotFound, req.Method, key)
+		}
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture for %s: %w", key, err)
+	}
+
+	return fixture.toResponse(req), nil
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response for recording: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	key := RequestKey(req)
+	fixture := Fixture{
+		Request: FixtureRequest{Method: req.Method, URL: key},
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     recordedHeader(resp.Header),
+			Body:       string(body),
+		},
+		RecordedAt: time.Now().UTC(),
+	}
+
+	if err := t.save(t.fixturePath(req, key), &fixture); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (t *Transport) save(path string, fixture *Fixture) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+
+	t.writeMutex.Lock()
+	defer t.writeMutex.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// fixturePath groups fixtures by host, named by a hash of the redacted request
+func (t *Transport) fixturePath(req *http.Request, key string) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + key))
+	host := strings.NewReplacer(":", "_", "/", "_").Replace(req.URL.Host)
+	return filepath.Join(t.dir, host, strings.ToLower(req.Method)+"_"+hex.EncodeToString(sum[:8])+".json")
+}
+
+// RequestKey is the request URL with credentials blanked and query parameters sorted
+func RequestKey(req *http.Request) string {
+	u := *req.URL
+	query := u.Query()
+	for name := range query {
+		if redactedParams[strings.ToLower(name)] {
+			query.Set(name, "REDACTED")
+		}
+	}
+	u.RawQuery = encodeSorted(query)
+	u.Fragment = ""
+	return u.String()
+}
+
+func encodeSorted(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		vals := append([]string(nil), values[key]...)
+		sort.Strings(vals)
+		for _, value := range vals {
+			parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// recordedHeader keeps only headers that matter to clients reading the body
+func recordedHeader(header http.Header) http.Header {
+	kept := http.Header{}
+	for _, name := range []string{"Content-Type", "Retry-After", "X-Ratelimit-Remaining", "X-Ratelimit-Limit"} {
+		if values := header.Values(name); len(values) > 0 {
+			kept[name] = values
+		}
+	}
+	return kept
+}
+
+func (f *Fixture) toResponse(req *http.Request) *http.Response {
+	header := f.Response.Header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Response.StatusCode, http.StatusText(f.Response.StatusCode)),
+		StatusCode:    f.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(f.Response.Body)),
+		ContentLength: int64(len(f.Response.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,111 @@
+package httpreplay
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "60")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"q":"`+r.URL.Query().Get("q")+`"}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder := &http.Client{Transport: NewTransport(ModeRecord, dir, nil)}
+	recorded := get(t, recorder, server.URL+"/search?q=india&apikey=live-secret")
+	if hits != 1 {
+		t.Fatalf("record mode reached the server %d times, want 1", hits)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("recorded %d fixtures, want 1", len(files))
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "live-secret") {
+		t.Errorf("fixture leaks the API key:\n%s", data)
+	}
+	if strings.Contains(string(data), "session=secret") {
+		t.Errorf("fixture keeps a header it should drop:\n%s", data)
+	}
+
+	// Replay matches with any key and parameter order, without the network
+	server.Close()
+	replayer := &http.Client{Transport: NewTransport(ModeReplay, dir, nil)}
+	replayed := get(t, replayer, server.URL+"/search?apikey=other-key&q=india")
+
+	if replayed.status != recorded.status || replayed.body != recorded.body {
+		t.Errorf("replayed %d %q, want %d %q", replayed.status, replayed.body, recorded.status, recorded.body)
+	}
+	if replayed.header.Get("Retry-After") != "60" || replayed.header.Get("Content-Type") != "application/json" {
+		t.Errorf("replayed headers %v lost Retry-After or Content-Type", replayed.header)
+	}
+}
+
+func TestReplayMissingFixture(t *testing.T) {
+	client := &http.Client{Transport: NewTransport(ModeReplay, t.TempDir(), nil)}
+	_, err := client.Get("http://api.example.com/search?q=unrecorded")
+	if !errors.Is(err, ErrFixtureNotFound) {
+		t.Fatalf("got error %v, want ErrFixtureNotFound", err)
+	}
+}
+
+func TestRequestKey(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://api.example.com/news?q=a&apikey=x", "https://api.example.com/news?apikey=REDACTED&q=a"},
+		{"https://api.example.com/news?token=x&access_key=y&key=z", "https://api.example.com/news?access_key=REDACTED&key=REDACTED&token=REDACTED"},
+		{"https://api.example.com/news?b=2&a=1#top", "https://api.example.com/news?a=1&b=2"},
+		{"https://api.example.com/news", "https://api.example.com/news"},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+		if got := RequestKey(req); got != tt.want {
+			t.Errorf("RequestKey(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestUnknownModeIsLive(t *testing.T) {
+	if mode := NewTransport("bogus", "", nil).Mode(); mode != ModeLive {
+		t.Errorf("mode = %s, want %s", mode, ModeLive)
+	}
+}
+
+type exchange struct {
+	status int
+	header http.Header
+	body   string
+}
+
+func get(t *testing.T, client *http.Client, url string) exchange {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exchange{status: resp.StatusCode, header: resp.Header, body: string(body)}
+}
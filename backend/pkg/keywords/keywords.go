@@ -0,0 +1,316 @@
+// Package keywords picks the key phrases of news text and normalizes article tags. Candidate
+// phrases are runs of words between stopwords and punctuation (RAKE); each is scored by how
+// strongly its words bind to other words in the text, weighted by inverse document frequency
+// over a rolling corpus of recent articles, so words most stories use ("government", "India")
+// rank below the ones that say what this story is about.
+package keywords
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/pkg/lang"
+)
+
+const (
+	// maxPhraseWords is the longest candidate phrase; longer runs yield their sub-phrases
+	maxPhraseWords = 3
+
+	// minCorpusDocuments is how many documents the corpus needs before IDF is trusted; until
+	// then every word weighs the same
+	minCorpusDocuments = 50
+
+	// titleWeight counts a phrase in the headline as this many mentions in the body
+	titleWeight = 2.0
+
+	// minWordRunes drops one- and two-letter words from candidates ("s" from "India's")
+	minWordRunes = 3
+)
+
+// Keyword is a key phrase of a text
+type Keyword struct {
+	Phrase string  `json:"phrase"` // Lowercase words separated by spaces
+	Score  float64 `json:"score"`
+}
+
+// ===============================
+// CORPUS
+// ===============================
+
+// Corpus counts the documents each word appears in across the most recently added documents.
+// It is safe for concurrent use.
+type Corpus struct {
+	capacity  int
+	documents [][]string // Ring of each document's distinct words
+	next      int
+	frequency map[string]int
+
+	mutex sync.RWMutex
+}
+
+// NewCorpus creates a corpus remembering up to capacity documents
+func NewCorpus(capacity int) *Corpus {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Corpus{
+		capacity:  capacity,
+		documents: make([][]string, 0, capacity),
+		frequency: make(map[string]int),
+	}
+}
+
+// Add records the words of a document, forgetting the oldest document once the corpus is full
+func (c *Corpus) Add(text string) {
+	words := ContentWords(text)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.documents) < c.capacity {
+		c.documents = append(c.documents, words)
+	} else {
+		for _, word := range c.documents[c.next] {
+			if c.frequency[word]--; c.frequency[word] <= 0 {
+				delete(c.frequency, word)
+			}
+		}
+		c.documents[c.next] = words
+		c.next = (c.next + 1) % c.capacity
+	}
+	for _, word := range words {
+		c.frequency[word]++
+	}
+}
+
+// Size returns the number of documents remembered
+func (c *Corpus) Size() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return len(c.documents)
+}
+
+// IDF returns the smoothed inverse document frequency of a word, at least 1. Every word weighs
+// 1 until the corpus holds minCorpusDocuments documents.
+func (c *Corpus) IDF(word string) float64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.idf(word)
+}
+
+func (c *Corpus) idf(word string) float64 {
+	documents := len(c.documents)
+	if documents < minCorpusDocuments {
+		return 1
+	}
+	return math.Log(float64(1+documents)/float64(1+c.frequency[word])) + 1
+}
+
+// ContentWords returns the distinct words of a text that can be part of a keyword: no stopwords,
+// numbers or one- and two-letter words
+func ContentWords(text string) []string {
+	var words []string
+	seen := make(map[string]bool)
+	for _, token := range lang.Tokens(text) {
+		if isContentWord(token) && !seen[token] {
+			seen[token] = true
+			words = append(words, token)
+		}
+	}
+	return words
+}
+
+// ===============================
+// EXTRACTION
+// ===============================
+
+// candidate is a phrase found in the text being scored
+type candidate struct {
+	words   []string
+	count   float64 // Mentions, with headline mentions weighted by titleWeight
+	partial bool    // Cut from a run longer than maxPhraseWords
+}
+
+// Extract returns up to limit key phrases of a headline and body, best first. Phrases need to
+// be in the headline or mentioned at least twice, and pieces of longer runs need one mention
+// beyond the headline; a phrase inside a better one is left out.
+func (c *Corpus) Extract(title, body string, limit int) []Keyword {
+	candidates := make(map[string]*candidate)
+	frequency := make(map[string]float64) // RAKE word frequency
+	degree := make(map[string]float64)    // RAKE word degree: frequency plus co-occurring words
+
+	for _, text := range []struct {
+		text   string
+		weight float64
+	}{{title, titleWeight}, {body, 1}} {
+		for _, run := range phraseRuns(text.text) {
+			span := float64(minInt(len(run), maxPhraseWords))
+			for _, word := range run {
+				frequency[word] += text.weight
+				degree[word] += span * text.weight
+			}
+			for _, phrase := range subPhrases(run) {
+				key := strings.Join(phrase, " ")
+				if candidates[key] == nil {
+					candidates[key] = &candidate{words: phrase}
+				}
+				candidates[key].count += text.weight
+				candidates[key].partial = candidates[key].partial || len(run) > maxPhraseWords
+			}
+		}
+	}
+
+	c.mutex.RLock()
+	keywords := make([]Keyword, 0, len(candidates))
+	for phrase, candidate := range candidates {
+		if candidate.count < titleWeight || (candidate.partial && candidate.count <= titleWeight) {
+			continue
+		}
+		// Mean word score, growing with the square root of length so a phrase beats its words
+		// without every long run beating the phrases repeated through the text
+		score := 0.0
+		for _, word := range candidate.words {
+			score += degree[word] / frequency[word] * c.idf(word)
+		}
+		score = score / math.Sqrt(float64(len(candidate.words))) * math.Log(1+candidate.count)
+		keywords = append(keywords, Keyword{Phrase: phrase, Score: math.Round(score*1000) / 1000})
+	}
+	c.mutex.RUnlock()
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Score != keywords[j].Score {
+			return keywords[i].Score > keywords[j].Score
+		}
+		return keywords[i].Phrase < keywords[j].Phrase
+	})
+
+	var picked []Keyword
+	for _, keyword := range keywords {
+		if len(picked) >= limit {
+			break
+		}
+		if !overlapsAny(keyword.Phrase, picked) {
+			picked = append(picked, keyword)
+		}
+	}
+	return picked
+}
+
+// phraseRuns splits text at punctuation and stopwords into runs of content words
+func phraseRuns(text string) [][]string {
+	var runs [][]string
+	for _, fragment := range strings.FieldsFunc(text, isPhraseDelimiter) {
+		var run []string
+		for _, token := range lang.Tokens(fragment) {
+			if isContentWord(token) {
+				run = append(run, token)
+				continue
+			}
+			if len(run) > 0 {
+				runs = append(runs, run)
+				run = nil
+			}
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+// subPhrases returns a run as one phrase, or every phrase of up to maxPhraseWords words in it
+// when the run is longer
+func subPhrases(run []string) [][]string {
+	if len(run) <= maxPhraseWords {
+		return [][]string{run}
+	}
+	var phrases [][]string
+	for size := 1; size <= maxPhraseWords; size++ {
+		for start := 0; start+size <= len(run); start++ {
+			phrases = append(phrases, run[start:start+size])
+		}
+	}
+	return phrases
+}
+
+// isPhraseDelimiter reports whether a rune ends a phrase. Hyphens and apostrophes sit inside
+// names ("Jammu-Kashmir", "India's") and only split words.
+func isPhraseDelimiter(r rune) bool {
+	switch r {
+	case '-', '\'', '’':
+		return false
+	}
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// isContentWord reports whether a token can be part of a keyword
+func isContentWord(token string) bool {
+	if stopwords[token] || utf8.RuneCountInString(token) < minWordRunes {
+		return false
+	}
+	return strings.IndexFunc(token, unicode.IsLetter) >= 0
+}
+
+// overlapsAny reports whether a phrase contains, or is contained in, a picked phrase
+func overlapsAny(phrase string, picked []Keyword) bool {
+	padded := " " + phrase + " "
+	for _, keyword := range picked {
+		other := " " + keyword.Phrase + " "
+		if strings.Contains(other, padded) || strings.Contains(padded, other) {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// stopwords end candidate phrases: English function words, words every news report uses, and
+// common Hindi and Marathi function words
+var stopwords = func() map[string]bool {
+	words := map[string]bool{}
+	for _, list := range []string{
+		// English
+		`a about above according across after again against ago ahead all almost along already also
+		although always am amid among an and another any anyone anything are around as at away back
+		be became because become been before being below between both but by came can cannot could
+		did do does doing done down during each either else even ever every few first for former
+		from further get gets getting given go goes going got had has have having he her here hers
+		him his how however if in including inside instead into is it its itself just last later
+		latter least less like made make makes making many may me might more most much must my
+		near nearly need never new next no nor not now of off on once one only onto or other others
+		our out over own per rather really said same say says see seen several she should since so
+		some still such take taken than that the their them then there these they this those though
+		through till to told too toward towards under until up upon us use used very via was we
+		well were what when where whether which while who whom whose why will with within without
+		would yet you your`,
+		// Words every news report uses
+		`according announced amid breaking check day days details exclusive full here latest live
+		month months news official officials people photos report reported reports read says set
+		sources statement thursday friday saturday sunday monday tuesday wednesday time times today
+		top update updates video watch week weeks year years yesterday`,
+		// Headline verbs
+		`announce announces calls called claims comes faces gets hits hit keeps kept launches
+		likely lists looks meets plans raises reach reaches remain remains rise rises rose seeks
+		sets slams takes unchanged urges wants warns wins`,
+		// Hindi
+		`और का की के को कि से में पर है हैं था थे थी ने भी यह वह इस उस एक लिए किया गया गई कर करने
+		नहीं तो ही जो हो रहा रही रहे साथ बाद अब तक कहा`,
+		// Marathi
+		`आणि आहे आहेत या व हे ही ते ती त्या त्यांनी मध्ये साठी केले केली झाले झाली नाही तर पण होते`,
+	} {
+		for _, word := range strings.Fields(list) {
+			words[word] = true
+		}
+	}
+	return words
+}()
@@ -0,0 +1,186 @@
+package keywords
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/pkg/lang"
+)
+
+// maxTagWords drops provider "tags" that are really sentences
+const maxTagWords = 5
+
+// Tag is a normalized tag
+type Tag struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// Resolver returns the canonical name of an entity a tag names, such as "Bengaluru" for
+// "Bangalore" or "Narendra Modi" for "PM Modi"
+type Resolver func(name string) (string, bool)
+
+// Normalizer turns provider tags and extracted keywords into canonical tags. Casing and
+// punctuation are normalized, GDELT theme codes become words, known synonyms and spelling
+// variants map to one name, and names the resolver knows take its canonical spelling.
+type Normalizer struct {
+	resolve Resolver
+}
+
+// NewNormalizer creates a normalizer; resolve may be nil
+func NewNormalizer(resolve Resolver) *Normalizer {
+	return &Normalizer{resolve: resolve}
+}
+
+// Slug returns the URL form of a tag name: lowercase words joined by hyphens. Indic words keep
+// their script.
+func Slug(name string) string {
+	return strings.Join(lang.Tokens(name), "-")
+}
+
+// Normalize returns the canonical form of a tag, or false when the tag isn't worth keeping
+// (empty, numeric, a stopword, a sentence or a generic GDELT theme)
+func (n *Normalizer) Normalize(tag string) (Tag, bool) {
+	tag = strings.TrimSpace(tag)
+	if gdeltTheme.MatchString(tag) {
+		tag = themeName(tag)
+	}
+
+	words := lang.Tokens(tag)
+	for len(words) > 1 && honorifics[words[0]] {
+		words = words[1:]
+	}
+	if len(words) == 0 || len(words) > maxTagWords {
+		return Tag{}, false
+	}
+	for i, word := range words {
+		if variant, ok := spellingVariants[word]; ok {
+			words[i] = variant
+		}
+	}
+	key := strings.Join(words, " ")
+
+	if name, ok := synonyms[key]; ok {
+		return Tag{Slug: Slug(name), Name: name}, true
+	}
+	if genericTags[key] || (len(words) == 1 && !isContentWord(key)) {
+		return Tag{}, false
+	}
+	if strings.IndexFunc(key, unicode.IsLetter) < 0 {
+		return Tag{}, false
+	}
+	if n.resolve != nil {
+		if name, ok := n.resolve(key); ok {
+			return Tag{Slug: Slug(name), Name: name}, true
+		}
+	}
+
+	name := displayName(tag, words)
+	return Tag{Slug: Slug(name), Name: name}, true
+}
+
+// NormalizeAll normalizes tags in order, dropping ones that normalize to a slug already seen
+func (n *Normalizer) NormalizeAll(tags []string) []Tag {
+	var normalized []Tag
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		canonical, ok := n.Normalize(tag)
+		if !ok || seen[canonical.Slug] {
+			continue
+		}
+		seen[canonical.Slug] = true
+		normalized = append(normalized, canonical)
+	}
+	return normalized
+}
+
+// displayName is the lowercase tag, keeping acronyms written in capitals ("GST", "ISRO")
+func displayName(original string, words []string) string {
+	acronyms := make(map[string]bool)
+	for _, token := range strings.FieldsFunc(original, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if utf8.RuneCountInString(token) >= 2 && utf8.RuneCountInString(token) <= 6 && token == strings.ToUpper(token) && token != strings.ToLower(token) {
+			acronyms[strings.ToLower(token)] = true
+		}
+	}
+
+	display := make([]string, len(words))
+	for i, word := range words {
+		display[i] = word
+		if acronyms[word] {
+			display[i] = strings.ToUpper(word)
+		}
+	}
+	return strings.Join(display, " ")
+}
+
+// ===============================
+// GDELT THEMES
+// ===============================
+
+var (
+	// gdeltTheme matches GDELT GKG theme codes such as "ECON_STOCKMARKET" or "WB_1921_PRIVATE_SECTOR"
+	gdeltTheme = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)+$`)
+
+	// gdeltThemePrefix is the taxonomy part of a theme code
+	gdeltThemePrefix = regexp.MustCompile(`^(TAX_[A-Z]+_|WB_\d+_|CRISISLEX_(C\d+_)?|[A-Z]+_)`)
+)
+
+// themeName turns a GDELT theme code into words: "TAX_FNCACT_FARMERS" becomes "farmers"
+func themeName(theme string) string {
+	name := gdeltThemePrefix.ReplaceAllString(theme, "")
+	return strings.ToLower(strings.ReplaceAll(name, "_", " "))
+}
+
+// ===============================
+// SYNONYMS & VARIANTS
+// ===============================
+
+// honorifics are dropped from the start of names ("Shri Amit Shah")
+var honorifics = map[string]bool{
+	"shri": true, "shree": true, "sri": true, "smt": true, "kumari": true,
+	"mr": true, "mrs": true, "ms": true, "dr": true, "justice": true,
+	"श्री": true, "श्रीमती": true, "डॉ": true,
+}
+
+// spellingVariants map transliteration variants of common name words to one spelling
+var spellingVariants = map[string]string{
+	"mohd": "mohammed", "mohammad": "mohammed", "muhammad": "mohammed", "mohamed": "mohammed", "muhammed": "mohammed",
+	"mahmud": "mahmood", "mehmood": "mahmood",
+	"chaudhary": "chaudhry", "choudhary": "chaudhry", "chowdhury": "chaudhry", "choudhury": "chaudhry",
+	"yadhav": "yadav", "laxmi": "lakshmi", "lakshmy": "lakshmi", "aadhar": "aadhaar",
+}
+
+// synonyms map a normalized tag (lowercase words separated by spaces) to its canonical name
+var synonyms = map[string]string{
+	"covid": "COVID-19", "covid 19": "COVID-19", "coronavirus": "COVID-19", "corona virus": "COVID-19",
+	"covid19": "COVID-19", "share market": "stock market", "share markets": "stock market",
+	"stock markets": "stock market", "stockmarket": "stock market", "stocks": "stock market", "equity market": "stock market",
+	"ai": "artificial intelligence", "genai": "artificial intelligence",
+	"gst": "GST", "goods and services tax": "GST",
+	"upi": "UPI", "unified payments interface": "UPI",
+	"ipo": "IPO", "ipos": "IPO", "initial public offering": "IPO",
+	"ev": "electric vehicles", "evs": "electric vehicles", "electric vehicle": "electric vehicles",
+	"crypto": "cryptocurrency", "cryptocurrencies": "cryptocurrency", "bitcoin price": "bitcoin",
+	"lok sabha polls": "Lok Sabha elections", "lok sabha election": "Lok Sabha elections",
+	"lok sabha elections": "Lok Sabha elections", "general election": "Lok Sabha elections",
+	"general elections": "Lok Sabha elections", "assembly polls": "assembly elections",
+	"assembly election": "assembly elections", "elections": "election", "polls": "election",
+	"monsoon rains": "monsoon", "monsoon rain": "monsoon",
+	"retail inflation": "inflation", "cpi inflation": "inflation",
+	"aadhaar card": "Aadhaar", "aadhaar": "Aadhaar",
+	"t20 wc": "T20 World Cup", "t20 world cup": "T20 World Cup",
+	"odi world cup": "ODI World Cup", "cricket world cup": "ODI World Cup",
+}
+
+// genericTags say nothing about an article's topic; mostly broad GDELT themes
+var genericTags = map[string]bool{
+	"general government": true, "government": true, "leader": true, "president": true,
+	"minister": true, "msm": true, "media msm": true, "kill": true, "security services": true,
+	"general health": true, "india": true, "indian": true, "news": true, "world": true,
+	"national": true, "breaking news": true, "top news": true, "latest news": true,
+	"trending": true, "headlines": true,
+}
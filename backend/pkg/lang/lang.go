@@ -0,0 +1,271 @@
+// Package lang detects the language of Indian news text from its script and splits text into
+// words without breaking Indic syllables. Languages are identified by ISO 639-1 codes.
+package lang
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Language codes for English and the Indian languages news is ingested in
+const (
+	English   = "en"
+	Hindi     = "hi"
+	Marathi   = "mr"
+	Bengali   = "bn"
+	Tamil     = "ta"
+	Telugu    = "te"
+	Kannada   = "kn"
+	Malayalam = "ml"
+	Gujarati  = "gu"
+	Punjabi   = "pa"
+	Odia      = "or"
+	Urdu      = "ur"
+)
+
+// Language describes one supported language
+type Language struct {
+	Code       string `json:"code"`        // ISO 639-1
+	Code3      string `json:"-"`           // ISO 639-2, as GDELT names source languages
+	Name       string `json:"name"`        // English name
+	NativeName string `json:"native_name"` // Name in its own script
+	NewsWord   string `json:"-"`           // "News" in the language, a search term for providers that need one
+	script     *unicode.RangeTable
+}
+
+var languages = []Language{
+	{English, "eng", "English", "English", "news", unicode.Latin},
+	{Hindi, "hin", "Hindi", "हिन्दी", "समाचार", unicode.Devanagari},
+	{Marathi, "mar", "Marathi", "मराठी", "बातम्या", unicode.Devanagari},
+	{Bengali, "ben", "Bengali", "বাংলা", "খবর", unicode.Bengali},
+	{Tamil, "tam", "Tamil", "தமிழ்", "செய்திகள்", unicode.Tamil},
+	{Telugu, "tel", "Telugu", "తెలుగు", "వార్తలు", unicode.Telugu},
+	{Kannada, "kan", "Kannada", "ಕನ್ನಡ", "ಸುದ್ದಿ", unicode.Kannada},
+	{Malayalam, "mal", "Malayalam", "മലയാളം", "വാർത്ത", unicode.Malayalam},
+	{Gujarati, "guj", "Gujarati", "ગુજરાતી", "સમાચાર", unicode.Gujarati},
+	{Punjabi, "pan", "Punjabi", "ਪੰਜਾਬੀ", "ਖ਼ਬਰਾਂ", unicode.Gurmukhi},
+	{Odia, "ori", "Odia", "ଓଡ଼ିଆ", "ସମ୍ବାଦ", unicode.Oriya},
+	{Urdu, "urd", "Urdu", "اردو", "خبریں", unicode.Arabic},
+}
+
+// byName finds a language by code or name, as providers report them ("hi", "hin", "Hindi")
+var byName = func() map[string]*Language {
+	names := make(map[string]*Language)
+	for i := range languages {
+		language := &languages[i]
+		names[language.Code] = language
+		names[language.Code3] = language
+		names[strings.ToLower(language.Name)] = language
+	}
+	names["oriya"] = names[Odia]
+	names["bangla"] = names[Bengali]
+	return names
+}()
+
+// Supported returns every language, English first
+func Supported() []Language {
+	return append([]Language(nil), languages...)
+}
+
+// Get returns a supported language by code or name
+func Get(name string) (Language, bool) {
+	language, ok := byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Language{}, false
+	}
+	return *language, true
+}
+
+// Normalize returns the ISO 639-1 code for a code or name, or "" when it isn't supported
+func Normalize(name string) string {
+	language, ok := Get(name)
+	if !ok {
+		return ""
+	}
+	return language.Code
+}
+
+// NormalizeAll normalizes a list of codes or names, dropping unsupported ones and repeats
+func NormalizeAll(names []string) []string {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		code := Normalize(name)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// TextSearchConfig is the Postgres text search configuration for a language. Only English has
+// stemming; other languages use "simple", which only lowercases.
+func TextSearchConfig(code string) string {
+	if code == English {
+		return "english"
+	}
+	return "simple"
+}
+
+// ===============================
+// DETECTION
+// ===============================
+
+// Devanagari words common in one of Hindi and Marathi but not the other
+var (
+	hindiMarkers = map[string]bool{
+		"है": true, "हैं": true, "था": true, "थे": true, "थी": true, "और": true, "में": true,
+		"की": true, "का": true, "के": true, "से": true, "को": true, "ने": true, "पर": true,
+		"यह": true, "वह": true, "नहीं": true, "लिए": true, "गया": true, "किया": true,
+	}
+	marathiMarkers = map[string]bool{
+		"आहे": true, "आहेत": true, "आणि": true, "मध्ये": true,
+		"नाही": true, "झाले": true, "झाली": true, "केले": true, "केली": true, "येथे": true,
+		"त्यांनी": true, "साठी": true, "हे": true, "करण्यात": true, "आली": true,
+	}
+	// Marathi attaches case endings to the noun ("भारताच्या"); Hindi writes them apart
+	marathiSuffixes = []string{"च्या", "ाची", "ाचा", "ाचे"}
+)
+
+// Detect returns the language of a text from the script most of its letters are written in.
+// Devanagari is told apart as Hindi or Marathi by their common words. Text without letters
+// returns "".
+func Detect(text string) string {
+	counts := make(map[*unicode.RangeTable]int)
+	total := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		total++
+		for i := range languages {
+			if unicode.Is(languages[i].script, r) {
+				counts[languages[i].script]++
+				break
+			}
+		}
+	}
+	if total == 0 {
+		return ""
+	}
+
+	var script *unicode.RangeTable
+	best := 0
+	for i := range languages {
+		if count := counts[languages[i].script]; count > best {
+			script, best = languages[i].script, count
+		}
+	}
+	switch script {
+	case nil, unicode.Latin:
+		return English
+	case unicode.Devanagari:
+		return detectDevanagari(text)
+	}
+	for i := range languages {
+		if languages[i].script == script {
+			return languages[i].Code
+		}
+	}
+	return English
+}
+
+// detectDevanagari scores Hindi against Marathi; ties go to Hindi, the more common of the two
+func detectDevanagari(text string) string {
+	hindi, marathi := 0, 0
+	for _, token := range Tokens(text) {
+		switch {
+		case hindiMarkers[token]:
+			hindi++
+		case marathiMarkers[token]:
+			marathi++
+		default:
+			for _, suffix := range marathiSuffixes {
+				if strings.HasSuffix(token, suffix) {
+					marathi++
+					break
+				}
+			}
+		}
+	}
+	if marathi > hindi {
+		return Marathi
+	}
+	return Hindi
+}
+
+// ===============================
+// TOKENIZATION
+// ===============================
+
+const (
+	zeroWidthNonJoiner = '\u200c'
+	zeroWidthJoiner    = '\u200d'
+)
+
+// isWordRune reports whether a rune continues a word. Indic vowel signs, viramas and nuktas
+// are combining marks, which a letters-only split would cut words at.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc) ||
+		r == zeroWidthNonJoiner || r == zeroWidthJoiner
+}
+
+// Tokens splits text into lowercase words. Letters keep their combining marks, so "हिंदी"
+// stays one word, and zero-width joiners are dropped so spellings with and without them
+// match. Punctuation, including the danda (।), separates words.
+func Tokens(text string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range text {
+		if !isWordRune(r) {
+			flush()
+			continue
+		}
+		switch {
+		case r == zeroWidthNonJoiner || r == zeroWidthJoiner:
+			continue
+		case word.Len() == 0 && unicode.In(r, unicode.Mn, unicode.Mc):
+			// A stray mark can't start a word
+			continue
+		}
+		word.WriteRune(unicode.ToLower(r))
+	}
+	flush()
+	return tokens
+}
+
+// DistinctTokens returns each token of a text once, in order of first use
+func DistinctTokens(text string) []string {
+	var distinct []string
+	seen := make(map[string]bool)
+	for _, token := range Tokens(text) {
+		if !seen[token] {
+			seen[token] = true
+			distinct = append(distinct, token)
+		}
+	}
+	return distinct
+}
+
+// WordCount counts the words in a text in any supported script. Words are separated by spaces
+// or dandas, so "don't" is one word, and a lone dash or bullet is not a word.
+func WordCount(text string) int {
+	count := 0
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '।' || r == '॥'
+	}) {
+		if strings.IndexFunc(field, isWordRune) >= 0 {
+			count++
+		}
+	}
+	return count
+}
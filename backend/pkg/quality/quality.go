@@ -0,0 +1,216 @@
+// Package quality scores how much a news item reads like clickbait or filler rather than news.
+// Headlines are checked for bait phrases, shouting, stacked punctuation, listicle patterns and
+// sensational words, and bodies for being too thin to carry a story. Each signal takes a fixed
+// penalty off a perfect score of 1, so a score is easy to explain from its flags. Explicit or
+// graphic content is flagged separately, as it is not a matter of quality.
+package quality
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Signals that lower a score
+const (
+	FlagClickbait   = "clickbait"
+	FlagAllCaps     = "all_caps"
+	FlagPunctuation = "excessive_punctuation"
+	FlagShortBody   = "short_body"
+	FlagListicle    = "listicle"
+	FlagSensational = "sensational"
+)
+
+const (
+	// LowThreshold is the score below which an item counts as low quality
+	LowThreshold = 0.6
+
+	clickbaitPenalty      = 0.4 // First bait phrase
+	clickbaitExtraPenalty = 0.1 // Each further bait phrase
+	maxClickbaitPenalty   = 0.6
+	sensationalPenalty    = 0.2 // Each sensational word
+	maxSensationalPenalty = 0.4
+	allCapsPenalty        = 0.45
+	punctuationPenalty    = 0.25
+	listiclePenalty       = 0.45
+	shortBodyPenalty      = 0.15
+
+	allCapsRatio      = 0.7 // Share of cased letters in capitals for a headline to be shouting
+	minAllCapsLetters = 12  // Shorter headlines are usually acronyms, not shouting
+	minAllCapsWords   = 3
+	shortBodyWords    = 12 // Bodies with fewer words are too thin to carry a story
+	maxCountedPhrases = 8
+)
+
+// Result is the quality score of one item
+type Result struct {
+	Score    float64  `json:"score"` // 0 to 1, where 1 shows no sign of clickbait or filler
+	Flags    []string `json:"flags,omitempty"`
+	Explicit bool     `json:"explicit"`
+	Matches  []string `json:"matches,omitempty"` // Phrases behind the flags, for explaining a score
+}
+
+// LowQuality reports whether the score is below LowThreshold
+func (r Result) LowQuality() bool {
+	return r.Score < LowThreshold
+}
+
+// Phrases are matched on whole words of the lowercased text with punctuation and apostrophes
+// dropped, so "You Won't Believe" matches "you wont believe"
+var clickbaitPhrases = []string{
+	"you wont believe", "wont believe what", "what happened next", "what happens next",
+	"will blow your mind", "blow your mind", "will shock you", "shocked to see",
+	"you need to see", "need to see this", "this is why", "heres why", "here is why",
+	"the reason will", "will leave you", "leave you speechless", "left speechless",
+	"doctors hate", "one weird trick", "this one trick", "cant stop laughing",
+	"goes viral", "went viral", "breaks the internet", "broke the internet",
+	"netizens react", "internet cant", "you must see", "must watch", "must see",
+	"guess who", "guess what", "find out why", "find out what", "see pics", "see photos",
+	"see pictures", "in pics", "number will surprise", "is what happened",
+	"before its deleted", "you wont guess", "nobody expected", "no one expected",
+	"jaankar hairan", "hosh uda", "hosh udd", "dekhiye video",
+}
+
+var sensationalWords = []string{
+	"shocking", "shocked", "horrifying", "jaw dropping", "mind blowing", "mind boggling",
+	"insane", "bombshell", "outrageous", "unbelievable", "omg", "stunning", "sensational",
+	"epic", "hilarious", "savage", "destroys", "wtf", "crazy",
+}
+
+var explicitPhrases = []string{
+	"graphic video", "graphic images", "graphic footage", "graphic content", "disturbing video",
+	"disturbing visuals", "gruesome", "beheaded", "beheading", "decapitated", "dismembered",
+	"mutilated", "porn", "porno", "pornographic", "nude", "nudes", "naked", "sex tape",
+	"sex video", "mms leak", "leaked mms", "obscene video", "nsfw", "viewer discretion",
+	"18+", "adult content", "explicit video", "explicit photos",
+}
+
+// listiclePattern matches counted-list headlines such as "10 things you…" or "Top 7 celebs who…"
+var listiclePattern = regexp.MustCompile(`^(top )?\d{1,3} (\w+ ){0,2}(things|reasons|ways|photos|pics|pictures|times|signs|facts|secrets|tricks|hacks|celebrities|celebs|moments|memes|tweets|reactions|mistakes|habits|foods|tips|places|stars|actresses|looks)\b`)
+
+// stackedPunctuation matches repeated or mixed ! and ? marks
+var stackedPunctuation = regexp.MustCompile(`[!?]{2,}|!\s+!`)
+
+// Score rates a headline and body for clickbait and filler and flags explicit content. An
+// empty body is not penalized, as many feeds carry headlines only.
+func Score(title, body string) Result {
+	result := Result{Score: 1}
+	normalizedTitle := normalize(title)
+	penalty := 0.0
+
+	matches := matchPhrases(normalizedTitle, clickbaitPhrases)
+	if trimmed := strings.TrimSpace(title); strings.HasSuffix(trimmed, "...") || strings.HasSuffix(trimmed, "…") {
+		matches = append(matches, "...") // A headline trailing off to make readers click through
+	}
+	if len(matches) > 0 {
+		penalty += math.Min(maxClickbaitPenalty, clickbaitPenalty+clickbaitExtraPenalty*float64(len(matches)-1))
+		result.flag(FlagClickbait, matches...)
+	}
+
+	if matches := matchPhrases(normalizedTitle, sensationalWords); len(matches) > 0 {
+		penalty += math.Min(maxSensationalPenalty, sensationalPenalty*float64(len(matches)))
+		result.flag(FlagSensational, matches...)
+	}
+
+	if isShouting(title) {
+		penalty += allCapsPenalty
+		result.flag(FlagAllCaps)
+	}
+
+	if match := stackedPunctuation.FindString(title + " " + body); match != "" || strings.Count(title, "!") >= 2 {
+		penalty += punctuationPenalty
+		result.flag(FlagPunctuation, match)
+	}
+
+	if match := listiclePattern.FindString(strings.TrimSpace(normalizedTitle)); match != "" {
+		penalty += listiclePenalty
+		result.flag(FlagListicle, match)
+	}
+
+	if words := len(strings.Fields(body)); words > 0 && words < shortBodyWords {
+		penalty += shortBodyPenalty
+		result.flag(FlagShortBody)
+	}
+
+	if matches := matchPhrases(normalizedTitle+normalize(body), explicitPhrases); len(matches) > 0 {
+		result.Explicit = true
+		result.Matches = append(result.Matches, matches...)
+	}
+
+	result.Score = math.Round(math.Max(0, 1-penalty)*1000) / 1000
+	return result
+}
+
+// flag records a signal and the phrases behind it
+func (r *Result) flag(name string, matches ...string) {
+	r.Flags = append(r.Flags, name)
+	for _, match := range matches {
+		if match = strings.TrimSpace(match); match != "" {
+			r.Matches = append(r.Matches, match)
+		}
+	}
+}
+
+// normalize lowercases text, drops apostrophes and replaces other punctuation with spaces,
+// padding the result so phrases can be matched on word boundaries. "+" is kept for "18+".
+func normalize(text string) string {
+	var builder strings.Builder
+	builder.Grow(len(text) + 2)
+	builder.WriteByte(' ')
+	space := true
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r == '\'' || r == '’' || r == '‘':
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '+':
+			builder.WriteRune(r)
+			space = false
+		case !space:
+			builder.WriteByte(' ')
+			space = true
+		}
+	}
+	if !space {
+		builder.WriteByte(' ')
+	}
+	return builder.String()
+}
+
+// matchPhrases returns the phrases found as whole words in normalized text
+func matchPhrases(normalized string, phrases []string) []string {
+	var matches []string
+	for _, phrase := range phrases {
+		if strings.Contains(normalized, " "+phrase+" ") {
+			matches = append(matches, phrase)
+			if len(matches) == maxCountedPhrases {
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// isShouting reports whether a headline is written mostly in capitals. Scripts without case,
+// such as Devanagari, never count as shouting.
+func isShouting(title string) bool {
+	upper, cased, capsWords := 0, 0, 0
+	for _, word := range strings.Fields(title) {
+		wordUpper, wordCased := 0, 0
+		for _, r := range word {
+			if unicode.IsUpper(r) {
+				wordUpper++
+				wordCased++
+			} else if unicode.IsLower(r) {
+				wordCased++
+			}
+		}
+		upper += wordUpper
+		cased += wordCased
+		if wordCased >= 2 && wordUpper == wordCased {
+			capsWords++
+		}
+	}
+	return cased >= minAllCapsLetters && capsWords >= minAllCapsWords &&
+		float64(upper)/float64(cased) >= allCapsRatio
+}
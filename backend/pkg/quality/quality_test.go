@@ -0,0 +1,83 @@
+package quality
+
+import (
+	"reflect"
+	"testing"
+)
+
+// goldenItems is the reference set the phrase lists and penalties are tuned against; re-run it
+// after changing either
+var goldenItems = []struct {
+	title        string
+	body         string
+	wantLow      bool
+	wantExplicit bool
+}{
+	// Plain news, including headline-only items and acronyms
+	{"RBI keeps repo rate unchanged at 6.5%, signals focus on inflation", "The Reserve Bank of India's monetary policy committee voted to hold the key lending rate for the sixth straight meeting on Friday.", false, false},
+	{"ISRO launches PSLV-C58 carrying XPoSat from Sriharikota", "", false, false},
+	{"BJP, INC trade charges over MGNREGA funds in Lok Sabha", "", false, false},
+	{"Top 10 states by GDP growth in FY24: Gujarat leads", "", false, false},
+	{"India beat Australia by six wickets in stunning chase", "Virat Kohli's unbeaten 85 steered India home with two overs to spare in Chennai on Sunday evening.", false, false},
+	{"Sensex, Nifty end lower as IT stocks drag", "Benchmark indices closed down for a second session as Infosys and TCS fell after weak guidance from US peers.", false, false},
+	{"Why the monsoon is late this year, explained", "", false, false},
+	{"Supreme Court slams Centre over delay in judges' appointments", "", false, false},
+	{"5 killed, 12 injured as bus falls into gorge in Himachal", "", false, false},
+	{"प्रधानमंत्री ने किसानों के लिए राहत पैकेज की घोषणा की", "", false, false},
+	{"Photos: Floods ravage Assam as Brahmaputra crosses danger mark", "", false, false},
+
+	// Clickbait
+	{"You won't believe what happened next at this wedding", "", true, false},
+	{"This one trick will blow your mind", "", true, false},
+	{"Bollywood actress's new look goes viral, netizens react", "", true, false},
+	{"Guess who was spotted at the airport...", "", true, false},
+	{"Shocking! You need to see this video", "", true, false},
+
+	// Shouting and punctuation
+	{"PM MODI ANNOUNCES HUGE RELIEF FOR FARMERS", "", true, false},
+	{"Can you believe this?!! Fans go crazy!!", "", true, false},
+
+	// Listicles
+	{"10 things you didn't know about your smartphone", "", true, false},
+	{"15 celebrities who look unrecognisable without makeup", "", true, false},
+
+	// Sensational and thin
+	{"Horrifying bombshell rocks Bollywood", "Read more.", true, false},
+
+	// Explicit or graphic, whatever the quality
+	{"Police release graphic video of highway crash", "", false, true},
+	{"Man arrested after MMS leak of college students", "", false, true},
+	{"Shocking gruesome footage, viewer discretion advised!!", "", true, true},
+}
+
+func TestScoreGoldenItems(t *testing.T) {
+	for _, golden := range goldenItems {
+		result := Score(golden.title, golden.body)
+		if result.LowQuality() != golden.wantLow || result.Explicit != golden.wantExplicit {
+			t.Errorf("Score(%q) = %.3f explicit=%v (flags %v, matches %v), want low=%v explicit=%v",
+				golden.title, result.Score, result.Explicit, result.Flags, result.Matches, golden.wantLow, golden.wantExplicit)
+		}
+	}
+}
+
+func TestScoreFlags(t *testing.T) {
+	tests := []struct {
+		title string
+		body  string
+		flags []string
+		score float64
+	}{
+		{"Parliament session to begin on Monday", "", nil, 1},
+		{"You Won't Believe what happened next", "", []string{FlagClickbait}, 0.4}, // Three overlapping bait phrases
+		{"PM MODI ANNOUNCES HUGE RELIEF FOR FARMERS", "", []string{FlagAllCaps}, 0.55},
+		{"10 things you didn't know about your smartphone", "", []string{FlagListicle}, 0.55},
+		{"Budget passed", "Read more here.", []string{FlagShortBody}, 0.85},
+		{"Shocking! Fans go crazy!!", "", []string{FlagSensational, FlagPunctuation}, 0.35},
+	}
+	for _, tt := range tests {
+		result := Score(tt.title, tt.body)
+		if !reflect.DeepEqual(result.Flags, tt.flags) || result.Score != tt.score {
+			t.Errorf("Score(%q) = %.3f %v, want %.3f %v", tt.title, result.Score, result.Flags, tt.score, tt.flags)
+		}
+	}
+}
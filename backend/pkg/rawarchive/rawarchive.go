@@ -0,0 +1,285 @@
+// Package rawarchive keeps every raw provider response as gzip-compressed JSON lines,
+// partitioned by source and hour, so past fetches can be re-ingested after a converter fix.
+package rawarchive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidSource is returned for source names that cannot be used as a directory
+var ErrInvalidSource = errors.New("invalid archive source name")
+
+// sourceName limits sources to names that are safe path segments
+var sourceName = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// Entry is one archived provider response
+type Entry struct {
+	Source    string            `json:"source"`
+	FetchedAt time.Time         `json:"fetched_at"`
+	Query     json.RawMessage   `json:"query,omitempty"`
+	Records   []json.RawMessage `json:"records"`
+}
+
+// Partition is one source-hour of archived responses
+type Partition struct {
+	Source    string    `json:"source"`
+	Hour      time.Time `json:"hour"` // UTC, truncated to the hour
+	SizeBytes int64     `json:"size_bytes"`
+}
+
+// Filter selects partitions; zero values are unbounded
+type Filter struct {
+	Source string
+	From   time.Time // Inclusive, matched against the partition hour
+	To     time.Time // Exclusive
+}
+
+// Matches reports whether a partition falls inside the filter
+func (f Filter) Matches(p Partition) bool {
+	if f.Source != "" && f.Source != p.Source {
+		return false
+	}
+	if !f.From.IsZero() && p.Hour.Before(f.From.UTC().Truncate(time.Hour)) {
+		return false
+	}
+	if !f.To.IsZero() && !p.Hour.Before(f.To.UTC()) {
+		return false
+	}
+	return true
+}
+
+// Store archives and reads back provider responses
+type Store interface {
+	// Append adds one response to the partition for its source and fetch hour
+	Append(entry Entry) error
+	// Partitions lists matching partitions, oldest first
+	Partitions(filter Filter) ([]Partition, error)
+	// Read calls fn for every entry in a partition, in the order they were appended
+	Read(partition Partition, fn func(Entry) error) error
+	Close() error
+}
+
+// ===============================
+// LOCAL DISK STORE
+// ===============================
+
+// LocalStore writes partitions to dir/<source>/<YYYY-MM-DD>/<HH>.jsonl.gz
+type LocalStore struct {
+	dir string
+
+	writers map[string]*partitionWriter
+	mutex   sync.Mutex
+}
+
+// partitionWriter is an open partition file. Each open adds a new gzip member, and every
+// append is flushed, so readers see complete lines even while the hour is still being written.
+type partitionWriter struct {
+	hour time.Time
+	file *os.File
+	gz   *gzip.Writer
+}
+
+// NewLocalStore creates the archive directory if needed
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	return &LocalStore{dir: dir, writers: make(map[string]*partitionWriter)}, nil
+}
+
+// Append implements Store
+func (s *LocalStore) Append(entry Entry) error {
+	if !sourceName.MatchString(entry.Source) {
+		return fmt.Errorf("%w: %q", ErrInvalidSource, entry.Source)
+	}
+	if entry.FetchedAt.IsZero() {
+		entry.FetchedAt = time.Now()
+	}
+	entry.FetchedAt = entry.FetchedAt.UTC()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode archive entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	hour := entry.FetchedAt.Truncate(time.Hour)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Responses arrive roughly in time order, so earlier hours are finished
+	s.closeBefore(hour)
+
+	key := entry.Source + "/" + hour.Format(time.RFC3339)
+	writer, ok := s.writers[key]
+	if !ok {
+		path := s.partitionPath(entry.Source, hour)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("failed to create partition directory: %w", err)
+		}
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open partition: %w", err)
+		}
+		writer = &partitionWriter{hour: hour, file: file, gz: gzip.NewWriter(file)}
+		s.writers[key] = writer
+	}
+
+	if _, err := writer.gz.Write(line); err != nil {
+		return fmt.Errorf("failed to write archive entry: %w", err)
+	}
+	if err := writer.gz.Flush(); err != nil {
+		return fmt.Errorf("failed to flush archive entry: %w", err)
+	}
+	return nil
+}
+
+// closeBefore finishes partitions for earlier hours; callers hold the mutex
+func (s *LocalStore) closeBefore(hour time.Time) {
+	for key, writer := range s.writers {
+		if writer.hour.Before(hour) {
+			writer.close()
+			delete(s.writers, key)
+		}
+	}
+}
+
+func (w *partitionWriter) close() error {
+	gzErr := w.gz.Close()
+	fileErr := w.file.Close()
+	if gzErr != nil {
+		return gzErr
+	}
+	return fileErr
+}
+
+// Partitions implements Store
+func (s *LocalStore) Partitions(filter Filter) ([]Partition, error) {
+	var partitions []Partition
+
+	sources, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive directory: %w", err)
+	}
+
+	for _, source := range sources {
+		if !source.IsDir() || (filter.Source != "" && source.Name() != filter.Source) {
+			continue
+		}
+
+		matches, err := filepath.Glob(filepath.Join(s.dir, source.Name(), "*", "*.jsonl.gz"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range matches {
+			hour, ok := partitionHour(path)
+			if !ok {
+				continue
+			}
+			partition := Partition{Source: source.Name(), Hour: hour}
+			if !filter.Matches(partition) {
+				continue
+			}
+			if info, err := os.Stat(path); err == nil {
+				partition.SizeBytes = info.Size()
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		if !partitions[i].Hour.Equal(partitions[j].Hour) {
+			return partitions[i].Hour.Before(partitions[j].Hour)
+		}
+		return partitions[i].Source < partitions[j].Source
+	})
+
+	return partitions, nil
+}
+
+// Read implements Store. A partition still being written, or cut short by a crash, ends at
+// its last complete line rather than failing.
+func (s *LocalStore) Read(partition Partition, fn func(Entry) error) error {
+	file, err := os.Open(s.partitionPath(partition.Source, partition.Hour))
+	if err != nil {
+		return fmt.Errorf("failed to open partition: %w", err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("failed to read partition: %w", err)
+	}
+	defer gz.Close()
+
+	reader := bufio.NewReaderSize(gz, 256<<10)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			var entry Entry
+			if decodeErr := json.Unmarshal(line, &entry); decodeErr != nil {
+				return fmt.Errorf("failed to decode archive entry in %s: %w", partition.Source, decodeErr)
+			}
+			if fnErr := fn(entry); fnErr != nil {
+				return fnErr
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read partition: %w", err)
+		}
+	}
+}
+
+// Close implements Store
+func (s *LocalStore) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var firstErr error
+	for key, writer := range s.writers {
+		if err := writer.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(s.writers, key)
+	}
+	return firstErr
+}
+
+func (s *LocalStore) partitionPath(source string, hour time.Time) string {
+	hour = hour.UTC()
+	return filepath.Join(s.dir, source, hour.Format("2006-01-02"), hour.Format("15")+".jsonl.gz")
+}
+
+// partitionHour parses <YYYY-MM-DD>/<HH>.jsonl.gz back into the partition hour
+func partitionHour(path string) (time.Time, bool) {
+	day, err := time.Parse("2006-01-02", filepath.Base(filepath.Dir(path)))
+	if err != nil {
+		return time.Time{}, false
+	}
+	hour, err := strconv.Atoi(strings.TrimSuffix(filepath.Base(path), ".jsonl.gz"))
+	if err != nil || hour < 0 || hour > 23 {
+		return time.Time{}, false
+	}
+	return day.Add(time.Duration(hour) * time.Hour), true
+}
@@ -0,0 +1,181 @@
+package sentiment
+
+// Valences run from -4 (most negative) to 4 (most positive), as in VADER. Inflections ending in
+// -s, -es, -ed, -d and -ing are found from their base form; irregular ones are listed.
+// Phrases are space-separated and matched before single words, longest first.
+
+// generalLexicon covers everyday news vocabulary
+var generalLexicon = map[string]float64{
+	// Positive
+	"good": 1.9, "great": 3.1, "excellent": 3.2, "best": 3.2, "better": 1.9, "positive": 2.3,
+	"success": 2.7, "successful": 2.8, "successfully": 2.5, "achieve": 1.8, "achievement": 2.3,
+	"win": 2.8, "won": 2.7, "winner": 2.8, "winning": 2.4, "victory": 2.8, "triumph": 2.9,
+	"growth": 1.6, "grow": 1.3, "improve": 1.9, "improvement": 2.0, "progress": 1.8,
+	"boost": 1.7, "benefit": 2.0, "beneficial": 2.0, "welcome": 2.0, "celebrate": 2.7,
+	"celebration": 2.7, "happy": 2.7, "joy": 2.8, "proud": 2.1, "pride": 1.5, "hope": 1.9,
+	"hopeful": 1.9, "optimism": 2.3, "optimistic": 2.3, "confident": 2.2, "confidence": 2.0,
+	"strong": 2.3, "stronger": 2.0, "strengthen": 1.6, "robust": 1.8, "stable": 1.2,
+	"peace": 2.5, "peaceful": 2.2, "safe": 1.9, "safety": 1.8, "secure": 1.4, "rescue": 1.6,
+	"rescued": 1.6, "recover": 1.6, "recovery": 1.6, "relief": 1.7, "support": 1.7,
+	"praise": 2.6, "award": 2.5, "honour": 2.2, "honor": 2.2, "honoured": 2.2, "honored": 2.2,
+	"innovative": 2.0, "innovation": 1.8, "breakthrough": 2.4, "milestone": 1.9, "historic": 1.3,
+	"landmark": 1.4, "approve": 1.7, "approval": 1.7, "agreement": 1.4, "deal": 0.8,
+	"launch": 0.9, "expand": 1.2, "expansion": 1.2, "opportunity": 1.8, "thrive": 2.3,
+	"effective": 2.0, "efficient": 1.8, "gain": 1.7, "gains": 1.7, "profit": 1.9,
+	"affordable": 1.6, "free": 1.2, "fair": 1.3, "healthy": 1.8, "cure": 2.3, "helpful": 1.9,
+	"help": 1.7, "love": 3.2, "wonderful": 2.7, "amazing": 2.8, "impressive": 2.3,
+	"remarkable": 2.1, "outstanding": 3.0, "brilliant": 2.8, "excited": 2.3, "exciting": 2.2,
+	"reward": 2.0, "resolve": 1.6, "resolved": 1.6, "reunite": 1.8, "uplift": 1.9,
+	"empower": 1.8, "empowerment": 1.8, "upbeat": 2.0, "smooth": 1.1, "easing": 0.9,
+	"goods": 0, // Not "good": goods and services
+
+	// Negative
+	"bad": -2.5, "worse": -2.1, "worst": -3.1, "negative": -2.3, "poor": -2.1, "fail": -2.5,
+	"failure": -2.8, "loss": -1.9, "losses": -1.9, "lose": -1.9, "lost": -1.8, "losing": -1.8,
+	"decline": -1.5, "crisis": -3.1, "problem": -1.7, "trouble": -2.1, "threat": -2.4,
+	"threaten": -2.2, "risk": -1.6, "risky": -1.7, "danger": -2.4, "dangerous": -2.5,
+	"fear": -2.2, "fears": -2.2, "worry": -1.9, "worried": -1.9, "concern": -1.4,
+	"concerns": -1.4, "anger": -2.7, "angry": -2.3, "outrage": -2.9, "protest": -1.4,
+	"clash": -2.1, "violence": -3.1, "violent": -2.9, "attack": -2.8, "attacked": -2.8,
+	"terror": -3.2, "terrorist": -3.2, "killed": -3.4, "kill": -3.3, "killing": -3.4,
+	"death": -2.9, "deaths": -2.9, "dead": -3.3, "die": -2.9, "died": -2.6, "dies": -2.6,
+	"murder": -3.6, "injured": -2.1, "injury": -1.8, "injuries": -1.8, "wounded": -2.4,
+	"accident": -2.1, "crash": -2.3, "disaster": -3.1, "tragedy": -3.4, "tragic": -3.2,
+	"flood": -1.9, "floods": -1.9, "drought": -1.9, "earthquake": -2.2, "fire": -1.4,
+	"blast": -2.3, "explosion": -2.3, "war": -2.9, "conflict": -1.8, "crime": -2.5,
+	"arrest": -1.6, "arrested": -1.7, "jail": -2.2, "jailed": -2.2, "fraud": -2.8,
+	"corruption": -2.9, "corrupt": -3.0, "scandal": -2.6, "scam": -2.7, "bribe": -2.4,
+	"allegation": -1.5, "allege": -1.3, "accused": -1.6, "guilty": -2.0, "ban": -2.0,
+	"banned": -2.0, "delay": -1.3, "delayed": -1.3, "shortage": -1.8, "poverty": -2.5,
+	"unemployment": -2.0, "hunger": -2.3, "disease": -2.0, "outbreak": -2.2, "pandemic": -2.0,
+	"victim": -2.3, "victims": -2.3, "suffer": -2.3, "pain": -2.3, "sad": -2.1, "grief": -2.5,
+	"mourn": -2.0, "shock": -1.6, "shocking": -2.0, "condemn": -2.2, "criticism": -1.6,
+	"criticise": -1.7, "criticize": -1.7, "slam": -1.7, "blame": -1.6, "reject": -1.7,
+	"rejected": -1.7, "collapse": -2.4, "damage": -2.2, "destroy": -2.7, "destroyed": -2.8,
+	"hit": -0.8, "struggle": -1.8, "weak": -1.9, "weaker": -1.6, "weakness": -1.6,
+	"cut": -1.1, "cuts": -1.1, "hike": -0.9, "layoff": -2.2, "layoffs": -2.2, "strike": -1.4,
+	"shutdown": -1.8, "unrest": -2.2, "riot": -2.9, "chaos": -2.7, "panic": -2.6,
+	"controversy": -1.8, "controversial": -1.5, "dispute": -1.7, "warning": -1.4, "warn": -1.4,
+	"penalty": -1.6, "fine": -1.0, "fines": -1.0, "fined": -1.6, "sued": -1.7, "lawsuit": -1.5,
+	"stampede": -3.0, "toxic": -2.5, "pollution": -2.1, "polluted": -2.1, "smog": -1.8,
+	"harassment": -2.9, "abuse": -3.0, "assault": -2.8, "missing": -1.4, "setback": -1.8,
+	"hurdle": -1.2, "deadlock": -1.5, "stall": -1.3, "downfall": -2.4, "worsen": -2.1,
+	"disrupt": -1.6, "bleak": -2.1, "grim": -2.3, "dismal": -2.4, "disappointing": -2.2, "disappointment": -2.3,
+
+	// Phrases
+	"fell short": -1.6, "falls short": -1.6, "step down": -0.8, "called off": -1.3,
+	"pulled out": -1.0, "back on track": 1.8, "on track": 1.2, "out of danger": 1.8,
+	"death toll": -3.0, "loss of life": -3.0, "no deaths": 1.5, "no casualties": 1.5,
+	"free fall": -2.2, "salary hike": 1.5, "pay hike": 1.5, "flood hit": -0.8, "drought hit": -0.8,
+}
+
+// marketLexicon covers stock, currency and business reporting
+var marketLexicon = map[string]float64{
+	"rally": 2.0, "rallies": 2.0, "rallied": 2.0, "surge": 2.0, "soar": 2.3, "jump": 1.3,
+	"climb": 1.2, "rise": 1.0, "rises": 1.0, "rose": 1.0, "rising": 0.9, "advance": 1.1,
+	"bullish": 2.0, "rebound": 1.7, "upswing": 1.6, "outperform": 1.7, "upgrade": 1.6,
+	"beat": 1.0, "beats": 1.2, "dividend": 1.0, "buyback": 1.1, "windfall": 1.9,
+	"inflow": 1.0, "inflows": 1.0, "upside": 1.4, "lift": 1.2,
+	"slump": -2.2, "plunge": -2.4, "tumble": -2.2, "sink": -1.8, "sank": -1.8,
+	"slide": -1.4, "slid": -1.4, "slip": -1.1, "slipped": -1.1, "dip": -1.0, "dipped": -1.0,
+	"fall": -1.3, "fell": -1.3, "falls": -1.3, "drop": -1.3, "dropped": -1.3, "decline": -1.5,
+	"bearish": -2.0, "selloff": -2.2, "tanked": -2.2, "rout": -2.5, "downgrade": -1.7, "underperform": -1.7,
+	"volatile": -1.2, "volatility": -1.1, "default": -2.3, "bankruptcy": -2.9, "bankrupt": -2.9,
+	"insolvency": -2.5, "recession": -2.6, "slowdown": -1.9, "stagflation": -2.5,
+	"inflation": -1.2, "deficit": -1.4, "debt": -1.1, "writedown": -1.7, "outflow": -1.0,
+	"outflows": -1.0, "downside": -1.4, "headwinds": -1.4, "tailwinds": 1.4,
+
+	"sell off": -2.2, "all time high": 2.2, "record high": 2.1, "lifetime high": 2.1,
+	"record low": -2.1, "all time low": -2.2, "week low": -1.3, "week high": 1.3,
+	"beats estimates": 1.9, "beat estimates": 1.9, "misses estimates": -1.8,
+	"missed estimates": -1.8, "profit warning": -2.2, "in the red": -1.5, "in the green": 1.5,
+	"bull run": 2.1, "bear market": -2.1, "market crash": -2.8, "rate cut": 0.9,
+	"rate hike": -0.9, "net loss": -1.9, "net profit": 1.6, "record profit": 2.4,
+}
+
+// sportsLexicon covers match reports
+var sportsLexicon = map[string]float64{
+	"champion": 2.5, "champions": 2.5, "championship": 1.6, "trophy": 2.0, "medal": 1.9,
+	"medals": 1.9, "clinch": 2.1, "clinched": 2.1, "century": 1.5, "hattrick": 1.8,
+	"unbeaten": 1.9, "comeback": 1.9, "dominate": 1.6, "dominant": 1.6, "qualify": 1.4,
+	"qualified": 1.4, "podium": 1.7, "thriller": 1.2, "thrilling": 2.0,
+	"defeat": -1.4, "defeated": -1.2, "beaten": -1.4, "eliminated": -1.7, "knocked": -0.8,
+	"washout": -1.3, "abandoned": -1.5, "duck": -1.2, "injury scare": -1.8, "sidelined": -1.5, "suspended": -1.7,
+	"whitewash": -1.6, "humiliating": -2.6, "humiliation": -2.6, "sacked": -1.9,
+
+	"hat trick": 1.8, "world record": 2.3, "ruled out": -1.7, "knocked out": -1.8,
+	"crashed out": -2.0, "series win": 2.3, "gold medal": 2.3, "final berth": 1.8,
+	"bowled out": -1.2, "match fixing": -3.0, "spot fixing": -3.0,
+}
+
+// indianLexicon covers Indian-English usage and romanized Hindi (Hinglish)
+var indianLexicon = map[string]float64{
+	// Indian-English
+	"bandh": -1.8, "gherao": -1.5, "lathicharge": -2.5, "dharna": -1.0, "agitation": -1.4,
+	"hooch": -1.8, "encounter": -1.6, "scamster": -2.6,
+	"lathi charge": -2.5, "hooch tragedy": -3.5, "paper leak": -2.5, "custodial death": -3.4,
+
+	// Hinglish
+	"accha": 1.9, "achha": 1.9, "acha": 1.9, "badhiya": 2.3, "shandaar": 2.9, "shandar": 2.9,
+	"zabardast": 2.8, "jabardast": 2.8, "mast": 2.0, "khush": 2.2, "khushi": 2.4,
+	"jeet": 2.6, "jeeta": 2.5, "jeete": 2.5, "safalta": 2.6, "safal": 2.4, "vikas": 1.6,
+	"tarakki": 1.9, "jashn": 2.6, "badhai": 2.2, "shabash": 2.6, "dhamakedar": 2.2,
+	"behtareen": 2.9, "umeed": 1.8, "khushkhabri": 2.7, "sukh": 1.9, "shanti": 2.1,
+	"bura": -2.4, "buri": -2.4, "kharab": -2.2, "bekaar": -2.1, "bekar": -2.1,
+	"haar": -2.0, "haare": -1.8, "ghotala": -2.8, "ghotale": -2.8,
+	"bawaal": -2.0, "hungama": -1.6, "dukh": -2.4, "dukhad": -2.8, "mehngai": -1.9,
+	"mehangai": -1.9, "maut": -3.0, "hadsa": -2.6, "haadsa": -2.6, "hatya": -3.4,
+	"dhokha": -2.6, "sharmnaak": -2.7, "sharmnak": -2.7, "nuksaan": -1.9, "nuksan": -1.9,
+	"pareshan": -1.9, "pareshani": -1.9, "mehnga": -1.3, "mehngi": -1.3, "takleef": -1.9, "gussa": -2.3, "naraz": -1.9, "naraaz": -1.9,
+	"khatra": -2.3, "tabahi": -3.1, "barbaad": -2.9,
+	"bahut accha": 2.6, "bahut badhiya": 2.9,
+}
+
+// boosterWords intensify (positive) or dampen (negative) the next sentiment word
+var boosterWords = map[string]float64{
+	"very": boosterIncrement, "extremely": boosterIncrement, "highly": boosterIncrement,
+	"hugely": boosterIncrement, "massively": boosterIncrement, "sharply": boosterIncrement,
+	"steeply": boosterIncrement, "deeply": boosterIncrement, "incredibly": boosterIncrement,
+	"really": boosterIncrement, "so": boosterIncrement, "most": boosterIncrement,
+	"totally": boosterIncrement, "completely": boosterIncrement, "absolutely": boosterIncrement,
+	"huge": boosterIncrement, "massive": boosterIncrement, "major": boosterIncrement,
+	"record": boosterIncrement, "biggest": boosterIncrement, "severe": boosterIncrement,
+	"severely": boosterIncrement, "heavy": boosterIncrement, "strongly": boosterIncrement,
+	"big": boosterIncrement, "steep": boosterIncrement, "sharp": boosterIncrement,
+	"bahut": boosterIncrement, "bohot": boosterIncrement, "bahot": boosterIncrement,
+	"ekdum": boosterIncrement, "bilkul": boosterIncrement, "zyada": boosterIncrement,
+	"jyada": boosterIncrement, "kaafi": boosterIncrement, "kafi": boosterIncrement,
+	"slightly": -boosterIncrement, "marginally": -boosterIncrement, "somewhat": -boosterIncrement,
+	"barely": -boosterIncrement, "partly": -boosterIncrement, "partially": -boosterIncrement,
+	"mildly": -boosterIncrement, "modest": -boosterIncrement, "modestly": -boosterIncrement,
+	"slight": -boosterIncrement, "marginal": -boosterIncrement, "little": -boosterIncrement,
+	"thoda": -boosterIncrement, "thodi": -boosterIncrement, "halka": -boosterIncrement,
+}
+
+// negationWords flip a sentiment word up to three words later
+var negationWords = map[string]bool{
+	"not": true, "no": true, "never": true, "none": true, "nobody": true, "nothing": true,
+	"neither": true, "nor": true, "without": true, "cannot": true, "cant": true, "dont": true,
+	"doesnt": true, "didnt": true, "isnt": true, "wasnt": true, "arent": true, "werent": true,
+	"wont": true, "hasnt": true, "havent": true, "fails": true, "failed": true, "lack": true,
+	"lacks": true, "lacking": true, "hardly": true, "zero": true, "mat": true, "bina": true,
+}
+
+// postNegationWords are Hindi negations that follow what they negate ("accha nahi hai")
+var postNegationWords = map[string]bool{
+	"nahi": true, "nahin": true, "nhi": true, "nai": true,
+}
+
+// contrastWords shift weight onto the clause after them
+var contrastWords = map[string]bool{
+	"but": true, "however": true, "lekin": true, "magar": true,
+}
+
+// Market closes: "Sensex ends higher", "rupee settled lower"
+func init() {
+	for _, verb := range []string{"close", "closes", "closed", "end", "ends", "ended", "settle", "settles",
+		"settled", "open", "opens", "opened", "trade", "trades", "traded", "finish", "finishes", "finished"} {
+		marketLexicon[verb+" higher"] = 1.2
+		marketLexicon[verb+" lower"] = -1.2
+	}
+}
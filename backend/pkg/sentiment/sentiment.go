@@ -0,0 +1,287 @@
+// Package sentiment scores news text with a VADER-style lexicon: word and phrase valences
+// adjusted for preceding intensifiers, negation, contrastive "but" clauses, capitals and
+// exclamation marks, then squashed into a compound score between -1 and 1. The default
+// lexicon adds market, sports, Indian-English and romanized Hindi (Hinglish) terms to a
+// general news vocabulary.
+package sentiment
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// Compound scores within this of zero are neutral
+	NeutralThreshold = 0.05
+
+	normalizeAlpha   = 15.0  // Larger values need more evidence to approach ±1
+	negationScalar   = -0.74 // A negated word flips and weakens
+	boosterIncrement = 0.293 // Added (or removed) by an intensifier right before a word
+	capsIncrement    = 0.733 // ALL-CAPS emphasis in otherwise mixed-case text
+	exclaimIncrement = 0.292 // Per exclamation mark, up to maxExclaims
+	maxExclaims      = 4
+	negationWindow   = 3 // Words before a sentiment word checked for negation and boosters
+	postNegWindow    = 2 // Words after one checked for trailing Hindi negation ("accha nahi")
+	maxPhraseWords   = 3
+
+	// Contrastive clauses: "gains early but closes lower" is mostly about the close
+	beforeButScalar = 0.5
+	afterButScalar  = 1.5
+)
+
+// Label names for compound scores
+const (
+	Positive = "positive"
+	Negative = "negative"
+	Neutral  = "neutral"
+)
+
+// Term is one lexicon match and the valence it contributed after adjustments
+type Term struct {
+	Text    string  `json:"text"`
+	Base    float64 `json:"base"`
+	Valence float64 `json:"valence"`
+	Negated bool    `json:"negated,omitempty"`
+}
+
+// Result is the analysis of one text
+type Result struct {
+	Compound float64 `json:"compound"` // -1 (most negative) to 1 (most positive)
+	Label    string  `json:"label"`
+	Terms    []Term  `json:"terms,omitempty"`
+}
+
+// Analyzer scores text against a lexicon. It is safe for concurrent use.
+type Analyzer struct {
+	lexicon      map[string]float64 // Single words and space-joined phrases
+	boosters     map[string]float64
+	negations    map[string]bool
+	postNegation map[string]bool
+	contrasts    map[string]bool
+}
+
+// New returns an analyzer with the default general, market, sports and Hinglish lexicons
+func New() *Analyzer {
+	analyzer := &Analyzer{
+		lexicon:      make(map[string]float64),
+		boosters:     boosterWords,
+		negations:    negationWords,
+		postNegation: postNegationWords,
+		contrasts:    contrastWords,
+	}
+	for _, lexicon := range []map[string]float64{generalLexicon, marketLexicon, sportsLexicon, indianLexicon} {
+		for term, valence := range lexicon {
+			analyzer.lexicon[term] = valence
+		}
+	}
+	return analyzer
+}
+
+// Score returns the compound score of the text
+func (a *Analyzer) Score(text string) float64 {
+	return a.Analyze(text).Compound
+}
+
+// Analyze scores the text and lists the terms that contributed
+func (a *Analyzer) Analyze(text string) Result {
+	words := tokenize(text)
+	shouting := isMixedCase(text)
+
+	var terms []Term
+	var positions []int
+	for i := 0; i < len(words); {
+		base, width := a.lookup(words, i)
+		if width == 0 {
+			i++
+			continue
+		}
+
+		valence := base
+		phrase := joinWords(words[i : i+width])
+
+		if shouting && words[i].upper {
+			valence += math.Copysign(capsIncrement, base)
+		}
+
+		for distance := 1; distance <= negationWindow && i-distance >= 0; distance++ {
+			if scalar, ok := a.boosters[words[i-distance].text]; ok {
+				decay := 1 - 0.05*float64(distance-1)
+				valence += math.Copysign(scalar*decay, valence)
+			}
+		}
+
+		negated := a.isNegated(words, i, i+width)
+		if negated {
+			valence *= negationScalar
+		}
+
+		terms = append(terms, Term{Text: phrase, Base: base, Valence: valence, Negated: negated})
+		positions = append(positions, i)
+		i += width
+	}
+
+	if contrast := a.lastContrast(words); contrast >= 0 {
+		for j := range terms {
+			if positions[j] < contrast {
+				terms[j].Valence *= beforeButScalar
+			} else {
+				terms[j].Valence *= afterButScalar
+			}
+		}
+	}
+
+	sum := 0.0
+	for _, term := range terms {
+		sum += term.Valence
+	}
+	if sum != 0 {
+		exclaims := math.Min(float64(strings.Count(text, "!")), maxExclaims)
+		sum += math.Copysign(exclaims*exclaimIncrement, sum)
+	}
+
+	compound := normalize(sum)
+	return Result{Compound: compound, Label: Label(compound), Terms: terms}
+}
+
+// Label names a compound score
+func Label(compound float64) string {
+	switch {
+	case compound >= NeutralThreshold:
+		return Positive
+	case compound <= -NeutralThreshold:
+		return Negative
+	}
+	return Neutral
+}
+
+// FromGDELTTone maps a GDELT document tone, which in practice runs from about -10 to 10,
+// onto the compound scale
+func FromGDELTTone(tone float64) float64 {
+	return math.Max(-1, math.Min(1, tone/10))
+}
+
+// lookup finds the longest lexicon phrase starting at words[start], returning its valence
+// and how many words it spans (0 when there is none)
+func (a *Analyzer) lookup(words []word, start int) (float64, int) {
+	for width := maxPhraseWords; width > 1; width-- {
+		if start+width > len(words) {
+			continue
+		}
+		if valence, ok := a.lexicon[joinWords(words[start:start+width])]; ok {
+			return valence, width
+		}
+	}
+
+	text := words[start].text
+	if valence, ok := a.lexicon[text]; ok {
+		return valence, 1
+	}
+	for _, stem := range stems(text) {
+		if valence, ok := a.lexicon[stem]; ok {
+			return valence, 1
+		}
+	}
+	return 0, 0
+}
+
+// isNegated reports whether a negation precedes the term within the window, or a Hindi
+// negation follows it
+func (a *Analyzer) isNegated(words []word, start, end int) bool {
+	for distance := 1; distance <= negationWindow && start-distance >= 0; distance++ {
+		text := words[start-distance].text
+		if a.negations[text] || strings.HasSuffix(text, "n't") {
+			return true
+		}
+		if a.contrasts[text] {
+			break // Negation doesn't reach across "but"
+		}
+	}
+	for offset := 0; offset < postNegWindow && end+offset < len(words); offset++ {
+		if a.postNegation[words[end+offset].text] {
+			return true
+		}
+	}
+	return false
+}
+
+// lastContrast returns the position of the last contrastive conjunction, or -1
+func (a *Analyzer) lastContrast(words []word) int {
+	for i := len(words) - 1; i > 0; i-- {
+		if a.contrasts[words[i].text] {
+			return i
+		}
+	}
+	return -1
+}
+
+// normalize squashes a valence sum into (-1, 1)
+func normalize(sum float64) float64 {
+	return sum / math.Sqrt(sum*sum+normalizeAlpha)
+}
+
+// ===============================
+// TOKENIZING
+// ===============================
+
+type word struct {
+	text  string // Lowercased, possessive 's removed
+	upper bool   // Written in capitals, at least two letters long
+}
+
+// tokenize splits text into words, keeping apostrophes inside words so "didn't" stays one
+func tokenize(text string) []word {
+	text = strings.NewReplacer("’", "'", "‘", "'").Replace(text)
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+
+	words := make([]word, 0, len(fields))
+	for _, field := range fields {
+		field = strings.Trim(field, "'")
+		if field == "" {
+			continue
+		}
+		lower := strings.ToLower(field)
+		lower = strings.TrimSuffix(lower, "'s")
+		words = append(words, word{
+			text:  lower,
+			upper: len([]rune(field)) > 1 && strings.ToUpper(field) == field && strings.ToLower(field) != field,
+		})
+	}
+	return words
+}
+
+// isMixedCase reports whether the text has lowercase letters, so capitals stand out
+func isMixedCase(text string) bool {
+	for _, r := range text {
+		if unicode.IsLower(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// stems returns likely base forms of an inflected word, tried when it isn't in the lexicon
+func stems(text string) []string {
+	var candidates []string
+	switch {
+	case strings.HasSuffix(text, "ing") && len(text) > 5:
+		candidates = append(candidates, text[:len(text)-3], text[:len(text)-3]+"e")
+	case strings.HasSuffix(text, "ed") && len(text) > 4:
+		candidates = append(candidates, text[:len(text)-1], text[:len(text)-2])
+	case strings.HasSuffix(text, "es") && len(text) > 4:
+		candidates = append(candidates, text[:len(text)-1], text[:len(text)-2])
+	case strings.HasSuffix(text, "s") && !strings.HasSuffix(text, "ss") && len(text) > 3:
+		candidates = append(candidates, text[:len(text)-1])
+	}
+	return candidates
+}
+
+func joinWords(words []word) string {
+	texts := make([]string, len(words))
+	for i, w := range words {
+		texts[i] = w.text
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,78 @@
+package sentiment
+
+import "testing"
+
+// goldenHeadlines is the reference set the lexicon is tuned against; re-run it after changing
+// the lexicon or the scoring constants
+var goldenHeadlines = []struct {
+	text string
+	want string
+}{
+	// General
+	{"Government announces relief package for flood-hit farmers", Positive},
+	{"Five killed, several injured as bus falls into gorge", Negative},
+	{"Scientists hail breakthrough in malaria vaccine trial", Positive},
+	{"Parliament session to begin on Monday", Neutral},
+	{"Cabinet meeting scheduled for Thursday afternoon", Neutral},
+	{"Police arrest three in connection with bank fraud", Negative},
+	{"Rescue teams pull out survivors, no casualties reported", Positive},
+	{"Stampede at temple leaves dozens dead", Negative},
+
+	// Negation
+	{"Economy shows no growth in third quarter", Negative},
+	{"Talks were not successful, says minister", Negative},
+	{"Patient is out of danger, doctors say", Positive},
+	{"Officials say the situation is not dangerous", Positive},
+	{"Company didn't see the growth it expected this year", Negative},
+	{"Zero deaths reported after cyclone makes landfall", Positive},
+
+	// Intensifiers and contrast
+	{"Exports show very strong growth in September", Positive},
+	{"Sensex opens higher but ends lower as banks slump", Negative},
+	{"Monsoon deficit narrows slightly but concerns remain", Negative},
+	{"Initial setback, but mission ends in historic success", Positive},
+
+	// Markets
+	{"Sensex rallies 800 points as banks surge", Positive},
+	{"Nifty slumps to three-month low on global selloff", Negative},
+	{"Rupee hits all-time low against dollar", Negative},
+	{"Infosys beats estimates, shares jump 5%", Positive},
+	{"Markets end in the red as IT stocks tumble", Negative},
+	{"Gold prices steady ahead of Fed meeting", Neutral},
+	{"Sensex fails to hold early gains", Negative},
+	{"Company posts record profit, announces dividend", Positive},
+	{"Startup announces layoffs amid funding slowdown", Negative},
+	{"RBI rate cut lifts bank stocks to record high", Positive},
+
+	// Sports
+	{"India clinch series win with thrilling comeback", Positive},
+	{"Kohli smashes century as India beat Australia", Positive},
+	{"Star pacer ruled out of World Cup with injury", Negative},
+	{"Team crashed out of tournament after humiliating defeat", Negative},
+	{"Neeraj Chopra wins gold medal, sets world record", Positive},
+	{"Match abandoned as rain forces washout", Negative},
+	{"Squad for the Asia Cup announced", Neutral},
+
+	// Indian-English and Hinglish
+	{"Bharat bandh disrupts normal life in several states", Negative},
+	{"Hooch tragedy claims 20 lives", Negative},
+	{"Shandaar jeet for Team India, fans celebrate", Positive},
+	{"Film ka trailer bahut badhiya hai", Positive},
+	{"Yeh budget accha nahi hai, says opposition", Negative},
+	{"Mehngai se janta pareshan, petrol phir mehnga", Negative},
+	{"Bada ghotala saamne aaya, vipaksh ka hungama", Negative},
+	{"Zabardast performance, ekdum mast match", Positive},
+	{"Sadak hadsa mein teen logon ki maut", Negative},
+	{"Kal ka match kaisa raha?", Neutral},
+}
+
+func TestAnalyzeGoldenHeadlines(t *testing.T) {
+	analyzer := New()
+	for _, golden := range goldenHeadlines {
+		result := analyzer.Analyze(golden.text)
+		if result.Label != golden.want {
+			t.Errorf("Analyze(%q) = %s (%.3f, terms %+v), want %s",
+				golden.text, result.Label, result.Compound, result.Terms, golden.want)
+		}
+	}
+}
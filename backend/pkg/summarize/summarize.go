@@ -0,0 +1,370 @@
+// Package summarize builds short extractive summaries of news articles. Sentences are ranked
+// with TextRank (PageRank over a graph of sentences linked by shared words), nudged towards the
+// lead as news is written top-down, and the best two or three are returned in article order.
+// Summaries that are likely useless, such as a repeat of the headline, are flagged so clients
+// can fall back to the description.
+package summarize
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/pkg/keywords"
+)
+
+const (
+	// Sentences in a summary: two for short texts, three once there are enough to choose from
+	shortSentences     = 2
+	longSentences      = 3
+	longTextSentences  = 8
+	maxSummaryRunes    = 480
+	minSentenceWords   = 5
+	maxSentenceWords   = 60
+	minSummaryWords    = 12
+	damping            = 0.85
+	maxIterations      = 50
+	convergence        = 1e-4
+	leadBias           = 1.0 // Extra weight for the first sentence, shrinking with position
+	redundantOverlap   = 0.6 // Sentences sharing this share of words with a picked one are skipped
+	titleRepeatOverlap = 0.8 // Summaries whose words are mostly the headline's are flagged
+)
+
+// Reasons a summary is flagged as low quality
+const (
+	ReasonNoText          = "no_text"
+	ReasonTooFewSentences = "too_few_sentences"
+	ReasonTooShort        = "too_short"
+	ReasonRepeatsTitle    = "repeats_title"
+	ReasonTruncated       = "truncated"
+)
+
+// Summary is an extractive summary of an article
+type Summary struct {
+	Text       string `json:"text"`
+	Sentences  int    `json:"sentences"`
+	LowQuality bool   `json:"low_quality"`
+	Reason     string `json:"reason,omitempty"` // Why the summary is low quality
+}
+
+// sentence is a candidate summary sentence
+type sentence struct {
+	text      string
+	position  int
+	words     map[string]bool
+	truncated bool
+	score     float64
+}
+
+var (
+	// truncationMarker matches provider cut-offs: "…", "..." or "[+1234 chars]" at the end
+	truncationMarker = regexp.MustCompile(`(…|\.\.\.|\[\+\d+ chars\])\s*$`)
+
+	// boilerplate matches sentences that are site furniture rather than reporting
+	boilerplate = regexp.MustCompile(`(?i)^(also read|read more|read also|click here|subscribe|follow us|download the|watch:|listen:|photo:|image:|\(this story has not been edited|disclaimer)`)
+)
+
+// Summarize returns the best sentences of an article's text in their original order. The
+// headline is only used to avoid repeating it.
+func Summarize(title, text string) Summary {
+	candidates := sentences(text)
+	if len(candidates) == 0 {
+		return Summary{LowQuality: true, Reason: ReasonNoText}
+	}
+
+	// Sentences that only restate the headline add nothing to it
+	titleWords := wordSet(title)
+	usable := candidates[:0:0]
+	for _, s := range candidates {
+		if len(titleWords) == 0 || overlap(s.words, titleWords) < titleRepeatOverlap {
+			usable = append(usable, s)
+		}
+	}
+	if len(usable) == 0 {
+		usable = candidates
+	}
+
+	rank(usable)
+
+	want := shortSentences
+	if len(candidates) >= longTextSentences {
+		want = longSentences
+	}
+	picked := pick(usable, want)
+
+	summary := Summary{Sentences: len(picked)}
+	parts := make([]string, len(picked))
+	for i, s := range picked {
+		parts[i] = s.text
+	}
+	summary.Text = strings.Join(parts, " ")
+	summary.Reason = assess(summary.Text, picked, len(candidates), titleWords)
+	summary.LowQuality = summary.Reason != ""
+	return summary
+}
+
+// assess returns why a summary is low quality, or "" when it is fine
+func assess(text string, picked []*sentence, available int, titleWords map[string]bool) string {
+	switch {
+	case len(picked) == 0:
+		return ReasonNoText
+	case picked[len(picked)-1].truncated:
+		return ReasonTruncated
+	case available < 2:
+		return ReasonTooFewSentences
+	case countWords(text) < minSummaryWords:
+		return ReasonTooShort
+	case len(titleWords) > 0 && overlap(wordSet(text), titleWords) >= titleRepeatOverlap:
+		return ReasonRepeatsTitle
+	}
+	return ""
+}
+
+// ===============================
+// TEXTRANK
+// ===============================
+
+// rank scores sentences by TextRank with a lead bias
+func rank(candidates []*sentence) {
+	n := len(candidates)
+	weights := make([][]float64, n)
+	totals := make([]float64, n)
+	for i := range candidates {
+		weights[i] = make([]float64, n)
+		for j := range candidates {
+			if i != j {
+				weights[i][j] = similarity(candidates[i].words, candidates[j].words)
+				totals[i] += weights[i][j]
+			}
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1
+	}
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		next := make([]float64, n)
+		change := 0.0
+		for i := range candidates {
+			sum := 0.0
+			for j := range candidates {
+				if weights[j][i] > 0 && totals[j] > 0 {
+					sum += weights[j][i] / totals[j] * scores[j]
+				}
+			}
+			next[i] = (1 - damping) + damping*sum
+			change += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		if change < convergence {
+			break
+		}
+	}
+
+	for i, s := range candidates {
+		s.score = scores[i] * (1 + leadBias/float64(1+s.position))
+		if s.truncated {
+			s.score /= 2
+		}
+	}
+}
+
+// similarity is TextRank's overlap of two sentences normalized by their lengths
+func similarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	if shared == 0 {
+		return 0
+	}
+	return float64(shared) / (math.Log(float64(1+len(a))) + math.Log(float64(1+len(b))))
+}
+
+// pick takes the best-scoring sentences that fit and don't repeat each other, in text order
+func pick(candidates []*sentence, want int) []*sentence {
+	ranked := append([]*sentence(nil), candidates...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	var picked []*sentence
+	length := 0
+	for _, s := range ranked {
+		if len(picked) >= want {
+			break
+		}
+		size := utf8.RuneCountInString(s.text)
+		if len(picked) > 0 && length+1+size > maxSummaryRunes {
+			continue
+		}
+		redundant := false
+		for _, other := range picked {
+			if overlap(s.words, other.words) >= redundantOverlap {
+				redundant = true
+				break
+			}
+		}
+		if redundant {
+			continue
+		}
+		picked = append(picked, s)
+		length += size + 1
+	}
+
+	sort.Slice(picked, func(i, j int) bool {
+		return picked[i].position < picked[j].position
+	})
+	return picked
+}
+
+// overlap is the share of a's words that are also in b
+func overlap(a, b map[string]bool) float64 {
+	if len(a) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a))
+}
+
+// ===============================
+// SENTENCES
+// ===============================
+
+// abbreviations end in a period without ending a sentence
+var abbreviations = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "dr": true, "prof": true, "st": true, "jr": true,
+	"sr": true, "rs": true, "no": true, "nos": true, "vs": true, "gen": true, "lt": true,
+	"col": true, "capt": true, "maj": true, "sgt": true, "govt": true, "dept": true,
+	"inc": true, "ltd": true, "co": true, "corp": true, "pvt": true, "approx": true,
+	"jan": true, "feb": true, "mar": true, "apr": true, "aug": true, "sept": true, "sep": true,
+	"oct": true, "nov": true, "dec": true, "hon": true, "sh": true, "smt": true, "etc": true,
+	"u.s": true, "u.k": true, "i.e": true, "e.g": true,
+}
+
+// sentences splits text into candidate sentences, dropping boilerplate and fragments
+func sentences(text string) []*sentence {
+	var result []*sentence
+	seen := make(map[string]bool)
+	for _, raw := range splitSentences(text) {
+		raw = strings.TrimSpace(raw)
+		words := countWords(raw)
+		if words < minSentenceWords || words > maxSentenceWords || boilerplate.MatchString(raw) {
+			continue
+		}
+		key := strings.ToLower(raw)
+		if seen[key] {
+			continue // Descriptions are often repeated at the start of the content
+		}
+		seen[key] = true
+
+		result = append(result, &sentence{
+			text:      raw,
+			position:  len(result),
+			words:     wordSet(raw),
+			truncated: truncationMarker.MatchString(raw),
+		})
+	}
+	return result
+}
+
+// splitSentences cuts text after sentence-ending punctuation followed by a space, and at line
+// breaks. Periods after abbreviations, initials ("A. P. J.") and inside numbers don't end one.
+func splitSentences(text string) []string {
+	runes := []rune(text)
+	var parts []string
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\n' {
+			parts = append(parts, string(runes[start:i]))
+			start = i + 1
+			continue
+		}
+		if !isTerminal(r) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isClosing(runes[end]) {
+			end++
+		}
+		if end < len(runes) && !unicode.IsSpace(runes[end]) {
+			continue
+		}
+		if r == '.' && !endsSentence(runes[start:i]) {
+			continue
+		}
+		parts = append(parts, string(runes[start:end]))
+		start = end
+		i = end - 1
+	}
+	if start < len(runes) {
+		parts = append(parts, string(runes[start:]))
+	}
+	return parts
+}
+
+// endsSentence reports whether a period after this text ends a sentence
+func endsSentence(before []rune) bool {
+	fields := strings.Fields(string(before))
+	if len(fields) == 0 {
+		return false
+	}
+	last := strings.TrimLeft(fields[len(fields)-1], `"'“‘(`)
+	if utf8.RuneCountInString(last) == 1 && unicode.IsUpper([]rune(last)[0]) {
+		return false // An initial
+	}
+	return !abbreviations[strings.ToLower(last)]
+}
+
+func isTerminal(r rune) bool {
+	switch r {
+	case '.', '!', '?', '।', '॥':
+		return true
+	}
+	return false
+}
+
+func isClosing(r rune) bool {
+	switch r {
+	case '"', '\'', '”', '’', ')':
+		return true
+	}
+	return false
+}
+
+// wordSet is the content words of a text
+func wordSet(text string) map[string]bool {
+	words := keywords.ContentWords(text)
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// countWords counts whitespace-separated words containing a letter or digit
+func countWords(text string) int {
+	count := 0
+	for _, field := range strings.Fields(text) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			count++
+		}
+	}
+	return count
+}
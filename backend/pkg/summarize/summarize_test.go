@@ -0,0 +1,102 @@
+package summarize
+
+import (
+	"strings"
+	"testing"
+)
+
+const rbiArticle = `The Reserve Bank of India kept its key repo rate unchanged at 6.5 percent on Friday, as expected by most economists. Governor Shaktikanta Das said inflation was easing but food prices remained a risk to the outlook. The monetary policy committee voted five to one to hold the rate steady. Also read: Sensex closes at record high. Markets rose after the decision, with bank shares leading the gains on the Sensex. Economists said the central bank could begin cutting the repo rate later this year if inflation keeps falling. The rupee was little changed against the dollar after the announcement. Das said the economy was expected to grow about seven percent this fiscal year. The next policy meeting is scheduled for early August in Mumbai.`
+
+const rainArticle = `Heavy rain lashed Mumbai on Tuesday, flooding low-lying areas and disrupting local train services. The weather office issued an orange alert for the city and its suburbs for the next two days. Schools in several districts were told to stay closed as a precaution. Civic officials said pumps were working to clear water from the worst-hit areas.`
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name      string
+		title     string
+		text      string
+		sentences int
+	}{
+		{"long article", "RBI keeps repo rate unchanged at 6.5 percent", rbiArticle, 3},
+		{"short article", "Heavy rain lashes Mumbai", rainArticle, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := Summarize(tt.title, tt.text)
+			if summary.LowQuality {
+				t.Fatalf("summary flagged %s: %q", summary.Reason, summary.Text)
+			}
+			if summary.Sentences != tt.sentences {
+				t.Errorf("got %d sentences, want %d: %q", summary.Sentences, tt.sentences, summary.Text)
+			}
+
+			// Picked sentences come verbatim from the text, in article order
+			last := -1
+			for _, part := range splitSentences(summary.Text) {
+				part = strings.TrimSpace(part)
+				at := strings.Index(tt.text, part)
+				if at < 0 {
+					t.Errorf("sentence %q is not in the text", part)
+					continue
+				}
+				if at <= last {
+					t.Errorf("sentence %q is out of article order", part)
+				}
+				last = at
+			}
+			if strings.Contains(summary.Text, "Also read") {
+				t.Errorf("summary keeps boilerplate: %q", summary.Text)
+			}
+		})
+	}
+}
+
+func TestSummarizeFlagsLowQuality(t *testing.T) {
+	const title = "Sensex rises 500 points as bank shares rally on strong quarterly results"
+
+	tests := []struct {
+		name   string
+		title  string
+		text   string
+		reason string
+	}{
+		{"empty description", title, "", ReasonNoText},
+		{"boilerplate only", title, "Also read: the latest market news and analysis here.", ReasonNoText},
+		{"headline as description", title, title, ReasonTooFewSentences},
+		{"headline reworded", title, title + ". Bank shares rally as the Sensex rises on strong results.", ReasonRepeatsTitle},
+		{"short sentences", "Rain in Mumbai", "Rain hit the city today. Trains ran late again this morning.", ReasonTooShort},
+		{"truncated", "Heavy rain lashes Mumbai", "Heavy rain lashed Mumbai on Tuesday, flooding low-lying areas. The weather office issued an orange alert for the city and…", ReasonTruncated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := Summarize(tt.title, tt.text)
+			if !summary.LowQuality || summary.Reason != tt.reason {
+				t.Errorf("low quality = %v (%s), want %s: %q", summary.LowQuality, summary.Reason, tt.reason, summary.Text)
+			}
+		})
+	}
+}
+
+func TestSummarizeShortText(t *testing.T) {
+	texts := []string{
+		"",
+		" ",
+		".",
+		"...",
+		"\n\n\n",
+		"Hi.",
+		"A. P. J.",
+		"Rs. 500",
+		"“Yes.”",
+		strings.Repeat(".", 1000),
+		strings.Repeat("word ", 200),
+		"भारी बारिश से मुंबई में जलभराव।",
+	}
+	for _, text := range texts {
+		summary := Summarize("Headline", text)
+		if !summary.LowQuality {
+			t.Errorf("Summarize(%q) = %+v, want it flagged", text, summary)
+		}
+	}
+}
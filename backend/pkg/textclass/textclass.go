@@ -0,0 +1,451 @@
+// Package textclass is a multinomial Naive Bayes text classifier. It trains on multi-label
+// examples, returns a confidence for every label, and saves models as JSON files so a
+// model trained offline can be loaded by the server.
+package textclass
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// FormatVersion is bumped when the model file layout changes
+const FormatVersion = 1
+
+var (
+	ErrNoExamples    = errors.New("no labeled examples to train on")
+	ErrModelFormat   = errors.New("unsupported model file format")
+	ErrTooFewClasses = errors.New("at least two labels are needed to train a classifier")
+)
+
+// stopWords are dropped before building features; they occur in every category alike
+var stopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "and": true, "or": true, "but": true, "of": true,
+	"to": true, "in": true, "on": true, "at": true, "for": true, "with": true, "by": true,
+	"from": true, "as": true, "is": true, "are": true, "was": true, "were": true, "be": true,
+	"been": true, "has": true, "have": true, "had": true, "it": true, "its": true, "this": true,
+	"that": true, "these": true, "those": true, "will": true, "would": true, "can": true,
+	"could": true, "after": true, "over": true, "into": true, "about": true, "says": true,
+	"said": true, "he": true, "she": true, "they": true, "his": true, "her": true, "their": true,
+	"not": true, "no": true, "new": true, "also": true, "more": true, "than": true, "up": true,
+}
+
+// Example is one training document and the labels it belongs to
+type Example struct {
+	Text   string
+	Labels []string
+}
+
+// Prediction is one label's posterior probability for a document
+type Prediction struct {
+	Label      string  `json:"label"`
+	Confidence float64 `json:"confidence"`
+}
+
+// Options control training
+type Options struct {
+	Alpha       float64 // Additive (Laplace) smoothing; 1 when zero
+	MinDocFreq  int     // Features seen in fewer documents are dropped; 2 when zero
+	MaxFeatures int     // Most frequent features kept; unlimited when zero
+}
+
+// LabelMetrics are one label's scores on held-out examples
+type LabelMetrics struct {
+	Support   int     `json:"support"`
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+}
+
+// Evaluation measures a model on examples it was not trained on. Accuracy counts the top
+// prediction as correct when it is any of the example's labels.
+type Evaluation struct {
+	Examples int                     `json:"examples"`
+	Accuracy float64                 `json:"accuracy"`
+	MacroF1  float64                 `json:"macro_f1"`
+	Labels   map[string]LabelMetrics `json:"labels"`
+}
+
+// Model is a trained classifier
+type Model struct {
+	Format    int       `json:"format"`
+	Version   string    `json:"version"`
+	TrainedAt time.Time `json:"trained_at"`
+	Alpha     float64   `json:"alpha"`
+	Documents int       `json:"documents"`
+
+	Labels         []string             `json:"labels"`
+	LogPriors      map[string]float64   `json:"log_priors"`
+	Features       map[string]int       `json:"features"`        // Feature -> column in LogLikelihoods
+	LogLikelihoods map[string][]float64 `json:"log_likelihoods"` // Label -> log P(feature | label)
+
+	Evaluation *Evaluation `json:"evaluation,omitempty"`
+}
+
+// ===============================
+// FEATURES
+// ===============================
+
+// Tokens lowercases text and returns its words, without stop words, one-letter words or bare numbers
+func Tokens(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := words[:0]
+	for _, word := range words {
+		if len([]rune(word)) < 2 || stopWords[word] || isNumber(word) {
+			continue
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// Features returns a document's words and adjacent word pairs with their counts. Pairs let
+// the model tell "us congress" from "congress leader" where single words cannot.
+func Features(text string) map[string]int {
+	tokens := Tokens(text)
+	features := make(map[string]int, len(tokens)*2)
+	for i, token := range tokens {
+		features[token]++
+		if i > 0 {
+			features[tokens[i-1]+" "+token]++
+		}
+	}
+	return features
+}
+
+func isNumber(word string) bool {
+	for _, r := range word {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ===============================
+// TRAINING
+// ===============================
+
+// Train fits a model. A multi-label example counts towards each of its labels.
+func Train(examples []Example, opts Options) (*Model, error) {
+	if opts.Alpha <= 0 {
+		opts.Alpha = 1
+	}
+	if opts.MinDocFreq <= 0 {
+		opts.MinDocFreq = 2
+	}
+
+	documents := make([]map[string]int, 0, len(examples))
+	labeled := make([]Example, 0, len(examples))
+	docFreq := make(map[string]int)
+	labelDocs := make(map[string]int)
+
+	for _, example := range examples {
+		labels := uniqueLabels(example.Labels)
+		if len(labels) == 0 {
+			continue
+		}
+		features := Features(example.Text)
+		if len(features) == 0 {
+			continue
+		}
+		for feature := range features {
+			docFreq[feature]++
+		}
+		for _, label := range labels {
+			labelDocs[label]++
+		}
+		documents = append(documents, features)
+		labeled = append(labeled, Example{Text: example.Text, Labels: labels})
+	}
+
+	if len(documents) == 0 {
+		return nil, ErrNoExamples
+	}
+	if len(labelDocs) < 2 {
+		return nil, ErrTooFewClasses
+	}
+
+	vocabulary := selectFeatures(docFreq, opts.MinDocFreq, opts.MaxFeatures)
+	if len(vocabulary) == 0 {
+		return nil, fmt.Errorf("no feature occurs in %d or more documents: %w", opts.MinDocFreq, ErrNoExamples)
+	}
+
+	model := &Model{
+		Format:         FormatVersion,
+		TrainedAt:      time.Now().UTC(),
+		Alpha:          opts.Alpha,
+		Documents:      len(documents),
+		LogPriors:      make(map[string]float64, len(labelDocs)),
+		Features:       make(map[string]int, len(vocabulary)),
+		LogLikelihoods: make(map[string][]float64, len(labelDocs)),
+	}
+	model.Version = model.TrainedAt.Format("20060102T150405Z")
+	for i, feature := range vocabulary {
+		model.Features[feature] = i
+	}
+
+	counts := make(map[string][]float64, len(labelDocs))
+	totals := make(map[string]float64, len(labelDocs))
+	for label := range labelDocs {
+		model.Labels = append(model.Labels, label)
+		counts[label] = make([]float64, len(vocabulary))
+	}
+	sort.Strings(model.Labels)
+
+	for i, features := range documents {
+		for _, label := range labeled[i].Labels {
+			for feature, count := range features {
+				if column, ok := model.Features[feature]; ok {
+					counts[label][column] += float64(count)
+					totals[label] += float64(count)
+				}
+			}
+		}
+	}
+
+	totalLabelDocs := 0
+	for _, docs := range labelDocs {
+		totalLabelDocs += docs
+	}
+	vocabSize := float64(len(vocabulary))
+	for _, label := range model.Labels {
+		model.LogPriors[label] = math.Log(float64(labelDocs[label]) / float64(totalLabelDocs))
+		denominator := totals[label] + opts.Alpha*vocabSize
+		likelihoods := make([]float64, len(vocabulary))
+		for column, count := range counts[label] {
+			likelihoods[column] = math.Log((count + opts.Alpha) / denominator)
+		}
+		model.LogLikelihoods[label] = likelihoods
+	}
+
+	return model, nil
+}
+
+// selectFeatures keeps features seen in enough documents, most frequent first when capped
+func selectFeatures(docFreq map[string]int, minDocFreq, maxFeatures int) []string {
+	features := make([]string, 0, len(docFreq))
+	for feature, freq := range docFreq {
+		if freq >= minDocFreq {
+			features = append(features, feature)
+		}
+	}
+	sort.Slice(features, func(i, j int) bool {
+		if docFreq[features[i]] != docFreq[features[j]] {
+			return docFreq[features[i]] > docFreq[features[j]]
+		}
+		return features[i] < features[j]
+	})
+	if maxFeatures > 0 && len(features) > maxFeatures {
+		features = features[:maxFeatures]
+	}
+	return features
+}
+
+// uniqueLabels trims labels and drops blanks and repeats
+func uniqueLabels(labels []string) []string {
+	seen := make(map[string]bool, len(labels))
+	unique := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		unique = append(unique, label)
+	}
+	return unique
+}
+
+// ===============================
+// PREDICTION
+// ===============================
+
+// Predict returns every label's confidence, highest first. Naive Bayes multiplies one
+// likelihood per feature, which makes raw posteriors near 0 or 1 for any long text; the
+// log-likelihood sum is divided by the square root of the feature count before the softmax
+// so confidences stay comparable across short headlines and long articles. A text with no
+// known features gets the label priors. Features are summed in column order, so a text
+// always gets exactly the same confidences from the same model.
+func (m *Model) Predict(text string) []Prediction {
+	type column struct{ index, count int }
+	var columns []column
+	matched := 0
+	for feature, count := range Features(text) {
+		if index, ok := m.Features[feature]; ok {
+			columns = append(columns, column{index, count})
+			matched += count
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].index < columns[j].index })
+
+	scale := 1.0
+	if matched > 1 {
+		scale = 1 / math.Sqrt(float64(matched))
+	}
+	scores := make([]float64, len(m.Labels))
+	for i, label := range m.Labels {
+		likelihoods := m.LogLikelihoods[label]
+		sum := 0.0
+		for _, c := range columns {
+			sum += float64(c.count) * likelihoods[c.index]
+		}
+		scores[i] = m.LogPriors[label] + sum*scale
+	}
+
+	maxScore := math.Inf(-1)
+	for _, score := range scores {
+		maxScore = math.Max(maxScore, score)
+	}
+	total := 0.0
+	for i, score := range scores {
+		scores[i] = math.Exp(score - maxScore)
+		total += scores[i]
+	}
+
+	predictions := make([]Prediction, len(m.Labels))
+	for i, label := range m.Labels {
+		predictions[i] = Prediction{Label: label, Confidence: scores[i] / total}
+	}
+	sort.Slice(predictions, func(i, j int) bool {
+		if predictions[i].Confidence != predictions[j].Confidence {
+			return predictions[i].Confidence > predictions[j].Confidence
+		}
+		return predictions[i].Label < predictions[j].Label
+	})
+	return predictions
+}
+
+// ===============================
+// EVALUATION
+// ===============================
+
+// Split divides examples into training and held-out sets. The split hashes each example's
+// text, so the same data always splits the same way.
+func Split(examples []Example, holdout float64) (train, test []Example) {
+	cut := uint64(holdout * 1000)
+	for _, example := range examples {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(example.Text))
+		if hasher.Sum64()%1000 < cut {
+			test = append(test, example)
+		} else {
+			train = append(train, example)
+		}
+	}
+	return train, test
+}
+
+// Evaluate scores the model's top prediction against labeled examples
+func (m *Model) Evaluate(examples []Example) *Evaluation {
+	truePositives := make(map[string]int)
+	predicted := make(map[string]int)
+	support := make(map[string]int)
+
+	evaluation := &Evaluation{Labels: make(map[string]LabelMetrics)}
+	correct := 0
+	for _, example := range examples {
+		labels := uniqueLabels(example.Labels)
+		if len(labels) == 0 {
+			continue
+		}
+		evaluation.Examples++
+
+		top := m.Predict(example.Text)[0].Label
+		predicted[top]++
+		for _, label := range labels {
+			support[label]++
+			if label == top {
+				truePositives[label]++
+				correct++
+			}
+		}
+	}
+	if evaluation.Examples == 0 {
+		return evaluation
+	}
+	evaluation.Accuracy = float64(correct) / float64(evaluation.Examples)
+
+	f1Total := 0.0
+	for _, label := range m.Labels {
+		metrics := LabelMetrics{Support: support[label]}
+		if predicted[label] > 0 {
+			metrics.Precision = float64(truePositives[label]) / float64(predicted[label])
+		}
+		if support[label] > 0 {
+			metrics.Recall = float64(truePositives[label]) / float64(support[label])
+		}
+		if metrics.Precision+metrics.Recall > 0 {
+			metrics.F1 = 2 * metrics.Precision * metrics.Recall / (metrics.Precision + metrics.Recall)
+		}
+		evaluation.Labels[label] = metrics
+		f1Total += metrics.F1
+	}
+	evaluation.MacroF1 = f1Total / float64(len(m.Labels))
+	return evaluation
+}
+
+// ===============================
+// PERSISTENCE
+// ===============================
+
+// Save writes the model as JSON. It writes a temporary file and renames it, so a server
+// reading the path never sees a half-written model.
+func (m *Model) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create model directory: %w", err)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(path), ".model-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to create model file: %w", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if err := json.NewEncoder(temp).Encode(m); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to write model: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to write model: %w", err)
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace model file: %w", err)
+	}
+	return nil
+}
+
+// Load reads a model written by Save
+func Load(path string) (*Model, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	model := &Model{}
+	if err := json.NewDecoder(file).Decode(model); err != nil {
+		return nil, fmt.Errorf("failed to decode model: %w", err)
+	}
+	if model.Format != FormatVersion {
+		return nil, fmt.Errorf("%w: format %d", ErrModelFormat, model.Format)
+	}
+	for _, label := range model.Labels {
+		if len(model.LogLikelihoods[label]) != len(model.Features) {
+			return nil, fmt.Errorf("%w: label %q has %d likelihoods for %d features",
+				ErrModelFormat, label, len(model.LogLikelihoods[label]), len(model.Features))
+		}
+	}
+	return model, nil
+}
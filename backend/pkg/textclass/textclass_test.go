@@ -0,0 +1,193 @@
+package textclass
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// minConfidence and secondaryConfidence match the CLASSIFIER_MIN_CONFIDENCE and
+// CLASSIFIER_SECONDARY_CONFIDENCE defaults
+const (
+	minConfidence       = 0.55
+	secondaryConfidence = 0.25
+)
+
+func TestPredict(t *testing.T) {
+	model := trainCorpus(t)
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		// Keyword rules called these politics and business
+		{"US Congress approves defence bill after Senate vote", "international"},
+		{"Smartphone market: Samsung unveils phone with AI features", "technology"},
+		{"Transfer market: Arsenal sign midfielder for record fee", "sports"},
+		// The same words where the rules were right
+		{"Congress leader slams BJP government over price rise", "politics"},
+		{"Stock market: Sensex closes higher as bank shares rally", "business"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			predictions := model.Predict(tt.text)
+			if len(predictions) != len(model.Labels) {
+				t.Fatalf("got %d predictions, want one per label (%d)", len(predictions), len(model.Labels))
+			}
+
+			top := predictions[0]
+			if top.Label != tt.want || top.Confidence < minConfidence {
+				t.Errorf("top prediction = %s %.2f, want %s at %.2f or more", top.Label, top.Confidence, tt.want, minConfidence)
+			}
+
+			total := 0.0
+			for i, prediction := range predictions {
+				total += prediction.Confidence
+				if i > 0 && predictions[i-1].Confidence < prediction.Confidence {
+					t.Errorf("predictions are not ordered most confident first: %+v", predictions)
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("confidences sum to %f, want 1", total)
+			}
+		})
+	}
+}
+
+func TestPredictSecondaryLabel(t *testing.T) {
+	model := trainCorpus(t)
+
+	predictions := model.Predict("US Congress tariff bill hits Indian stock market exporters")
+	if predictions[0].Label != "international" || predictions[1].Label != "business" {
+		t.Fatalf("top labels = %s, %s, want international, business", predictions[0].Label, predictions[1].Label)
+	}
+	if predictions[1].Confidence < secondaryConfidence {
+		t.Errorf("business confidence = %.2f, want a secondary label at %.2f or more", predictions[1].Confidence, secondaryConfidence)
+	}
+	if predictions[2].Confidence >= secondaryConfidence {
+		t.Errorf("%s confidence = %.2f, want only two labels at %.2f or more", predictions[2].Label, predictions[2].Confidence, secondaryConfidence)
+	}
+}
+
+func TestPredictBelowThreshold(t *testing.T) {
+	model := trainCorpus(t)
+
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"unknown words", "Weather today"},
+		{"empty", ""},
+		{"mixed topics", "Congress market"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if top := model.Predict(tt.text)[0]; top.Confidence >= minConfidence {
+				t.Errorf("top prediction = %s %.2f, want below %.2f so it is reviewed", top.Label, top.Confidence, minConfidence)
+			}
+		})
+	}
+
+	// Without known features the model falls back to the label priors
+	for _, prediction := range model.Predict("Weather today") {
+		if prior := math.Exp(model.LogPriors[prediction.Label]); math.Abs(prediction.Confidence-prior) > 1e-9 {
+			t.Errorf("%s confidence = %f, want its prior %f", prediction.Label, prediction.Confidence, prior)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	model := trainCorpus(t)
+	path := filepath.Join(t.TempDir(), "models", "category_nb.json")
+	if err := model.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Version != model.Version || !reflect.DeepEqual(loaded.Labels, model.Labels) {
+		t.Errorf("loaded model %s %v, want %s %v", loaded.Version, loaded.Labels, model.Version, model.Labels)
+	}
+	for _, example := range loadCorpus(t) {
+		if got, want := loaded.Predict(example.Text), model.Predict(example.Text); !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: loaded model predicts %+v, want %+v", example.Text, got, want)
+		}
+	}
+
+	// Only the model file is left behind
+	if files, _ := os.ReadDir(filepath.Dir(path)); len(files) != 1 {
+		t.Errorf("model directory holds %d files, want 1", len(files))
+	}
+}
+
+func TestLoadRejectsOtherFormats(t *testing.T) {
+	model := trainCorpus(t)
+	dir := t.TempDir()
+
+	future := *model
+	future.Format = FormatVersion + 1
+	truncated := *model
+	truncated.LogLikelihoods = map[string][]float64{model.Labels[0]: {-1}}
+
+	for name, broken := range map[string]*Model{"format": &future, "likelihoods": &truncated} {
+		path := filepath.Join(dir, name+".json")
+		if err := broken.Save(path); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); !errors.Is(err, ErrModelFormat) {
+			t.Errorf("%s: got error %v, want ErrModelFormat", name, err)
+		}
+	}
+}
+
+func TestTrainErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		examples []Example
+		want     error
+	}{
+		{"no examples", nil, ErrNoExamples},
+		{"no labels", []Example{{Text: "Sensex rallies"}, {Text: "Sensex falls"}}, ErrNoExamples},
+		{"one label", []Example{{Text: "Sensex rallies", Labels: []string{"business"}}, {Text: "Sensex falls", Labels: []string{"business"}}}, ErrTooFewClasses},
+		{"no shared features", []Example{{Text: "Sensex rallies", Labels: []string{"business"}}, {Text: "Kohli century", Labels: []string{"sports"}}}, ErrNoExamples},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Train(tt.examples, Options{}); !errors.Is(err, tt.want) {
+				t.Errorf("got error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// trainCorpus trains a model on the fixture corpus with default options
+func trainCorpus(t *testing.T) *Model {
+	t.Helper()
+	model, err := Train(loadCorpus(t), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return model
+}
+
+func loadCorpus(t *testing.T) []Example {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "corpus.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var examples []Example
+	if err := json.Unmarshal(data, &examples); err != nil {
+		t.Fatal(err)
+	}
+	return examples
+}